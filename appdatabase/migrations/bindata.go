@@ -11,6 +11,10 @@
 // 0005_waku_mode.down.sql (0)
 // 0005_waku_mode.up.sql (146B)
 // 0006_appearance.up.sql (67B)
+// 0007_link_previews.up.sql (67B)
+// 0008_read_receipts.up.sql (74B)
+// 0009_hide_non_contact_messages.up.sql (81B)
+// 0010_read_receipts_clock.up.sql (81B)
 // doc.go (74B)
 
 package migrations
@@ -300,6 +304,86 @@ func _0006_appearanceUpSql() (*asset, error) {
 	return a, nil
 }
 
+var __0007_link_previewsUpSql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x00\x43\x00\xbc\xff\x41\x4c\x54\x45\x52\x20\x54\x41\x42\x4c\x45\x20\x73\x65\x74\x74\x69\x6e\x67\x73\x20\x41\x44\x44\x20\x43\x4f\x4c\x55\x4d\x4e\x20\x6c\x69\x6e\x6b\x5f\x70\x72\x65\x76\x69\x65\x77\x5f\x61\x6c\x6c\x6f\x77\x65\x64\x5f\x64\x6f\x6d\x61\x69\x6e\x73\x20\x42\x4c\x4f\x42\x3b\x0a\x03\x00\x62\x04\xb3\x82\x43\x00\x00\x00")
+
+func _0007_link_previewsUpSqlBytes() ([]byte, error) {
+	return bindataRead(
+		__0007_link_previewsUpSql,
+		"0007_link_previews.up.sql",
+	)
+}
+
+func _0007_link_previewsUpSql() (*asset, error) {
+	bytes, err := _0007_link_previewsUpSqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "0007_link_previews.up.sql", size: 67, mode: os.FileMode(0644), modTime: time.Unix(1792203419, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x7, 0x69, 0xe, 0xed, 0xad, 0x92, 0xa0, 0xf2, 0xb9, 0x29, 0x9d, 0x5, 0xda, 0x9, 0xc0, 0xba, 0xcc, 0xa7, 0x1d, 0xe8, 0x4b, 0x68, 0x6d, 0xcb, 0x4c, 0x1f, 0x5b, 0x38, 0xfa, 0x49, 0x90, 0x3f}}
+	return a, nil
+}
+
+var __0008_read_receiptsUpSql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x00\x4a\x00\xb5\xff\x41\x4c\x54\x45\x52\x20\x54\x41\x42\x4c\x45\x20\x73\x65\x74\x74\x69\x6e\x67\x73\x20\x41\x44\x44\x20\x43\x4f\x4c\x55\x4d\x4e\x20\x73\x65\x6e\x64\x5f\x72\x65\x61\x64\x5f\x72\x65\x63\x65\x69\x70\x74\x73\x20\x42\x4f\x4f\x4c\x45\x41\x4e\x20\x44\x45\x46\x41\x55\x4c\x54\x20\x66\x61\x6c\x73\x65\x3b\x0a\x03\x00\xb8\x95\x3b\xa2\x4a\x00\x00\x00")
+
+func _0008_read_receiptsUpSqlBytes() ([]byte, error) {
+	return bindataRead(
+		__0008_read_receiptsUpSql,
+		"0008_read_receipts.up.sql",
+	)
+}
+
+func _0008_read_receiptsUpSql() (*asset, error) {
+	bytes, err := _0008_read_receiptsUpSqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "0008_read_receipts.up.sql", size: 74, mode: os.FileMode(0644), modTime: time.Unix(1792204027, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x64, 0x87, 0x14, 0x88, 0x46, 0xd1, 0xa0, 0xf4, 0xae, 0xc0, 0xfc, 0x78, 0xe, 0x7d, 0x0, 0x11, 0x12, 0x84, 0xc7, 0xf1, 0xbd, 0xb8, 0x4a, 0xb8, 0x8, 0xb8, 0x4c, 0xa1, 0x41, 0x6c, 0x29, 0xd8}}
+	return a, nil
+}
+
+var __0009_hide_non_contact_messagesUpSql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x00\x51\x00\xae\xff\x41\x4c\x54\x45\x52\x20\x54\x41\x42\x4c\x45\x20\x73\x65\x74\x74\x69\x6e\x67\x73\x20\x41\x44\x44\x20\x43\x4f\x4c\x55\x4d\x4e\x20\x68\x69\x64\x65\x5f\x6e\x6f\x6e\x5f\x63\x6f\x6e\x74\x61\x63\x74\x5f\x6d\x65\x73\x73\x61\x67\x65\x73\x20\x42\x4f\x4f\x4c\x45\x41\x4e\x20\x44\x45\x46\x41\x55\x4c\x54\x20\x66\x61\x6c\x73\x65\x3b\x0a\x03\x00\x9b\xd4\x61\xa3\x51\x00\x00\x00")
+
+func _0009_hide_non_contact_messagesUpSqlBytes() ([]byte, error) {
+	return bindataRead(
+		__0009_hide_non_contact_messagesUpSql,
+		"0009_hide_non_contact_messages.up.sql",
+	)
+}
+
+func _0009_hide_non_contact_messagesUpSql() (*asset, error) {
+	bytes, err := _0009_hide_non_contact_messagesUpSqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "0009_hide_non_contact_messages.up.sql", size: 81, mode: os.FileMode(0644), modTime: time.Unix(1792205694, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x23, 0xae, 0xb3, 0xc1, 0x97, 0x77, 0x5, 0x93, 0x9c, 0x9d, 0xbd, 0x46, 0xd2, 0xcf, 0xcb, 0x2d, 0xb5, 0xb6, 0x96, 0xb0, 0x9f, 0x83, 0x9e, 0x4e, 0xfe, 0x58, 0xf2, 0x7b, 0x5b, 0xea, 0x7e, 0xe1}}
+	return a, nil
+}
+
+var __0010_read_receipts_clockUpSql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x00\x51\x00\xae\xff\x41\x4c\x54\x45\x52\x20\x54\x41\x42\x4c\x45\x20\x73\x65\x74\x74\x69\x6e\x67\x73\x20\x41\x44\x44\x20\x43\x4f\x4c\x55\x4d\x4e\x20\x73\x65\x6e\x64\x5f\x72\x65\x61\x64\x5f\x72\x65\x63\x65\x69\x70\x74\x73\x5f\x63\x6c\x6f\x63\x6b\x20\x49\x4e\x54\x20\x4e\x4f\x54\x20\x4e\x55\x4c\x4c\x20\x44\x45\x46\x41\x55\x4c\x54\x20\x30\x3b\x0a\x03\x00\x6c\x1a\x0d\xf6\x51\x00\x00\x00")
+
+func _0010_read_receipts_clockUpSqlBytes() ([]byte, error) {
+	return bindataRead(
+		__0010_read_receipts_clockUpSql,
+		"0010_read_receipts_clock.up.sql",
+	)
+}
+
+func _0010_read_receipts_clockUpSql() (*asset, error) {
+	bytes, err := _0010_read_receipts_clockUpSqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "0010_read_receipts_clock.up.sql", size: 81, mode: os.FileMode(0644), modTime: time.Unix(1792209986, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0xeb, 0xe2, 0xac, 0xd0, 0x62, 0xe1, 0x18, 0xd6, 0xe3, 0xff, 0x14, 0x61, 0xef, 0x5d, 0x85, 0xdd, 0x2, 0xaf, 0xad, 0x19, 0x9c, 0xae, 0x5c, 0x16, 0x37, 0xc6, 0x5e, 0xb, 0xa7, 0x63, 0xad, 0xc8}}
+	return a, nil
+}
+
 var _docGo = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x2c\xc9\xb1\x0d\xc4\x20\x0c\x05\xd0\x9e\x29\xfe\x02\xd8\xfd\x6d\xe3\x4b\xac\x2f\x44\x82\x09\x78\x7f\xa5\x49\xfd\xa6\x1d\xdd\xe8\xd8\xcf\x55\x8a\x2a\xe3\x47\x1f\xbe\x2c\x1d\x8c\xfa\x6f\xe3\xb4\x34\xd4\xd9\x89\xbb\x71\x59\xb6\x18\x1b\x35\x20\xa2\x9f\x0a\x03\xa2\xe5\x0d\x00\x00\xff\xff\x60\xcd\x06\xbe\x4a\x00\x00\x00")
 
 func docGoBytes() ([]byte, error) {
@@ -433,6 +517,14 @@ var _bindata = map[string]func() (*asset, error){
 
 	"0006_appearance.up.sql": _0006_appearanceUpSql,
 
+	"0007_link_previews.up.sql": _0007_link_previewsUpSql,
+
+	"0008_read_receipts.up.sql": _0008_read_receiptsUpSql,
+
+	"0009_hide_non_contact_messages.up.sql": _0009_hide_non_contact_messagesUpSql,
+
+	"0010_read_receipts_clock.up.sql": _0010_read_receipts_clockUpSql,
+
 	"doc.go": docGo,
 }
 
@@ -477,18 +569,22 @@ type bintree struct {
 }
 
 var _bintree = &bintree{nil, map[string]*bintree{
-	"0001_app.down.sql":              &bintree{_0001_appDownSql, map[string]*bintree{}},
-	"0001_app.up.sql":                &bintree{_0001_appUpSql, map[string]*bintree{}},
-	"0002_tokens.down.sql":           &bintree{_0002_tokensDownSql, map[string]*bintree{}},
-	"0002_tokens.up.sql":             &bintree{_0002_tokensUpSql, map[string]*bintree{}},
-	"0003_settings.down.sql":         &bintree{_0003_settingsDownSql, map[string]*bintree{}},
-	"0003_settings.up.sql":           &bintree{_0003_settingsUpSql, map[string]*bintree{}},
-	"0004_pending_stickers.down.sql": &bintree{_0004_pending_stickersDownSql, map[string]*bintree{}},
-	"0004_pending_stickers.up.sql":   &bintree{_0004_pending_stickersUpSql, map[string]*bintree{}},
-	"0005_waku_mode.down.sql":        &bintree{_0005_waku_modeDownSql, map[string]*bintree{}},
-	"0005_waku_mode.up.sql":          &bintree{_0005_waku_modeUpSql, map[string]*bintree{}},
-	"0006_appearance.up.sql":         &bintree{_0006_appearanceUpSql, map[string]*bintree{}},
-	"doc.go":                         &bintree{docGo, map[string]*bintree{}},
+	"0001_app.down.sql":                     &bintree{_0001_appDownSql, map[string]*bintree{}},
+	"0001_app.up.sql":                       &bintree{_0001_appUpSql, map[string]*bintree{}},
+	"0002_tokens.down.sql":                  &bintree{_0002_tokensDownSql, map[string]*bintree{}},
+	"0002_tokens.up.sql":                    &bintree{_0002_tokensUpSql, map[string]*bintree{}},
+	"0003_settings.down.sql":                &bintree{_0003_settingsDownSql, map[string]*bintree{}},
+	"0003_settings.up.sql":                  &bintree{_0003_settingsUpSql, map[string]*bintree{}},
+	"0004_pending_stickers.down.sql":        &bintree{_0004_pending_stickersDownSql, map[string]*bintree{}},
+	"0004_pending_stickers.up.sql":          &bintree{_0004_pending_stickersUpSql, map[string]*bintree{}},
+	"0005_waku_mode.down.sql":               &bintree{_0005_waku_modeDownSql, map[string]*bintree{}},
+	"0005_waku_mode.up.sql":                 &bintree{_0005_waku_modeUpSql, map[string]*bintree{}},
+	"0006_appearance.up.sql":                &bintree{_0006_appearanceUpSql, map[string]*bintree{}},
+	"0007_link_previews.up.sql":             &bintree{_0007_link_previewsUpSql, map[string]*bintree{}},
+	"0008_read_receipts.up.sql":             &bintree{_0008_read_receiptsUpSql, map[string]*bintree{}},
+	"0009_hide_non_contact_messages.up.sql": &bintree{_0009_hide_non_contact_messagesUpSql, map[string]*bintree{}},
+	"0010_read_receipts_clock.up.sql":       &bintree{_0010_read_receipts_clockUpSql, map[string]*bintree{}},
+	"doc.go":                                &bintree{docGo, map[string]*bintree{}},
 }}
 
 // RestoreAsset restores an asset under the given directory.
@@ -0,0 +1 @@
+ALTER TABLE settings ADD COLUMN link_preview_allowed_domains BLOB;
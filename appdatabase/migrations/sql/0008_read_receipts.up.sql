@@ -0,0 +1 @@
+ALTER TABLE settings ADD COLUMN send_read_receipts BOOLEAN DEFAULT false;
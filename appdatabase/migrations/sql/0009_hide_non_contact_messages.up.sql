@@ -0,0 +1 @@
+ALTER TABLE settings ADD COLUMN hide_non_contact_messages BOOLEAN DEFAULT false;
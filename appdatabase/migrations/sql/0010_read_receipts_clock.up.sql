@@ -0,0 +1 @@
+ALTER TABLE settings ADD COLUMN send_read_receipts_clock INT NOT NULL DEFAULT 0;
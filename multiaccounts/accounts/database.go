@@ -38,46 +38,49 @@ type Account struct {
 
 type Settings struct {
 	// required
-	Address                types.Address    `json:"address"`
-	ChaosMode              bool             `json:"chaos-mode?,omitempty"`
-	Currency               string           `json:"currency,omitempty"`
-	CurrentNetwork         string           `json:"networks/current-network"`
-	CustomBootnodes        *json.RawMessage `json:"custom-bootnodes,omitempty"`
-	CustomBootnodesEnabled *json.RawMessage `json:"custom-bootnodes-enabled?,omitempty"`
-	DappsAddress           types.Address    `json:"dapps-address"`
-	EIP1581Address         types.Address    `json:"eip1581-address"`
-	Fleet                  *string          `json:"fleet,omitempty"`
-	HideHomeTooltip        bool             `json:"hide-home-tooltip?,omitempty"`
-	InstallationID         string           `json:"installation-id"`
-	KeyUID                 string           `json:"key-uid"`
-	KeycardInstanceUID     string           `json:"keycard-instance-uid,omitempty"`
-	KeycardPAiredOn        int64            `json:"keycard-paired-on,omitempty"`
-	KeycardPairing         string           `json:"keycard-pairing,omitempty"`
-	LastUpdated            *int64           `json:"last-updated,omitempty"`
-	LatestDerivedPath      uint             `json:"latest-derived-path"`
-	LogLevel               *string          `json:"log-level,omitempty"`
-	Mnemonic               *string          `json:"mnemonic,omitempty"`
-	Name                   string           `json:"name,omitempty"`
-	Networks               *json.RawMessage `json:"networks/networks"`
-	NotificationsEnabled   bool             `json:"notifications-enabled?,omitempty"`
-	PhotoPath              string           `json:"photo-path"`
-	PinnedMailserver       *json.RawMessage `json:"pinned-mailservers,omitempty"`
-	PreferredName          *string          `json:"preferred-name,omitempty"`
-	PreviewPrivacy         bool             `json:"preview-privacy?"`
-	PublicKey              string           `json:"public-key"`
-	RememberSyncingChoice  bool             `json:"remember-syncing-choice?,omitempty"`
-	SigningPhrase          string           `json:"signing-phrase"`
-	StickerPacksInstalled  *json.RawMessage `json:"stickers/packs-installed,omitempty"`
-	StickerPacksPending    *json.RawMessage `json:"stickers/packs-pending,omitempty"`
-	StickersRecentStickers *json.RawMessage `json:"stickers/recent-stickers,omitempty"`
-	SyncingOnMobileNetwork bool             `json:"syncing-on-mobile-network?,omitempty"`
-	Appearance             uint             `json:"appearance"`
-	Usernames              *json.RawMessage `json:"usernames,omitempty"`
-	WalletRootAddress      types.Address    `json:"wallet-root-address,omitempty"`
-	WalletSetUpPassed      bool             `json:"wallet-set-up-passed?,omitempty"`
-	WalletVisibleTokens    *json.RawMessage `json:"wallet/visible-tokens,omitempty"`
-	WakuEnabled            bool             `json:"waku-enabled,omitempty"`
-	WakuBloomFilterMode    bool             `json:"waku-bloom-filter-mode,omitempty"`
+	Address                   types.Address    `json:"address"`
+	ChaosMode                 bool             `json:"chaos-mode?,omitempty"`
+	Currency                  string           `json:"currency,omitempty"`
+	CurrentNetwork            string           `json:"networks/current-network"`
+	CustomBootnodes           *json.RawMessage `json:"custom-bootnodes,omitempty"`
+	CustomBootnodesEnabled    *json.RawMessage `json:"custom-bootnodes-enabled?,omitempty"`
+	DappsAddress              types.Address    `json:"dapps-address"`
+	EIP1581Address            types.Address    `json:"eip1581-address"`
+	Fleet                     *string          `json:"fleet,omitempty"`
+	HideNonContactMessages    bool             `json:"hide-non-contact-messages?,omitempty"`
+	HideHomeTooltip           bool             `json:"hide-home-tooltip?,omitempty"`
+	InstallationID            string           `json:"installation-id"`
+	KeyUID                    string           `json:"key-uid"`
+	KeycardInstanceUID        string           `json:"keycard-instance-uid,omitempty"`
+	KeycardPAiredOn           int64            `json:"keycard-paired-on,omitempty"`
+	KeycardPairing            string           `json:"keycard-pairing,omitempty"`
+	LastUpdated               *int64           `json:"last-updated,omitempty"`
+	LatestDerivedPath         uint             `json:"latest-derived-path"`
+	LinkPreviewAllowedDomains *json.RawMessage `json:"link-previews/allowed-domains,omitempty"`
+	LogLevel                  *string          `json:"log-level,omitempty"`
+	Mnemonic                  *string          `json:"mnemonic,omitempty"`
+	Name                      string           `json:"name,omitempty"`
+	Networks                  *json.RawMessage `json:"networks/networks"`
+	NotificationsEnabled      bool             `json:"notifications-enabled?,omitempty"`
+	PhotoPath                 string           `json:"photo-path"`
+	PinnedMailserver          *json.RawMessage `json:"pinned-mailservers,omitempty"`
+	PreferredName             *string          `json:"preferred-name,omitempty"`
+	PreviewPrivacy            bool             `json:"preview-privacy?"`
+	PublicKey                 string           `json:"public-key"`
+	RememberSyncingChoice     bool             `json:"remember-syncing-choice?,omitempty"`
+	SendReadReceipts          bool             `json:"send-read-receipts?,omitempty"`
+	SigningPhrase             string           `json:"signing-phrase"`
+	StickerPacksInstalled     *json.RawMessage `json:"stickers/packs-installed,omitempty"`
+	StickerPacksPending       *json.RawMessage `json:"stickers/packs-pending,omitempty"`
+	StickersRecentStickers    *json.RawMessage `json:"stickers/recent-stickers,omitempty"`
+	SyncingOnMobileNetwork    bool             `json:"syncing-on-mobile-network?,omitempty"`
+	Appearance                uint             `json:"appearance"`
+	Usernames                 *json.RawMessage `json:"usernames,omitempty"`
+	WalletRootAddress         types.Address    `json:"wallet-root-address,omitempty"`
+	WalletSetUpPassed         bool             `json:"wallet-set-up-passed?,omitempty"`
+	WalletVisibleTokens       *json.RawMessage `json:"wallet/visible-tokens,omitempty"`
+	WakuEnabled               bool             `json:"waku-enabled,omitempty"`
+	WakuBloomFilterMode       bool             `json:"waku-bloom-filter-mode,omitempty"`
 }
 
 func NewDB(db *sql.DB) *Database {
@@ -191,6 +194,12 @@ func (db *Database) SaveSetting(setting string, value interface{}) error {
 			return ErrInvalidConfig
 		}
 		update, err = db.db.Prepare("UPDATE settings SET hide_home_tooltip = ? WHERE synthetic_id = 'id'")
+	case "hide-non-contact-messages?":
+		_, ok := value.(bool)
+		if !ok {
+			return ErrInvalidConfig
+		}
+		update, err = db.db.Prepare("UPDATE settings SET hide_non_contact_messages = ? WHERE synthetic_id = 'id'")
 	case "keycard-instance_uid":
 		update, err = db.db.Prepare("UPDATE settings SET keycard_instance_uid = ? WHERE synthetic_id = 'id'")
 	case "keycard-paired_on":
@@ -201,6 +210,9 @@ func (db *Database) SaveSetting(setting string, value interface{}) error {
 		update, err = db.db.Prepare("UPDATE settings SET last_updated = ? WHERE synthetic_id = 'id'")
 	case "latest-derived-path":
 		update, err = db.db.Prepare("UPDATE settings SET latest_derived_path = ? WHERE synthetic_id = 'id'")
+	case "link-previews/allowed-domains":
+		value = &sqlite.JSONBlob{value}
+		update, err = db.db.Prepare("UPDATE settings SET link_preview_allowed_domains = ? WHERE synthetic_id = 'id'")
 	case "log-level":
 		update, err = db.db.Prepare("UPDATE settings SET log_level = ? WHERE synthetic_id = 'id'")
 	case "mnemonic":
@@ -298,9 +310,51 @@ func (db *Database) GetNodeConfig(nodecfg interface{}) error {
 	return db.db.QueryRow("SELECT node_config FROM settings WHERE synthetic_id = 'id'").Scan(&sqlite.JSONBlob{nodecfg})
 }
 
+// GetLinkPreviewAllowedDomains returns the domains for which links are
+// unfurled when sending messages
+func (db *Database) GetLinkPreviewAllowedDomains() (rst []string, err error) {
+	err = db.db.QueryRow("SELECT link_preview_allowed_domains FROM settings WHERE synthetic_id = 'id'").Scan(&sqlite.JSONBlob{&rst})
+	return
+}
+
+// GetSendReadReceipts returns whether read receipts are sent to contacts
+func (db *Database) GetSendReadReceipts() (rst bool, err error) {
+	err = db.db.QueryRow("SELECT send_read_receipts FROM settings WHERE synthetic_id = 'id'").Scan(&rst)
+	return
+}
+
+// GetSendReadReceiptsClock returns the clock value of the last change of
+// the read receipts setting
+func (db *Database) GetSendReadReceiptsClock() (rst uint64, err error) {
+	err = db.db.QueryRow("SELECT send_read_receipts_clock FROM settings WHERE synthetic_id = 'id'").Scan(&rst)
+	return
+}
+
+// SetSendReadReceipts sets whether read receipts are sent to contacts, along
+// with the clock value of the change. It's not a setting SaveSetting can
+// change, the change has to be synced to paired installations by the
+// messenger
+func (db *Database) SetSendReadReceipts(enabled bool, clock uint64) error {
+	_, err := db.db.Exec("UPDATE settings SET send_read_receipts = ?, send_read_receipts_clock = ? WHERE synthetic_id = 'id'", enabled, clock)
+	return err
+}
+
+// GetHideNonContactMessages returns whether one-to-one messages from
+// non-contacts are hidden
+func (db *Database) GetHideNonContactMessages() (rst bool, err error) {
+	err = db.db.QueryRow("SELECT hide_non_contact_messages FROM settings WHERE synthetic_id = 'id'").Scan(&rst)
+	return
+}
+
+// SetHideNonContactMessages sets whether one-to-one messages from
+// non-contacts are hidden
+func (db *Database) SetHideNonContactMessages(enabled bool) error {
+	return db.SaveSetting("hide-non-contact-messages?", enabled)
+}
+
 func (db *Database) GetSettings() (Settings, error) {
 	var s Settings
-	err := db.db.QueryRow("SELECT address, chaos_mode, currency, current_network, custom_bootnodes, custom_bootnodes_enabled, dapps_address, eip1581_address, fleet, hide_home_tooltip, installation_id, key_uid, keycard_instance_uid, keycard_paired_on, keycard_pairing, last_updated, latest_derived_path, log_level, mnemonic, name, networks, notifications_enabled, photo_path, pinned_mailservers, preferred_name, preview_privacy, public_key, remember_syncing_choice, signing_phrase, stickers_packs_installed, stickers_packs_pending, stickers_recent_stickers, syncing_on_mobile_network, usernames, appearance, wallet_root_address, wallet_set_up_passed, wallet_visible_tokens, waku_enabled, waku_bloom_filter_mode FROM settings WHERE synthetic_id = 'id'").Scan(
+	err := db.db.QueryRow("SELECT address, chaos_mode, currency, current_network, custom_bootnodes, custom_bootnodes_enabled, dapps_address, eip1581_address, fleet, hide_home_tooltip, hide_non_contact_messages, installation_id, key_uid, keycard_instance_uid, keycard_paired_on, keycard_pairing, last_updated, latest_derived_path, link_preview_allowed_domains, log_level, mnemonic, name, networks, notifications_enabled, photo_path, pinned_mailservers, preferred_name, preview_privacy, public_key, remember_syncing_choice, send_read_receipts, signing_phrase, stickers_packs_installed, stickers_packs_pending, stickers_recent_stickers, syncing_on_mobile_network, usernames, appearance, wallet_root_address, wallet_set_up_passed, wallet_visible_tokens, waku_enabled, waku_bloom_filter_mode FROM settings WHERE synthetic_id = 'id'").Scan(
 		&s.Address,
 		&s.ChaosMode,
 		&s.Currency,
@@ -311,6 +365,7 @@ func (db *Database) GetSettings() (Settings, error) {
 		&s.EIP1581Address,
 		&s.Fleet,
 		&s.HideHomeTooltip,
+		&s.HideNonContactMessages,
 		&s.InstallationID,
 		&s.KeyUID,
 		&s.KeycardInstanceUID,
@@ -318,6 +373,7 @@ func (db *Database) GetSettings() (Settings, error) {
 		&s.KeycardPairing,
 		&s.LastUpdated,
 		&s.LatestDerivedPath,
+		&s.LinkPreviewAllowedDomains,
 		&s.LogLevel,
 		&s.Mnemonic,
 		&s.Name,
@@ -329,6 +385,7 @@ func (db *Database) GetSettings() (Settings, error) {
 		&s.PreviewPrivacy,
 		&s.PublicKey,
 		&s.RememberSyncingChoice,
+		&s.SendReadReceipts,
 		&s.SigningPhrase,
 		&s.StickerPacksInstalled,
 		&s.StickerPacksPending,
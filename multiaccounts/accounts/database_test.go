@@ -71,6 +71,74 @@ func TestSaveSetting(t *testing.T) {
 	require.NoError(t, err)
 }
 
+func TestGetLinkPreviewAllowedDomains(t *testing.T) {
+	db, stop := setupTestDB(t)
+	defer stop()
+
+	require.NoError(t, db.CreateSettings(settings, config))
+
+	domains, err := db.GetLinkPreviewAllowedDomains()
+	require.NoError(t, err)
+	require.Empty(t, domains)
+
+	require.NoError(t, db.SaveSetting("link-previews/allowed-domains", []string{"status.im", "github.com"}))
+
+	domains, err = db.GetLinkPreviewAllowedDomains()
+	require.NoError(t, err)
+	require.Equal(t, []string{"status.im", "github.com"}, domains)
+}
+
+func TestSendReadReceipts(t *testing.T) {
+	db, stop := setupTestDB(t)
+	defer stop()
+
+	require.NoError(t, db.CreateSettings(settings, config))
+
+	enabled, err := db.GetSendReadReceipts()
+	require.NoError(t, err)
+	require.False(t, enabled)
+
+	clock, err := db.GetSendReadReceiptsClock()
+	require.NoError(t, err)
+	require.Equal(t, uint64(0), clock)
+
+	require.NoError(t, db.SetSendReadReceipts(true, 10))
+
+	enabled, err = db.GetSendReadReceipts()
+	require.NoError(t, err)
+	require.True(t, enabled)
+
+	clock, err = db.GetSendReadReceiptsClock()
+	require.NoError(t, err)
+	require.Equal(t, uint64(10), clock)
+
+	require.Equal(t, ErrInvalidConfig, db.SaveSetting("send-read-receipts?", "true"))
+	require.Equal(t, ErrInvalidConfig, db.SaveSetting("send-read-receipts?", false))
+
+	enabled, err = db.GetSendReadReceipts()
+	require.NoError(t, err)
+	require.True(t, enabled)
+}
+
+func TestHideNonContactMessages(t *testing.T) {
+	db, stop := setupTestDB(t)
+	defer stop()
+
+	require.NoError(t, db.CreateSettings(settings, config))
+
+	enabled, err := db.GetHideNonContactMessages()
+	require.NoError(t, err)
+	require.False(t, enabled)
+
+	require.NoError(t, db.SetHideNonContactMessages(true))
+
+	enabled, err = db.GetHideNonContactMessages()
+	require.NoError(t, err)
+	require.True(t, enabled)
+
+	require.Equal(t, ErrInvalidConfig, db.SaveSetting("hide-non-contact-messages?", "true"))
+}
+
 func TestGetNodeConfig(t *testing.T) {
 	db, stop := setupTestDB(t)
 	defer stop()
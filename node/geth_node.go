@@ -369,7 +369,9 @@ func activateShhService(stack *node.Node, config *params.NodeConfig, db *leveldb
 		if err := ctx.Service(&ethnode); err != nil {
 			return nil, err
 		}
-		return shhext.New(config.ShhextConfig, ethnode.Node, ctx, ext.EnvelopeSignalHandler{}, db), nil
+		shhextConfig := config.ShhextConfig
+		shhextConfig.MaxMessageSize = config.WhisperConfig.MaxMessageSize
+		return shhext.New(shhextConfig, ethnode.Node, ctx, ext.EnvelopeSignalHandler{}, db), nil
 	})
 }
 
@@ -409,7 +411,9 @@ func activateWakuService(stack *node.Node, config *params.NodeConfig, db *leveld
 		if err := ctx.Service(&ethnode); err != nil {
 			return nil, err
 		}
-		return wakuext.New(config.ShhextConfig, ethnode.Node, ctx, ext.EnvelopeSignalHandler{}, db), nil
+		shhextConfig := config.ShhextConfig
+		shhextConfig.MaxMessageSize = config.WakuConfig.MaxMessageSize
+		return wakuext.New(shhextConfig, ethnode.Node, ctx, ext.EnvelopeSignalHandler{}, db), nil
 	})
 }
 
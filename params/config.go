@@ -544,6 +544,10 @@ type ShhextConfig struct {
 	VerifyENSContractAddress string
 
 	VerifyTransactionChainID int64
+
+	// MaxMessageSize is the maximum size of the envelopes of the transport
+	// protocol, it's set from the configuration of Whisper or Waku
+	MaxMessageSize uint32 `json:"-"`
 }
 
 // Validate validates the ShhextConfig struct and returns an error if inconsistent values are found
@@ -10,6 +10,7 @@ import (
 	"github.com/status-im/status-go/eth-node/crypto"
 	"github.com/status-im/status-go/eth-node/types"
 	"github.com/status-im/status-go/protocol/protobuf"
+	"github.com/status-im/status-go/protocol/transport"
 	v1protocol "github.com/status-im/status-go/protocol/v1"
 )
 
@@ -28,12 +29,28 @@ const (
 	ChatTypeOneToOne ChatType = iota + 1
 	ChatTypePublic
 	ChatTypePrivateGroupChat
+	ChatTypeCommunityChat
+	ChatTypeTimeline
+)
+
+// ChatNotificationLevel indicates which messages of a chat notify the user
+type ChatNotificationLevel int
+
+const (
+	// ChatNotificationLevelAll notifies every new message
+	ChatNotificationLevelAll ChatNotificationLevel = iota
+	// ChatNotificationLevelMentions only notifies messages mentioning the user
+	ChatNotificationLevelMentions
+	// ChatNotificationLevelNone never notifies
+	ChatNotificationLevelNone
 )
 
 type Chat struct {
 	// ID is the id of the chat, for public chats it is the name e.g. status, for one-to-one
-	// is the hex encoded public key and for group chats is a random uuid appended with
-	// the hex encoded pk of the creator of the chat
+	// is the hex encoded public key, for group chats is a random uuid appended with
+	// the hex encoded pk of the creator of the chat, for community chats is the
+	// id of the community appended with the id of the channel and for timelines
+	// is the timeline topic of their owner
 	ID    string `json:"id"`
 	Name  string `json:"name"`
 	Color string `json:"color"`
@@ -52,13 +69,39 @@ type Chat struct {
 
 	// Denormalized fields
 	UnviewedMessagesCount uint   `json:"unviewedMessagesCount"`
+	UnviewedMentionsCount uint   `json:"unviewedMentionsCount"`
 	LastMessage           []byte `json:"lastMessage"`
 
+	// Notification settings
+	// Muted indicates whether the notifications of the chat are silenced
+	Muted bool `json:"muted"`
+	// MutedUntil is the timestamp in ms until which the chat is muted, 0 if
+	// it's muted until it's unmuted
+	MutedUntil        uint64                `json:"mutedUntil"`
+	NotificationLevel ChatNotificationLevel `json:"notificationLevel"`
+	// NotificationSettingsClock is the clock value of the last change to the
+	// notification settings, used when syncing them with paired devices
+	NotificationSettingsClock uint64 `json:"notificationSettingsClock"`
+
+	// DisappearingMessagesTimer is the time in ms after which the messages
+	// sent to the chat are deleted, 0 if they never are
+	DisappearingMessagesTimer uint64 `json:"disappearingMessagesTimer"`
+	// DisappearingMessagesClock is the clock value of the last change to the
+	// timer, agreed on by all the members of the chat
+	DisappearingMessagesClock uint64 `json:"disappearingMessagesClock"`
+
 	// Group chat fields
+	// Description is the description of the group chat set by its admins
+	Description string `json:"description,omitempty"`
+	// Image is the encoded image of the group chat set by its admins
+	Image []byte `json:"image,omitempty"`
 	// Members are the members who have been invited to the group chat
 	Members []ChatMember `json:"members"`
 	// MembershipUpdates is all the membership events in the chat
 	MembershipUpdates []v1protocol.MembershipUpdateEvent `json:"membershipUpdateEvents"`
+
+	// CommunityID is the id of the community of a community chat
+	CommunityID string `json:"communityId,omitempty"`
 }
 
 func (c *Chat) PublicKey() (*ecdsa.PublicKey, error) {
@@ -83,6 +126,93 @@ func (c *Chat) OneToOne() bool {
 	return c.ChatType == ChatTypeOneToOne
 }
 
+func (c *Chat) PrivateGroupChat() bool {
+	return c.ChatType == ChatTypePrivateGroupChat
+}
+
+func (c *Chat) CommunityChat() bool {
+	return c.ChatType == ChatTypeCommunityChat
+}
+
+func (c *Chat) Timeline() bool {
+	return c.ChatType == ChatTypeTimeline
+}
+
+// IsAdmin returns whether the member identified by the hex encoded public
+// key is an admin of the chat
+func (c *Chat) IsAdmin(id string) bool {
+	for _, member := range c.Members {
+		if member.ID == id {
+			return member.Admin
+		}
+	}
+	return false
+}
+
+// IsMember returns whether the member identified by the hex encoded public
+// key is a member of the chat
+func (c *Chat) IsMember(id string) bool {
+	for _, member := range c.Members {
+		if member.ID == id {
+			return true
+		}
+	}
+	return false
+}
+
+// MessageType returns the protobuf message type of the messages sent in the chat
+func (c *Chat) MessageType() protobuf.ChatMessage_MessageType {
+	switch c.ChatType {
+	case ChatTypeOneToOne:
+		return protobuf.ChatMessage_ONE_TO_ONE
+	case ChatTypePublic:
+		return protobuf.ChatMessage_PUBLIC_GROUP
+	case ChatTypePrivateGroupChat:
+		return protobuf.ChatMessage_PRIVATE_GROUP
+	case ChatTypeCommunityChat:
+		return protobuf.ChatMessage_COMMUNITY_CHAT
+	case ChatTypeTimeline:
+		return protobuf.ChatMessage_TIMELINE
+	default:
+		return protobuf.ChatMessage_UNKNOWN_MESSAGE_TYPE
+	}
+}
+
+// IsMuted returns whether the chat is muted at the given timestamp in ms
+func (c *Chat) IsMuted(now uint64) bool {
+	return c.Muted && (c.MutedUntil == 0 || c.MutedUntil > now)
+}
+
+// ShouldNotify returns whether a new message received in the chat at the
+// given timestamp in ms should notify the user
+func (c *Chat) ShouldNotify(message *Message, now uint64) bool {
+	if c.IsMuted(now) {
+		return false
+	}
+	switch c.NotificationLevel {
+	case ChatNotificationLevelAll:
+		return true
+	case ChatNotificationLevelMentions:
+		return message.Mentioned
+	default:
+		return false
+	}
+}
+
+// applyNotificationSettings applies the notification settings synced by a
+// paired device, unless they are older than ours
+func (c *Chat) applyNotificationSettings(settings *protobuf.SyncChatNotificationSettings) bool {
+	if c.NotificationSettingsClock >= settings.Clock {
+		return false
+	}
+
+	c.Muted = settings.Muted
+	c.MutedUntil = settings.MutedUntil
+	c.NotificationLevel = ChatNotificationLevel(settings.NotificationLevel)
+	c.NotificationSettingsClock = settings.Clock
+	return true
+}
+
 func (c *Chat) Validate() error {
 	if c.ID == "" {
 		return errors.New("chatID can't be blank")
@@ -129,8 +259,18 @@ func (c *Chat) UnmarshalJSON(data []byte) error {
 	c.LastClockValue = aux.LastClockValue
 	c.DeletedAtClockValue = aux.DeletedAtClockValue
 	c.UnviewedMessagesCount = aux.UnviewedMessagesCount
+	c.UnviewedMentionsCount = aux.UnviewedMentionsCount
+	c.Muted = aux.Muted
+	c.MutedUntil = aux.MutedUntil
+	c.NotificationLevel = aux.NotificationLevel
+	c.NotificationSettingsClock = aux.NotificationSettingsClock
+	c.DisappearingMessagesTimer = aux.DisappearingMessagesTimer
+	c.DisappearingMessagesClock = aux.DisappearingMessagesClock
+	c.Description = aux.Description
+	c.Image = aux.Image
 	c.Members = aux.Members
 	c.MembershipUpdates = aux.MembershipUpdates
+	c.CommunityID = aux.CommunityID
 
 	if aux.LastMessage != nil {
 		data, err := json.Marshal(aux.LastMessage)
@@ -157,6 +297,10 @@ func (c *Chat) updateChatFromProtocolGroup(g *v1protocol.Group) {
 	// Name
 	c.Name = g.Name()
 
+	// Description and image
+	c.Description = g.Description()
+	c.Image = g.Image()
+
 	// Members
 	members := g.Members()
 	admins := g.Admins()
@@ -167,6 +311,7 @@ func (c *Chat) updateChatFromProtocolGroup(g *v1protocol.Group) {
 			ID: m,
 		}
 		chatMember.Admin = stringSliceContains(admins, m)
+		chatMember.Owner = m == g.Owner()
 		chatMember.Joined = stringSliceContains(joined, m)
 		chatMembers = append(chatMembers, chatMember)
 	}
@@ -229,6 +374,9 @@ type ChatMember struct {
 	ID string `json:"id"`
 	// Admin indicates if the member is an admin of the group chat
 	Admin bool `json:"admin"`
+	// Owner indicates if the member is the owner of the group chat, the
+	// admin who can remove other admins
+	Owner bool `json:"owner"`
 	// Joined indicates if the member has joined the group chat
 	Joined bool `json:"joined"`
 }
@@ -282,6 +430,43 @@ func CreateGroupChat(timesource TimeSource) Chat {
 	}
 }
 
+// CreateCommunityChat returns the chat of a channel of a community
+func CreateCommunityChat(communityID string, channel *protobuf.CommunityChannel, timesource TimeSource) Chat {
+	return Chat{
+		ID:          communityChatID(communityID, channel.Id),
+		Name:        channel.Name,
+		Description: channel.Description,
+		Active:      true,
+		Timestamp:   int64(timesource.GetCurrentTime()),
+		Color:       chatColors[rand.Intn(len(chatColors))],
+		ChatType:    ChatTypeCommunityChat,
+		CommunityID: communityID,
+	}
+}
+
+func communityChatID(communityID string, channelID string) string {
+	return communityID + channelID
+}
+
+// CreateTimelineChat returns the timeline of the user with the given public
+// key, who is its only admin and the only one allowed to post status updates
+func CreateTimelineChat(publicKey *ecdsa.PublicKey, timesource TimeSource) Chat {
+	ownerID := types.EncodeHex(crypto.FromECDSAPub(publicKey))
+	return Chat{
+		ID:        timelineChatID(publicKey),
+		Name:      ownerID[:8],
+		Active:    true,
+		Timestamp: int64(timesource.GetCurrentTime()),
+		Color:     chatColors[rand.Intn(len(chatColors))],
+		ChatType:  ChatTypeTimeline,
+		Members:   []ChatMember{{ID: ownerID, Admin: true, Owner: true, Joined: true}},
+	}
+}
+
+func timelineChatID(publicKey *ecdsa.PublicKey) string {
+	return transport.TimelineTopic(publicKey)
+}
+
 func stringSliceToPublicKeys(slice []string, prefixed bool) ([]*ecdsa.PublicKey, error) {
 	result := make([]*ecdsa.PublicKey, len(slice))
 	for idx, item := range slice {
@@ -313,3 +498,12 @@ func stringSliceContains(slice []string, item string) bool {
 	}
 	return false
 }
+
+func chatsContain(chats []*Chat, chatID string) bool {
+	for _, chat := range chats {
+		if chat.ID == chatID {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,15 @@
+package protocol
+
+import (
+	"crypto/ecdsa"
+
+	"github.com/status-im/status-go/protocol/protobuf"
+)
+
+// ChatEntity is anything that is sent in a chat and can be matched
+// against a local chat, i.e. a message or a reaction to a message.
+type ChatEntity interface {
+	GetChatId() string
+	GetMessageType() protobuf.ChatMessage_MessageType
+	GetSigPubKey() *ecdsa.PublicKey
+}
@@ -0,0 +1,463 @@
+package protocol
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"html/template"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/status-im/status-go/eth-node/crypto"
+	"github.com/status-im/status-go/eth-node/types"
+	"github.com/status-im/status-go/protocol/protobuf"
+)
+
+// ExportFormat is the format a chat is exported in
+type ExportFormat string
+
+const (
+	// ExportFormatJSON exports all the fields of the messages, the export
+	// can be imported back with ImportChat
+	ExportFormatJSON ExportFormat = "json"
+	// ExportFormatMarkdown and ExportFormatHTML are human readable exports,
+	// they can't be imported
+	ExportFormatMarkdown ExportFormat = "markdown"
+	ExportFormatHTML     ExportFormat = "html"
+)
+
+// chatExportVersion is the version of the JSON export format
+const chatExportVersion = 1
+
+// exportPageSize is the number of messages loaded at once when exporting
+const exportPageSize = 1000
+
+// ChatExport is the JSON export of a chat
+type ChatExport struct {
+	Version    int                `json:"version"`
+	ExportedAt uint64             `json:"exportedAt"`
+	ChatID     string             `json:"chatId"`
+	ChatName   string             `json:"chatName"`
+	ChatType   ChatType           `json:"chatType"`
+	Messages   []*ExportedMessage `json:"messages"`
+}
+
+// ExportedMessage is a message in a JSON export. Unlike the JSON encoding of
+// Message it contains only stored fields, so that it can be imported back
+type ExportedMessage struct {
+	ID                    string                           `json:"id"`
+	WhisperTimestamp      uint64                           `json:"whisperTimestamp"`
+	From                  string                           `json:"from"`
+	Alias                 string                           `json:"alias"`
+	Seen                  bool                             `json:"seen"`
+	OutgoingStatus        string                           `json:"outgoingStatus,omitempty"`
+	Clock                 uint64                           `json:"clock"`
+	Timestamp             uint64                           `json:"timestamp"`
+	Text                  string                           `json:"text"`
+	ResponseTo            string                           `json:"responseTo,omitempty"`
+	EnsName               string                           `json:"ensName,omitempty"`
+	ChatID                string                           `json:"chatId"`
+	MessageType           protobuf.ChatMessage_MessageType `json:"messageType"`
+	ContentType           protobuf.ChatMessage_ContentType `json:"contentType"`
+	Sticker               *protobuf.StickerMessage         `json:"sticker,omitempty"`
+	Image                 *protobuf.ImageMessage           `json:"image,omitempty"`
+	Audio                 *protobuf.AudioMessage           `json:"audio,omitempty"`
+	Poll                  *protobuf.PollMessage            `json:"poll,omitempty"`
+	Links                 []*protobuf.UnfurledLink         `json:"links,omitempty"`
+	ForwardedFrom         *protobuf.ForwardedFrom          `json:"forwardedFrom,omitempty"`
+	ForwardedFromVerified bool                             `json:"forwardedFromVerified,omitempty"`
+	CommandParameters     *CommandParameters               `json:"commandParameters,omitempty"`
+	EditedAt              uint64                           `json:"editedAt,omitempty"`
+	Deleted               bool                             `json:"deleted,omitempty"`
+	Mentioned             bool                             `json:"mentioned,omitempty"`
+}
+
+func newExportedMessage(message *Message) *ExportedMessage {
+	return &ExportedMessage{
+		ID:                    message.ID,
+		WhisperTimestamp:      message.WhisperTimestamp,
+		From:                  message.From,
+		Alias:                 message.Alias,
+		Seen:                  message.Seen,
+		OutgoingStatus:        message.OutgoingStatus,
+		Clock:                 message.Clock,
+		Timestamp:             message.Timestamp,
+		Text:                  message.Text,
+		ResponseTo:            message.ResponseTo,
+		EnsName:               message.EnsName,
+		ChatID:                message.ChatId,
+		MessageType:           message.MessageType,
+		ContentType:           message.ContentType,
+		Sticker:               message.GetSticker(),
+		Image:                 message.GetImage(),
+		Audio:                 message.GetAudio(),
+		Poll:                  message.GetPoll(),
+		Links:                 message.Links,
+		ForwardedFrom:         message.ForwardedFrom,
+		ForwardedFromVerified: message.ForwardedFromVerified,
+		CommandParameters:     message.CommandParameters,
+		EditedAt:              message.EditedAt,
+		Deleted:               message.Deleted,
+		Mentioned:             message.Mentioned,
+	}
+}
+
+func (e *ExportedMessage) toMessage(localChatID string) *Message {
+	message := &Message{
+		ID:                e.ID,
+		WhisperTimestamp:  e.WhisperTimestamp,
+		From:              e.From,
+		Alias:             e.Alias,
+		LocalChatID:       localChatID,
+		Seen:              e.Seen,
+		OutgoingStatus:    e.OutgoingStatus,
+		CommandParameters: e.CommandParameters,
+		EditedAt:          e.EditedAt,
+		Deleted:           e.Deleted,
+		Mentioned:         e.Mentioned,
+	}
+	message.Clock = e.Clock
+	message.Timestamp = e.Timestamp
+	message.Text = e.Text
+	message.ResponseTo = e.ResponseTo
+	message.EnsName = e.EnsName
+	message.ChatId = e.ChatID
+	message.MessageType = e.MessageType
+	message.ContentType = e.ContentType
+	message.Links = e.Links
+	// The attribution of forwarded messages is not trusted once imported
+	message.ForwardedFrom = e.ForwardedFrom
+	switch {
+	case e.Sticker != nil:
+		message.Payload = &protobuf.ChatMessage_Sticker{Sticker: e.Sticker}
+	case e.Image != nil:
+		message.Payload = &protobuf.ChatMessage_Image{Image: e.Image}
+	case e.Audio != nil:
+		message.Payload = &protobuf.ChatMessage_Audio{Audio: e.Audio}
+	case e.Poll != nil:
+		message.Payload = &protobuf.ChatMessage_Poll{Poll: e.Poll}
+	}
+	return message
+}
+
+// ExportChat exports the history of a chat, oldest messages first. Messages
+// deleted along with the chat history and disappearing messages are not
+// exported
+func (m *Messenger) ExportChat(chatID string, format ExportFormat) ([]byte, error) {
+	m.mutex.Lock()
+	chat, ok := m.allChats[chatID]
+	m.mutex.Unlock()
+	if !ok {
+		return nil, errors.New("Chat not found")
+	}
+
+	var messages []*Message
+	var cursor string
+	for {
+		page, nextCursor, err := m.persistence.MessageByChatID(chatID, cursor, exportPageSize)
+		if err != nil {
+			return nil, err
+		}
+		for _, message := range page {
+			if message.Clock <= chat.DeletedAtClockValue || message.ExpiresAt != 0 {
+				continue
+			}
+			messages = append(messages, message)
+		}
+		if len(nextCursor) == 0 {
+			break
+		}
+		cursor = nextCursor
+	}
+
+	// Pages come newest first
+	for i, j := 0, len(messages)-1; i < j; i, j = i+1, j-1 {
+		messages[i], messages[j] = messages[j], messages[i]
+	}
+
+	switch format {
+	case ExportFormatJSON:
+		export := &ChatExport{
+			Version:    chatExportVersion,
+			ExportedAt: m.getTimesource().GetCurrentTime(),
+			ChatID:     chat.ID,
+			ChatName:   chat.Name,
+			ChatType:   chat.ChatType,
+			Messages:   make([]*ExportedMessage, 0, len(messages)),
+		}
+		for _, message := range messages {
+			export.Messages = append(export.Messages, newExportedMessage(message))
+		}
+		return json.MarshalIndent(export, "", "  ")
+	case ExportFormatMarkdown, ExportFormatHTML:
+		m.mutex.Lock()
+		entries := exportEntries(messages, m.allContacts)
+		m.mutex.Unlock()
+		if format == ExportFormatMarkdown {
+			return renderMarkdownExport(chat.Name, entries), nil
+		}
+		return renderHTMLExport(chat.Name, entries)
+	default:
+		return nil, errors.New("unknown export format")
+	}
+}
+
+// ImportChat restores the messages of a JSON export into their chat.
+// Messages that already exist are skipped, and so are the ones deleted along
+// with the chat history. Public and one-to-one chats are created if needed,
+// private group chats need to exist already
+func (m *Messenger) ImportChat(data []byte) (*MessengerResponse, error) {
+	var export ChatExport
+	if err := json.Unmarshal(data, &export); err != nil {
+		return nil, err
+	}
+	if export.Version != chatExportVersion {
+		return nil, errors.New("unsupported export version")
+	}
+
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	chat, ok := m.allChats[export.ChatID]
+	if !ok {
+		var err error
+		chat, err = m.importedChat(&export)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	ids := make([]string, 0, len(export.Messages))
+	for _, exported := range export.Messages {
+		ids = append(ids, exported.ID)
+	}
+	existing, err := m.persistence.MessagesExist(ids)
+	if err != nil {
+		return nil, err
+	}
+
+	var messages []*Message
+	for _, exported := range export.Messages {
+		if len(exported.ID) == 0 || existing[exported.ID] || exported.Clock <= chat.DeletedAtClockValue {
+			continue
+		}
+		// The same message might be exported twice
+		existing[exported.ID] = true
+
+		message := exported.toMessage(chat.ID)
+		// Imported history is not new to the user
+		message.Seen = true
+		if err := message.prepareContent(m.allContacts); err != nil {
+			return nil, err
+		}
+		messages = append(messages, message)
+	}
+
+	if len(messages) != 0 {
+		err = m.persistence.SaveMessagesLegacy(messages)
+		if err != nil {
+			return nil, err
+		}
+
+		var latest *Message
+		for _, message := range messages {
+			if latest == nil || message.Clock > latest.Clock {
+				latest = message
+			}
+		}
+		if latest.Clock > chat.LastClockValue {
+			if err := chat.UpdateFromMessage(latest, m.getTimesource()); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	err = m.saveChat(chat)
+	if err != nil {
+		return nil, err
+	}
+
+	return &MessengerResponse{Chats: []*Chat{chat}, Messages: messages}, nil
+}
+
+// importedChat creates and joins the chat of an export
+func (m *Messenger) importedChat(export *ChatExport) (*Chat, error) {
+	var chat Chat
+	switch export.ChatType {
+	case ChatTypePublic:
+		chat = CreatePublicChat(export.ChatID, m.getTimesource())
+	case ChatTypeOneToOne:
+		publicKeyBytes, err := types.DecodeHex(export.ChatID)
+		if err != nil {
+			return nil, err
+		}
+		publicKey, err := crypto.UnmarshalPubkey(publicKeyBytes)
+		if err != nil {
+			return nil, err
+		}
+		chat = *OneToOneFromPublicKey(publicKey, m.getTimesource())
+		if len(export.ChatName) != 0 {
+			chat.Name = export.ChatName
+		}
+	default:
+		return nil, errors.New("Chat not found")
+	}
+
+	if err := m.Join(chat); err != nil {
+		return nil, err
+	}
+	return &chat, nil
+}
+
+// exportEntry is a message as rendered in a human readable export
+type exportEntry struct {
+	Author    string
+	Time      string
+	Quote     string
+	QuoteFrom string
+	Body      string
+	Notes     []string
+	System    bool
+}
+
+var mentionRegexp = regexp.MustCompile(`@0x04[0-9a-fA-F]{128}`)
+
+// exportName returns the name of a user in human readable exports, falling
+// back to the public key if no name can be generated from it
+func exportName(publicKey string, contacts map[string]*Contact) string {
+	name, err := mentionName(strings.ToLower(publicKey), contacts)
+	if err != nil {
+		return publicKey
+	}
+	return name
+}
+
+// exportEntries renders messages for human readable exports, mentions of
+// public keys being replaced by the names of the mentioned users
+func exportEntries(messages []*Message, contacts map[string]*Contact) []*exportEntry {
+	resolveMentions := func(text string) string {
+		return mentionRegexp.ReplaceAllStringFunc(text, func(mention string) string {
+			return "@" + exportName(mention[1:], contacts)
+		})
+	}
+
+	entries := make([]*exportEntry, 0, len(messages))
+	for _, message := range messages {
+		author := message.Alias
+		if len(message.EnsName) != 0 {
+			author = message.EnsName
+		}
+		if contact, ok := contacts[message.From]; ok && len(contact.LocalNickname) != 0 {
+			author = contact.LocalNickname
+		}
+		entry := &exportEntry{
+			Author: author,
+			Time:   time.Unix(0, int64(message.Timestamp)*int64(time.Millisecond)).UTC().Format("2006-01-02 15:04 UTC"),
+			Body:   resolveMentions(message.Text),
+		}
+
+		if message.QuotedMessage != nil {
+			entry.QuoteFrom = exportName(message.QuotedMessage.From, contacts)
+			entry.Quote = resolveMentions(message.QuotedMessage.Text)
+			if message.QuotedMessage.Deleted {
+				entry.Quote = "This message has been deleted"
+			}
+		}
+
+		if forwardedFrom := message.ForwardedFrom; forwardedFrom != nil {
+			if message.ForwardedFromVerified {
+				entry.Notes = append(entry.Notes, "Forwarded from "+exportName(forwardedFrom.From, contacts))
+			} else {
+				entry.Notes = append(entry.Notes, "Forwarded, attributed to "+exportName(forwardedFrom.From, contacts)+" (unverified)")
+			}
+		}
+
+		switch {
+		case message.Deleted:
+			entry.Body = ""
+			entry.Notes = append(entry.Notes, "This message has been deleted")
+		case message.ContentType == protobuf.ChatMessage_SYSTEM_MESSAGE_CONTENT_PRIVATE_GROUP:
+			entry.System = true
+		case message.ContentType == protobuf.ChatMessage_STICKER && message.GetSticker() != nil:
+			sticker := message.GetSticker()
+			entry.Body = ""
+			entry.Notes = append(entry.Notes, fmt.Sprintf("Sticker %s from pack %d", sticker.Hash, sticker.Pack))
+		case message.ContentType == protobuf.ChatMessage_IMAGE:
+			entry.Notes = append(entry.Notes, "Image")
+		case message.ContentType == protobuf.ChatMessage_AUDIO && message.GetAudio() != nil:
+			entry.Notes = append(entry.Notes, fmt.Sprintf("Audio, %ds", message.GetAudio().DurationMs/1000))
+		case message.ContentType == protobuf.ChatMessage_POLL && message.GetPoll() != nil:
+			entry.Notes = append(entry.Notes, "Poll: "+strings.Join(message.GetPoll().Options, ", "))
+		case message.ContentType == protobuf.ChatMessage_TRANSACTION_COMMAND && message.CommandParameters != nil:
+			if hash := message.CommandParameters.TransactionHash; len(hash) != 0 {
+				entry.Notes = append(entry.Notes, "Transaction "+hash)
+			}
+		}
+
+		if message.EditedAt != 0 && !message.Deleted {
+			entry.Notes = append(entry.Notes, "edited")
+		}
+
+		entries = append(entries, entry)
+	}
+	return entries
+}
+
+func renderMarkdownExport(chatName string, entries []*exportEntry) []byte {
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "# %s\n", chatName)
+	for _, entry := range entries {
+		buf.WriteString("\n")
+		if entry.System {
+			fmt.Fprintf(&buf, "_%s — %s_\n", entry.Body, entry.Time)
+			continue
+		}
+		fmt.Fprintf(&buf, "**%s** — %s\n", entry.Author, entry.Time)
+		if len(entry.QuoteFrom) != 0 {
+			fmt.Fprintf(&buf, "> **%s**: %s\n\n", entry.QuoteFrom, strings.Replace(entry.Quote, "\n", "\n> ", -1))
+		}
+		if len(entry.Body) != 0 {
+			buf.WriteString(entry.Body + "\n")
+		}
+		for _, note := range entry.Notes {
+			fmt.Fprintf(&buf, "_[%s]_\n", note)
+		}
+	}
+	return buf.Bytes()
+}
+
+var htmlExportTemplate = template.Must(template.New("export").Parse(`<!DOCTYPE html>
+<html>
+<head>
+<meta charset="utf-8">
+<title>{{.Name}}</title>
+</head>
+<body>
+<h1>{{.Name}}</h1>
+{{range .Entries}}{{if .System}}<p class="system"><em>{{.Body}}</em> <time>{{.Time}}</time></p>
+{{else}}<div class="message">
+<p><strong>{{.Author}}</strong> <time>{{.Time}}</time></p>
+{{if .QuoteFrom}}<blockquote><strong>{{.QuoteFrom}}</strong>: {{.Quote}}</blockquote>
+{{end}}{{if .Body}}<p style="white-space: pre-wrap">{{.Body}}</p>
+{{end}}{{range .Notes}}<p><em>[{{.}}]</em></p>
+{{end}}</div>
+{{end}}{{end}}</body>
+</html>
+`))
+
+func renderHTMLExport(chatName string, entries []*exportEntry) ([]byte, error) {
+	var buf bytes.Buffer
+	err := htmlExportTemplate.Execute(&buf, struct {
+		Name    string
+		Entries []*exportEntry
+	}{
+		Name:    chatName,
+		Entries: entries,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
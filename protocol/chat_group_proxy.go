@@ -7,3 +7,18 @@ import (
 func newProtocolGroupFromChat(chat *Chat) (*v1protocol.Group, error) {
 	return v1protocol.NewGroupWithEvents(chat.ID, chat.MembershipUpdates)
 }
+
+// appliedMembershipUpdateEvents returns the events applied to the group
+// among the received ones
+func appliedMembershipUpdateEvents(received []v1protocol.MembershipUpdateEvent, applied []v1protocol.MembershipUpdateEvent) []v1protocol.MembershipUpdateEvent {
+	var events []v1protocol.MembershipUpdateEvent
+	for _, event := range received {
+		for _, appliedEvent := range applied {
+			if appliedEvent.Equal(event) {
+				events = append(events, event)
+				break
+			}
+		}
+	}
+	return events
+}
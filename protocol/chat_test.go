@@ -70,3 +70,65 @@ func (s *ChatTestSuite) TestValidateChat() {
 	}
 
 }
+
+func (s *ChatTestSuite) TestShouldNotify() {
+	now := uint64(1000)
+	mention := &Message{Mentioned: true}
+	message := &Message{}
+
+	testCases := []struct {
+		Name    string
+		Chat    Chat
+		Message *Message
+		Notify  bool
+	}{
+		{
+			Name:    "all messages",
+			Chat:    Chat{NotificationLevel: ChatNotificationLevelAll},
+			Message: message,
+			Notify:  true,
+		},
+		{
+			Name:    "mentions only, not mentioned",
+			Chat:    Chat{NotificationLevel: ChatNotificationLevelMentions},
+			Message: message,
+			Notify:  false,
+		},
+		{
+			Name:    "mentions only, mentioned",
+			Chat:    Chat{NotificationLevel: ChatNotificationLevelMentions},
+			Message: mention,
+			Notify:  true,
+		},
+		{
+			Name:    "no notifications",
+			Chat:    Chat{NotificationLevel: ChatNotificationLevelNone},
+			Message: mention,
+			Notify:  false,
+		},
+		{
+			Name:    "muted until unmuted",
+			Chat:    Chat{Muted: true},
+			Message: mention,
+			Notify:  false,
+		},
+		{
+			Name:    "muted until later",
+			Chat:    Chat{Muted: true, MutedUntil: now + 1},
+			Message: message,
+			Notify:  false,
+		},
+		{
+			Name:    "mute expired",
+			Chat:    Chat{Muted: true, MutedUntil: now},
+			Message: message,
+			Notify:  true,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.Name, func() {
+			s.Require().Equal(tc.Notify, tc.Chat.ShouldNotify(tc.Message, now))
+		})
+	}
+}
@@ -0,0 +1,730 @@
+package protocol
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/rand"
+	"encoding/json"
+	"errors"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"github.com/status-im/status-go/eth-node/crypto"
+	"github.com/status-im/status-go/eth-node/types"
+	"github.com/status-im/status-go/protocol/protobuf"
+)
+
+type CommunityRequestToJoinState int
+
+const (
+	CommunityRequestToJoinStatePending CommunityRequestToJoinState = iota + 1
+	CommunityRequestToJoinStateAccepted
+	CommunityRequestToJoinStateDeclined
+)
+
+// Community is a space owned by a single user, made of channels its members
+// can post to. Its description is signed by the owner and published on the
+// topic of the community, the messages of its channels are encrypted with a
+// key the owner shares with the members. The key is replaced whenever a
+// member is removed, so that they can't read the messages sent afterwards
+type Community struct {
+	protobuf.CommunityDescription
+	// Joined indicates whether we are a member of the community and hold
+	// its key
+	Joined bool
+	// SignedDescription is the encoded description, signed by the owner
+	SignedDescription []byte
+}
+
+// CommunityRequestToJoin is a request to join a community sent to its owner
+type CommunityRequestToJoin struct {
+	CommunityID string                      `json:"communityId"`
+	From        string                      `json:"from"`
+	Clock       uint64                      `json:"clock"`
+	State       CommunityRequestToJoinState `json:"state"`
+}
+
+func (c *Community) MarshalJSON() ([]byte, error) {
+	item := struct {
+		ID          string                               `json:"id"`
+		Clock       uint64                               `json:"clock"`
+		Name        string                               `json:"name"`
+		Description string                               `json:"description"`
+		Owner       string                               `json:"owner"`
+		Access      protobuf.CommunityDescription_Access `json:"access"`
+		Members     []string                             `json:"members"`
+		Channels    []*protobuf.CommunityChannel         `json:"channels"`
+		Joined      bool                                 `json:"joined"`
+	}{
+		ID:          c.Id,
+		Clock:       c.Clock,
+		Name:        c.Name,
+		Description: c.Description,
+		Owner:       c.Owner,
+		Access:      c.Access,
+		Members:     c.Members,
+		Channels:    c.Channels,
+		Joined:      c.Joined,
+	}
+
+	return json.Marshal(item)
+}
+
+// IsMember returns whether the member identified by the hex encoded public
+// key is a member of the community
+func (c *Community) IsMember(id string) bool {
+	for _, member := range c.Members {
+		if member == id {
+			return true
+		}
+	}
+	return false
+}
+
+// CreateCommunity creates a community owned by us, with no channels
+func (m *Messenger) CreateCommunity(ctx context.Context, name string, description string, access protobuf.CommunityDescription_Access) (*MessengerResponse, error) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	ourID := contactIDFromPublicKey(&m.identity.PublicKey)
+	community := &Community{
+		CommunityDescription: protobuf.CommunityDescription{
+			Id:          uuid.New().String() + "-" + ourID,
+			Name:        name,
+			Description: description,
+			Owner:       ourID,
+			Access:      access,
+			Members:     []string{ourID},
+			KeyId:       1,
+		},
+		Joined: true,
+	}
+
+	if err := ValidateCommunityDescription(&community.CommunityDescription); err != nil {
+		return nil, err
+	}
+
+	key, err := generateCommunityKey()
+	if err != nil {
+		return nil, err
+	}
+
+	err = m.persistence.SaveCommunityKey(community.Id, community.KeyId, key)
+	if err != nil {
+		return nil, err
+	}
+
+	// The description of the community is published on its own topic
+	err = m.transport.JoinPublic(community.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	err = m.publishCommunityDescription(ctx, community)
+	if err != nil {
+		return nil, err
+	}
+
+	return &MessengerResponse{Communities: []*Community{community}}, nil
+}
+
+// CreateCommunityChannel adds a channel to a community we own
+func (m *Messenger) CreateCommunityChannel(ctx context.Context, communityID string, name string, description string) (*MessengerResponse, error) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	community, err := m.handler.ownedCommunity(communityID)
+	if err != nil {
+		return nil, err
+	}
+
+	community.Channels = append(community.Channels, &protobuf.CommunityChannel{
+		Id:          uuid.New().String(),
+		Name:        name,
+		Description: description,
+	})
+
+	if err := ValidateCommunityDescription(&community.CommunityDescription); err != nil {
+		return nil, err
+	}
+
+	err = m.publishCommunityDescription(ctx, community)
+	if err != nil {
+		return nil, err
+	}
+
+	chats, err := m.updateCommunityChats(community)
+	if err != nil {
+		return nil, err
+	}
+
+	return &MessengerResponse{Chats: chats, Communities: []*Community{community}}, nil
+}
+
+// Communities returns the communities we know of, joined or not
+func (m *Messenger) Communities() ([]*Community, error) {
+	return m.persistence.Communities()
+}
+
+// RequestToJoinCommunity sends a request to join a community to its owner.
+// We become a member once the owner accepts it, straight away for open
+// communities, and sends us the key of the community
+func (m *Messenger) RequestToJoinCommunity(ctx context.Context, communityID string) error {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	owner, err := communityOwner(communityID)
+	if err != nil {
+		return err
+	}
+
+	if isPubKeyEqual(owner, &m.identity.PublicKey) {
+		return errors.New("can't join our own community")
+	}
+
+	community, err := m.persistence.Community(communityID)
+	if err != nil && err != errRecordNotFound {
+		return err
+	}
+	if community != nil && community.Joined {
+		return errors.New("already a member of the community")
+	}
+
+	// We listen to the topic of the community to receive its description
+	err = m.transport.JoinPublic(communityID)
+	if err != nil {
+		return err
+	}
+
+	// The request is sent in the one-to-one chat with the owner
+	chat, ok := m.allChats[contactIDFromPublicKey(owner)]
+	if !ok {
+		chat = OneToOneFromPublicKey(owner, m.getTimesource())
+		// We don't want to show the chat to the user
+		chat.Active = false
+	}
+	m.allChats[chat.ID] = chat
+
+	clock, _ := chat.NextClockAndTimestamp(m.getTimesource())
+	encodedMessage, err := proto.Marshal(&protobuf.CommunityRequestToJoin{
+		Clock:       clock,
+		CommunityId: communityID,
+	})
+	if err != nil {
+		return err
+	}
+
+	_, err = m.dispatchMessage(ctx, &RawMessage{
+		LocalChatID:         chat.ID,
+		Payload:             encodedMessage,
+		MessageType:         protobuf.ApplicationMetadataMessage_COMMUNITY_REQUEST_TO_JOIN,
+		ResendAutomatically: true,
+	})
+	if err != nil {
+		return err
+	}
+
+	if chat.LastClockValue < clock {
+		chat.LastClockValue = clock
+	}
+	return m.saveChat(chat)
+}
+
+// PendingCommunityRequestsToJoin returns the requests to join a community we
+// own we have not accepted or declined yet
+func (m *Messenger) PendingCommunityRequestsToJoin(communityID string) ([]*CommunityRequestToJoin, error) {
+	return m.persistence.CommunityRequestsToJoin(communityID, CommunityRequestToJoinStatePending)
+}
+
+// AcceptCommunityRequestToJoin adds the author of a pending request to join
+// to the members of the community and sends them its key
+func (m *Messenger) AcceptCommunityRequestToJoin(ctx context.Context, communityID string, from string) (*MessengerResponse, error) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	community, err := m.handler.ownedCommunity(communityID)
+	if err != nil {
+		return nil, err
+	}
+
+	request, err := m.pendingCommunityRequestToJoin(communityID, from)
+	if err != nil {
+		return nil, err
+	}
+
+	return m.acceptCommunityRequestToJoin(ctx, community, request)
+}
+
+// DeclineCommunityRequestToJoin declines a pending request to join, further
+// requests from its author are ignored
+func (m *Messenger) DeclineCommunityRequestToJoin(communityID string, from string) error {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	request, err := m.pendingCommunityRequestToJoin(communityID, from)
+	if err != nil {
+		return err
+	}
+
+	request.State = CommunityRequestToJoinStateDeclined
+	return m.persistence.SaveCommunityRequestToJoin(request)
+}
+
+// RemoveUserFromCommunity removes a member from a community we own. The key
+// of the community is rotated, so that they can't read the messages sent
+// from then on
+func (m *Messenger) RemoveUserFromCommunity(ctx context.Context, communityID string, member string) (*MessengerResponse, error) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	community, err := m.handler.ownedCommunity(communityID)
+	if err != nil {
+		return nil, err
+	}
+
+	if member == community.Owner {
+		return nil, errors.New("can't remove the owner")
+	}
+
+	if !community.IsMember(member) {
+		return nil, errors.New("not a member of the community")
+	}
+
+	return m.removeMembersFromCommunity(ctx, community, []string{member})
+}
+
+// LeaveCommunity notifies the owner of a community we are a member of that we
+// are leaving it, and deactivates its chats
+func (m *Messenger) LeaveCommunity(ctx context.Context, communityID string) (*MessengerResponse, error) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	community, err := m.persistence.Community(communityID)
+	if err == errRecordNotFound {
+		return nil, errors.New("can't find community")
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if community.Owner == contactIDFromPublicKey(&m.identity.PublicKey) {
+		return nil, errors.New("the owner can't leave the community")
+	}
+
+	if !community.Joined {
+		return nil, errors.New("not a member of the community")
+	}
+
+	owner, err := communityOwner(communityID)
+	if err != nil {
+		return nil, err
+	}
+
+	chat, ok := m.allChats[contactIDFromPublicKey(owner)]
+	if !ok {
+		chat = OneToOneFromPublicKey(owner, m.getTimesource())
+		chat.Active = false
+	}
+	m.allChats[chat.ID] = chat
+
+	clock, _ := chat.NextClockAndTimestamp(m.getTimesource())
+	encodedMessage, err := proto.Marshal(&protobuf.CommunityRequestToLeave{
+		Clock:       clock,
+		CommunityId: communityID,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = m.dispatchMessage(ctx, &RawMessage{
+		LocalChatID:         chat.ID,
+		Payload:             encodedMessage,
+		MessageType:         protobuf.ApplicationMetadataMessage_COMMUNITY_REQUEST_TO_LEAVE,
+		ResendAutomatically: true,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if chat.LastClockValue < clock {
+		chat.LastClockValue = clock
+	}
+	err = m.saveChat(chat)
+	if err != nil {
+		return nil, err
+	}
+
+	community.Joined = false
+	err = m.persistence.SaveCommunity(community)
+	if err != nil {
+		return nil, err
+	}
+
+	err = m.persistence.DeleteCommunityKeys(communityID)
+	if err != nil {
+		return nil, err
+	}
+
+	chats, err := m.updateCommunityChats(community)
+	if err != nil {
+		return nil, err
+	}
+
+	return &MessengerResponse{Chats: chats, Communities: []*Community{community}}, nil
+}
+
+func (m *Messenger) pendingCommunityRequestToJoin(communityID string, from string) (*CommunityRequestToJoin, error) {
+	request, err := m.persistence.CommunityRequestToJoin(communityID, from)
+	if err == errRecordNotFound || (err == nil && request.State != CommunityRequestToJoinStatePending) {
+		return nil, errors.New("no pending request to join")
+	}
+	return request, err
+}
+
+func (m *Messenger) acceptCommunityRequestToJoin(ctx context.Context, community *Community, request *CommunityRequestToJoin) (*MessengerResponse, error) {
+	request.State = CommunityRequestToJoinStateAccepted
+	err := m.persistence.SaveCommunityRequestToJoin(request)
+	if err != nil {
+		return nil, err
+	}
+
+	if !community.IsMember(request.From) {
+		community.Members = append(community.Members, request.From)
+	}
+
+	err = m.publishCommunityDescription(ctx, community)
+	if err != nil {
+		return nil, err
+	}
+
+	err = m.sendCommunityKey(ctx, community, []string{request.From})
+	if err != nil {
+		return nil, err
+	}
+
+	return &MessengerResponse{Communities: []*Community{community}}, nil
+}
+
+// removeMembersFromCommunity removes members from a community we own and
+// shares a new key with the remaining ones
+func (m *Messenger) removeMembersFromCommunity(ctx context.Context, community *Community, members []string) (*MessengerResponse, error) {
+	removed := make(map[string]bool)
+	for _, member := range members {
+		removed[member] = true
+	}
+
+	var remaining []string
+	for _, member := range community.Members {
+		if !removed[member] {
+			remaining = append(remaining, member)
+		}
+	}
+	community.Members = remaining
+
+	key, err := generateCommunityKey()
+	if err != nil {
+		return nil, err
+	}
+
+	community.KeyId++
+	err = m.persistence.SaveCommunityKey(community.Id, community.KeyId, key)
+	if err != nil {
+		return nil, err
+	}
+
+	err = m.publishCommunityDescription(ctx, community)
+	if err != nil {
+		return nil, err
+	}
+
+	err = m.sendCommunityKey(ctx, community, community.Members)
+	if err != nil {
+		return nil, err
+	}
+
+	return &MessengerResponse{Communities: []*Community{community}}, nil
+}
+
+// publishCommunityDescription signs the description of a community we own
+// with a new clock value, saves it and publishes it on the topic of the
+// community
+func (m *Messenger) publishCommunityDescription(ctx context.Context, community *Community) error {
+	clock := community.Clock + 1
+	if now := m.getTimesource().GetCurrentTime(); now > clock {
+		clock = now
+	}
+	community.Clock = clock
+
+	signedDescription, err := signCommunityDescription(&community.CommunityDescription, m.identity)
+	if err != nil {
+		return err
+	}
+	community.SignedDescription = signedDescription
+
+	err = m.persistence.SaveCommunity(community)
+	if err != nil {
+		return err
+	}
+
+	_, err = m.processor.SendPublicRaw(ctx, community.Id, signedDescription, protobuf.ApplicationMetadataMessage_COMMUNITY_DESCRIPTION)
+	return err
+}
+
+// sendCommunityKey sends the current key of a community we own, along with
+// its description, to some of its members. It's sent through encryption.Protocol
+// like any private message, only the members hold it
+func (m *Messenger) sendCommunityKey(ctx context.Context, community *Community, members []string) error {
+	var recipients []string
+	for _, member := range members {
+		if member != community.Owner {
+			recipients = append(recipients, member)
+		}
+	}
+	if len(recipients) == 0 {
+		return nil
+	}
+
+	publicKeys, err := stringSliceToPublicKeys(recipients, true)
+	if err != nil {
+		return err
+	}
+
+	key, err := m.persistence.CommunityKey(community.Id, community.KeyId)
+	if err != nil {
+		return err
+	}
+
+	var signedDescription protobuf.SignedCommunityDescription
+	err = proto.Unmarshal(community.SignedDescription, &signedDescription)
+	if err != nil {
+		return err
+	}
+
+	encodedMessage, err := proto.Marshal(&protobuf.CommunityKey{
+		Clock:       community.Clock,
+		KeyId:       community.KeyId,
+		Key:         key,
+		Description: &signedDescription,
+	})
+	if err != nil {
+		return err
+	}
+
+	_, err = m.processor.SendGroupRaw(ctx, publicKeys, encodedMessage, protobuf.ApplicationMetadataMessage_COMMUNITY_KEY)
+	return err
+}
+
+// updateCommunityChats creates the chats of the channels of a community, and
+// activates or deactivates them depending on whether we are a member. It
+// returns the chats that changed
+func (m *Messenger) updateCommunityChats(community *Community) ([]*Chat, error) {
+	var chats []*Chat
+	for _, channel := range community.Channels {
+		chat, ok := m.allChats[communityChatID(community.Id, channel.Id)]
+		if !ok {
+			if !community.Joined {
+				continue
+			}
+			newChat := CreateCommunityChat(community.Id, channel, m.getTimesource())
+			chat = &newChat
+		} else if chat.Active == community.Joined && chat.Name == channel.Name && chat.Description == channel.Description {
+			continue
+		}
+
+		chat.Name = channel.Name
+		chat.Description = channel.Description
+		chat.Active = community.Joined
+
+		var err error
+		if chat.Active {
+			err = m.Join(*chat)
+		} else {
+			err = m.Leave(*chat)
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		m.allChats[chat.ID] = chat
+		err = m.saveChat(chat)
+		if err != nil {
+			return nil, err
+		}
+		chats = append(chats, chat)
+	}
+	return chats, nil
+}
+
+// handleCommunityChanges accepts the requests to join open communities we
+// own, removes the members who left them and updates the chats of the
+// communities modified by the handled messages
+func (m *Messenger) handleCommunityChanges(state *ReceivedMessageState) {
+	ctx := context.Background()
+
+	for _, request := range state.CommunityRequestsToJoin {
+		community, err := m.persistence.Community(request.CommunityID)
+		if err != nil {
+			m.logger.Warn("failed to get community", zap.Error(err))
+			continue
+		}
+
+		if community.Access != protobuf.CommunityDescription_OPEN {
+			state.Response.CommunityRequestsToJoin = append(state.Response.CommunityRequestsToJoin, request)
+			continue
+		}
+
+		if _, err := m.acceptCommunityRequestToJoin(ctx, community, request); err != nil {
+			m.logger.Warn("failed to accept request to join", zap.Error(err))
+			continue
+		}
+		state.ModifiedCommunities[community.Id] = community
+	}
+
+	for communityID, members := range state.CommunityMembersLeft {
+		community, err := m.persistence.Community(communityID)
+		if err != nil {
+			m.logger.Warn("failed to get community", zap.Error(err))
+			continue
+		}
+
+		if _, err := m.removeMembersFromCommunity(ctx, community, members); err != nil {
+			m.logger.Warn("failed to remove members from community", zap.Error(err))
+			continue
+		}
+		state.ModifiedCommunities[community.Id] = community
+	}
+
+	for _, community := range state.ModifiedCommunities {
+		state.Response.Communities = append(state.Response.Communities, community)
+
+		chats, err := m.updateCommunityChats(community)
+		if err != nil {
+			m.logger.Warn("failed to update community chats", zap.Error(err))
+			continue
+		}
+		for _, chat := range chats {
+			if !chatsContain(state.Response.Chats, chat.ID) {
+				state.Response.Chats = append(state.Response.Chats, chat)
+			}
+		}
+	}
+}
+
+// decryptCommunityMessage replaces the payload of a message received in a
+// community chat with its decrypted content. Messages encrypted with a key we
+// don't hold, sent after we were removed, can't be read
+func (m *Messenger) decryptCommunityMessage(chat *Chat, shhMessage *types.Message) error {
+	var message protobuf.CommunityEncryptedMessage
+	err := proto.Unmarshal(shhMessage.Payload, &message)
+	if err != nil {
+		return err
+	}
+
+	if message.CommunityId != chat.CommunityID {
+		return errors.New("message sent to the wrong community")
+	}
+
+	key, err := m.persistence.CommunityKey(message.CommunityId, message.KeyId)
+	if err == errRecordNotFound {
+		return errors.New("unknown community key")
+	}
+	if err != nil {
+		return err
+	}
+
+	payload, err := crypto.DecryptSymmetric(key, message.Payload)
+	if err != nil {
+		return err
+	}
+
+	shhMessage.Payload = payload
+	return nil
+}
+
+func generateCommunityKey() ([]byte, error) {
+	key := make([]byte, communityKeyLength)
+	_, err := rand.Read(key)
+	return key, err
+}
+
+// communityOwner returns the public key of the owner of a community, the id
+// of a community ends with it
+func communityOwner(communityID string) (*ecdsa.PublicKey, error) {
+	if len(communityID) < PubKeyStringLength {
+		return nil, errors.New("invalid community id")
+	}
+
+	publicKeys, err := stringSliceToPublicKeys([]string{communityID[len(communityID)-PubKeyStringLength:]}, true)
+	if err != nil {
+		return nil, errors.New("invalid community id")
+	}
+	return publicKeys[0], nil
+}
+
+// signCommunityDescription returns the encoded description, signed with key
+func signCommunityDescription(description *protobuf.CommunityDescription, key *ecdsa.PrivateKey) ([]byte, error) {
+	payload, err := proto.Marshal(description)
+	if err != nil {
+		return nil, err
+	}
+
+	signature, err := crypto.SignBytes(payload, key)
+	if err != nil {
+		return nil, err
+	}
+
+	return proto.Marshal(&protobuf.SignedCommunityDescription{
+		Payload:   payload,
+		Signature: signature,
+	})
+}
+
+// verifyCommunityDescription returns the description if it's valid and has
+// been signed by the owner of the community
+func verifyCommunityDescription(signedDescription *protobuf.SignedCommunityDescription) (*protobuf.CommunityDescription, error) {
+	if signedDescription == nil || len(signedDescription.Payload) == 0 {
+		return nil, errors.New("invalid description")
+	}
+
+	signer, err := crypto.ExtractSignature(signedDescription.Payload, signedDescription.Signature)
+	if err != nil {
+		return nil, errors.New("invalid description signature")
+	}
+
+	var description protobuf.CommunityDescription
+	err = proto.Unmarshal(signedDescription.Payload, &description)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := ValidateCommunityDescription(&description); err != nil {
+		return nil, err
+	}
+
+	if description.Owner != contactIDFromPublicKey(signer) {
+		return nil, errors.New("description not signed by the owner")
+	}
+	return &description, nil
+}
+
+// decodeCommunity returns the community of a description that has already
+// been verified
+func decodeCommunity(signedDescription []byte, joined bool) (*Community, error) {
+	var signed protobuf.SignedCommunityDescription
+	err := proto.Unmarshal(signedDescription, &signed)
+	if err != nil {
+		return nil, err
+	}
+
+	community := &Community{
+		Joined:            joined,
+		SignedDescription: signedDescription,
+	}
+	err = proto.Unmarshal(signed.Payload, &community.CommunityDescription)
+	if err != nil {
+		return nil, err
+	}
+	return community, nil
+}
@@ -8,6 +8,7 @@ import (
 	"github.com/status-im/status-go/eth-node/types"
 	"github.com/status-im/status-go/protocol/identity/alias"
 	"github.com/status-im/status-go/protocol/identity/identicon"
+	"github.com/status-im/status-go/protocol/protobuf"
 )
 
 const (
@@ -16,6 +17,27 @@ const (
 	contactRequestReceived = ":contact/request-received"
 )
 
+// ContactRequestState is the state of the contact request exchanged with a
+// contact, from our point of view
+type ContactRequestState int
+
+const (
+	// ContactRequestStateNone means no request is pending, either none was
+	// sent or it has been retracted
+	ContactRequestStateNone ContactRequestState = iota
+	// ContactRequestStateSent means we sent a request to the contact
+	ContactRequestStateSent
+	// ContactRequestStateReceived means the contact sent us a request we
+	// have not answered yet
+	ContactRequestStateReceived
+	// ContactRequestStateAccepted means a request has been accepted, by us
+	// or by the contact
+	ContactRequestStateAccepted
+	// ContactRequestStateDeclined means a request has been declined, by us
+	// or by the contact
+	ContactRequestStateDeclined
+)
+
 // ContactDeviceInfo is a struct containing information about a particular device owned by a contact
 type ContactDeviceInfo struct {
 	// The installation id of the device
@@ -26,6 +48,16 @@ type ContactDeviceInfo struct {
 	FCMToken string `json:"fcmToken"`
 }
 
+// ContactImage is one of the sizes of the avatar of a contact
+type ContactImage struct {
+	// Name is the size of the image, e.g. thumbnail or large
+	Name string `json:"name"`
+	// Payload is the base64 encoded image
+	Payload string `json:"payload"`
+	Width   uint32 `json:"width"`
+	Height  uint32 `json:"height"`
+}
+
 // Contact has information about a "Contact". A contact is not necessarily one
 // that we added or added us, that's based on SystemTags.
 type Contact struct {
@@ -59,6 +91,33 @@ type Contact struct {
 
 	DeviceInfo    []ContactDeviceInfo `json:"deviceInfo"`
 	TributeToTalk string              `json:"tributeToTalk,omitempty"`
+
+	// ContactRequestState is the state of the contact request exchanged
+	// with the contact
+	ContactRequestState ContactRequestState `json:"contactRequestState"`
+	// ContactRequestClock is the clock value of the last request, acceptance,
+	// decline or retraction exchanged with the contact, older ones are
+	// discarded
+	ContactRequestClock uint64 `json:"contactRequestClock"`
+	// ContactRequestText is the introduction text of the request
+	ContactRequestText string `json:"contactRequestText,omitempty"`
+
+	// LocalNickname is the nickname we gave to the contact, it's only shared
+	// with our paired installations
+	LocalNickname string `json:"localNickname,omitempty"`
+	// LocalNicknameClock is the clock value of the last change of the local
+	// nickname, older changes synced from paired installations are discarded
+	LocalNicknameClock uint64 `json:"localNicknameClock"`
+
+	// DisplayName is the name the contact chose for themselves, updated
+	// along with the rest of the profile when LastUpdated changes
+	DisplayName string `json:"displayName,omitempty"`
+	// Bio is a short text the contact wrote about themselves
+	Bio string `json:"bio,omitempty"`
+	// Images are the sizes of the avatar of the contact
+	Images []ContactImage `json:"images,omitempty"`
+	// ProfileLink is a link the contact shares on their profile
+	ProfileLink string `json:"profileLink,omitempty"`
 }
 
 func (c Contact) PublicKey() (*ecdsa.PublicKey, error) {
@@ -69,6 +128,54 @@ func (c Contact) PublicKey() (*ecdsa.PublicKey, error) {
 	return crypto.UnmarshalPubkey(b)
 }
 
+// displayName returns the name to show for the contact, its local nickname
+// if any, its verified ENS name otherwise, then the name it chose and its
+// generated alias as a last resort
+func (c Contact) displayName() string {
+	if len(c.LocalNickname) != 0 {
+		return c.LocalNickname
+	}
+	if c.ENSVerified && len(c.Name) != 0 {
+		return c.Name
+	}
+	if len(c.DisplayName) != 0 {
+		return c.DisplayName
+	}
+	return c.Alias
+}
+
+// updateProfile sets the profile of the contact, as received from the contact
+// or synced from a paired installation
+func (c *Contact) updateProfile(displayName, bio string, images []*protobuf.ProfileImage, profileLink string) {
+	c.DisplayName = displayName
+	c.Bio = bio
+	c.ProfileLink = profileLink
+	c.Images = nil
+	for _, image := range images {
+		c.Images = append(c.Images, ContactImage{
+			Name:    image.Name,
+			Payload: image.Payload,
+			Width:   image.Width,
+			Height:  image.Height,
+		})
+	}
+}
+
+// protobufProfileImages returns the images of a profile as sent in profile
+// updates
+func protobufProfileImages(contactImages []ContactImage) []*protobuf.ProfileImage {
+	var images []*protobuf.ProfileImage
+	for _, image := range contactImages {
+		images = append(images, &protobuf.ProfileImage{
+			Name:    image.Name,
+			Payload: image.Payload,
+			Width:   image.Width,
+			Height:  image.Height,
+		})
+	}
+	return images
+}
+
 func (c Contact) IsAdded() bool {
 	return existsInStringSlice(c.SystemTags, contactAdded)
 }
@@ -81,6 +188,22 @@ func (c Contact) IsBlocked() bool {
 	return existsInStringSlice(c.SystemTags, contactBlocked)
 }
 
+func (c *Contact) addSystemTag(tag string) {
+	if !existsInStringSlice(c.SystemTags, tag) {
+		c.SystemTags = append(c.SystemTags, tag)
+	}
+}
+
+func (c *Contact) removeSystemTag(tag string) {
+	var tags []string
+	for _, t := range c.SystemTags {
+		if t != tag {
+			tags = append(tags, t)
+		}
+	}
+	c.SystemTags = tags
+}
+
 func (c *Contact) ResetENSVerification(clock uint64, name string) {
 	c.ENSVerifiedAt = 0
 	c.ENSVerified = false
@@ -0,0 +1,347 @@
+package protocol
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"sort"
+	"unicode/utf8"
+
+	"github.com/golang/protobuf/proto"
+
+	"github.com/status-im/status-go/protocol/protobuf"
+)
+
+// ContactRequestsSettings gives access to the setting, global to the account,
+// indicating whether one-to-one messages from non-contacts are hidden until
+// their contact request is accepted
+type ContactRequestsSettings interface {
+	GetHideNonContactMessages() (bool, error)
+	SetHideNonContactMessages(enabled bool) error
+}
+
+// SetHideNonContactMessages turns hiding one-to-one messages from
+// non-contacts on or off. Messages already hidden stay hidden until the
+// contact request of their author is accepted
+func (m *Messenger) SetHideNonContactMessages(enabled bool) error {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	if m.contactRequestsSettings == nil {
+		return errors.New("hiding messages from non-contacts is not available")
+	}
+
+	return m.contactRequestsSettings.SetHideNonContactMessages(enabled)
+}
+
+func (m *Messenger) hideNonContactMessagesEnabled() (bool, error) {
+	if m.contactRequestsSettings == nil {
+		return false, nil
+	}
+	return m.contactRequestsSettings.GetHideNonContactMessages()
+}
+
+// SendContactRequest adds the contact and asks to be added back, text being
+// shown to the contact along with the request. A request received from the
+// contact is accepted instead
+func (m *Messenger) SendContactRequest(ctx context.Context, contactID string, text string) (*MessengerResponse, error) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	if utf8.RuneCountInString(text) > maxContactRequestTextLength {
+		return nil, errors.New("contact request text too long")
+	}
+
+	contact, err := m.contactRequestContact(contactID)
+	if err != nil {
+		return nil, err
+	}
+
+	switch contact.ContactRequestState {
+	case ContactRequestStateReceived:
+		return m.acceptContactRequest(ctx, contact)
+	case ContactRequestStateSent, ContactRequestStateAccepted:
+		return nil, errors.New("contact request already sent")
+	}
+
+	chat, clock, err := m.dispatchContactRequestMessage(ctx, contact, protobuf.ApplicationMetadataMessage_CONTACT_REQUEST, func(clock uint64) proto.Message {
+		return &protobuf.ContactRequest{
+			Clock: clock,
+			Text:  text,
+		}
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	contact.ContactRequestState = ContactRequestStateSent
+	contact.ContactRequestClock = clock
+	contact.ContactRequestText = text
+	contact.addSystemTag(contactAdded)
+
+	return m.saveContactRequest(ctx, contact, chat)
+}
+
+// AcceptContactRequest adds the author of a pending contact request, the
+// messages they sent while they were not a contact are shown
+func (m *Messenger) AcceptContactRequest(ctx context.Context, contactID string) (*MessengerResponse, error) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	contact, ok := m.allContacts[contactID]
+	if !ok || contact.ContactRequestState != ContactRequestStateReceived {
+		return nil, errors.New("no pending contact request")
+	}
+
+	return m.acceptContactRequest(ctx, contact)
+}
+
+func (m *Messenger) acceptContactRequest(ctx context.Context, contact *Contact) (*MessengerResponse, error) {
+	chat, clock, err := m.dispatchContactRequestMessage(ctx, contact, protobuf.ApplicationMetadataMessage_ACCEPT_CONTACT_REQUEST, func(clock uint64) proto.Message {
+		return &protobuf.AcceptContactRequest{Clock: clock}
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	contact.ContactRequestState = ContactRequestStateAccepted
+	contact.ContactRequestClock = clock
+	contact.addSystemTag(contactAdded)
+
+	_, err = showMessagesFromContact(m.persistence, chat, m.getTimesource())
+	if err != nil {
+		return nil, err
+	}
+
+	return m.saveContactRequest(ctx, contact, chat)
+}
+
+// DeclineContactRequest removes a pending contact request, the messages of
+// its author stay hidden
+func (m *Messenger) DeclineContactRequest(ctx context.Context, contactID string) (*MessengerResponse, error) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	contact, ok := m.allContacts[contactID]
+	if !ok || contact.ContactRequestState != ContactRequestStateReceived {
+		return nil, errors.New("no pending contact request")
+	}
+
+	chat, clock, err := m.dispatchContactRequestMessage(ctx, contact, protobuf.ApplicationMetadataMessage_DECLINE_CONTACT_REQUEST, func(clock uint64) proto.Message {
+		return &protobuf.DeclineContactRequest{Clock: clock}
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	contact.ContactRequestState = ContactRequestStateDeclined
+	contact.ContactRequestClock = clock
+
+	return m.saveContactRequest(ctx, contact, chat)
+}
+
+// RetractContactRequest withdraws the contact request we sent, or removes
+// the contact if it was accepted
+func (m *Messenger) RetractContactRequest(ctx context.Context, contactID string) (*MessengerResponse, error) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	contact, ok := m.allContacts[contactID]
+	if !ok || (contact.ContactRequestState != ContactRequestStateSent && contact.ContactRequestState != ContactRequestStateAccepted) {
+		return nil, errors.New("no contact request to retract")
+	}
+
+	chat, clock, err := m.dispatchContactRequestMessage(ctx, contact, protobuf.ApplicationMetadataMessage_RETRACT_CONTACT_REQUEST, func(clock uint64) proto.Message {
+		return &protobuf.RetractContactRequest{Clock: clock}
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	contact.ContactRequestState = ContactRequestStateNone
+	contact.ContactRequestClock = clock
+	contact.ContactRequestText = ""
+	contact.removeSystemTag(contactAdded)
+
+	return m.saveContactRequest(ctx, contact, chat)
+}
+
+// PendingContactRequests returns the contacts whose request has not been
+// answered yet, the most recent first
+func (m *Messenger) PendingContactRequests() []*Contact {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	var contacts []*Contact
+	for _, contact := range m.allContacts {
+		if contact.ContactRequestState == ContactRequestStateReceived {
+			contacts = append(contacts, contact)
+		}
+	}
+	sort.Slice(contacts, func(i, j int) bool {
+		return contacts[i].ContactRequestClock > contacts[j].ContactRequestClock
+	})
+	return contacts
+}
+
+// contactRequestContact returns the contact a request can be sent to
+func (m *Messenger) contactRequestContact(contactID string) (*Contact, error) {
+	if contactID == contactIDFromPublicKey(&m.identity.PublicKey) {
+		return nil, errors.New("can't send a contact request to ourselves")
+	}
+
+	if contact, ok := m.allContacts[contactID]; ok {
+		if contact.IsBlocked() {
+			return nil, errors.New("contact is blocked")
+		}
+		return contact, nil
+	}
+
+	contact := &Contact{ID: contactID}
+	publicKey, err := contact.PublicKey()
+	if err != nil {
+		return nil, err
+	}
+	return buildContact(publicKey)
+}
+
+// dispatchContactRequestMessage sends the message built by buildMessage to
+// the contact, returning the chat with the contact and the clock value of the
+// message, which is greater than the one of the last request exchanged
+func (m *Messenger) dispatchContactRequestMessage(ctx context.Context, contact *Contact, messageType protobuf.ApplicationMetadataMessage_Type, buildMessage func(clock uint64) proto.Message) (*Chat, uint64, error) {
+	chat, ok := m.allChats[contact.ID]
+	if !ok {
+		publicKey, err := contact.PublicKey()
+		if err != nil {
+			return nil, 0, err
+		}
+		chat = OneToOneFromPublicKey(publicKey, m.getTimesource())
+		// We don't want to show the chat to the user
+		chat.Active = false
+	}
+
+	m.allChats[chat.ID] = chat
+	clock, _ := chat.NextClockAndTimestamp(m.getTimesource())
+	if clock <= contact.ContactRequestClock {
+		clock = contact.ContactRequestClock + 1
+	}
+
+	encodedMessage, err := proto.Marshal(buildMessage(clock))
+	if err != nil {
+		return nil, 0, err
+	}
+
+	_, err = m.dispatchMessage(ctx, &RawMessage{
+		LocalChatID:         chat.ID,
+		Payload:             encodedMessage,
+		MessageType:         messageType,
+		ResendAutomatically: true,
+	})
+	if err != nil {
+		return nil, 0, err
+	}
+
+	if chat.LastClockValue < clock {
+		chat.LastClockValue = clock
+	}
+	return chat, clock, nil
+}
+
+// saveContactRequest saves the contact and its chat after a change of the
+// state of its contact request, and syncs it with paired devices
+func (m *Messenger) saveContactRequest(ctx context.Context, contact *Contact, chat *Chat) (*MessengerResponse, error) {
+	err := m.saveChat(chat)
+	if err != nil {
+		return nil, err
+	}
+
+	err = m.persistence.SaveContact(contact, nil)
+	if err != nil {
+		return nil, err
+	}
+	m.allContacts[contact.ID] = contact
+
+	err = m.updateTimelineSubscription(contact)
+	if err != nil {
+		return nil, err
+	}
+
+	err = m.syncContact(ctx, contact)
+	if err != nil {
+		return nil, err
+	}
+
+	return &MessengerResponse{
+		Chats:    []*Chat{chat},
+		Contacts: []*Contact{contact},
+	}, nil
+}
+
+// saveHiddenMessages saves messages received from non-contacts, hidden
+func (m *Messenger) saveHiddenMessages(messages []*Message) error {
+	err := m.persistence.SaveMessagesLegacy(messages)
+	if err != nil {
+		return err
+	}
+
+	ids := make([]string, 0, len(messages))
+	for _, message := range messages {
+		ids = append(ids, message.ID)
+	}
+	return m.persistence.HideMessagesFromNonContact(ids)
+}
+
+// showMessagesFromAddedContact shows the messages hidden while a contact was
+// not added, once it is, saving its one-to-one chat if there were any
+func (m *Messenger) showMessagesFromAddedContact(contact *Contact) error {
+	if !contact.IsAdded() {
+		return nil
+	}
+
+	chat, ok := m.allChats[contact.ID]
+	if !ok {
+		publicKey, err := contact.PublicKey()
+		if err != nil {
+			return err
+		}
+		chat = OneToOneFromPublicKey(publicKey, m.getTimesource())
+		chat.Active = false
+	}
+	shown, err := showMessagesFromContact(m.persistence, chat, m.getTimesource())
+	if err != nil || !shown {
+		return err
+	}
+	return m.saveChat(chat)
+}
+
+// showMessagesFromContact shows the messages of the one-to-one chat with a
+// contact that were hidden while they were not one, activating the chat.
+// It returns whether there were any
+func showMessagesFromContact(persistence *sqlitePersistence, chat *Chat, timesource TimeSource) (bool, error) {
+	count, err := persistence.ShowMessagesFromContact(chat.ID)
+	if err != nil || count == 0 {
+		return false, err
+	}
+
+	messages, _, err := persistence.MessageByChatID(chat.ID, "", 1)
+	if err != nil {
+		return false, err
+	}
+	// The clock value of the chat might be ahead of the shown messages, the
+	// last message is set regardless as it's the most recent one of the chat
+	if len(messages) != 0 {
+		jsonMessage, err := json.Marshal(messages[0])
+		if err != nil {
+			return false, err
+		}
+		chat.LastMessage = jsonMessage
+		if chat.LastClockValue < messages[0].Clock {
+			chat.LastClockValue = messages[0].Clock
+		}
+		chat.Timestamp = int64(timesource.GetCurrentTime())
+	}
+
+	chat.UnviewedMessagesCount += count
+	chat.Active = true
+	return true, nil
+}
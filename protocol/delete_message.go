@@ -0,0 +1,31 @@
+package protocol
+
+import (
+	"crypto/ecdsa"
+
+	"github.com/status-im/status-go/protocol/protobuf"
+)
+
+// DeleteMessage represents a request from the author of a message to delete
+// it for everyone, used for persistence and processing
+type DeleteMessage struct {
+	protobuf.DeleteMessage
+
+	// ID is the ID of the message that carried the deletion
+	ID string
+
+	// From is a public key of the author of the deletion
+	From string
+
+	// LocalChatID is the chat the deletion has been received in
+	LocalChatID string
+
+	// SigPubKey is the ecdsa encoded public key of the deletion author
+	SigPubKey *ecdsa.PublicKey `json:"-"`
+}
+
+// GetSigPubKey returns an ecdsa encoded public key
+// this function is required to implement the ChatEntity interface
+func (d *DeleteMessage) GetSigPubKey() *ecdsa.PublicKey {
+	return d.SigPubKey
+}
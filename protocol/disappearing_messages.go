@@ -0,0 +1,129 @@
+package protocol
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"errors"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	"go.uber.org/zap"
+
+	"github.com/status-im/status-go/protocol/protobuf"
+)
+
+// expiredMessagesInterval is how often the expired messages are deleted
+const expiredMessagesInterval = 10 * time.Second
+
+// DisappearingMessagesSetting represents a change of the time after which
+// the messages of a chat are deleted
+type DisappearingMessagesSetting struct {
+	protobuf.DisappearingMessagesSetting
+
+	// From is a public key of the author of the change
+	From string
+
+	// SigPubKey is the ecdsa encoded public key of the author of the change
+	SigPubKey *ecdsa.PublicKey `json:"-"`
+}
+
+// GetSigPubKey returns an ecdsa encoded public key
+// this function is required to implement the ChatEntity interface
+func (s *DisappearingMessagesSetting) GetSigPubKey() *ecdsa.PublicKey {
+	return s.SigPubKey
+}
+
+// SetDisappearingMessagesTimer sets the time in milliseconds after which the
+// messages sent to a one-to-one or private group chat are deleted, 0 turning
+// disappearing messages off. The change is sent to the members of the chat,
+// the most recent change being the one all of them apply
+func (m *Messenger) SetDisappearingMessagesTimer(ctx context.Context, chatID string, timer uint64) (*MessengerResponse, error) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	chat, ok := m.allChats[chatID]
+	if !ok {
+		return nil, errors.New("Chat not found")
+	}
+
+	if chat.ChatType != ChatTypeOneToOne && chat.ChatType != ChatTypePrivateGroupChat {
+		return nil, errors.New("disappearing messages are only supported in one-to-one and private group chats")
+	}
+
+	clock, _ := chat.NextClockAndTimestamp(m.getTimesource())
+	if clock <= chat.DisappearingMessagesClock {
+		clock = chat.DisappearingMessagesClock + 1
+	}
+
+	setting := &protobuf.DisappearingMessagesSetting{
+		Clock:       clock,
+		ChatId:      chat.ID,
+		Timer:       timer,
+		MessageType: chat.MessageType(),
+	}
+	encodedMessage, err := proto.Marshal(setting)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = m.dispatchMessage(ctx, &RawMessage{
+		LocalChatID:         chat.ID,
+		Payload:             encodedMessage,
+		MessageType:         protobuf.ApplicationMetadataMessage_DISAPPEARING_MESSAGES_SETTING,
+		ResendAutomatically: true,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	chat.DisappearingMessagesTimer = timer
+	chat.DisappearingMessagesClock = clock
+
+	err = m.saveChat(chat)
+	if err != nil {
+		return nil, err
+	}
+
+	return &MessengerResponse{Chats: []*Chat{chat}}, nil
+}
+
+func (m *Messenger) expiredMessagesLoop() {
+	defer m.loops.Done()
+
+	ticker := time.NewTicker(expiredMessagesInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			if err := m.deleteExpiredMessages(); err != nil {
+				m.logger.Warn("failed to delete expired messages", zap.Error(err))
+			}
+			if err := m.deleteStaleMessageChunks(); err != nil {
+				m.logger.Warn("failed to delete stale message chunks", zap.Error(err))
+			}
+		case <-m.quit:
+			return
+		}
+	}
+}
+
+// deleteExpiredMessages deletes the messages that expired. The ids of the
+// deleted messages and their updated chats are returned by the next call to
+// RetrieveAll
+func (m *Messenger) deleteExpiredMessages() error {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	messageIDs, chats, err := m.persistence.DeleteExpiredMessages(m.getTimesource().GetCurrentTime())
+	if err != nil {
+		return err
+	}
+
+	m.expiredMessageIDs = append(m.expiredMessageIDs, messageIDs...)
+	for _, chat := range chats {
+		m.allChats[chat.ID] = chat
+		m.expiredMessagesChats[chat.ID] = true
+	}
+	return nil
+}
@@ -0,0 +1,52 @@
+package protocol
+
+import (
+	"crypto/ecdsa"
+	"encoding/json"
+
+	"github.com/status-im/status-go/protocol/protobuf"
+)
+
+// EditMessage represents an edit of a chat message in the application layer,
+// used for persistence, querying and signaling. The versions of a message
+// stored in the edit history are represented as EditMessage as well
+type EditMessage struct {
+	protobuf.EditMessage
+
+	// ID is the ID of the message that carried the edit
+	ID string
+
+	// From is a public key of the author of the edit
+	From string
+
+	// LocalChatID is the chat the edit has been received in
+	LocalChatID string
+
+	// SigPubKey is the ecdsa encoded public key of the edit author
+	SigPubKey *ecdsa.PublicKey `json:"-"`
+}
+
+// GetSigPubKey returns an ecdsa encoded public key
+// this function is required to implement the ChatEntity interface
+func (e *EditMessage) GetSigPubKey() *ecdsa.PublicKey {
+	return e.SigPubKey
+}
+
+// MarshalJSON implements the json.Marshaler interface
+func (e *EditMessage) MarshalJSON() ([]byte, error) {
+	item := struct {
+		ID        string `json:"id"`
+		Clock     uint64 `json:"clock"`
+		Text      string `json:"text"`
+		MessageID string `json:"messageId"`
+		From      string `json:"from"`
+	}{
+		ID:        e.ID,
+		Clock:     e.Clock,
+		Text:      e.Text,
+		MessageID: e.MessageId,
+		From:      e.From,
+	}
+
+	return json.Marshal(item)
+}
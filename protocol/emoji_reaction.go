@@ -0,0 +1,60 @@
+package protocol
+
+import (
+	"crypto/ecdsa"
+	"encoding/json"
+
+	"github.com/status-im/status-go/protocol/protobuf"
+)
+
+// EmojiReaction represents an emoji reaction from a user in the application layer, used for persistence, querying and
+// signaling
+type EmojiReaction struct {
+	protobuf.EmojiReaction
+
+	// ID is the ID of the message that first sent the reaction, it's kept
+	// stable across retractions of the same reaction
+	ID string
+
+	// From is a public key of the author of the emoji reaction.
+	From string
+
+	// LocalChatID is the chat id to be stored locally
+	LocalChatID string
+
+	// SigPubKey is the ecdsa encoded public key of the emoji reaction author
+	SigPubKey *ecdsa.PublicKey `json:"-"`
+}
+
+// GetSigPubKey returns an ecdsa encoded public key
+// this function is required to implement the ChatEntity interface
+func (e *EmojiReaction) GetSigPubKey() *ecdsa.PublicKey {
+	return e.SigPubKey
+}
+
+// MarshalJSON implements the json.Marshaler interface
+func (e *EmojiReaction) MarshalJSON() ([]byte, error) {
+	item := struct {
+		ID          string                           `json:"id"`
+		Clock       uint64                           `json:"clock"`
+		ChatID      string                           `json:"chatId"`
+		LocalChatID string                           `json:"localChatId"`
+		MessageID   string                           `json:"messageId"`
+		From        string                           `json:"from"`
+		EmojiID     protobuf.EmojiReaction_Type      `json:"emojiId"`
+		Retracted   bool                             `json:"retracted"`
+		MessageType protobuf.ChatMessage_MessageType `json:"messageType"`
+	}{
+		ID:          e.ID,
+		Clock:       e.Clock,
+		ChatID:      e.ChatId,
+		LocalChatID: e.LocalChatID,
+		MessageID:   e.MessageId,
+		From:        e.From,
+		EmojiID:     e.Type,
+		Retracted:   e.Retracted,
+		MessageType: e.MessageType,
+	}
+
+	return json.Marshal(item)
+}
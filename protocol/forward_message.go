@@ -0,0 +1,163 @@
+package protocol
+
+import (
+	"context"
+	"errors"
+
+	"github.com/status-im/status-go/protocol/protobuf"
+)
+
+// ForwardMessage sends a copy of the message to each of the target chats,
+// attributed to the author of the original message
+func (m *Messenger) ForwardMessage(ctx context.Context, messageID string, targetChatIDs []string) (*MessengerResponse, error) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	if len(targetChatIDs) == 0 {
+		return nil, errors.New("no chat to forward the message to")
+	}
+
+	original, err := m.persistence.MessageByID(messageID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Check all the chats first, so that nothing is sent if one of them
+	// doesn't exist. A failure while sending still leaves the message
+	// forwarded to the chats preceding the failing one
+	for _, chatID := range targetChatIDs {
+		if _, ok := m.allChats[chatID]; !ok {
+			return nil, errors.New("Chat not found")
+		}
+	}
+
+	var response MessengerResponse
+	for _, chatID := range targetChatIDs {
+		message, err := forwardedMessage(original)
+		if err != nil {
+			return nil, err
+		}
+		message.ChatId = chatID
+
+		chatResponse, err := m.sendChatMessage(ctx, message)
+		if err != nil {
+			return nil, err
+		}
+		response.Chats = append(response.Chats, chatResponse.Chats...)
+		response.Messages = append(response.Messages, chatResponse.Messages...)
+	}
+
+	return &response, nil
+}
+
+// forwardedMessage builds a copy of original to be sent to another chat.
+// Transaction commands only make sense in the chat they were sent in, the
+// result of a transaction is forwarded as text along with its hash
+func forwardedMessage(original *Message) (*Message, error) {
+	if original.Deleted {
+		return nil, errors.New("can't forward a deleted message")
+	}
+
+	message := &Message{}
+	message.Text = original.Text
+	message.ContentType = original.ContentType
+	message.ForwardedFrom = &protobuf.ForwardedFrom{
+		MessageId: original.ID,
+		From:      original.From,
+		Timestamp: original.Timestamp,
+	}
+	message.ForwardedFromVerified = true
+
+	switch original.ContentType {
+	case protobuf.ChatMessage_TEXT_PLAIN:
+		message.Links = original.Links
+	case protobuf.ChatMessage_STICKER:
+		message.Payload = original.Payload
+	case protobuf.ChatMessage_TRANSACTION_COMMAND:
+		command := original.CommandParameters
+		if command == nil || command.CommandState != CommandStateTransactionSent || len(command.TransactionHash) == 0 {
+			return nil, errors.New("only sent transactions can be forwarded")
+		}
+		message.ContentType = protobuf.ChatMessage_TEXT_PLAIN
+		message.ForwardedFrom.TransactionHash = command.TransactionHash
+	default:
+		return nil, errors.New("content type can't be forwarded")
+	}
+
+	// Forwarding a forwarded message keeps the attribution of the original
+	if original.ForwardedFrom != nil {
+		message.ForwardedFrom.MessageId = original.ForwardedFrom.MessageId
+		message.ForwardedFrom.From = original.ForwardedFrom.From
+		message.ForwardedFrom.Timestamp = original.ForwardedFrom.Timestamp
+		if len(original.ForwardedFrom.TransactionHash) != 0 {
+			message.ForwardedFrom.TransactionHash = original.ForwardedFrom.TransactionHash
+		}
+		message.ForwardedFromVerified = original.ForwardedFromVerified
+	}
+
+	return message, nil
+}
+
+// verifyForwardedFrom returns whether the attribution of a received
+// forwarded message matches the original message: same author, timestamp
+// and content. The attribution is asserted by the sender only, it can't be
+// verified if the original message is not known locally. received are the
+// messages of the batch being processed, not saved yet
+func (m *MessageHandler) verifyForwardedFrom(message *Message, received []*Message) (bool, error) {
+	forwardedFrom := message.ForwardedFrom
+
+	var original *Message
+	for _, r := range received {
+		if r.ID == forwardedFrom.MessageId {
+			original = r
+			break
+		}
+	}
+	if original == nil {
+		var err error
+		original, err = m.persistence.MessageByID(forwardedFrom.MessageId)
+		if err == errRecordNotFound {
+			return false, nil
+		}
+		if err != nil {
+			return false, err
+		}
+	}
+
+	if original.Deleted || original.From != forwardedFrom.From || original.Timestamp != forwardedFrom.Timestamp {
+		return false, nil
+	}
+
+	switch {
+	case len(forwardedFrom.TransactionHash) != 0:
+		command := original.CommandParameters
+		return original.ContentType == protobuf.ChatMessage_TRANSACTION_COMMAND && command != nil &&
+			command.TransactionHash == forwardedFrom.TransactionHash && original.Text == message.Text, nil
+
+	case message.ContentType == protobuf.ChatMessage_STICKER:
+		sticker := message.GetSticker()
+		originalSticker := original.GetSticker()
+		return sticker != nil && originalSticker != nil &&
+			sticker.Hash == originalSticker.Hash && sticker.Pack == originalSticker.Pack, nil
+
+	default:
+		if original.ContentType != protobuf.ChatMessage_TEXT_PLAIN {
+			return false, nil
+		}
+		if original.Text == message.Text {
+			return true, nil
+		}
+
+		// The original message might have been edited since it was forwarded
+		history, err := m.persistence.MessageEditHistory(original.ID)
+		if err != nil {
+			return false, err
+		}
+		for _, version := range history {
+			if version.Text == message.Text {
+				return true, nil
+			}
+		}
+		return false, nil
+	}
+}
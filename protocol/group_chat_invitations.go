@@ -0,0 +1,393 @@
+package protocol
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"encoding/base64"
+	"errors"
+	"strings"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/google/uuid"
+
+	"github.com/status-im/status-go/eth-node/crypto"
+	"github.com/status-im/status-go/protocol/protobuf"
+)
+
+type GroupChatJoinRequestState int
+
+const (
+	GroupChatJoinRequestStatePending GroupChatJoinRequestState = iota + 1
+	GroupChatJoinRequestStateApproved
+	GroupChatJoinRequestStateRejected
+)
+
+// GroupChatInvitation is an invitation to join a private group chat created
+// by one of its admins. Its code can be shared with anyone, redeeming it
+// sends a join request to the admins of the chat
+type GroupChatInvitation struct {
+	ID     string `json:"id"`
+	ChatID string `json:"chatId"`
+	// ExpiresAt is the time in milliseconds after which the invitation
+	// can't be redeemed
+	ExpiresAt uint64 `json:"expiresAt"`
+	Revoked   bool   `json:"revoked"`
+	// Code is the signed invitation, only set when it's created
+	Code string `json:"code,omitempty"`
+}
+
+// GroupChatJoinRequest is a request to join a private group chat sent by the
+// redeemer of an invitation to the admins of the chat. Once one of them
+// approves or rejects it, it's approved or rejected for all of them
+type GroupChatJoinRequest struct {
+	ChatID       string                    `json:"chatId"`
+	From         string                    `json:"from"`
+	InvitationID string                    `json:"invitationId"`
+	Clock        uint64                    `json:"clock"`
+	State        GroupChatJoinRequestState `json:"state"`
+}
+
+// CreateGroupChatInvitation creates an invitation to join a private group
+// chat valid for validity milliseconds. Only admins can create invitations
+func (m *Messenger) CreateGroupChatInvitation(chatID string, validity uint64) (*GroupChatInvitation, error) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	chat, ok := m.allChats[chatID]
+	if !ok || !chat.PrivateGroupChat() {
+		return nil, errors.New("can't find chat")
+	}
+
+	if !chat.IsAdmin(contactIDFromPublicKey(&m.identity.PublicKey)) {
+		return nil, errors.New("only admins can create invitations")
+	}
+
+	if validity == 0 {
+		return nil, errors.New("invitations must expire")
+	}
+
+	invitation := &GroupChatInvitation{
+		ID:        uuid.New().String(),
+		ChatID:    chat.ID,
+		ExpiresAt: m.getTimesource().GetCurrentTime() + validity,
+	}
+
+	code, err := signGroupChatInvitation(invitation, groupChatAdmins(chat), m.identity)
+	if err != nil {
+		return nil, err
+	}
+
+	err = m.persistence.SaveGroupChatInvitation(invitation)
+	if err != nil {
+		return nil, err
+	}
+
+	invitation.Code = code
+	return invitation, nil
+}
+
+// RevokeGroupChatInvitation revokes an invitation we created, join requests
+// sent with it are ignored from then on. The other admins of the chat are
+// told, so that they ignore them as well
+func (m *Messenger) RevokeGroupChatInvitation(ctx context.Context, invitationID string) error {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	invitation, err := m.persistence.GroupChatInvitation(invitationID)
+	if err == errRecordNotFound {
+		return errors.New("can't find invitation")
+	} else if err != nil {
+		return err
+	}
+
+	err = m.persistence.RevokeGroupChatInvitation(invitationID)
+	if err != nil {
+		return err
+	}
+
+	chat, ok := m.allChats[invitation.ChatID]
+	if !ok {
+		return nil
+	}
+	for _, id := range groupChatAdmins(chat) {
+		if id == contactIDFromPublicKey(&m.identity.PublicKey) {
+			continue
+		}
+		err := m.sendToGroupChatAdmin(ctx, id, protobuf.ApplicationMetadataMessage_GROUP_CHAT_INVITATION_REVOKED, func(clock uint64) proto.Message {
+			return &protobuf.GroupChatInvitationRevoked{
+				Clock:        clock,
+				ChatId:       chat.ID,
+				InvitationId: invitationID,
+			}
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// RedeemGroupChatInvitation sends a request to join the group chat of the
+// invitation to each of its admins: the ones listed in the invitation when it
+// was created, along with the ones we know of if we have the chat. We become
+// a member of the chat once one of them approves the request
+func (m *Messenger) RedeemGroupChatInvitation(ctx context.Context, code string) error {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	signedInvitation, err := decodeGroupChatInvitationCode(code)
+	if err != nil {
+		return err
+	}
+
+	invitation, admin, err := verifyGroupChatInvitation(signedInvitation)
+	if err != nil {
+		return err
+	}
+
+	if invitation.ExpiresAt <= m.getTimesource().GetCurrentTime() {
+		return errors.New("invitation expired")
+	}
+
+	if isPubKeyEqual(admin, &m.identity.PublicKey) {
+		return errors.New("can't redeem our own invitation")
+	}
+
+	admins := append([]string{contactIDFromPublicKey(admin)}, invitation.Admins...)
+	if chat, ok := m.allChats[invitation.ChatId]; ok {
+		if chat.IsMember(contactIDFromPublicKey(&m.identity.PublicKey)) {
+			return errors.New("already a member of the chat")
+		}
+		admins = append(admins, groupChatAdmins(chat)...)
+	}
+
+	sent := make(map[string]bool)
+	for _, id := range admins {
+		if sent[id] || id == contactIDFromPublicKey(&m.identity.PublicKey) {
+			continue
+		}
+		sent[id] = true
+
+		err := m.sendToGroupChatAdmin(ctx, id, protobuf.ApplicationMetadataMessage_GROUP_CHAT_JOIN_REQUEST, func(clock uint64) proto.Message {
+			return &protobuf.GroupChatJoinRequest{
+				Clock:      clock,
+				Invitation: signedInvitation,
+			}
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// PendingGroupChatJoinRequests returns the join requests of a group chat we
+// have not approved or rejected yet
+func (m *Messenger) PendingGroupChatJoinRequests(chatID string) ([]*GroupChatJoinRequest, error) {
+	return m.persistence.GroupChatJoinRequests(chatID, GroupChatJoinRequestStatePending)
+}
+
+// ApproveGroupChatJoinRequest adds the author of a pending join request to the
+// group chat
+func (m *Messenger) ApproveGroupChatJoinRequest(ctx context.Context, chatID string, from string) (*MessengerResponse, error) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	if _, err := m.pendingGroupChatJoinRequest(chatID, from); err != nil {
+		return nil, err
+	}
+
+	return m.addMembersToGroupChat(ctx, chatID, []string{from})
+}
+
+// RejectGroupChatJoinRequest rejects a pending join request, further requests
+// from its author are ignored. The other admins of the chat are told, so that
+// they reject it as well
+func (m *Messenger) RejectGroupChatJoinRequest(ctx context.Context, chatID string, from string) error {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	request, err := m.pendingGroupChatJoinRequest(chatID, from)
+	if err != nil {
+		return err
+	}
+
+	request.State = GroupChatJoinRequestStateRejected
+	err = m.persistence.SaveGroupChatJoinRequest(request)
+	if err != nil {
+		return err
+	}
+
+	chat, ok := m.allChats[chatID]
+	if !ok {
+		return nil
+	}
+	for _, id := range groupChatAdmins(chat) {
+		if id == contactIDFromPublicKey(&m.identity.PublicKey) {
+			continue
+		}
+		err := m.sendToGroupChatAdmin(ctx, id, protobuf.ApplicationMetadataMessage_GROUP_CHAT_JOIN_REQUEST_REJECTED, func(clock uint64) proto.Message {
+			return &protobuf.GroupChatJoinRequestRejected{
+				Clock:     clock,
+				ChatId:    chatID,
+				Requester: from,
+			}
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// sendToGroupChatAdmin sends the message built with the next clock value of
+// our one-to-one chat with the admin of a group chat, in that chat
+func (m *Messenger) sendToGroupChatAdmin(ctx context.Context, adminID string, messageType protobuf.ApplicationMetadataMessage_Type, build func(clock uint64) proto.Message) error {
+	publicKeys, err := stringSliceToPublicKeys([]string{adminID}, true)
+	if err != nil {
+		return err
+	}
+
+	chat, ok := m.allChats[adminID]
+	if !ok {
+		chat = OneToOneFromPublicKey(publicKeys[0], m.getTimesource())
+		// We don't want to show the chat to the user
+		chat.Active = false
+	}
+	m.allChats[chat.ID] = chat
+
+	clock, _ := chat.NextClockAndTimestamp(m.getTimesource())
+	encodedMessage, err := proto.Marshal(build(clock))
+	if err != nil {
+		return err
+	}
+
+	_, err = m.dispatchMessage(ctx, &RawMessage{
+		LocalChatID:         chat.ID,
+		Payload:             encodedMessage,
+		MessageType:         messageType,
+		ResendAutomatically: true,
+	})
+	if err != nil {
+		return err
+	}
+
+	if chat.LastClockValue < clock {
+		chat.LastClockValue = clock
+	}
+	return m.saveChat(chat)
+}
+
+func (m *Messenger) pendingGroupChatJoinRequest(chatID string, from string) (*GroupChatJoinRequest, error) {
+	request, err := m.persistence.GroupChatJoinRequest(chatID, from)
+	if err == errRecordNotFound || (err == nil && request.State != GroupChatJoinRequestStatePending) {
+		return nil, errors.New("no pending join request")
+	}
+	return request, err
+}
+
+// approveGroupChatJoinRequests marks the pending join requests of the
+// members of a group chat as approved, whichever admin added them. The
+// approved requests are returned
+func approveGroupChatJoinRequests(persistence *sqlitePersistence, chat *Chat) ([]*GroupChatJoinRequest, error) {
+	requests, err := persistence.GroupChatJoinRequests(chat.ID, GroupChatJoinRequestStatePending)
+	if err != nil {
+		return nil, err
+	}
+
+	var approved []*GroupChatJoinRequest
+	for _, request := range requests {
+		if !chat.IsMember(request.From) {
+			continue
+		}
+
+		request.State = GroupChatJoinRequestStateApproved
+		if err := persistence.SaveGroupChatJoinRequest(request); err != nil {
+			return nil, err
+		}
+		approved = append(approved, request)
+	}
+	return approved, nil
+}
+
+// groupChatAdmins returns the public keys of the admins of a group chat
+func groupChatAdmins(chat *Chat) []string {
+	var admins []string
+	for _, member := range chat.Members {
+		if member.Admin {
+			admins = append(admins, member.ID)
+		}
+	}
+	return admins
+}
+
+// signGroupChatInvitation returns the code of the invitation listing the
+// admins of the chat, signed with key
+func signGroupChatInvitation(invitation *GroupChatInvitation, admins []string, key *ecdsa.PrivateKey) (string, error) {
+	payload, err := proto.Marshal(&protobuf.GroupChatInvitation{
+		Id:        invitation.ID,
+		ChatId:    invitation.ChatID,
+		ExpiresAt: invitation.ExpiresAt,
+		Admins:    admins,
+	})
+	if err != nil {
+		return "", err
+	}
+
+	signature, err := crypto.SignBytes(payload, key)
+	if err != nil {
+		return "", err
+	}
+
+	encodedInvitation, err := proto.Marshal(&protobuf.SignedGroupChatInvitation{
+		Payload:   payload,
+		Signature: signature,
+	})
+	if err != nil {
+		return "", err
+	}
+
+	return base64.RawURLEncoding.EncodeToString(encodedInvitation), nil
+}
+
+func decodeGroupChatInvitationCode(code string) (*protobuf.SignedGroupChatInvitation, error) {
+	encodedInvitation, err := base64.RawURLEncoding.DecodeString(strings.TrimSpace(code))
+	if err != nil {
+		return nil, errors.New("invalid invitation code")
+	}
+
+	var signedInvitation protobuf.SignedGroupChatInvitation
+	err = proto.Unmarshal(encodedInvitation, &signedInvitation)
+	if err != nil {
+		return nil, errors.New("invalid invitation code")
+	}
+	return &signedInvitation, nil
+}
+
+// verifyGroupChatInvitation returns the invitation along with the public key
+// of the admin who signed it
+func verifyGroupChatInvitation(signedInvitation *protobuf.SignedGroupChatInvitation) (*protobuf.GroupChatInvitation, *ecdsa.PublicKey, error) {
+	if signedInvitation == nil || len(signedInvitation.Payload) == 0 {
+		return nil, nil, errors.New("invalid invitation")
+	}
+
+	admin, err := crypto.ExtractSignature(signedInvitation.Payload, signedInvitation.Signature)
+	if err != nil {
+		return nil, nil, errors.New("invalid invitation signature")
+	}
+
+	var invitation protobuf.GroupChatInvitation
+	err = proto.Unmarshal(signedInvitation.Payload, &invitation)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if len(invitation.Id) == 0 || len(invitation.ChatId) == 0 {
+		return nil, nil, errors.New("invalid invitation")
+	}
+
+	if _, err := stringSliceToPublicKeys(invitation.Admins, true); err != nil {
+		return nil, nil, errors.New("invalid invitation admins")
+	}
+	return &invitation, admin, nil
+}
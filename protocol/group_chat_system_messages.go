@@ -11,13 +11,16 @@ import (
 )
 
 var defaultSystemMessagesTranslations = map[protobuf.MembershipUpdateEvent_EventType]string{
-	protobuf.MembershipUpdateEvent_CHAT_CREATED:   "{{from}} created the group {{name}}",
-	protobuf.MembershipUpdateEvent_NAME_CHANGED:   "{{from}} changed the group's name to {{name}}",
-	protobuf.MembershipUpdateEvent_MEMBERS_ADDED:  "{{from}} has invited {{members}}",
-	protobuf.MembershipUpdateEvent_MEMBER_JOINED:  "{{from}} joined the group",
-	protobuf.MembershipUpdateEvent_ADMINS_ADDED:   "{{from}} has made {{members}} admin",
-	protobuf.MembershipUpdateEvent_MEMBER_REMOVED: "{{member}} left the group",
-	protobuf.MembershipUpdateEvent_ADMIN_REMOVED:  "{{member}} is not admin anymore",
+	protobuf.MembershipUpdateEvent_CHAT_CREATED:          "{{from}} created the group {{name}}",
+	protobuf.MembershipUpdateEvent_NAME_CHANGED:          "{{from}} changed the group's name to {{name}}",
+	protobuf.MembershipUpdateEvent_DESCRIPTION_CHANGED:   "{{from}} changed the group's description to {{description}}",
+	protobuf.MembershipUpdateEvent_IMAGE_CHANGED:         "{{from}} changed the group's image",
+	protobuf.MembershipUpdateEvent_MEMBERS_ADDED:         "{{from}} has invited {{members}}",
+	protobuf.MembershipUpdateEvent_MEMBER_JOINED:         "{{from}} joined the group",
+	protobuf.MembershipUpdateEvent_ADMINS_ADDED:          "{{from}} has made {{members}} admin",
+	protobuf.MembershipUpdateEvent_MEMBER_REMOVED:        "{{member}} left the group",
+	protobuf.MembershipUpdateEvent_ADMIN_REMOVED:         "{{member}} is not admin anymore",
+	protobuf.MembershipUpdateEvent_OWNERSHIP_TRANSFERRED: "{{from}} has made {{member}} the owner of the group",
 }
 
 func tsprintf(format string, params map[string]string) string {
@@ -34,6 +37,10 @@ func eventToSystemMessage(e v1protocol.MembershipUpdateEvent, translations map[p
 		text = tsprintf(translations[protobuf.MembershipUpdateEvent_CHAT_CREATED], map[string]string{"from": "@" + e.From, "name": e.Name})
 	case protobuf.MembershipUpdateEvent_NAME_CHANGED:
 		text = tsprintf(translations[protobuf.MembershipUpdateEvent_NAME_CHANGED], map[string]string{"from": "@" + e.From, "name": e.Name})
+	case protobuf.MembershipUpdateEvent_DESCRIPTION_CHANGED:
+		text = tsprintf(translations[protobuf.MembershipUpdateEvent_DESCRIPTION_CHANGED], map[string]string{"from": "@" + e.From, "description": e.Description})
+	case protobuf.MembershipUpdateEvent_IMAGE_CHANGED:
+		text = tsprintf(translations[protobuf.MembershipUpdateEvent_IMAGE_CHANGED], map[string]string{"from": "@" + e.From})
 	case protobuf.MembershipUpdateEvent_MEMBERS_ADDED:
 
 		var memberMentions []string
@@ -53,6 +60,8 @@ func eventToSystemMessage(e v1protocol.MembershipUpdateEvent, translations map[p
 		text = tsprintf(translations[protobuf.MembershipUpdateEvent_MEMBER_REMOVED], map[string]string{"member": "@" + e.Members[0]})
 	case protobuf.MembershipUpdateEvent_ADMIN_REMOVED:
 		text = tsprintf(translations[protobuf.MembershipUpdateEvent_ADMIN_REMOVED], map[string]string{"member": "@" + e.Members[0]})
+	case protobuf.MembershipUpdateEvent_OWNERSHIP_TRANSFERRED:
+		text = tsprintf(translations[protobuf.MembershipUpdateEvent_OWNERSHIP_TRANSFERRED], map[string]string{"from": "@" + e.From, "member": "@" + e.Members[0]})
 
 	}
 	timestamp := v1protocol.TimestampInMsFromTime(time.Now())
@@ -0,0 +1,46 @@
+package protocol
+
+import (
+	"bytes"
+	"errors"
+	"image"
+	// Register the decoders of the supported image formats
+	_ "image/gif"
+	_ "image/jpeg"
+	_ "image/png"
+	"io/ioutil"
+
+	"github.com/status-im/status-go/protocol/protobuf"
+)
+
+var imageFormats = map[string]protobuf.ImageMessage_ImageFormat{
+	"png":  protobuf.ImageMessage_PNG,
+	"jpeg": protobuf.ImageMessage_JPEG,
+	"gif":  protobuf.ImageMessage_GIF,
+}
+
+// loadImage reads the image at path and builds the payload of an image
+// message out of it. Only PNG, JPEG and GIF images can be sent
+func loadImage(path string) (*protobuf.ImageMessage, error) {
+	payload, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	config, format, err := image.DecodeConfig(bytes.NewReader(payload))
+	if err != nil {
+		return nil, err
+	}
+
+	imageFormat, ok := imageFormats[format]
+	if !ok {
+		return nil, errors.New("unsupported image format")
+	}
+
+	return &protobuf.ImageMessage{
+		Payload: payload,
+		Format:  imageFormat,
+		Width:   uint32(config.Width),
+		Height:  uint32(config.Height),
+	}, nil
+}
@@ -0,0 +1,81 @@
+package protocol
+
+import (
+	"context"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/status-im/status-go/protocol/linkpreview"
+	"github.com/status-im/status-go/protocol/protobuf"
+)
+
+const (
+	// linkPreviewTimeout is the maximum time spent fetching a single page
+	// or thumbnail
+	linkPreviewTimeout = 5 * time.Second
+	// linkPreviewCacheTTL is how long, in milliseconds, a fetched preview is
+	// reused before fetching it again
+	linkPreviewCacheTTL = uint64(24 * time.Hour / time.Millisecond)
+)
+
+// unfurlLinks returns the previews of the links to the allowed domains found
+// in the text. Links that can't be unfurled are skipped, as a message is
+// sent regardless of its previews
+func (m *Messenger) unfurlLinks(ctx context.Context, text string) []*protobuf.UnfurledLink {
+	if m.linkPreviewAllowedDomains == nil {
+		return nil
+	}
+
+	logger := m.logger.With(zap.String("site", "unfurlLinks"))
+
+	allowedDomains, err := m.linkPreviewAllowedDomains()
+	if err != nil {
+		logger.Warn("failed to get the allowed domains", zap.Error(err))
+		return nil
+	}
+	if len(allowedDomains) == 0 {
+		return nil
+	}
+
+	var links []*protobuf.UnfurledLink
+	for _, url := range linkpreview.ExtractURLs(text) {
+		if len(links) == maxUnfurledLinks {
+			break
+		}
+		if !linkpreview.IsAllowed(url, allowedDomains) {
+			continue
+		}
+		link, err := m.linkPreview(ctx, url, allowedDomains)
+		if err != nil {
+			logger.Debug("failed to unfurl link", zap.String("url", url), zap.Error(err))
+			continue
+		}
+		links = append(links, link)
+	}
+	return links
+}
+
+// linkPreview returns the preview of the url, from the cache if it has been
+// fetched recently. Only the allowed domains are contacted while fetching it
+func (m *Messenger) linkPreview(ctx context.Context, url string, allowedDomains []string) (*protobuf.UnfurledLink, error) {
+	now := m.getTimesource().GetCurrentTime()
+
+	link, fetchedAt, err := m.persistence.LinkPreview(url)
+	if err == nil && fetchedAt+linkPreviewCacheTTL > now {
+		return link, nil
+	}
+	if err != nil && err != errRecordNotFound {
+		return nil, err
+	}
+
+	link, err = linkpreview.Unfurl(ctx, m.httpClient, url, allowedDomains)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := m.persistence.SaveLinkPreview(link, now); err != nil {
+		return nil, err
+	}
+	return link, nil
+}
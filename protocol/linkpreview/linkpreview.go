@@ -0,0 +1,274 @@
+package linkpreview
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"io/ioutil"
+	"mime"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strings"
+
+	"golang.org/x/net/html"
+
+	"github.com/status-im/status-go/protocol/protobuf"
+)
+
+const (
+	// maxPageSize is the maximum number of bytes read from a page when
+	// looking for its metadata
+	maxPageSize = 512 * 1024
+	// maxOEmbedSize is the maximum size of an oEmbed response
+	maxOEmbedSize = 64 * 1024
+	// MaxThumbnailSize is the maximum size of the thumbnail of a preview,
+	// bigger thumbnails are dropped
+	MaxThumbnailSize = 64 * 1024
+	// maxRedirects is the maximum number of redirects followed per request
+	maxRedirects = 10
+)
+
+var urlRegexp = regexp.MustCompile(`https?://[^\s<>"]+`)
+
+// ExtractURLs returns the distinct http(s) urls contained in the text, in
+// order of appearance
+func ExtractURLs(text string) []string {
+	var urls []string
+	seen := make(map[string]bool)
+	for _, u := range urlRegexp.FindAllString(text, -1) {
+		// Punctuation ending a sentence is not part of the url
+		u = strings.TrimRight(u, ".,;:!?)]}'")
+		if seen[u] {
+			continue
+		}
+		seen[u] = true
+		urls = append(urls, u)
+	}
+	return urls
+}
+
+// IsAllowed returns whether the host of the url is one of the allowed
+// domains or a subdomain of one of them
+func IsAllowed(rawURL string, allowedDomains []string) bool {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return false
+	}
+	host := strings.ToLower(u.Hostname())
+	for _, domain := range allowedDomains {
+		domain = strings.ToLower(strings.TrimSpace(domain))
+		if len(domain) == 0 {
+			continue
+		}
+		if host == domain || strings.HasSuffix(host, "."+domain) {
+			return true
+		}
+	}
+	return false
+}
+
+// Unfurl fetches the page at rawURL and builds a preview out of its
+// OpenGraph metadata, falling back to its oEmbed endpoint and to its
+// title and description meta tags. Every url fetched, the page, its oEmbed
+// endpoint, its thumbnail and any redirect, must be on one of the allowed
+// domains, so that a page can't make us contact any other host
+func Unfurl(ctx context.Context, client *http.Client, rawURL string, allowedDomains []string) (*protobuf.UnfurledLink, error) {
+	pageURL, err := url.Parse(rawURL)
+	if err != nil {
+		return nil, err
+	}
+
+	restrictedClient := *client
+	restrictedClient.CheckRedirect = func(request *http.Request, via []*http.Request) error {
+		if len(via) >= maxRedirects {
+			return errors.New("too many redirects")
+		}
+		if !IsAllowed(request.URL.String(), allowedDomains) {
+			return errors.New("redirect to a domain not allowed")
+		}
+		return nil
+	}
+	client = &restrictedClient
+
+	body, contentType, err := fetch(ctx, client, rawURL, maxPageSize, allowedDomains)
+	if err != nil {
+		return nil, err
+	}
+
+	mediaType, _, err := mime.ParseMediaType(contentType)
+	if err != nil || mediaType != "text/html" {
+		return nil, errors.New("not an html page")
+	}
+
+	metadata := parseMetadata(body)
+
+	link := &protobuf.UnfurledLink{
+		Url:         rawURL,
+		Title:       metadata.ogTitle,
+		Description: metadata.ogDescription,
+	}
+	thumbnailURL := metadata.ogImage
+
+	if (len(link.Title) == 0 || len(thumbnailURL) == 0) && len(metadata.oEmbedURL) != 0 {
+		oEmbed, err := fetchOEmbed(ctx, client, resolve(pageURL, metadata.oEmbedURL), allowedDomains)
+		if err == nil {
+			if len(link.Title) == 0 {
+				link.Title = oEmbed.Title
+			}
+			if len(thumbnailURL) == 0 {
+				thumbnailURL = oEmbed.ThumbnailURL
+			}
+		}
+	}
+
+	if len(link.Title) == 0 {
+		link.Title = metadata.title
+	}
+	if len(link.Description) == 0 {
+		link.Description = metadata.description
+	}
+
+	if len(link.Title) == 0 {
+		return nil, errors.New("no preview available")
+	}
+
+	if len(thumbnailURL) != 0 {
+		// The thumbnail is optional, a preview is still returned if it
+		// can't be fetched
+		thumbnail, _, err := fetch(ctx, client, resolve(pageURL, thumbnailURL), MaxThumbnailSize+1, allowedDomains)
+		if err == nil && len(thumbnail) <= MaxThumbnailSize {
+			link.ThumbnailPayload = thumbnail
+		}
+	}
+
+	return link, nil
+}
+
+type pageMetadata struct {
+	title         string
+	description   string
+	ogTitle       string
+	ogDescription string
+	ogImage       string
+	oEmbedURL     string
+}
+
+func parseMetadata(body []byte) *pageMetadata {
+	metadata := &pageMetadata{}
+	tokenizer := html.NewTokenizer(bytes.NewReader(body))
+	inTitle := false
+	for {
+		switch tokenizer.Next() {
+		case html.ErrorToken:
+			return metadata
+		case html.StartTagToken, html.SelfClosingTagToken:
+			token := tokenizer.Token()
+			switch token.Data {
+			case "title":
+				inTitle = len(metadata.title) == 0
+			case "meta":
+				name := attribute(token, "property")
+				if len(name) == 0 {
+					name = attribute(token, "name")
+				}
+				content := strings.TrimSpace(attribute(token, "content"))
+				switch strings.ToLower(name) {
+				case "og:title":
+					metadata.ogTitle = content
+				case "og:description":
+					metadata.ogDescription = content
+				case "og:image":
+					metadata.ogImage = content
+				case "description":
+					metadata.description = content
+				}
+			case "link":
+				if strings.ToLower(attribute(token, "rel")) == "alternate" && strings.ToLower(attribute(token, "type")) == "application/json+oembed" {
+					metadata.oEmbedURL = attribute(token, "href")
+				}
+			case "body":
+				// Metadata is only found in the head
+				return metadata
+			}
+		case html.TextToken:
+			if inTitle {
+				metadata.title = strings.TrimSpace(string(tokenizer.Text()))
+				inTitle = false
+			}
+		case html.EndTagToken:
+			inTitle = false
+		}
+	}
+}
+
+func attribute(token html.Token, key string) string {
+	for _, attr := range token.Attr {
+		if attr.Key == key {
+			return attr.Val
+		}
+	}
+	return ""
+}
+
+type oEmbedResponse struct {
+	Title        string `json:"title"`
+	ThumbnailURL string `json:"thumbnail_url"`
+}
+
+func fetchOEmbed(ctx context.Context, client *http.Client, rawURL string, allowedDomains []string) (*oEmbedResponse, error) {
+	body, _, err := fetch(ctx, client, rawURL, maxOEmbedSize, allowedDomains)
+	if err != nil {
+		return nil, err
+	}
+	var response oEmbedResponse
+	if err := json.Unmarshal(body, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+func resolve(base *url.URL, ref string) string {
+	u, err := url.Parse(ref)
+	if err != nil {
+		return ref
+	}
+	return base.ResolveReference(u).String()
+}
+
+// fetch returns at most limit bytes of the body of a GET request to rawURL,
+// along with its content type. rawURL must be on one of the allowed domains
+func fetch(ctx context.Context, client *http.Client, rawURL string, limit int64, allowedDomains []string) ([]byte, string, error) {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return nil, "", err
+	}
+	if u.Scheme != "http" && u.Scheme != "https" {
+		return nil, "", errors.New("unsupported url scheme")
+	}
+	if !IsAllowed(rawURL, allowedDomains) {
+		return nil, "", errors.New("domain not allowed")
+	}
+
+	request, err := http.NewRequest(http.MethodGet, rawURL, nil)
+	if err != nil {
+		return nil, "", err
+	}
+	response, err := client.Do(request.WithContext(ctx))
+	if err != nil {
+		return nil, "", err
+	}
+	defer response.Body.Close()
+
+	if response.StatusCode != http.StatusOK {
+		return nil, "", errors.New("unexpected status: " + response.Status)
+	}
+
+	body, err := ioutil.ReadAll(io.LimitReader(response.Body, limit))
+	if err != nil {
+		return nil, "", err
+	}
+	return body, response.Header.Get("Content-Type"), nil
+}
@@ -0,0 +1,204 @@
+package linkpreview
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+var thumbnail = []byte{0x89, 0x50, 0x4e, 0x47}
+
+func newTestServer() *httptest.Server {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/og", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/html; charset=utf-8")
+		fmt.Fprint(w, `<html><head>
+<title>Page title</title>
+<meta property="og:title" content="OG title">
+<meta property="og:description" content="OG description">
+<meta property="og:image" content="/thumbnail.png">
+</head><body><meta property="og:title" content="ignored"></body></html>`)
+	})
+	mux.HandleFunc("/oembed", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/html")
+		fmt.Fprint(w, `<html><head>
+<title>Page title</title>
+<meta name="description" content="Meta description">
+<link rel="alternate" type="application/json+oembed" href="/oembed.json">
+</head></html>`)
+	})
+	mux.HandleFunc("/oembed.json", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{"title": "oEmbed title", "thumbnail_url": "/thumbnail.png"}`)
+	})
+	mux.HandleFunc("/plain", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/html")
+		fmt.Fprint(w, `<html><head><title> Page title </title></head></html>`)
+	})
+	mux.HandleFunc("/empty", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/html")
+		fmt.Fprint(w, `<html><head></head></html>`)
+	})
+	mux.HandleFunc("/image.png", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "image/png")
+		_, _ = w.Write(thumbnail)
+	})
+	mux.HandleFunc("/thumbnail.png", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "image/png")
+		_, _ = w.Write(thumbnail)
+	})
+	return httptest.NewServer(mux)
+}
+
+func TestUnfurl(t *testing.T) {
+	server := newTestServer()
+	defer server.Close()
+
+	testCases := []struct {
+		Name        string
+		Path        string
+		Title       string
+		Description string
+		Thumbnail   []byte
+		Valid       bool
+	}{
+		{
+			Name:        "OpenGraph",
+			Path:        "/og",
+			Title:       "OG title",
+			Description: "OG description",
+			Thumbnail:   thumbnail,
+			Valid:       true,
+		},
+		{
+			Name:        "oEmbed fallback",
+			Path:        "/oembed",
+			Title:       "oEmbed title",
+			Description: "Meta description",
+			Thumbnail:   thumbnail,
+			Valid:       true,
+		},
+		{
+			Name:  "title fallback",
+			Path:  "/plain",
+			Title: "Page title",
+			Valid: true,
+		},
+		{
+			Name:  "no metadata",
+			Path:  "/empty",
+			Valid: false,
+		},
+		{
+			Name:  "not an html page",
+			Path:  "/image.png",
+			Valid: false,
+		},
+		{
+			Name:  "not found",
+			Path:  "/not-found",
+			Valid: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.Name, func(t *testing.T) {
+			url := server.URL + tc.Path
+			link, err := Unfurl(context.Background(), server.Client(), url, []string{"127.0.0.1"})
+			if !tc.Valid {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, url, link.Url)
+			require.Equal(t, tc.Title, link.Title)
+			require.Equal(t, tc.Description, link.Description)
+			require.Equal(t, tc.Thumbnail, link.ThumbnailPayload)
+		})
+	}
+}
+
+func TestUnfurlOnlyContactsAllowedDomains(t *testing.T) {
+	// The server is reachable as 127.0.0.1, which is allowed, and as
+	// localhost, which isn't
+	var offListRequests int32
+	mux := http.NewServeMux()
+	server := httptest.NewServer(mux)
+	defer server.Close()
+	offListURL := strings.Replace(server.URL, "127.0.0.1", "localhost", 1)
+
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		if strings.HasPrefix(r.Host, "localhost") {
+			atomic.AddInt32(&offListRequests, 1)
+		}
+		switch r.URL.Path {
+		case "/thumbnail":
+			w.Header().Set("Content-Type", "text/html")
+			fmt.Fprintf(w, `<html><head>
+<meta property="og:title" content="OG title">
+<meta property="og:image" content="%s/thumbnail.png">
+</head></html>`, offListURL)
+		case "/oembed":
+			w.Header().Set("Content-Type", "text/html")
+			fmt.Fprintf(w, `<html><head>
+<title>Page title</title>
+<link rel="alternate" type="application/json+oembed" href="%s/oembed.json">
+</head></html>`, offListURL)
+		case "/redirect":
+			http.Redirect(w, r, offListURL+"/page", http.StatusFound)
+		case "/allowed-redirect":
+			http.Redirect(w, r, "/page", http.StatusFound)
+		case "/page":
+			w.Header().Set("Content-Type", "text/html")
+			fmt.Fprint(w, `<html><head><title>Page title</title></head></html>`)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+
+	allowedDomains := []string{"127.0.0.1"}
+
+	link, err := Unfurl(context.Background(), server.Client(), server.URL+"/thumbnail", allowedDomains)
+	require.NoError(t, err)
+	require.Equal(t, "OG title", link.Title)
+	require.Empty(t, link.ThumbnailPayload)
+
+	link, err = Unfurl(context.Background(), server.Client(), server.URL+"/oembed", allowedDomains)
+	require.NoError(t, err)
+	require.Equal(t, "Page title", link.Title)
+
+	_, err = Unfurl(context.Background(), server.Client(), server.URL+"/redirect", allowedDomains)
+	require.Error(t, err)
+
+	link, err = Unfurl(context.Background(), server.Client(), server.URL+"/allowed-redirect", allowedDomains)
+	require.NoError(t, err)
+	require.Equal(t, "Page title", link.Title)
+
+	_, err = Unfurl(context.Background(), server.Client(), offListURL+"/page", allowedDomains)
+	require.Error(t, err)
+
+	require.Equal(t, int32(0), atomic.LoadInt32(&offListRequests))
+}
+
+func TestExtractURLs(t *testing.T) {
+	text := "see https://status.im/docs, http://github.com/status-im and https://status.im/docs."
+	require.Equal(t, []string{"https://status.im/docs", "http://github.com/status-im"}, ExtractURLs(text))
+	require.Empty(t, ExtractURLs("no links ftp://status.im"))
+}
+
+func TestIsAllowed(t *testing.T) {
+	allowedDomains := []string{"status.im", " GitHub.com "}
+
+	require.True(t, IsAllowed("https://status.im/docs", allowedDomains))
+	require.True(t, IsAllowed("https://our.status.im", allowedDomains))
+	require.True(t, IsAllowed("https://github.com:443/status-im", allowedDomains))
+	require.False(t, IsAllowed("https://notstatus.im", allowedDomains))
+	require.False(t, IsAllowed("https://status.im.evil.com", allowedDomains))
+	require.False(t, IsAllowed("https://status.im", nil))
+}
@@ -0,0 +1,55 @@
+package protocol
+
+import (
+	"errors"
+	"strings"
+)
+
+// SetContactLocalNickname sets the nickname the contact is shown with, an
+// empty nickname removing it. The nickname is private, it's only synced with
+// our paired installations
+func (m *Messenger) SetContactLocalNickname(contactID string, nickname string) (*Contact, error) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	contact, ok := m.allContacts[contactID]
+	if !ok {
+		return nil, errors.New("no contact found")
+	}
+
+	// The change is made on a copy, so that saveContact can tell the
+	// nickname changed
+	updatedContact := *contact
+	updatedContact.LocalNickname = strings.TrimSpace(nickname)
+
+	err := m.saveContact(&updatedContact)
+	if err != nil {
+		return nil, err
+	}
+	return &updatedContact, nil
+}
+
+// updateLocalNicknameClock sets the clock value of the local nickname of the
+// contact, bumping it if the nickname changed since the contact was last
+// saved. It returns whether it changed
+func (m *Messenger) updateLocalNicknameClock(contact *Contact) bool {
+	var previousNickname string
+	if previous, ok := m.allContacts[contact.ID]; ok {
+		previousNickname = previous.LocalNickname
+		// Contacts saved by the client might not carry the clock value
+		if contact.LocalNicknameClock < previous.LocalNicknameClock {
+			contact.LocalNicknameClock = previous.LocalNicknameClock
+		}
+	}
+
+	if contact.LocalNickname == previousNickname {
+		return false
+	}
+
+	clock := m.getTimesource().GetCurrentTime()
+	if clock <= contact.LocalNicknameClock {
+		clock = contact.LocalNicknameClock + 1
+	}
+	contact.LocalNicknameClock = clock
+	return true
+}
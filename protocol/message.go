@@ -8,7 +8,9 @@ import (
 	"unicode/utf8"
 
 	"github.com/status-im/markdown"
+	"github.com/status-im/markdown/ast"
 
+	"github.com/status-im/status-go/protocol/identity/alias"
 	"github.com/status-im/status-go/protocol/protobuf"
 )
 
@@ -17,6 +19,8 @@ type QuotedMessage struct {
 	// From is a public key of the author of the message.
 	From string `json:"from"`
 	Text string `json:"text"`
+	// Deleted indicates that the quoted message has been deleted by its author
+	Deleted bool `json:"deleted,omitempty"`
 }
 
 type CommandState int
@@ -58,6 +62,9 @@ func (c *CommandParameters) IsTokenTransfer() bool {
 const (
 	OutgoingStatusSending = "sending"
 	OutgoingStatusSent    = "sent"
+	// OutgoingStatusSeen is set once the recipient of a message in a
+	// one-to-one chat sent a read receipt for it
+	OutgoingStatusSeen = "seen"
 )
 
 // Message represents a message record in the database,
@@ -95,6 +102,29 @@ type Message struct {
 	// that has been updated
 	Replace   string           `json:"replace,omitempty"`
 	SigPubKey *ecdsa.PublicKey `json:"-"`
+
+	// EditedAt is the clock value of the last edit applied to the message,
+	// 0 if the message has never been edited
+	EditedAt uint64 `json:"editedAt,omitempty"`
+
+	// Deleted indicates that the message has been deleted by its author,
+	// only a tombstone without content is kept in its place
+	Deleted bool `json:"deleted,omitempty"`
+
+	// ImagePath is the path of the image to send, only used when sending
+	// a message with an IMAGE content type
+	ImagePath string `json:"imagePath,omitempty"`
+
+	// Mentioned indicates that the message mentions us
+	Mentioned bool `json:"mentioned,omitempty"`
+
+	// ForwardedFromVerified indicates that the attribution of a forwarded
+	// message has been checked against the original message, known locally
+	ForwardedFromVerified bool `json:"-"`
+
+	// mentions are the public keys mentioned in the text of the message,
+	// set by PrepareContent
+	mentions []string
 }
 
 // RawMessage represent a sent or received message, kept for being able
@@ -116,6 +146,37 @@ func (m *Message) MarshalJSON() ([]byte, error) {
 		Hash string `json:"hash"`
 		Pack int32  `json:"pack"`
 	}
+	type ImageAlias struct {
+		Payload []byte                            `json:"payload"`
+		Format  protobuf.ImageMessage_ImageFormat `json:"format"`
+		Width   uint32                            `json:"width"`
+		Height  uint32                            `json:"height"`
+	}
+	type AudioAlias struct {
+		Payload    []byte                          `json:"payload"`
+		Type       protobuf.AudioMessage_AudioType `json:"type"`
+		DurationMs uint64                          `json:"durationMs"`
+	}
+	type PollAlias struct {
+		Question       string   `json:"question"`
+		Options        []string `json:"options"`
+		MultipleChoice bool     `json:"multipleChoice"`
+		ClosesAt       uint64   `json:"closesAt,omitempty"`
+	}
+	type LinkAlias struct {
+		URL         string `json:"url"`
+		Title       string `json:"title"`
+		Description string `json:"description"`
+		Thumbnail   []byte `json:"thumbnail,omitempty"`
+	}
+	type ForwardedFromAlias struct {
+		MessageID       string `json:"messageId"`
+		From            string `json:"from"`
+		Alias           string `json:"alias"`
+		Timestamp       uint64 `json:"timestamp"`
+		TransactionHash string `json:"transactionHash,omitempty"`
+		Verified        bool   `json:"verified"`
+	}
 	item := struct {
 		ID                string                           `json:"id"`
 		WhisperTimestamp  uint64                           `json:"whisperTimestamp"`
@@ -136,10 +197,18 @@ func (m *Message) MarshalJSON() ([]byte, error) {
 		ResponseTo        string                           `json:"responseTo"`
 		EnsName           string                           `json:"ensName"`
 		Sticker           *StickerAlias                    `json:"sticker"`
+		Image             *ImageAlias                      `json:"image,omitempty"`
+		Audio             *AudioAlias                      `json:"audio,omitempty"`
+		Poll              *PollAlias                       `json:"poll,omitempty"`
+		Links             []*LinkAlias                     `json:"links,omitempty"`
+		ForwardedFrom     *ForwardedFromAlias              `json:"forwardedFrom,omitempty"`
 		CommandParameters *CommandParameters               `json:"commandParameters"`
 		Timestamp         uint64                           `json:"timestamp"`
 		ContentType       protobuf.ChatMessage_ContentType `json:"contentType"`
 		MessageType       protobuf.ChatMessage_MessageType `json:"messageType"`
+		EditedAt          uint64                           `json:"editedAt,omitempty"`
+		Deleted           bool                             `json:"deleted,omitempty"`
+		Mentioned         bool                             `json:"mentioned,omitempty"`
 	}{
 		ID:                m.ID,
 		WhisperTimestamp:  m.WhisperTimestamp,
@@ -163,6 +232,9 @@ func (m *Message) MarshalJSON() ([]byte, error) {
 		ContentType:       m.ContentType,
 		MessageType:       m.MessageType,
 		CommandParameters: m.CommandParameters,
+		EditedAt:          m.EditedAt,
+		Deleted:           m.Deleted,
+		Mentioned:         m.Mentioned,
 	}
 
 	if sticker := m.GetSticker(); sticker != nil {
@@ -171,6 +243,58 @@ func (m *Message) MarshalJSON() ([]byte, error) {
 			Hash: sticker.Hash,
 		}
 	}
+
+	if image := m.GetImage(); image != nil {
+		item.Image = &ImageAlias{
+			Payload: image.Payload,
+			Format:  image.Format,
+			Width:   image.Width,
+			Height:  image.Height,
+		}
+	}
+
+	for _, link := range m.Links {
+		item.Links = append(item.Links, &LinkAlias{
+			URL:         link.Url,
+			Title:       link.Title,
+			Description: link.Description,
+			Thumbnail:   link.ThumbnailPayload,
+		})
+	}
+
+	if forwardedFrom := m.GetForwardedFrom(); forwardedFrom != nil {
+		// The author of the original message might not be a contact, it's
+		// rendered with its generated name
+		name, err := alias.GenerateFromPublicKeyString(forwardedFrom.From)
+		if err != nil {
+			return nil, err
+		}
+		item.ForwardedFrom = &ForwardedFromAlias{
+			MessageID:       forwardedFrom.MessageId,
+			From:            forwardedFrom.From,
+			Alias:           name,
+			Timestamp:       forwardedFrom.Timestamp,
+			TransactionHash: forwardedFrom.TransactionHash,
+			Verified:        m.ForwardedFromVerified,
+		}
+	}
+
+	if audio := m.GetAudio(); audio != nil {
+		item.Audio = &AudioAlias{
+			Payload:    audio.Payload,
+			Type:       audio.Type,
+			DurationMs: audio.DurationMs,
+		}
+	}
+
+	if poll := m.GetPoll(); poll != nil {
+		item.Poll = &PollAlias{
+			Question:       poll.Question,
+			Options:        poll.Options,
+			MultipleChoice: poll.MultipleChoice,
+			ClosesAt:       poll.ClosesAt,
+		}
+	}
 	return json.Marshal(item)
 }
 
@@ -182,6 +306,9 @@ func (m *Message) UnmarshalJSON(data []byte) error {
 		EnsName     string                           `json:"ensName"`
 		ChatID      string                           `json:"chatId"`
 		Sticker     *protobuf.StickerMessage         `json:"sticker"`
+		Image       *protobuf.ImageMessage           `json:"image"`
+		Audio       *protobuf.AudioMessage           `json:"audio"`
+		Poll        *protobuf.PollMessage            `json:"poll"`
 		ContentType protobuf.ChatMessage_ContentType `json:"contentType"`
 	}{
 		Alias: (*Alias)(m),
@@ -192,6 +319,15 @@ func (m *Message) UnmarshalJSON(data []byte) error {
 	if aux.ContentType == protobuf.ChatMessage_STICKER {
 		m.Payload = &protobuf.ChatMessage_Sticker{Sticker: aux.Sticker}
 	}
+	if aux.ContentType == protobuf.ChatMessage_IMAGE && aux.Image != nil {
+		m.Payload = &protobuf.ChatMessage_Image{Image: aux.Image}
+	}
+	if aux.ContentType == protobuf.ChatMessage_AUDIO && aux.Audio != nil {
+		m.Payload = &protobuf.ChatMessage_Audio{Audio: aux.Audio}
+	}
+	if aux.ContentType == protobuf.ChatMessage_POLL && aux.Poll != nil {
+		m.Payload = &protobuf.ChatMessage_Poll{Poll: aux.Poll}
+	}
 	m.ResponseTo = aux.ResponseTo
 	m.EnsName = aux.EnsName
 	m.ChatId = aux.ChatID
@@ -208,10 +344,79 @@ func isRTL(s string) bool {
 		first == '\u200f'
 }
 
+// mentionNode replaces the mentions in the parsed text so that the name of
+// the mentioned user is available along with its public key
+type mentionNode struct {
+	ast.Leaf
+	Name string
+}
+
+func (n *mentionNode) MarshalJSON() ([]byte, error) {
+	item := struct {
+		Type    string `json:"type"`
+		Literal string `json:"literal"`
+		Name    string `json:"name"`
+	}{
+		Type:    "mention",
+		Literal: string(n.Literal),
+		Name:    n.Name,
+	}
+	return json.Marshal(item)
+}
+
+// mentionName returns the name to display for a mentioned public key, the
+// display name of the contact if known, its generated alias otherwise
+func mentionName(publicKey string, contacts map[string]*Contact) (string, error) {
+	if contact, ok := contacts[publicKey]; ok {
+		if name := contact.displayName(); len(name) != 0 {
+			return name, nil
+		}
+	}
+	return alias.GenerateFromPublicKeyString(publicKey)
+}
+
 // PrepareContent return the parsed content of the message, the line-count and whether
 // is a right-to-left message
 func (m *Message) PrepareContent() error {
+	return m.prepareContent(nil)
+}
+
+// prepareContent is like PrepareContent, mentions of the given contacts are
+// resolved to their names
+func (m *Message) prepareContent(contacts map[string]*Contact) error {
 	parsedText := markdown.Parse([]byte(m.Text), nil)
+
+	var mentions []*ast.Mention
+	ast.WalkFunc(parsedText, func(node ast.Node, entering bool) ast.WalkStatus {
+		if mention, ok := node.(*ast.Mention); ok && entering {
+			mentions = append(mentions, mention)
+		}
+		return ast.GoToNext
+	})
+
+	m.mentions = nil
+	for _, mention := range mentions {
+		publicKey := strings.ToLower(string(mention.Literal))
+		name, err := mentionName(publicKey, contacts)
+		if err != nil {
+			return err
+		}
+		if !stringSliceContains(m.mentions, publicKey) {
+			m.mentions = append(m.mentions, publicKey)
+		}
+
+		node := &mentionNode{Name: name}
+		node.Literal = mention.Literal
+		parent := mention.GetParent()
+		children := parent.GetChildren()
+		for i, child := range children {
+			if child == mention {
+				children[i] = node
+			}
+		}
+		node.SetParent(parent)
+	}
+
 	jsonParsedText, err := json.Marshal(parsedText)
 	if err != nil {
 		return err
@@ -221,3 +426,9 @@ func (m *Message) PrepareContent() error {
 	m.RTL = isRTL(m.Text)
 	return nil
 }
+
+// GetSigPubKey returns an ecdsa encoded public key
+// this function is required to implement the ChatEntity interface
+func (m *Message) GetSigPubKey() *ecdsa.PublicKey {
+	return m.SigPubKey
+}
@@ -20,6 +20,9 @@ func extendMessageFromChat(message *Message, chat *Chat, key *ecdsa.PublicKey, t
 	message.WhisperTimestamp = timestamp
 	message.Seen = true
 	message.OutgoingStatus = OutgoingStatusSending
+	if chat.DisappearingMessagesTimer != 0 {
+		message.ExpiresAt = timestamp + chat.DisappearingMessagesTimer
+	}
 
 	identicon, err := identicon.GenerateBase64(message.From)
 	if err != nil {
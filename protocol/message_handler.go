@@ -5,6 +5,7 @@ import (
 	"encoding/hex"
 	"fmt"
 
+	"github.com/golang/protobuf/proto"
 	"github.com/pkg/errors"
 	"go.uber.org/zap"
 
@@ -74,11 +75,12 @@ func (m *MessageHandler) HandleMembershipUpdate(messageState *ReceivedMessageSta
 		if err != nil {
 			return errors.Wrap(err, "failed to create a Group from Chat")
 		}
-		updateGroup, err := v1protocol.NewGroupWithEvents(message.ChatID, message.Events)
-		if err != nil {
-			return errors.Wrap(err, "invalid membership update")
+		if message.ChatID != chat.ID {
+			return errors.New("invalid membership update")
 		}
-		merged := v1protocol.MergeMembershipUpdateEvents(existingGroup.Events(), updateGroup.Events())
+		// The events are merged before being applied, as the validity of
+		// concurrent events depends on the ones applied before them
+		merged := v1protocol.MergeMembershipUpdateEvents(existingGroup.Events(), message.Events)
 		group, err = v1protocol.NewGroupWithEvents(chat.ID, merged)
 		if err != nil {
 			return errors.Wrap(err, "failed to create a group with new membership updates")
@@ -86,7 +88,18 @@ func (m *MessageHandler) HandleMembershipUpdate(messageState *ReceivedMessageSta
 	}
 
 	chat.updateChatFromProtocolGroup(group)
-	systemMessages := buildSystemMessages(message.Events, translations)
+
+	// The join requests of the members added by another admin are approved
+	if chat.IsAdmin(contactIDFromPublicKey(&m.identity.PublicKey)) {
+		approved, err := approveGroupChatJoinRequests(m.persistence, chat)
+		if err != nil {
+			return err
+		}
+		messageState.Response.GroupChatJoinRequests = append(messageState.Response.GroupChatJoinRequests, approved...)
+	}
+
+	// Events dropped as they conflict with others are not shown
+	systemMessages := buildSystemMessages(appliedMembershipUpdateEvents(message.Events, group.Events()), translations)
 
 	for _, message := range systemMessages {
 		messageID := message.ID
@@ -124,7 +137,7 @@ func (m *MessageHandler) handleCommandMessage(state *ReceivedMessageState, messa
 	if err := message.PrepareContent(); err != nil {
 		return fmt.Errorf("failed to prepare content: %v", err)
 	}
-	chat, err := m.matchMessage(message, state.AllChats, state.Timesource)
+	chat, err := m.matchChatEntity(message, state.AllChats, state.Timesource)
 	if err != nil {
 		return err
 	}
@@ -196,24 +209,101 @@ func (m *MessageHandler) HandleSyncInstallationContact(state *ReceivedMessageSta
 	}
 
 	if contact.LastUpdated < message.Clock {
-		if !contact.IsAdded() {
+		// Contacts synced along with a contact request have their tags set
+		// according to the state of the request
+		if !contact.IsAdded() && message.ContactRequestClock == 0 && !message.NotAdded {
 			contact.SystemTags = append(contact.SystemTags, contactAdded)
+			if err := m.showMessagesFromAddedContact(state, contact); err != nil {
+				return err
+			}
 		}
 		if contact.Name != message.EnsName {
 			contact.Name = message.EnsName
 			contact.ENSVerified = false
 		}
 		contact.Photo = message.ProfileImage
+		contact.updateProfile(message.DisplayName, message.Bio, message.Images, message.ProfileLink)
 		contact.LastUpdated = message.Clock
 		state.ModifiedContacts[contact.ID] = true
 		state.AllContacts[contact.ID] = contact
 	}
 
+	if contact.ContactRequestClock < message.ContactRequestClock {
+		contact.ContactRequestState = ContactRequestState(message.ContactRequestState)
+		contact.ContactRequestClock = message.ContactRequestClock
+		contact.ContactRequestText = message.ContactRequestText
+
+		switch contact.ContactRequestState {
+		case ContactRequestStateSent, ContactRequestStateAccepted:
+			contact.addSystemTag(contactAdded)
+		case ContactRequestStateNone:
+			contact.removeSystemTag(contactAdded)
+		}
+
+		if err := m.showMessagesFromAddedContact(state, contact); err != nil {
+			return err
+		}
+
+		state.ModifiedContacts[contact.ID] = true
+		state.AllContacts[contact.ID] = contact
+	}
+
+	if contact.LocalNicknameClock < message.LocalNicknameClock {
+		contact.LocalNickname = message.LocalNickname
+		contact.LocalNicknameClock = message.LocalNicknameClock
+		state.ModifiedContacts[contact.ID] = true
+		state.AllContacts[contact.ID] = contact
+	}
+
 	state.AllChats[chat.ID] = chat
 
 	return nil
 }
 
+// showMessagesFromAddedContact shows the messages hidden while a contact
+// was not added, once it is
+func (m *MessageHandler) showMessagesFromAddedContact(state *ReceivedMessageState, contact *Contact) error {
+	if !contact.IsAdded() {
+		return nil
+	}
+
+	contactChat, ok := state.AllChats[contact.ID]
+	if !ok {
+		publicKey, err := contact.PublicKey()
+		if err != nil {
+			return err
+		}
+		contactChat = OneToOneFromPublicKey(publicKey, state.Timesource)
+		contactChat.Active = false
+	}
+	shown, err := showMessagesFromContact(m.persistence, contactChat, state.Timesource)
+	if err != nil {
+		return err
+	}
+	if shown {
+		state.ModifiedChats[contactChat.ID] = true
+		state.AllChats[contactChat.ID] = contactChat
+	}
+	return nil
+}
+
+// HandleSyncInstallationAccount applies our profile synced from a paired
+// installation, unless it's older than ours
+func (m *MessageHandler) HandleSyncInstallationAccount(state *ReceivedMessageState, message protobuf.SyncInstallationAccount) error {
+	contact := state.CurrentMessageState.Contact
+	if contact.LastUpdated >= message.LastUpdated {
+		return nil
+	}
+
+	contact.Photo = message.ProfileImage
+	contact.updateProfile(message.DisplayName, message.Bio, message.Images, message.ProfileLink)
+	contact.LastUpdated = message.LastUpdated
+	state.ModifiedContacts[contact.ID] = true
+	state.AllContacts[contact.ID] = contact
+
+	return nil
+}
+
 func (m *MessageHandler) HandleSyncInstallationPublicChat(state *ReceivedMessageState, message protobuf.SyncInstallationPublicChat) error {
 	chatID := message.Id
 	_, ok := state.AllChats[chatID]
@@ -229,8 +319,37 @@ func (m *MessageHandler) HandleSyncInstallationPublicChat(state *ReceivedMessage
 	return nil
 }
 
+// HandleSyncChatNotificationSettings applies the notification settings of a
+// chat changed on a paired device, unless they are older than ours. The
+// settings of a chat we don't have yet are kept until it's created
+func (m *MessageHandler) HandleSyncChatNotificationSettings(state *ReceivedMessageState, message protobuf.SyncChatNotificationSettings) error {
+	chat, ok := state.AllChats[message.ChatId]
+	if !ok {
+		pending, ok := state.PendingChatNotificationSettings[message.ChatId]
+		if ok && pending.Clock >= message.Clock {
+			return nil
+		}
+		if err := m.persistence.SavePendingChatNotificationSettings(&message); err != nil {
+			return err
+		}
+		state.PendingChatNotificationSettings[message.ChatId] = &message
+		return nil
+	}
+
+	if chat.applyNotificationSettings(&message) {
+		state.ModifiedChats[chat.ID] = true
+	}
+
+	return nil
+}
+
 func (m *MessageHandler) HandleContactUpdate(state *ReceivedMessageState, message protobuf.ContactUpdate) error {
 	logger := m.logger.With(zap.String("site", "HandleContactUpdate"))
+	if err := ValidateReceivedContactUpdate(&message, state.CurrentMessageState.WhisperTimestamp); err != nil {
+		logger.Warn("failed to validate contact update", zap.Error(err))
+		return err
+	}
+
 	contact := state.CurrentMessageState.Contact
 	chat, ok := state.AllChats[contact.ID]
 	if !ok {
@@ -251,6 +370,7 @@ func (m *MessageHandler) HandleContactUpdate(state *ReceivedMessageState, messag
 			contact.ENSVerified = false
 		}
 		contact.Photo = message.ProfileImage
+		contact.updateProfile(message.DisplayName, message.Bio, message.Images, message.ProfileLink)
 		contact.LastUpdated = message.Clock
 		state.ModifiedContacts[contact.ID] = true
 		state.AllContacts[contact.ID] = contact
@@ -306,13 +426,13 @@ func (m *MessageHandler) HandleChatMessage(state *ReceivedMessageState) error {
 		WhisperTimestamp: state.CurrentMessageState.WhisperTimestamp,
 	}
 
-	err := receivedMessage.PrepareContent()
+	err := receivedMessage.prepareContent(state.AllContacts)
 	if err != nil {
 		return fmt.Errorf("failed to prepare message content: %v", err)
 	}
-	chat, err := m.matchMessage(receivedMessage, state.AllChats, state.Timesource)
+	chat, err := m.matchChatEntity(receivedMessage, state.AllChats, state.Timesource)
 	if err != nil {
-		return err // matchMessage returns a descriptive error message
+		return err // matchChatEntity returns a descriptive error message
 	}
 
 	// If deleted-at is greater, ignore message
@@ -330,9 +450,74 @@ func (m *MessageHandler) HandleChatMessage(state *ReceivedMessageState) error {
 	// Set the LocalChatID for the message
 	receivedMessage.LocalChatID = chat.ID
 
-	// Increase unviewed count
+	awaitingPost := false
+	if chat.Timeline() {
+		received, err := m.checkTimelineMessage(chat, receivedMessage, state.Response.Messages)
+		if err != nil {
+			return err
+		}
+		awaitingPost = !received
+	}
+
+	// Forwarded messages are shown as such, their attribution is marked as
+	// verified only if it matches the original message
+	if receivedMessage.ForwardedFrom != nil {
+		verified, err := m.verifyForwardedFrom(receivedMessage, state.Response.Messages)
+		if err != nil {
+			return err
+		}
+		receivedMessage.ForwardedFromVerified = verified
+	}
+
+	// Pins of the message received before it in another chat are bogus
+	if err := m.persistence.DeletePinMessagesOutsideChat(receivedMessage.ID, receivedMessage.LocalChatID); err != nil {
+		return err
+	}
+
+	// Apply the deletion or the most recent edit, in case they have been
+	// received before the message. Only the ones sent to the chat of the
+	// message are taken into account
+	deletion, err := m.persistence.deleteBy(receivedMessage.ID, receivedMessage.From, receivedMessage.LocalChatID)
+	if err != nil && err != errRecordNotFound {
+		return err
+	}
+	if deletion != nil && isDeletable(receivedMessage) {
+		if err := m.applyDelete(receivedMessage); err != nil {
+			return err
+		}
+	} else if receivedMessage.ContentType == protobuf.ChatMessage_TEXT_PLAIN {
+		edit, err := m.persistence.latestEditBy(receivedMessage.ID, receivedMessage.From, receivedMessage.LocalChatID)
+		if err != nil && err != errRecordNotFound {
+			return err
+		}
+		if edit != nil {
+			if _, err := m.applyEdit(receivedMessage, edit); err != nil {
+				return err
+			}
+		}
+	}
+
+	// Comments on a status update not received yet are saved hidden, they
+	// are shown once it is
+	if awaitingPost {
+		state.MessagesAwaitingPost = append(state.MessagesAwaitingPost, receivedMessage)
+		return nil
+	}
+
+	// One-to-one messages from non-contacts are saved hidden, they are shown
+	// once their contact request is accepted
+	if state.HideNonContactMessages && receivedMessage.MessageType == protobuf.ChatMessage_ONE_TO_ONE && !state.CurrentMessageState.Contact.IsAdded() && !isPubKeyEqual(receivedMessage.SigPubKey, &m.identity.PublicKey) {
+		state.HiddenMessages = append(state.HiddenMessages, receivedMessage)
+		return nil
+	}
+
+	// Increase unviewed counts
 	if !isPubKeyEqual(receivedMessage.SigPubKey, &m.identity.PublicKey) {
 		chat.UnviewedMessagesCount++
+		if !receivedMessage.Deleted && stringSliceContains(receivedMessage.mentions, contactIDFromPublicKey(&m.identity.PublicKey)) {
+			receivedMessage.Mentioned = true
+			chat.UnviewedMentionsCount++
+		}
 	} else {
 		// Our own message, mark as sent
 		receivedMessage.OutgoingStatus = OutgoingStatusSent
@@ -562,26 +747,26 @@ func (m *MessageHandler) HandleDeclineRequestTransaction(messageState *ReceivedM
 	return m.handleCommandMessage(messageState, oldMessage)
 }
 
-func (m *MessageHandler) matchMessage(message *Message, chats map[string]*Chat, timesource TimeSource) (*Chat, error) {
-	if message.SigPubKey == nil {
+func (m *MessageHandler) matchChatEntity(chatEntity ChatEntity, chats map[string]*Chat, timesource TimeSource) (*Chat, error) {
+	if chatEntity.GetSigPubKey() == nil {
 		m.logger.Error("public key can't be empty")
 		return nil, errors.New("received a message with empty public key")
 	}
 
 	switch {
-	case message.MessageType == protobuf.ChatMessage_PUBLIC_GROUP:
+	case chatEntity.GetMessageType() == protobuf.ChatMessage_PUBLIC_GROUP:
 		// For public messages, all outgoing and incoming messages have the same chatID
 		// equal to a public chat name.
-		chatID := message.ChatId
+		chatID := chatEntity.GetChatId()
 		chat := chats[chatID]
 		if chat == nil {
 			return nil, errors.New("received a public message from non-existing chat")
 		}
 		return chat, nil
-	case message.MessageType == protobuf.ChatMessage_ONE_TO_ONE && isPubKeyEqual(message.SigPubKey, &m.identity.PublicKey):
+	case chatEntity.GetMessageType() == protobuf.ChatMessage_ONE_TO_ONE && isPubKeyEqual(chatEntity.GetSigPubKey(), &m.identity.PublicKey):
 		// It's a private message coming from us so we rely on Message.ChatID
 		// If chat does not exist, it should be created to support multidevice synchronization.
-		chatID := message.ChatId
+		chatID := chatEntity.GetChatId()
 		chat := chats[chatID]
 		if chat == nil {
 			if len(chatID) != PubKeyStringLength {
@@ -601,27 +786,54 @@ func (m *MessageHandler) matchMessage(message *Message, chats map[string]*Chat,
 			chat = &newChat
 		}
 		return chat, nil
-	case message.MessageType == protobuf.ChatMessage_ONE_TO_ONE:
+	case chatEntity.GetMessageType() == protobuf.ChatMessage_ONE_TO_ONE:
 		// It's an incoming private message. ChatID is calculated from the signature.
 		// If a chat does not exist, a new one is created and saved.
-		chatID := contactIDFromPublicKey(message.SigPubKey)
+		chatID := contactIDFromPublicKey(chatEntity.GetSigPubKey())
 		chat := chats[chatID]
 		if chat == nil {
 			// TODO: this should be a three-word name used in the mobile client
-			newChat := CreateOneToOneChat(chatID[:8], message.SigPubKey, timesource)
+			newChat := CreateOneToOneChat(chatID[:8], chatEntity.GetSigPubKey(), timesource)
 			chat = &newChat
 		}
 		return chat, nil
-	case message.MessageType == protobuf.ChatMessage_PRIVATE_GROUP:
+	case chatEntity.GetMessageType() == protobuf.ChatMessage_COMMUNITY_CHAT:
+		// Community messages can only be decrypted by the members, it still
+		// needs to be verified that the author has not been removed since
+		chatID := chatEntity.GetChatId()
+		chat := chats[chatID]
+		if chat == nil || !chat.CommunityChat() {
+			return nil, errors.New("received community message for non-existing chat")
+		}
+
+		community, err := m.persistence.Community(chat.CommunityID)
+		if err != nil {
+			return nil, err
+		}
+
+		if !community.IsMember(contactIDFromPublicKey(chatEntity.GetSigPubKey())) {
+			return nil, errors.New("did not find a matching community member")
+		}
+		return chat, nil
+	case chatEntity.GetMessageType() == protobuf.ChatMessage_TIMELINE:
+		// Timelines are only followed when their owner is a contact, what can be
+		// posted on them is checked against the status updates they reference
+		chatID := chatEntity.GetChatId()
+		chat := chats[chatID]
+		if chat == nil || !chat.Timeline() || !chat.Active {
+			return nil, errors.New("received timeline message for non-followed timeline")
+		}
+		return chat, nil
+	case chatEntity.GetMessageType() == protobuf.ChatMessage_PRIVATE_GROUP:
 		// In the case of a group message, ChatID is the same for all messages belonging to a group.
 		// It needs to be verified if the signature public key belongs to the chat.
-		chatID := message.ChatId
+		chatID := chatEntity.GetChatId()
 		chat := chats[chatID]
 		if chat == nil {
 			return nil, errors.New("received group chat message for non-existing chat")
 		}
 
-		theirKeyHex := contactIDFromPublicKey(message.SigPubKey)
+		theirKeyHex := contactIDFromPublicKey(chatEntity.GetSigPubKey())
 		myKeyHex := contactIDFromPublicKey(&m.identity.PublicKey)
 		var theyJoined bool
 		var iJoined bool
@@ -664,3 +876,1088 @@ func (m *MessageHandler) messageExists(messageID string, existingMessagesMap map
 	}
 	return false, nil
 }
+
+func (m *MessageHandler) HandleEmojiReaction(state *ReceivedMessageState, pbEmojiR protobuf.EmojiReaction) error {
+	logger := m.logger.With(zap.String("site", "HandleEmojiReaction"))
+	if err := ValidateReceivedEmojiReaction(&pbEmojiR, state.CurrentMessageState.WhisperTimestamp); err != nil {
+		logger.Warn("failed to validate emoji reaction", zap.Error(err))
+		return err
+	}
+
+	from := state.CurrentMessageState.Contact.ID
+
+	existingEmoji, err := m.persistence.emojiReactionBy(pbEmojiR.MessageId, from, pbEmojiR.Type)
+	if err != nil && err != errRecordNotFound {
+		return err
+	}
+
+	if existingEmoji != nil && existingEmoji.Clock >= pbEmojiR.Clock {
+		// this is not a valid emoji, ignoring
+		return nil
+	}
+
+	emojiReaction := &EmojiReaction{
+		EmojiReaction: pbEmojiR,
+		ID:            state.CurrentMessageState.MessageID,
+		From:          from,
+		SigPubKey:     state.CurrentMessageState.PublicKey,
+	}
+
+	// The ID of a reaction is the ID of the message that first sent it,
+	// retractions and re-additions keep it stable
+	if existingEmoji != nil {
+		emojiReaction.ID = existingEmoji.ID
+	}
+
+	chat, err := m.matchChatEntity(emojiReaction, state.AllChats, state.Timesource)
+	if err != nil {
+		return err // matchChatEntity returns a descriptive error message
+	}
+
+	// If deleted-at is greater, ignore message
+	if chat.DeletedAtClockValue >= pbEmojiR.Clock {
+		return nil
+	}
+
+	// Reactions on a timeline are scoped to its status updates
+	awaitingPost := false
+	if chat.Timeline() {
+		received, err := m.checkTimelineReference(chat, pbEmojiR.MessageId, state.Response.Messages)
+		if err != nil {
+			return err
+		}
+		awaitingPost = !received
+	}
+
+	// Set local chat id
+	emojiReaction.LocalChatID = chat.ID
+
+	err = m.persistence.SaveEmojiReaction(emojiReaction)
+	if err != nil {
+		return err
+	}
+
+	// Reactions on a status update not received yet are returned once it is
+	if awaitingPost {
+		return nil
+	}
+
+	state.Response.EmojiReactions = append(state.Response.EmojiReactions, emojiReaction)
+
+	return nil
+}
+
+func (m *MessageHandler) HandleEditMessage(state *ReceivedMessageState, pbEdit protobuf.EditMessage) error {
+	logger := m.logger.With(zap.String("site", "HandleEditMessage"))
+	if err := ValidateReceivedEditMessage(&pbEdit, state.CurrentMessageState.WhisperTimestamp); err != nil {
+		logger.Warn("failed to validate edit message", zap.Error(err))
+		return err
+	}
+
+	edit := &EditMessage{
+		EditMessage: pbEdit,
+		ID:          state.CurrentMessageState.MessageID,
+		From:        state.CurrentMessageState.Contact.ID,
+		SigPubKey:   state.CurrentMessageState.PublicKey,
+	}
+
+	chat, err := m.matchChatEntity(edit, state.AllChats, state.Timesource)
+	if err != nil {
+		return err // matchChatEntity returns a descriptive error message
+	}
+	edit.LocalChatID = chat.ID
+
+	// If deleted-at is greater, ignore message
+	if chat.DeletedAtClockValue >= edit.Clock {
+		return nil
+	}
+
+	// The message might have been received in this same batch, in which case
+	// it's not been saved yet
+	var originalMessage *Message
+	inResponse := false
+	for _, message := range state.Response.Messages {
+		if message.ID == edit.MessageId {
+			originalMessage = message
+			inResponse = true
+			break
+		}
+	}
+
+	if originalMessage == nil {
+		originalMessage, err = m.persistence.MessageByID(edit.MessageId)
+		if err == errRecordNotFound {
+			// The edit arrived before the message, keep it around so
+			// that it's applied once the message is received
+			return m.persistence.SaveEdits([]*EditMessage{edit})
+		} else if err != nil {
+			return err
+		}
+	}
+
+	if originalMessage.From != edit.From {
+		return errors.New("edit not sent by the author of the message")
+	}
+
+	if originalMessage.LocalChatID != chat.ID {
+		return errors.New("edit sent to a different chat than the message")
+	}
+
+	if originalMessage.ContentType != protobuf.ChatMessage_TEXT_PLAIN {
+		return errors.New("only text messages can be edited")
+	}
+
+	// A deleted message can't be edited anymore
+	if originalMessage.Deleted {
+		return nil
+	}
+
+	applied, err := m.applyEdit(originalMessage, edit)
+	if err != nil {
+		return err
+	}
+
+	if !applied {
+		return nil
+	}
+
+	if !inResponse {
+		state.Response.Messages = append(state.Response.Messages, originalMessage)
+	}
+
+	// Update the last message of the chat if it's the one edited
+	if chat.LastClockValue <= originalMessage.Clock {
+		err = chat.UpdateFromMessage(originalMessage, state.Timesource)
+		if err != nil {
+			return err
+		}
+		state.ModifiedChats[chat.ID] = true
+		state.AllChats[chat.ID] = chat
+	}
+
+	return nil
+}
+
+// applyEdit replaces the text of message with the one of edit, if it's the
+// most recent edit, and keeps the previous version in the edit history.
+// It returns whether the message has been changed
+func (m *MessageHandler) applyEdit(message *Message, edit *EditMessage) (bool, error) {
+	// An edit can't be older than the message it edits
+	if edit.Clock <= message.Clock {
+		return false, nil
+	}
+
+	// An older edit only goes into the history
+	if edit.Clock <= message.EditedAt {
+		return false, m.persistence.SaveEdits([]*EditMessage{edit})
+	}
+
+	edits := []*EditMessage{edit}
+
+	// Keep the original version of the message the first time it's edited
+	if message.EditedAt == 0 {
+		original := &EditMessage{
+			EditMessage: protobuf.EditMessage{
+				Clock:       message.Clock,
+				Text:        message.Text,
+				ChatId:      message.ChatId,
+				MessageId:   message.ID,
+				MessageType: message.MessageType,
+			},
+			ID:          message.ID,
+			From:        message.From,
+			LocalChatID: message.LocalChatID,
+		}
+		edits = append([]*EditMessage{original}, edits...)
+	}
+
+	message.Text = edit.Text
+	message.EditedAt = edit.Clock
+
+	err := message.PrepareContent()
+	if err != nil {
+		return false, fmt.Errorf("failed to prepare message content: %v", err)
+	}
+
+	return true, m.persistence.SaveEditedMessage(message, edits)
+}
+
+func (m *MessageHandler) HandleDeleteMessage(state *ReceivedMessageState, pbDelete protobuf.DeleteMessage) error {
+	logger := m.logger.With(zap.String("site", "HandleDeleteMessage"))
+	if err := ValidateReceivedDeleteMessage(&pbDelete, state.CurrentMessageState.WhisperTimestamp); err != nil {
+		logger.Warn("failed to validate delete message", zap.Error(err))
+		return err
+	}
+
+	deletion := &DeleteMessage{
+		DeleteMessage: pbDelete,
+		ID:            state.CurrentMessageState.MessageID,
+		From:          state.CurrentMessageState.Contact.ID,
+		SigPubKey:     state.CurrentMessageState.PublicKey,
+	}
+
+	chat, err := m.matchChatEntity(deletion, state.AllChats, state.Timesource)
+	if err != nil {
+		return err // matchChatEntity returns a descriptive error message
+	}
+	deletion.LocalChatID = chat.ID
+
+	// If deleted-at is greater, ignore message
+	if chat.DeletedAtClockValue >= deletion.Clock {
+		return nil
+	}
+
+	// The message might have been received in this same batch, in which case
+	// it's not been saved yet
+	var originalMessage *Message
+	inResponse := false
+	for _, message := range state.Response.Messages {
+		if message.ID == deletion.MessageId {
+			originalMessage = message
+			inResponse = true
+			break
+		}
+	}
+
+	if originalMessage == nil {
+		originalMessage, err = m.persistence.MessageByID(deletion.MessageId)
+		if err == errRecordNotFound {
+			// The deletion arrived before the message, keep it around so
+			// that it's applied once the message is received
+			return m.persistence.SaveDeletes([]*DeleteMessage{deletion})
+		} else if err != nil {
+			return err
+		}
+	}
+
+	if originalMessage.From != deletion.From {
+		return errors.New("deletion not sent by the author of the message")
+	}
+
+	if originalMessage.LocalChatID != chat.ID {
+		return errors.New("deletion sent to a different chat than the message")
+	}
+
+	if !isDeletable(originalMessage) {
+		return errors.New("message can't be deleted")
+	}
+
+	if originalMessage.Deleted {
+		return nil
+	}
+
+	err = m.applyDelete(originalMessage)
+	if err != nil {
+		return err
+	}
+
+	if !inResponse {
+		state.Response.Messages = append(state.Response.Messages, originalMessage)
+	}
+
+	// Messages quoting the deleted one need to be refreshed
+	for _, message := range state.Response.Messages {
+		if message.ResponseTo == originalMessage.ID && message.QuotedMessage != nil {
+			message.QuotedMessage.Text = ""
+			message.QuotedMessage.Deleted = true
+		}
+	}
+	quotingMessages, err := m.persistence.MessagesByResponseTo(originalMessage.ID)
+	if err != nil {
+		return err
+	}
+	for _, quotingMessage := range quotingMessages {
+		found := false
+		for _, message := range state.Response.Messages {
+			if message.ID == quotingMessage.ID {
+				found = true
+				break
+			}
+		}
+		if !found {
+			state.Response.Messages = append(state.Response.Messages, quotingMessage)
+		}
+	}
+
+	// Update the last message of the chat if it's the one deleted
+	if chat.LastClockValue <= originalMessage.Clock {
+		err = chat.UpdateFromMessage(originalMessage, state.Timesource)
+		if err != nil {
+			return err
+		}
+		state.ModifiedChats[chat.ID] = true
+		state.AllChats[chat.ID] = chat
+	}
+
+	return nil
+}
+
+// applyDelete turns message into a tombstone, which keeps its place in the
+// chat but none of its content
+func (m *MessageHandler) applyDelete(message *Message) error {
+	message.Deleted = true
+	message.Text = ""
+	message.ParsedText = nil
+	message.LineCount = 0
+	message.RTL = false
+	message.Payload = nil
+	message.Links = nil
+	message.ForwardedFrom = nil
+	message.ForwardedFromVerified = false
+
+	return m.persistence.SaveDeletedMessage(message)
+}
+
+// isDeletable returns whether message can be deleted by its author, system
+// messages and transaction commands can't
+func isDeletable(message *Message) bool {
+	return message.ContentType != protobuf.ChatMessage_TRANSACTION_COMMAND &&
+		message.ContentType != protobuf.ChatMessage_SYSTEM_MESSAGE_CONTENT_PRIVATE_GROUP
+}
+
+// HandleMessageChunk stores a chunk of a message too big to be sent in a
+// single envelope. Once all the chunks have been received, it returns the
+// reassembled message, ready to be processed as any other message.
+// receivedAt is the time in milliseconds the chunk has been received at
+func (m *MessageHandler) HandleMessageChunk(statusMessage *v1protocol.StatusMessage, chunk protobuf.MessageChunk, receivedAt uint64) (*v1protocol.StatusMessage, error) {
+	logger := m.logger.With(zap.String("site", "HandleMessageChunk"))
+	if err := ValidateReceivedMessageChunk(&chunk); err != nil {
+		logger.Warn("failed to validate message chunk", zap.Error(err))
+		return nil, err
+	}
+
+	publicKey := statusMessage.SigPubKey()
+	source := contactIDFromPublicKey(publicKey)
+
+	err := m.persistence.SaveMessageChunk(&chunk, source, receivedAt)
+	if err != nil {
+		return nil, err
+	}
+
+	chunks, err := m.persistence.MessageChunks(chunk.Id, source)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(chunks) < int(chunk.Total) {
+		return nil, nil
+	}
+
+	var payload []byte
+	for i, c := range chunks {
+		if c.Index != uint32(i) || c.Total != chunk.Total || c.Type != chunk.Type {
+			return nil, errors.New("inconsistent message chunks")
+		}
+		payload = append(payload, c.Payload...)
+	}
+
+	err = m.persistence.DeleteMessageChunks(chunk.Id, source)
+	if err != nil {
+		return nil, err
+	}
+
+	messageID := v1protocol.MessageID(publicKey, payload)
+	if messageID.String() != chunk.Id {
+		return nil, errors.New("reassembled message does not match its id")
+	}
+
+	message, err := statusMessage.Clone()
+	if err != nil {
+		return nil, err
+	}
+	message.ID = messageID
+	message.Type = chunk.Type
+	message.DecryptedPayload = payload
+	message.ParsedMessage = nil
+
+	err = message.HandleApplication()
+	if err != nil {
+		return nil, err
+	}
+
+	return message, nil
+}
+
+func (m *MessageHandler) HandlePinMessage(state *ReceivedMessageState, pbPin protobuf.PinMessage) error {
+	logger := m.logger.With(zap.String("site", "HandlePinMessage"))
+	if err := ValidateReceivedPinMessage(&pbPin, state.CurrentMessageState.WhisperTimestamp); err != nil {
+		logger.Warn("failed to validate pin message", zap.Error(err))
+		return err
+	}
+
+	pinMessage := &PinMessage{
+		PinMessage: pbPin,
+		ID:         state.CurrentMessageState.MessageID,
+		From:       state.CurrentMessageState.Contact.ID,
+		SigPubKey:  state.CurrentMessageState.PublicKey,
+	}
+
+	chat, err := m.matchChatEntity(pinMessage, state.AllChats, state.Timesource)
+	if err != nil {
+		return err // matchChatEntity returns a descriptive error message
+	}
+
+	// If deleted-at is greater, ignore message
+	if chat.DeletedAtClockValue >= pinMessage.Clock {
+		return nil
+	}
+
+	// Only admins can pin messages in private group chats
+	if chat.PrivateGroupChat() && !chat.IsAdmin(pinMessage.From) {
+		return errors.New("pin message not sent by an admin")
+	}
+
+	existingPin, err := m.persistence.pinMessageBy(pinMessage.MessageId, chat.ID)
+	if err != nil && err != errRecordNotFound {
+		return err
+	}
+
+	if existingPin != nil && existingPin.Clock >= pinMessage.Clock {
+		// A more recent pin or unpin has already been applied, ignoring
+		return nil
+	}
+
+	// The pinned message might not have been received yet, in which case
+	// the pin is stored and shows up once the message arrives
+	message, err := m.persistence.MessageByID(pinMessage.MessageId)
+	if err != nil && err != errRecordNotFound {
+		return err
+	}
+
+	if message != nil && message.LocalChatID != chat.ID {
+		return errors.New("pin message sent to a different chat than the message")
+	}
+
+	pinMessage.LocalChatID = chat.ID
+
+	err = m.persistence.SavePinMessage(pinMessage)
+	if err != nil {
+		return err
+	}
+
+	state.Response.PinMessages = append(state.Response.PinMessages, pinMessage)
+
+	return nil
+}
+
+// HandleReadReceipt marks as seen the messages we sent in the one-to-one chat
+// with the author of the receipt
+func (m *MessageHandler) HandleReadReceipt(state *ReceivedMessageState, receipt protobuf.ReadReceipt) error {
+	err := ValidateReceivedReadReceipt(&receipt, state.CurrentMessageState.WhisperTimestamp)
+	if err != nil {
+		return err
+	}
+
+	contactID := state.CurrentMessageState.Contact.ID
+	for _, id := range receipt.MessageIds {
+		message, err := m.persistence.MessageByID(id)
+		if err == errRecordNotFound {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+
+		// Only messages we sent in the chat with the author of the receipt
+		// can be marked as seen by it
+		if message.LocalChatID != contactID || message.OutgoingStatus == "" || message.OutgoingStatus == OutgoingStatusSeen {
+			continue
+		}
+
+		err = m.persistence.UpdateMessageOutgoingStatus(message.ID, OutgoingStatusSeen)
+		if err != nil {
+			return err
+		}
+		message.OutgoingStatus = OutgoingStatusSeen
+
+		state.Response.Messages = append(state.Response.Messages, message)
+	}
+
+	return nil
+}
+
+// HandleTypingStatus passes on the typing status of a contact in one of our
+// chats, it's never persisted
+func (m *MessageHandler) HandleTypingStatus(state *ReceivedMessageState, pbStatus protobuf.TypingStatus) error {
+	if err := ValidateReceivedTypingStatus(&pbStatus, state.CurrentMessageState.WhisperTimestamp); err != nil {
+		return err
+	}
+
+	status := &TypingStatus{
+		TypingStatus: pbStatus,
+		From:         state.CurrentMessageState.Contact.ID,
+		SigPubKey:    state.CurrentMessageState.PublicKey,
+	}
+
+	chat, err := m.matchChatEntity(status, state.AllChats, state.Timesource)
+	if err != nil {
+		return err // matchChatEntity returns a descriptive error message
+	}
+
+	// Typing statuses don't create chats
+	if _, ok := state.AllChats[chat.ID]; !ok || !chat.Active {
+		return nil
+	}
+
+	status.LocalChatID = chat.ID
+
+	state.Response.TypingStatuses = append(state.Response.TypingStatuses, status)
+
+	return nil
+}
+
+func (m *MessageHandler) HandleDisappearingMessagesSetting(state *ReceivedMessageState, pbSetting protobuf.DisappearingMessagesSetting) error {
+	if err := ValidateReceivedDisappearingMessagesSetting(&pbSetting, state.CurrentMessageState.WhisperTimestamp); err != nil {
+		return err
+	}
+
+	setting := &DisappearingMessagesSetting{
+		DisappearingMessagesSetting: pbSetting,
+		From:                        state.CurrentMessageState.Contact.ID,
+		SigPubKey:                   state.CurrentMessageState.PublicKey,
+	}
+
+	chat, err := m.matchChatEntity(setting, state.AllChats, state.Timesource)
+	if err != nil {
+		return err // matchChatEntity returns a descriptive error message
+	}
+
+	// The setting doesn't create chats
+	if _, ok := state.AllChats[chat.ID]; !ok {
+		return nil
+	}
+
+	// If deleted-at is greater, ignore message
+	if chat.DeletedAtClockValue >= setting.Clock {
+		return nil
+	}
+
+	if chat.DisappearingMessagesClock >= setting.Clock {
+		// A more recent change has already been applied, ignoring
+		return nil
+	}
+
+	chat.DisappearingMessagesTimer = setting.Timer
+	chat.DisappearingMessagesClock = setting.Clock
+
+	state.ModifiedChats[chat.ID] = true
+	state.AllChats[chat.ID] = chat
+
+	return nil
+}
+
+// HandleContactRequest adds the request to the pending contact requests,
+// unless we sent one to its author too, in which case both are accepted
+func (m *MessageHandler) HandleContactRequest(state *ReceivedMessageState, request protobuf.ContactRequest) error {
+	if err := ValidateReceivedContactRequest(&request, state.CurrentMessageState.WhisperTimestamp); err != nil {
+		return err
+	}
+
+	contact := state.CurrentMessageState.Contact
+	// Our own requests are synced through SyncInstallationContact
+	if contact.ID == contactIDFromPublicKey(&m.identity.PublicKey) {
+		return nil
+	}
+
+	if contact.ContactRequestClock >= request.Clock {
+		return nil
+	}
+
+	if contact.ContactRequestState == ContactRequestStateSent {
+		contact.ContactRequestState = ContactRequestStateAccepted
+	} else {
+		contact.ContactRequestState = ContactRequestStateReceived
+	}
+	contact.ContactRequestClock = request.Clock
+	contact.ContactRequestText = request.Text
+	contact.addSystemTag(contactRequestReceived)
+
+	state.ModifiedContacts[contact.ID] = true
+	state.AllContacts[contact.ID] = contact
+
+	return nil
+}
+
+// HandleAcceptContactRequest marks the request we sent to the author as
+// accepted
+func (m *MessageHandler) HandleAcceptContactRequest(state *ReceivedMessageState, accept protobuf.AcceptContactRequest) error {
+	if err := ValidateReceivedContactRequestAnswer(accept.Clock, state.CurrentMessageState.WhisperTimestamp); err != nil {
+		return err
+	}
+
+	contact := state.CurrentMessageState.Contact
+	if contact.ContactRequestState != ContactRequestStateSent || contact.ContactRequestClock >= accept.Clock {
+		return nil
+	}
+
+	contact.ContactRequestState = ContactRequestStateAccepted
+	contact.ContactRequestClock = accept.Clock
+	contact.addSystemTag(contactRequestReceived)
+
+	state.ModifiedContacts[contact.ID] = true
+	state.AllContacts[contact.ID] = contact
+
+	return nil
+}
+
+// HandleDeclineContactRequest marks the request we sent to the author as
+// declined
+func (m *MessageHandler) HandleDeclineContactRequest(state *ReceivedMessageState, decline protobuf.DeclineContactRequest) error {
+	if err := ValidateReceivedContactRequestAnswer(decline.Clock, state.CurrentMessageState.WhisperTimestamp); err != nil {
+		return err
+	}
+
+	contact := state.CurrentMessageState.Contact
+	if contact.ContactRequestState != ContactRequestStateSent || contact.ContactRequestClock >= decline.Clock {
+		return nil
+	}
+
+	contact.ContactRequestState = ContactRequestStateDeclined
+	contact.ContactRequestClock = decline.Clock
+
+	state.ModifiedContacts[contact.ID] = true
+	state.AllContacts[contact.ID] = contact
+
+	return nil
+}
+
+// HandleRetractContactRequest removes the request of the author from the
+// pending contact requests, or the author from the contacts who added us if
+// it was accepted
+func (m *MessageHandler) HandleRetractContactRequest(state *ReceivedMessageState, retract protobuf.RetractContactRequest) error {
+	if err := ValidateReceivedContactRequestAnswer(retract.Clock, state.CurrentMessageState.WhisperTimestamp); err != nil {
+		return err
+	}
+
+	contact := state.CurrentMessageState.Contact
+	if contact.ContactRequestClock >= retract.Clock {
+		return nil
+	}
+	if contact.ContactRequestState != ContactRequestStateReceived && contact.ContactRequestState != ContactRequestStateAccepted {
+		return nil
+	}
+
+	contact.ContactRequestState = ContactRequestStateNone
+	contact.ContactRequestClock = retract.Clock
+	contact.ContactRequestText = ""
+	contact.removeSystemTag(contactRequestReceived)
+
+	state.ModifiedContacts[contact.ID] = true
+	state.AllContacts[contact.ID] = contact
+
+	return nil
+}
+
+// HandleGroupChatJoinRequest stores a request to join a group chat sent with
+// an invitation we created, so that it can be approved or rejected
+func (m *MessageHandler) HandleGroupChatJoinRequest(state *ReceivedMessageState, request protobuf.GroupChatJoinRequest) error {
+	if err := ValidateReceivedGroupChatJoinRequest(&request, state.CurrentMessageState.WhisperTimestamp); err != nil {
+		return err
+	}
+
+	invitation, admin, err := verifyGroupChatInvitation(request.Invitation)
+	if err != nil {
+		return err
+	}
+
+	if invitation.ExpiresAt <= state.CurrentMessageState.WhisperTimestamp {
+		return errors.New("invitation expired")
+	}
+
+	revoked, err := m.persistence.GroupChatInvitationRevoked(invitation.Id, invitation.ChatId)
+	if err != nil {
+		return err
+	}
+	if revoked {
+		return errors.New("invitation revoked")
+	}
+
+	chat, ok := state.AllChats[invitation.ChatId]
+	if !ok || !chat.PrivateGroupChat() {
+		return errors.New("can't find chat")
+	}
+
+	// We might not be an admin anymore
+	if !chat.IsAdmin(contactIDFromPublicKey(&m.identity.PublicKey)) {
+		return errors.New("not an admin of the chat")
+	}
+
+	// The invitation might have been created by another admin, it's no
+	// longer valid once they're not an admin anymore
+	if !chat.IsAdmin(contactIDFromPublicKey(admin)) {
+		return errors.New("invitation not created by an admin of the chat")
+	}
+
+	from := state.CurrentMessageState.Contact.ID
+	if chat.IsMember(from) {
+		return nil
+	}
+
+	existingRequest, err := m.persistence.GroupChatJoinRequest(chat.ID, from)
+	if err != nil && err != errRecordNotFound {
+		return err
+	}
+	if existingRequest != nil && (existingRequest.Clock >= request.Clock || existingRequest.State == GroupChatJoinRequestStateRejected) {
+		return nil
+	}
+
+	joinRequest := &GroupChatJoinRequest{
+		ChatID:       chat.ID,
+		From:         from,
+		InvitationID: invitation.Id,
+		Clock:        request.Clock,
+		State:        GroupChatJoinRequestStatePending,
+	}
+	err = m.persistence.SaveGroupChatJoinRequest(joinRequest)
+	if err != nil {
+		return err
+	}
+
+	state.Response.GroupChatJoinRequests = append(state.Response.GroupChatJoinRequests, joinRequest)
+
+	return nil
+}
+
+// HandleGroupChatJoinRequestRejected rejects the join request rejected by
+// another admin of the group chat, further requests from its author are
+// ignored
+func (m *MessageHandler) HandleGroupChatJoinRequestRejected(state *ReceivedMessageState, rejection protobuf.GroupChatJoinRequestRejected) error {
+	if err := ValidateReceivedGroupChatJoinRequestRejected(&rejection, state.CurrentMessageState.WhisperTimestamp); err != nil {
+		return err
+	}
+
+	chat, ok := state.AllChats[rejection.ChatId]
+	if !ok || !chat.PrivateGroupChat() {
+		return errors.New("can't find chat")
+	}
+
+	if !chat.IsAdmin(state.CurrentMessageState.Contact.ID) {
+		return errors.New("rejection not sent by an admin of the chat")
+	}
+
+	// The request might have been approved by another admin meanwhile
+	if chat.IsMember(rejection.Requester) {
+		return nil
+	}
+
+	request, err := m.persistence.GroupChatJoinRequest(chat.ID, rejection.Requester)
+	if err == errRecordNotFound {
+		// The rejection might be received before the request
+		request = &GroupChatJoinRequest{
+			ChatID: chat.ID,
+			From:   rejection.Requester,
+		}
+	} else if err != nil {
+		return err
+	}
+
+	if request.State == GroupChatJoinRequestStateRejected {
+		return nil
+	}
+
+	request.State = GroupChatJoinRequestStateRejected
+	err = m.persistence.SaveGroupChatJoinRequest(request)
+	if err != nil {
+		return err
+	}
+
+	state.Response.GroupChatJoinRequests = append(state.Response.GroupChatJoinRequests, request)
+
+	return nil
+}
+
+// HandleGroupChatInvitationRevoked revokes the invitation revoked by another
+// admin of the group chat, join requests sent with it are ignored from then on
+func (m *MessageHandler) HandleGroupChatInvitationRevoked(state *ReceivedMessageState, revocation protobuf.GroupChatInvitationRevoked) error {
+	if err := ValidateReceivedGroupChatInvitationRevoked(&revocation, state.CurrentMessageState.WhisperTimestamp); err != nil {
+		return err
+	}
+
+	chat, ok := state.AllChats[revocation.ChatId]
+	if !ok || !chat.PrivateGroupChat() {
+		return errors.New("can't find chat")
+	}
+
+	if !chat.IsAdmin(state.CurrentMessageState.Contact.ID) {
+		return errors.New("revocation not sent by an admin of the chat")
+	}
+
+	return m.persistence.SaveRevokedGroupChatInvitation(revocation.InvitationId, chat.ID)
+}
+
+func (m *MessageHandler) HandleCommunityDescription(state *ReceivedMessageState, signedDescription protobuf.SignedCommunityDescription) error {
+	description, err := verifyCommunityDescription(&signedDescription)
+	if err != nil {
+		return err
+	}
+
+	encodedDescription, err := proto.Marshal(&signedDescription)
+	if err != nil {
+		return err
+	}
+
+	_, err = m.handleCommunityDescription(state, description, encodedDescription)
+	return err
+}
+
+// handleCommunityDescription applies a verified description if it's more
+// recent than the one we have, and returns the community
+func (m *MessageHandler) handleCommunityDescription(state *ReceivedMessageState, description *protobuf.CommunityDescription, signedDescription []byte) (*Community, error) {
+	community, err := m.persistence.Community(description.Id)
+	if err != nil && err != errRecordNotFound {
+		return nil, err
+	}
+	if community != nil && community.Clock >= description.Clock {
+		return community, nil
+	}
+	if community == nil {
+		community = &Community{}
+	}
+
+	community.CommunityDescription = *description
+	community.SignedDescription = signedDescription
+
+	// We have been removed from the community, the keys we hold are of no
+	// use anymore as the following messages are encrypted with a new one
+	if community.Joined && !community.IsMember(contactIDFromPublicKey(&m.identity.PublicKey)) {
+		community.Joined = false
+		if err := m.persistence.DeleteCommunityKeys(community.Id); err != nil {
+			return nil, err
+		}
+	}
+
+	err = m.persistence.SaveCommunity(community)
+	if err != nil {
+		return nil, err
+	}
+
+	state.ModifiedCommunities[community.Id] = community
+	return community, nil
+}
+
+func (m *MessageHandler) HandleCommunityKey(state *ReceivedMessageState, message protobuf.CommunityKey) error {
+	if err := ValidateReceivedCommunityKey(&message, state.CurrentMessageState.WhisperTimestamp); err != nil {
+		return err
+	}
+
+	description, err := verifyCommunityDescription(message.Description)
+	if err != nil {
+		return err
+	}
+
+	if description.Owner != state.CurrentMessageState.Contact.ID {
+		return errors.New("key not sent by the owner of the community")
+	}
+
+	encodedDescription, err := proto.Marshal(message.Description)
+	if err != nil {
+		return err
+	}
+
+	community, err := m.handleCommunityDescription(state, description, encodedDescription)
+	if err != nil {
+		return err
+	}
+
+	err = m.persistence.SaveCommunityKey(community.Id, message.KeyId, message.Key)
+	if err != nil {
+		return err
+	}
+
+	if community.Joined || !community.IsMember(contactIDFromPublicKey(&m.identity.PublicKey)) {
+		return nil
+	}
+
+	community.Joined = true
+	err = m.persistence.SaveCommunity(community)
+	if err != nil {
+		return err
+	}
+
+	state.ModifiedCommunities[community.Id] = community
+	return nil
+}
+
+func (m *MessageHandler) HandleCommunityRequestToJoin(state *ReceivedMessageState, message protobuf.CommunityRequestToJoin) error {
+	if err := ValidateReceivedCommunityRequestToJoin(&message, state.CurrentMessageState.WhisperTimestamp); err != nil {
+		return err
+	}
+
+	community, err := m.ownedCommunity(message.CommunityId)
+	if err != nil {
+		return err
+	}
+
+	from := state.CurrentMessageState.Contact.ID
+	if community.IsMember(from) {
+		return nil
+	}
+
+	existingRequest, err := m.persistence.CommunityRequestToJoin(community.Id, from)
+	if err != nil && err != errRecordNotFound {
+		return err
+	}
+	if existingRequest != nil && (existingRequest.Clock >= message.Clock || existingRequest.State == CommunityRequestToJoinStateDeclined) {
+		return nil
+	}
+
+	request := &CommunityRequestToJoin{
+		CommunityID: community.Id,
+		From:        from,
+		Clock:       message.Clock,
+		State:       CommunityRequestToJoinStatePending,
+	}
+	err = m.persistence.SaveCommunityRequestToJoin(request)
+	if err != nil {
+		return err
+	}
+
+	state.CommunityRequestsToJoin = append(state.CommunityRequestsToJoin, request)
+
+	return nil
+}
+
+func (m *MessageHandler) HandleCommunityRequestToLeave(state *ReceivedMessageState, message protobuf.CommunityRequestToLeave) error {
+	if err := ValidateReceivedCommunityRequestToLeave(&message, state.CurrentMessageState.WhisperTimestamp); err != nil {
+		return err
+	}
+
+	community, err := m.ownedCommunity(message.CommunityId)
+	if err != nil {
+		return err
+	}
+
+	from := state.CurrentMessageState.Contact.ID
+	if !community.IsMember(from) || from == community.Owner {
+		return nil
+	}
+
+	state.CommunityMembersLeft[community.Id] = append(state.CommunityMembersLeft[community.Id], from)
+
+	return nil
+}
+
+func (m *MessageHandler) ownedCommunity(communityID string) (*Community, error) {
+	community, err := m.persistence.Community(communityID)
+	if err == errRecordNotFound {
+		return nil, errors.New("can't find community")
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if community.Owner != contactIDFromPublicKey(&m.identity.PublicKey) {
+		return nil, errors.New("not the owner of the community")
+	}
+	return community, nil
+}
+
+func (m *MessageHandler) HandlePollVote(state *ReceivedMessageState, pbVote protobuf.PollVote) error {
+	if err := ValidateReceivedPollVote(&pbVote, state.CurrentMessageState.WhisperTimestamp); err != nil {
+		return err
+	}
+
+	vote := &PollVote{
+		PollVote:  pbVote,
+		From:      state.CurrentMessageState.Contact.ID,
+		Timestamp: state.CurrentMessageState.WhisperTimestamp,
+		SigPubKey: state.CurrentMessageState.PublicKey,
+	}
+
+	// In private group chats only the members can vote
+	chat, err := m.matchChatEntity(vote, state.AllChats, state.Timesource)
+	if err != nil {
+		return err // matchChatEntity returns a descriptive error message
+	}
+
+	// If deleted-at is greater, ignore message
+	if chat.DeletedAtClockValue >= vote.Clock {
+		return nil
+	}
+
+	existingVote, err := m.persistence.PollVote(vote.MessageId, vote.From)
+	if err != nil && err != errRecordNotFound {
+		return err
+	}
+	if existingVote != nil && existingVote.Clock >= vote.Clock {
+		return nil
+	}
+
+	// The poll might have been received in this same batch, in which case
+	// it's not been saved yet, or not at all yet. Votes are checked against
+	// the poll again when tallied
+	var poll *Message
+	for _, message := range state.Response.Messages {
+		if message.ID == vote.MessageId {
+			poll = message
+			break
+		}
+	}
+	if poll == nil {
+		poll, err = m.persistence.MessageByID(vote.MessageId)
+		if err != nil && err != errRecordNotFound {
+			return err
+		}
+	}
+
+	if poll != nil {
+		if poll.LocalChatID != chat.ID {
+			return errors.New("vote sent to a different chat than the poll")
+		}
+		if poll.ContentType != protobuf.ChatMessage_POLL || poll.GetPoll() == nil {
+			return errors.New("vote for a message that is not a poll")
+		}
+	}
+
+	err = m.persistence.SavePollVote(vote)
+	if err != nil {
+		return err
+	}
+
+	state.ModifiedPolls[vote.MessageId] = true
+
+	return nil
+}
+
+// checkTimelineMessage checks that only the owner of a timeline posts status
+// updates on it, any other message being a comment on one of them. It returns
+// whether the status update a comment refers to has been received
+func (m *MessageHandler) checkTimelineMessage(chat *Chat, message *Message, received []*Message) (bool, error) {
+	if message.ContentType == protobuf.ChatMessage_STATUS {
+		if !chat.IsAdmin(message.From) {
+			return false, errors.New("status update not posted by the owner of the timeline")
+		}
+		return true, nil
+	}
+	return m.checkTimelineReference(chat, message.ResponseTo, received)
+}
+
+// checkTimelineReference checks that the message a comment or a reaction
+// refers to is a status update of the timeline. The status update might not
+// have been received yet, in which case false is returned and the reference
+// is checked once it is
+func (m *MessageHandler) checkTimelineReference(chat *Chat, messageID string, received []*Message) (bool, error) {
+	var post *Message
+	for _, message := range received {
+		if message.ID == messageID {
+			post = message
+			break
+		}
+	}
+
+	if post == nil {
+		var err error
+		post, err = m.persistence.MessageByID(messageID)
+		if err == errRecordNotFound {
+			return false, nil
+		}
+		if err != nil {
+			return false, err
+		}
+	}
+
+	if post.LocalChatID != chat.ID || post.ContentType != protobuf.ChatMessage_STATUS {
+		return false, errors.New("not a status update of the timeline")
+	}
+	return true, nil
+}
@@ -36,6 +36,18 @@ func (s *EventToSystemMessageSuite) TestRun() {
 			From:     "admin",
 			Expected: "@admin changed the group's name to chat-name-2",
 		},
+		{
+			Name:     "chat description changed event",
+			Event:    v1protocol.NewDescriptionChangedEvent("chat-description", 12),
+			From:     "admin",
+			Expected: "@admin changed the group's description to chat-description",
+		},
+		{
+			Name:     "chat image changed event",
+			Event:    v1protocol.NewImageChangedEvent([]byte("image"), 12),
+			From:     "admin",
+			Expected: "@admin changed the group's image",
+		},
 		{
 			Name:     "members added event",
 			Event:    v1protocol.NewMembersAddedEvent([]string{"a", "b", "c"}, 12),
@@ -66,6 +78,12 @@ func (s *EventToSystemMessageSuite) TestRun() {
 			From:     "admin",
 			Expected: "@a is not admin anymore",
 		},
+		{
+			Name:     "ownership transferred event",
+			Event:    v1protocol.NewOwnershipTransferredEvent("a", 12),
+			From:     "admin",
+			Expected: "@admin has made @a the owner of the group",
+		},
 	}
 	for _, tc := range testCases {
 		s.Run(tc.Name, func() {
@@ -27,6 +27,9 @@ const (
 	whisperTTL     = 15
 	whisperPoW     = 0.002
 	whisperPoWTime = 5
+	// ephemeralTTL is the TTL of ephemeral messages, which are only
+	// relevant for a few seconds
+	ephemeralTTL = 5
 )
 
 type messageProcessor struct {
@@ -170,6 +173,46 @@ func (p *messageProcessor) sendPrivate(
 	return messageID, nil
 }
 
+// SendEphemeralRaw takes encoded data, encrypts it and sends it to each of the
+// recipients with a short TTL. Ephemeral messages are never sent through
+// datasync nor tracked, as they are not worth retransmitting
+func (p *messageProcessor) SendEphemeralRaw(
+	ctx context.Context,
+	recipients []*ecdsa.PublicKey,
+	data []byte,
+	messageType protobuf.ApplicationMetadataMessage_Type,
+) ([]byte, error) {
+	p.logger.Debug(
+		"sending an ephemeral message",
+		zap.String("site", "SendEphemeralRaw"),
+	)
+
+	wrappedMessage, err := p.wrapMessageV1(data, messageType)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to wrap message")
+	}
+
+	for _, recipient := range recipients {
+		messageSpec, err := p.protocol.BuildDirectMessage(p.identity, recipient, wrappedMessage)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to encrypt message")
+		}
+
+		newMessage, err := messageSpecToWhisper(messageSpec)
+		if err != nil {
+			return nil, err
+		}
+		newMessage.TTL = ephemeralTTL
+
+		_, err = p.sendNewMessage(ctx, recipient, messageSpec, newMessage)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to send a message spec")
+		}
+	}
+
+	return v1protocol.MessageID(&p.identity.PublicKey, wrappedMessage), nil
+}
+
 // sendPairInstallation sends data to the recipients, using DH
 func (p *messageProcessor) SendPairInstallation(
 	ctx context.Context,
@@ -251,6 +294,60 @@ func (p *messageProcessor) SendPublicRaw(
 	return messageID, nil
 }
 
+// SendCommunityRaw sends a message to a channel of a community, encrypted
+// with the key of the community identified by keyID. The message is not built
+// with encryption.Protocol: it only provides sessions between two users, which
+// would mean encrypting and sending the message once per member installation,
+// the cost private group chats are limited by. The key is shared by the owner
+// through those sessions instead, see sendCommunityKey, so that channel
+// messages are encrypted and published once whatever the number of members
+func (p *messageProcessor) SendCommunityRaw(
+	ctx context.Context,
+	chatName string,
+	communityID string,
+	keyID uint32,
+	key []byte,
+	data []byte,
+	messageType protobuf.ApplicationMetadataMessage_Type,
+) ([]byte, error) {
+	wrappedMessage, err := p.wrapMessageV1(data, messageType)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to wrap message")
+	}
+
+	ciphertext, err := crypto.EncryptSymmetric(key, wrappedMessage)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to encrypt message")
+	}
+
+	encryptedMessage, err := proto.Marshal(&protobuf.CommunityEncryptedMessage{
+		CommunityId: communityID,
+		KeyId:       keyID,
+		Payload:     ciphertext,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	newMessage := &types.NewMessage{
+		TTL:       whisperTTL,
+		Payload:   encryptedMessage,
+		PowTarget: whisperPoW,
+		PowTime:   whisperPoWTime,
+	}
+
+	hash, err := p.transport.SendPublic(ctx, newMessage, chatName)
+	if err != nil {
+		return nil, err
+	}
+
+	messageID := v1protocol.MessageID(&p.identity.PublicKey, wrappedMessage)
+
+	p.transport.Track([][]byte{messageID}, hash, newMessage)
+
+	return messageID, nil
+}
+
 // handleMessages expects a whisper message as input, and it will go through
 // a series of transformations until the message is parsed into an application
 // layer message, or in case of Raw methods, the processing stops at the layer
@@ -396,9 +493,21 @@ func (p *messageProcessor) sendMessageSpec(ctx context.Context, publicKey *ecdsa
 		return nil, nil, err
 	}
 
-	logger := p.logger.With(zap.String("site", "sendMessageSpec"))
+	hash, err := p.sendNewMessage(ctx, publicKey, messageSpec, newMessage)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return hash, newMessage, nil
+}
+
+// sendNewMessage sends the message built out of the spec through the
+// transport method matching the spec properties.
+func (p *messageProcessor) sendNewMessage(ctx context.Context, publicKey *ecdsa.PublicKey, messageSpec *encryption.ProtocolMessageSpec, newMessage *types.NewMessage) ([]byte, error) {
+	logger := p.logger.With(zap.String("site", "sendNewMessage"))
 
 	var hash []byte
+	var err error
 
 	switch {
 	case messageSpec.SharedSecret != nil:
@@ -409,10 +518,10 @@ func (p *messageProcessor) sendMessageSpec(ctx context.Context, publicKey *ecdsa
 		hash, err = p.transport.SendPrivateWithPartitioned(ctx, newMessage, publicKey)
 	}
 	if err != nil {
-		return nil, nil, err
+		return nil, err
 	}
 
-	return hash, newMessage, nil
+	return hash, nil
 }
 
 func messageSpecToWhisper(spec *encryption.ProtocolMessageSpec) (*types.NewMessage, error) {
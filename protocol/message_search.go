@@ -0,0 +1,68 @@
+package protocol
+
+import (
+	"errors"
+	"strconv"
+	"strings"
+)
+
+// SearchResult is a message matching a full-text search, along with the
+// position of the matching terms in its text
+type SearchResult struct {
+	Message *Message `json:"message"`
+	// Rank is the number of matching terms found in the message, results
+	// with a higher rank are returned first
+	Rank int `json:"rank"`
+	// Highlights are the positions of the matching terms in the text
+	Highlights []*Highlight `json:"highlights"`
+}
+
+// Highlight is the position of a matching term in the text of a message,
+// expressed in bytes of the UTF-8 encoded text
+type Highlight struct {
+	Offset int `json:"offset"`
+	Length int `json:"length"`
+}
+
+var errEmptySearchQuery = errors.New("search query can't be empty")
+
+// buildMatchExpression turns a user query into a full-text MATCH expression,
+// each word being quoted so that the query can't contain any of the
+// full-text query operators. Messages need to contain all the words.
+func buildMatchExpression(query string) (string, error) {
+	var terms []string
+	for _, word := range strings.Fields(query) {
+		word = strings.Replace(word, `"`, "", -1)
+		if len(word) == 0 {
+			continue
+		}
+		terms = append(terms, `"`+word+`"`)
+	}
+	if len(terms) == 0 {
+		return "", errEmptySearchQuery
+	}
+	return strings.Join(terms, " "), nil
+}
+
+// parseHighlights parses the result of the offsets() full-text function,
+// a list of integers grouped by four: column, term, offset and length
+func parseHighlights(offsets string) ([]*Highlight, error) {
+	fields := strings.Fields(offsets)
+	if len(fields)%4 != 0 {
+		return nil, errors.New("invalid offsets")
+	}
+
+	highlights := make([]*Highlight, 0, len(fields)/4)
+	for i := 0; i < len(fields); i += 4 {
+		offset, err := strconv.Atoi(fields[i+2])
+		if err != nil {
+			return nil, err
+		}
+		length, err := strconv.Atoi(fields[i+3])
+		if err != nil {
+			return nil, err
+		}
+		highlights = append(highlights, &Highlight{Offset: offset, Length: length})
+	}
+	return highlights, nil
+}
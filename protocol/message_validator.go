@@ -1,10 +1,17 @@
 package protocol
 
 import (
+	"bytes"
 	"errors"
+	"image"
+	"net/url"
 	"strconv"
 	"strings"
+	"unicode/utf8"
 
+	"github.com/status-im/status-go/eth-node/crypto"
+	"github.com/status-im/status-go/eth-node/types"
+	"github.com/status-im/status-go/protocol/linkpreview"
 	"github.com/status-im/status-go/protocol/protobuf"
 	"github.com/status-im/status-go/protocol/v1"
 )
@@ -25,6 +32,14 @@ func validateClockValue(clock uint64, whisperTimestamp uint64) error {
 	return nil
 }
 
+// maxGroupChatDescriptionLength is the maximum length in characters of the
+// description of a group chat
+const maxGroupChatDescriptionLength = 256
+
+// maxGroupChatImageSize is the maximum size in bytes of the image of a group
+// chat, as it's sent along with every membership update
+const maxGroupChatImageSize = 128 * 1024
+
 func ValidateMembershipUpdateMessage(message *protocol.MembershipUpdateMessage, timeNowMs uint64) error {
 
 	for _, e := range message.Events {
@@ -32,10 +47,42 @@ func ValidateMembershipUpdateMessage(message *protocol.MembershipUpdateMessage,
 			return err
 		}
 
+		switch e.Type {
+		case protobuf.MembershipUpdateEvent_DESCRIPTION_CHANGED:
+			if utf8.RuneCountInString(e.Description) > maxGroupChatDescriptionLength {
+				return errors.New("group chat description too long")
+			}
+		case protobuf.MembershipUpdateEvent_IMAGE_CHANGED:
+			if err := validateGroupChatImage(e.Image); err != nil {
+				return err
+			}
+		}
 	}
 	return nil
 }
 
+// validateGroupChatImage checks the image of a group chat is small enough and
+// in a supported format, an empty image removing it
+func validateGroupChatImage(payload []byte) error {
+	if len(payload) == 0 {
+		return nil
+	}
+
+	if len(payload) > maxGroupChatImageSize {
+		return errors.New("group chat image too large")
+	}
+
+	_, format, err := image.DecodeConfig(bytes.NewReader(payload))
+	if err != nil {
+		return err
+	}
+	if _, ok := imageFormats[format]; !ok {
+		return errors.New("unsupported image format")
+	}
+
+	return nil
+}
+
 func ValidateReceivedPairInstallation(message *protobuf.PairInstallation, whisperTimestamp uint64) error {
 	if err := validateClockValue(message.Clock, whisperTimestamp); err != nil {
 		return err
@@ -150,6 +197,14 @@ func ValidateReceivedDeclineRequestTransaction(message *protobuf.DeclineRequestT
 	return nil
 }
 
+// maxAudioPayloadSize is the maximum size in bytes of the payload of an audio
+// message, roughly a few minutes of compressed voice
+const maxAudioPayloadSize = 2 * 1024 * 1024
+
+// maxUnfurledLinks is the maximum number of link previews attached to a
+// message
+const maxUnfurledLinks = 3
+
 func ValidateReceivedChatMessage(message *protobuf.ChatMessage, whisperTimestamp uint64) error {
 	if err := validateClockValue(message.Clock, whisperTimestamp); err != nil {
 		return err
@@ -159,7 +214,8 @@ func ValidateReceivedChatMessage(message *protobuf.ChatMessage, whisperTimestamp
 		return errors.New("timestamp can't be 0")
 	}
 
-	if len(strings.TrimSpace(message.Text)) == 0 {
+	// Images and audio don't need a caption
+	if len(strings.TrimSpace(message.Text)) == 0 && message.ContentType != protobuf.ChatMessage_IMAGE && message.ContentType != protobuf.ChatMessage_AUDIO {
 		return errors.New("text can't be empty")
 	}
 
@@ -191,5 +247,644 @@ func ValidateReceivedChatMessage(message *protobuf.ChatMessage, whisperTimestamp
 			return errors.New("sticker hash not set")
 		}
 	}
+
+	if message.ContentType == protobuf.ChatMessage_IMAGE {
+		image := message.GetImage()
+		if image == nil {
+			return errors.New("no image content")
+		}
+		if len(image.Payload) == 0 {
+			return errors.New("image payload can't be empty")
+		}
+		if image.Format == protobuf.ImageMessage_UNKNOWN_IMAGE_FORMAT {
+			return errors.New("unknown image format")
+		}
+	}
+
+	if message.ContentType == protobuf.ChatMessage_AUDIO {
+		audio := message.GetAudio()
+		if audio == nil {
+			return errors.New("no audio content")
+		}
+		if len(audio.Payload) == 0 {
+			return errors.New("audio payload can't be empty")
+		}
+		if len(audio.Payload) > maxAudioPayloadSize {
+			return errors.New("audio payload too large")
+		}
+		if audio.Type == protobuf.AudioMessage_UNKNOWN_AUDIO_TYPE {
+			return errors.New("unknown audio type")
+		}
+		if audio.DurationMs == 0 {
+			return errors.New("audio duration can't be 0")
+		}
+	}
+
+	if message.ContentType == protobuf.ChatMessage_POLL {
+		poll := message.GetPoll()
+		if poll == nil {
+			return errors.New("no poll content")
+		}
+		if err := ValidatePoll(poll); err != nil {
+			return err
+		}
+	}
+
+	if message.ContentType == protobuf.ChatMessage_STATUS && message.MessageType != protobuf.ChatMessage_TIMELINE {
+		return errors.New("status updates can only be posted on a timeline")
+	}
+
+	if message.MessageType == protobuf.ChatMessage_TIMELINE {
+		if err := validateTimelineChatMessage(message); err != nil {
+			return err
+		}
+	}
+
+	if len(message.Links) > maxUnfurledLinks {
+		return errors.New("too many link previews")
+	}
+
+	for _, link := range message.Links {
+		if len(link.Url) == 0 {
+			return errors.New("link preview url can't be empty")
+		}
+		if len(link.ThumbnailPayload) > linkpreview.MaxThumbnailSize {
+			return errors.New("link preview thumbnail too large")
+		}
+	}
+
+	if message.ExpiresAt != 0 && message.ExpiresAt <= message.Timestamp {
+		return errors.New("message can't expire before being sent")
+	}
+
+	if message.ForwardedFrom != nil {
+		if err := validateForwardedFrom(message); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// validateTimelineChatMessage checks that a message posted on a timeline is
+// either a status update or a comment replying to one
+func validateTimelineChatMessage(message *protobuf.ChatMessage) error {
+	if message.ContentType == protobuf.ChatMessage_STATUS {
+		if len(message.ResponseTo) != 0 {
+			return errors.New("status updates can't reply to a message")
+		}
+		return nil
+	}
+
+	if len(message.ResponseTo) == 0 {
+		return errors.New("comments must reply to a status update")
+	}
+	return nil
+}
+
+// validateForwardedFrom checks that the attribution of a forwarded message
+// is well formed, only text messages, stickers and transaction results can
+// be forwarded. Whether it matches the original message is checked once the
+// message is handled, if the original message is known
+func validateForwardedFrom(message *protobuf.ChatMessage) error {
+	forwardedFrom := message.ForwardedFrom
+
+	if len(forwardedFrom.MessageId) == 0 {
+		return errors.New("forwarded message-id can't be empty")
+	}
+
+	if forwardedFrom.Timestamp == 0 {
+		return errors.New("forwarded timestamp can't be 0")
+	}
+
+	publicKeyBytes, err := types.DecodeHex(forwardedFrom.From)
+	if err != nil {
+		return errors.New("invalid forwarded author")
+	}
+	if _, err := crypto.UnmarshalPubkey(publicKeyBytes); err != nil {
+		return errors.New("invalid forwarded author")
+	}
+
+	if len(message.ResponseTo) != 0 {
+		return errors.New("forwarded message can't be a reply")
+	}
+
+	switch message.ContentType {
+	case protobuf.ChatMessage_TEXT_PLAIN:
+	case protobuf.ChatMessage_STICKER:
+		if len(forwardedFrom.TransactionHash) != 0 {
+			return errors.New("forwarded sticker can't have a transaction hash")
+		}
+	default:
+		return errors.New("content type can't be forwarded")
+	}
+
+	if len(forwardedFrom.TransactionHash) != 0 {
+		hash, err := types.DecodeHex(forwardedFrom.TransactionHash)
+		if err != nil || len(hash) != types.HashLength {
+			return errors.New("invalid forwarded transaction hash")
+		}
+	}
+
+	return nil
+}
+
+func ValidateReceivedEmojiReaction(emoji *protobuf.EmojiReaction, whisperTimestamp uint64) error {
+	if err := validateClockValue(emoji.Clock, whisperTimestamp); err != nil {
+		return err
+	}
+
+	if len(emoji.MessageId) == 0 {
+		return errors.New("message-id can't be empty")
+	}
+
+	if len(emoji.ChatId) == 0 {
+		return errors.New("chat-id can't be empty")
+	}
+
+	if emoji.Type == protobuf.EmojiReaction_UNKNOWN_EMOJI_REACTION_TYPE {
+		return errors.New("unknown emoji reaction type")
+	}
+
+	if _, ok := protobuf.EmojiReaction_Type_name[int32(emoji.Type)]; !ok {
+		return errors.New("invalid emoji reaction type")
+	}
+
+	if emoji.MessageType == protobuf.ChatMessage_UNKNOWN_MESSAGE_TYPE || emoji.MessageType == protobuf.ChatMessage_SYSTEM_MESSAGE_PRIVATE_GROUP {
+		return errors.New("unknown message type")
+	}
+
+	return nil
+}
+
+func ValidateReceivedEditMessage(message *protobuf.EditMessage, whisperTimestamp uint64) error {
+	if err := validateClockValue(message.Clock, whisperTimestamp); err != nil {
+		return err
+	}
+
+	if len(strings.TrimSpace(message.Text)) == 0 {
+		return errors.New("text can't be empty")
+	}
+
+	if len(message.ChatId) == 0 {
+		return errors.New("chat-id can't be empty")
+	}
+
+	if len(message.MessageId) == 0 {
+		return errors.New("message-id can't be empty")
+	}
+
+	if message.MessageType == protobuf.ChatMessage_UNKNOWN_MESSAGE_TYPE || message.MessageType == protobuf.ChatMessage_SYSTEM_MESSAGE_PRIVATE_GROUP {
+		return errors.New("unknown message type")
+	}
+
+	return nil
+}
+
+func ValidateReceivedDeleteMessage(message *protobuf.DeleteMessage, whisperTimestamp uint64) error {
+	if err := validateClockValue(message.Clock, whisperTimestamp); err != nil {
+		return err
+	}
+
+	if len(message.ChatId) == 0 {
+		return errors.New("chat-id can't be empty")
+	}
+
+	if len(message.MessageId) == 0 {
+		return errors.New("message-id can't be empty")
+	}
+
+	if message.MessageType == protobuf.ChatMessage_UNKNOWN_MESSAGE_TYPE || message.MessageType == protobuf.ChatMessage_SYSTEM_MESSAGE_PRIVATE_GROUP {
+		return errors.New("unknown message type")
+	}
+
+	return nil
+}
+
+func ValidateReceivedPinMessage(message *protobuf.PinMessage, whisperTimestamp uint64) error {
+	if err := validateClockValue(message.Clock, whisperTimestamp); err != nil {
+		return err
+	}
+
+	if len(message.ChatId) == 0 {
+		return errors.New("chat-id can't be empty")
+	}
+
+	if len(message.MessageId) == 0 {
+		return errors.New("message-id can't be empty")
+	}
+
+	if message.MessageType == protobuf.ChatMessage_UNKNOWN_MESSAGE_TYPE || message.MessageType == protobuf.ChatMessage_SYSTEM_MESSAGE_PRIVATE_GROUP {
+		return errors.New("unknown message type")
+	}
+
+	return nil
+}
+
+func ValidateReceivedReadReceipt(receipt *protobuf.ReadReceipt, whisperTimestamp uint64) error {
+	if err := validateClockValue(receipt.Clock, whisperTimestamp); err != nil {
+		return err
+	}
+
+	if len(receipt.MessageIds) == 0 {
+		return errors.New("message-ids can't be empty")
+	}
+
+	for _, id := range receipt.MessageIds {
+		if len(id) == 0 {
+			return errors.New("message-id can't be empty")
+		}
+	}
+
+	return nil
+}
+
+func ValidateReceivedTypingStatus(status *protobuf.TypingStatus, whisperTimestamp uint64) error {
+	if err := validateClockValue(status.Clock, whisperTimestamp); err != nil {
+		return err
+	}
+
+	if len(status.ChatId) == 0 {
+		return errors.New("chat-id can't be empty")
+	}
+
+	if status.MessageType != protobuf.ChatMessage_ONE_TO_ONE && status.MessageType != protobuf.ChatMessage_PRIVATE_GROUP {
+		return errors.New("typing statuses are only supported in one-to-one and private group chats")
+	}
+
+	return nil
+}
+
+func ValidateReceivedDisappearingMessagesSetting(setting *protobuf.DisappearingMessagesSetting, whisperTimestamp uint64) error {
+	if err := validateClockValue(setting.Clock, whisperTimestamp); err != nil {
+		return err
+	}
+
+	if len(setting.ChatId) == 0 {
+		return errors.New("chat-id can't be empty")
+	}
+
+	if setting.MessageType != protobuf.ChatMessage_ONE_TO_ONE && setting.MessageType != protobuf.ChatMessage_PRIVATE_GROUP {
+		return errors.New("disappearing messages are only supported in one-to-one and private group chats")
+	}
+
+	return nil
+}
+
+// maxContactRequestTextLength is the maximum length in characters of the
+// introduction text of a contact request
+const maxContactRequestTextLength = 280
+
+func ValidateReceivedContactRequest(request *protobuf.ContactRequest, whisperTimestamp uint64) error {
+	if err := validateClockValue(request.Clock, whisperTimestamp); err != nil {
+		return err
+	}
+
+	if utf8.RuneCountInString(request.Text) > maxContactRequestTextLength {
+		return errors.New("contact request text too long")
+	}
+
+	return nil
+}
+
+// maxDisplayNameLength is the maximum length in characters of the name a
+// user chooses for themselves
+const maxDisplayNameLength = 24
+
+// maxBioLength is the maximum length in characters of the bio of a user
+const maxBioLength = 240
+
+// maxProfileImages is the maximum number of sizes of the avatar of a user
+const maxProfileImages = 4
+
+// maxProfileImageSize is the maximum size in bytes of the base64 encoded
+// payload of each size of the avatar of a user
+const maxProfileImageSize = 350000
+
+// maxProfileImagesSize is the maximum size in bytes of the base64 encoded
+// payloads of all the sizes of the avatar of a user and of their profile
+// image together. They are sent along with every contact update, it keeps
+// them well under the maximum size of a message
+const maxProfileImagesSize = 512 * 1024
+
+// maxProfileLinkLength is the maximum length in characters of the link a
+// user shares on their profile
+const maxProfileLinkLength = 256
+
+func ValidateReceivedContactUpdate(update *protobuf.ContactUpdate, whisperTimestamp uint64) error {
+	if err := validateClockValue(update.Clock, whisperTimestamp); err != nil {
+		return err
+	}
+
+	return ValidateProfile(update.ProfileImage, update.DisplayName, update.Bio, update.Images, update.ProfileLink)
+}
+
+// ValidateProfile checks the fields of a profile, on top of the ENS name. The
+// profile image is only taken into account in the total size of the images
+func ValidateProfile(profileImage, displayName, bio string, images []*protobuf.ProfileImage, profileLink string) error {
+	if utf8.RuneCountInString(displayName) > maxDisplayNameLength {
+		return errors.New("display name too long")
+	}
+
+	if utf8.RuneCountInString(bio) > maxBioLength {
+		return errors.New("bio too long")
+	}
+
+	if len(images) > maxProfileImages {
+		return errors.New("too many profile images")
+	}
+
+	imagesSize := len(profileImage)
+	names := make(map[string]bool)
+	for _, image := range images {
+		if len(image.Name) == 0 {
+			return errors.New("profile image name can't be empty")
+		}
+		if names[image.Name] {
+			return errors.New("duplicate profile image name")
+		}
+		names[image.Name] = true
+
+		if len(image.Payload) == 0 {
+			return errors.New("profile image payload can't be empty")
+		}
+		if len(image.Payload) > maxProfileImageSize {
+			return errors.New("profile image payload too large")
+		}
+		imagesSize += len(image.Payload)
+	}
+
+	if imagesSize > maxProfileImagesSize {
+		return errors.New("profile images too large")
+	}
+
+	if len(profileLink) != 0 {
+		if utf8.RuneCountInString(profileLink) > maxProfileLinkLength {
+			return errors.New("profile link too long")
+		}
+		link, err := url.Parse(profileLink)
+		if err != nil {
+			return err
+		}
+		if (link.Scheme != "https" && link.Scheme != "http") || len(link.Host) == 0 {
+			return errors.New("profile link must be a http or https url")
+		}
+	}
+
+	return nil
+}
+
+// ValidateReceivedContactRequestAnswer validates the clock of an acceptance,
+// a decline or a retraction of a contact request
+func ValidateReceivedContactRequestAnswer(clock uint64, whisperTimestamp uint64) error {
+	return validateClockValue(clock, whisperTimestamp)
+}
+
+func ValidateReceivedGroupChatJoinRequest(request *protobuf.GroupChatJoinRequest, whisperTimestamp uint64) error {
+	if err := validateClockValue(request.Clock, whisperTimestamp); err != nil {
+		return err
+	}
+
+	if request.Invitation == nil || len(request.Invitation.Payload) == 0 {
+		return errors.New("invitation can't be empty")
+	}
+
+	if len(request.Invitation.Signature) == 0 {
+		return errors.New("invitation signature can't be empty")
+	}
+
+	return nil
+}
+
+// ValidateReceivedGroupChatJoinRequestRejected validates the rejection of a
+// join request sent by another admin of the chat
+func ValidateReceivedGroupChatJoinRequestRejected(rejection *protobuf.GroupChatJoinRequestRejected, whisperTimestamp uint64) error {
+	if err := validateClockValue(rejection.Clock, whisperTimestamp); err != nil {
+		return err
+	}
+
+	if len(rejection.ChatId) == 0 {
+		return errors.New("chat-id can't be empty")
+	}
+
+	publicKeyBytes, err := types.DecodeHex(rejection.Requester)
+	if err != nil {
+		return errors.New("invalid requester")
+	}
+	if _, err := crypto.UnmarshalPubkey(publicKeyBytes); err != nil {
+		return errors.New("invalid requester")
+	}
+
+	return nil
+}
+
+// ValidateReceivedGroupChatInvitationRevoked validates the revocation of an
+// invitation sent by another admin of the chat
+func ValidateReceivedGroupChatInvitationRevoked(revocation *protobuf.GroupChatInvitationRevoked, whisperTimestamp uint64) error {
+	if err := validateClockValue(revocation.Clock, whisperTimestamp); err != nil {
+		return err
+	}
+
+	if len(revocation.ChatId) == 0 {
+		return errors.New("chat-id can't be empty")
+	}
+
+	if len(revocation.InvitationId) == 0 {
+		return errors.New("invitation-id can't be empty")
+	}
+
+	return nil
+}
+
+// maxCommunityNameLength is the maximum length in characters of the name of
+// a community and of its channels
+const maxCommunityNameLength = 30
+
+// maxCommunityDescriptionLength is the maximum length in characters of the
+// description of a community and of its channels
+const maxCommunityDescriptionLength = 256
+
+// communityKeyLength is the length in bytes of the key the messages of the
+// channels of a community are encrypted with
+const communityKeyLength = 32
+
+func ValidateCommunityDescription(description *protobuf.CommunityDescription) error {
+	if len(description.Owner) != PubKeyStringLength {
+		return errors.New("invalid owner")
+	}
+
+	if !strings.HasSuffix(description.Id, description.Owner) {
+		return errors.New("community id must end with the owner key")
+	}
+
+	if err := validateCommunityName(description.Name, description.Description); err != nil {
+		return err
+	}
+
+	if description.Access != protobuf.CommunityDescription_OPEN && description.Access != protobuf.CommunityDescription_REQUEST_TO_JOIN {
+		return errors.New("unknown access")
+	}
+
+	if description.KeyId == 0 {
+		return errors.New("key-id can't be empty")
+	}
+
+	channelIDs := make(map[string]bool)
+	for _, channel := range description.Channels {
+		if len(channel.Id) == 0 {
+			return errors.New("channel-id can't be empty")
+		}
+		if channelIDs[channel.Id] {
+			return errors.New("duplicate channel-id")
+		}
+		channelIDs[channel.Id] = true
+
+		if err := validateCommunityName(channel.Name, channel.Description); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func validateCommunityName(name string, description string) error {
+	if len(strings.TrimSpace(name)) == 0 {
+		return errors.New("name can't be empty")
+	}
+
+	if utf8.RuneCountInString(name) > maxCommunityNameLength {
+		return errors.New("name too long")
+	}
+
+	if utf8.RuneCountInString(description) > maxCommunityDescriptionLength {
+		return errors.New("description too long")
+	}
+
+	return nil
+}
+
+func ValidateReceivedCommunityRequestToJoin(request *protobuf.CommunityRequestToJoin, whisperTimestamp uint64) error {
+	if err := validateClockValue(request.Clock, whisperTimestamp); err != nil {
+		return err
+	}
+
+	if len(request.CommunityId) == 0 {
+		return errors.New("community-id can't be empty")
+	}
+
+	return nil
+}
+
+func ValidateReceivedCommunityRequestToLeave(request *protobuf.CommunityRequestToLeave, whisperTimestamp uint64) error {
+	if err := validateClockValue(request.Clock, whisperTimestamp); err != nil {
+		return err
+	}
+
+	if len(request.CommunityId) == 0 {
+		return errors.New("community-id can't be empty")
+	}
+
+	return nil
+}
+
+func ValidateReceivedCommunityKey(key *protobuf.CommunityKey, whisperTimestamp uint64) error {
+	if err := validateClockValue(key.Clock, whisperTimestamp); err != nil {
+		return err
+	}
+
+	if key.KeyId == 0 {
+		return errors.New("key-id can't be empty")
+	}
+
+	if len(key.Key) != communityKeyLength {
+		return errors.New("invalid key length")
+	}
+
+	if key.Description == nil || len(key.Description.Payload) == 0 {
+		return errors.New("description can't be empty")
+	}
+
+	return nil
+}
+
+// maxPollOptions is the maximum number of options of a poll
+const maxPollOptions = 10
+
+// maxPollOptionLength is the maximum length in characters of an option of a
+// poll
+const maxPollOptionLength = 100
+
+func ValidatePoll(poll *protobuf.PollMessage) error {
+	if len(strings.TrimSpace(poll.Question)) == 0 {
+		return errors.New("question can't be empty")
+	}
+
+	if len(poll.Options) < 2 {
+		return errors.New("a poll needs at least two options")
+	}
+
+	if len(poll.Options) > maxPollOptions {
+		return errors.New("too many options")
+	}
+
+	for _, option := range poll.Options {
+		if len(strings.TrimSpace(option)) == 0 {
+			return errors.New("option can't be empty")
+		}
+		if utf8.RuneCountInString(option) > maxPollOptionLength {
+			return errors.New("option too long")
+		}
+	}
+
+	return nil
+}
+
+func ValidateReceivedPollVote(vote *protobuf.PollVote, whisperTimestamp uint64) error {
+	if err := validateClockValue(vote.Clock, whisperTimestamp); err != nil {
+		return err
+	}
+
+	if len(vote.ChatId) == 0 {
+		return errors.New("chat-id can't be empty")
+	}
+
+	if len(vote.MessageId) == 0 {
+		return errors.New("message-id can't be empty")
+	}
+
+	if vote.MessageType == protobuf.ChatMessage_UNKNOWN_MESSAGE_TYPE || vote.MessageType == protobuf.ChatMessage_SYSTEM_MESSAGE_PRIVATE_GROUP {
+		return errors.New("unknown message type")
+	}
+
+	if len(vote.Options) == 0 {
+		return errors.New("a vote needs at least one option")
+	}
+
+	return nil
+}
+
+// maxMessageChunks is the maximum number of chunks a message can be split in
+const maxMessageChunks = 64
+
+func ValidateReceivedMessageChunk(chunk *protobuf.MessageChunk) error {
+	if len(chunk.Id) == 0 {
+		return errors.New("id can't be empty")
+	}
+
+	if chunk.Total == 0 || chunk.Total > maxMessageChunks {
+		return errors.New("invalid total number of chunks")
+	}
+
+	if chunk.Index >= chunk.Total {
+		return errors.New("chunk index out of range")
+	}
+
+	if len(chunk.Payload) == 0 {
+		return errors.New("payload can't be empty")
+	}
+
+	if chunk.Type == protobuf.ApplicationMetadataMessage_UNKNOWN || chunk.Type == protobuf.ApplicationMetadataMessage_MESSAGE_CHUNK {
+		return errors.New("invalid chunked message type")
+	}
+
 	return nil
 }
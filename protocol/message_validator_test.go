@@ -1,10 +1,12 @@
 package protocol
 
 import (
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/suite"
 
+	"github.com/status-im/status-go/protocol/linkpreview"
 	"github.com/status-im/status-go/protocol/protobuf"
 )
 
@@ -340,11 +342,1684 @@ func (s *MessageValidatorSuite) TestValidatePlainTextMessage() {
 				ContentType: protobuf.ChatMessage_STICKER,
 			},
 		},
+		{
+			Name:             "Valid audio message",
+			WhisperTimestamp: 2,
+			Valid:            true,
+			Message: protobuf.ChatMessage{
+				ChatId:    "a",
+				Clock:     2,
+				Timestamp: 3,
+				Payload: &protobuf.ChatMessage_Audio{
+					Audio: &protobuf.AudioMessage{
+						Payload:    []byte("audio"),
+						Type:       protobuf.AudioMessage_AAC,
+						DurationMs: 1000,
+					},
+				},
+				MessageType: protobuf.ChatMessage_ONE_TO_ONE,
+				ContentType: protobuf.ChatMessage_AUDIO,
+			},
+		},
+		{
+			Name:             "Valid poll message",
+			WhisperTimestamp: 2,
+			Valid:            true,
+			Message: protobuf.ChatMessage{
+				ChatId:    "a",
+				Text:      "question",
+				Clock:     2,
+				Timestamp: 3,
+				Payload: &protobuf.ChatMessage_Poll{
+					Poll: &protobuf.PollMessage{
+						Question: "question",
+						Options:  []string{"yes", "no"},
+					},
+				},
+				MessageType: protobuf.ChatMessage_PUBLIC_GROUP,
+				ContentType: protobuf.ChatMessage_POLL,
+			},
+		},
+		{
+			Name:             "Invalid poll message with a single option",
+			WhisperTimestamp: 2,
+			Valid:            false,
+			Message: protobuf.ChatMessage{
+				ChatId:    "a",
+				Text:      "question",
+				Clock:     2,
+				Timestamp: 3,
+				Payload: &protobuf.ChatMessage_Poll{
+					Poll: &protobuf.PollMessage{
+						Question: "question",
+						Options:  []string{"yes"},
+					},
+				},
+				MessageType: protobuf.ChatMessage_PUBLIC_GROUP,
+				ContentType: protobuf.ChatMessage_POLL,
+			},
+		},
+		{
+			Name:             "Valid status update",
+			WhisperTimestamp: 2,
+			Valid:            true,
+			Message: protobuf.ChatMessage{
+				ChatId:      "a",
+				Text:        "status",
+				Clock:       2,
+				Timestamp:   3,
+				MessageType: protobuf.ChatMessage_TIMELINE,
+				ContentType: protobuf.ChatMessage_STATUS,
+			},
+		},
+		{
+			Name:             "Invalid status update outside of a timeline",
+			WhisperTimestamp: 2,
+			Valid:            false,
+			Message: protobuf.ChatMessage{
+				ChatId:      "a",
+				Text:        "status",
+				Clock:       2,
+				Timestamp:   3,
+				MessageType: protobuf.ChatMessage_PUBLIC_GROUP,
+				ContentType: protobuf.ChatMessage_STATUS,
+			},
+		},
+		{
+			Name:             "Invalid status update replying to a message",
+			WhisperTimestamp: 2,
+			Valid:            false,
+			Message: protobuf.ChatMessage{
+				ChatId:      "a",
+				Text:        "status",
+				Clock:       2,
+				Timestamp:   3,
+				ResponseTo:  "0x01",
+				MessageType: protobuf.ChatMessage_TIMELINE,
+				ContentType: protobuf.ChatMessage_STATUS,
+			},
+		},
+		{
+			Name:             "Valid comment on a status update",
+			WhisperTimestamp: 2,
+			Valid:            true,
+			Message: protobuf.ChatMessage{
+				ChatId:      "a",
+				Text:        "comment",
+				Clock:       2,
+				Timestamp:   3,
+				ResponseTo:  "0x01",
+				MessageType: protobuf.ChatMessage_TIMELINE,
+				ContentType: protobuf.ChatMessage_TEXT_PLAIN,
+			},
+		},
+		{
+			Name:             "Invalid comment not replying to a status update",
+			WhisperTimestamp: 2,
+			Valid:            false,
+			Message: protobuf.ChatMessage{
+				ChatId:      "a",
+				Text:        "comment",
+				Clock:       2,
+				Timestamp:   3,
+				MessageType: protobuf.ChatMessage_TIMELINE,
+				ContentType: protobuf.ChatMessage_TEXT_PLAIN,
+			},
+		},
+		{
+			Name:             "Invalid audio message without any content",
+			WhisperTimestamp: 2,
+			Valid:            false,
+			Message: protobuf.ChatMessage{
+				ChatId:      "a",
+				Clock:       2,
+				Timestamp:   3,
+				MessageType: protobuf.ChatMessage_ONE_TO_ONE,
+				ContentType: protobuf.ChatMessage_AUDIO,
+			},
+		},
+		{
+			Name:             "Invalid audio message with an empty payload",
+			WhisperTimestamp: 2,
+			Valid:            false,
+			Message: protobuf.ChatMessage{
+				ChatId:    "a",
+				Clock:     2,
+				Timestamp: 3,
+				Payload: &protobuf.ChatMessage_Audio{
+					Audio: &protobuf.AudioMessage{
+						Payload:    []byte{},
+						Type:       protobuf.AudioMessage_AAC,
+						DurationMs: 1000,
+					},
+				},
+				MessageType: protobuf.ChatMessage_ONE_TO_ONE,
+				ContentType: protobuf.ChatMessage_AUDIO,
+			},
+		},
+		{
+			Name:             "Invalid audio message with a payload too large",
+			WhisperTimestamp: 2,
+			Valid:            false,
+			Message: protobuf.ChatMessage{
+				ChatId:    "a",
+				Clock:     2,
+				Timestamp: 3,
+				Payload: &protobuf.ChatMessage_Audio{
+					Audio: &protobuf.AudioMessage{
+						Payload:    make([]byte, maxAudioPayloadSize+1),
+						Type:       protobuf.AudioMessage_OPUS,
+						DurationMs: 1000,
+					},
+				},
+				MessageType: protobuf.ChatMessage_ONE_TO_ONE,
+				ContentType: protobuf.ChatMessage_AUDIO,
+			},
+		},
+		{
+			Name:             "Invalid audio message with an unknown type",
+			WhisperTimestamp: 2,
+			Valid:            false,
+			Message: protobuf.ChatMessage{
+				ChatId:    "a",
+				Clock:     2,
+				Timestamp: 3,
+				Payload: &protobuf.ChatMessage_Audio{
+					Audio: &protobuf.AudioMessage{
+						Payload:    []byte("audio"),
+						Type:       protobuf.AudioMessage_UNKNOWN_AUDIO_TYPE,
+						DurationMs: 1000,
+					},
+				},
+				MessageType: protobuf.ChatMessage_ONE_TO_ONE,
+				ContentType: protobuf.ChatMessage_AUDIO,
+			},
+		},
+		{
+			Name:             "Invalid audio message without a duration",
+			WhisperTimestamp: 2,
+			Valid:            false,
+			Message: protobuf.ChatMessage{
+				ChatId:    "a",
+				Clock:     2,
+				Timestamp: 3,
+				Payload: &protobuf.ChatMessage_Audio{
+					Audio: &protobuf.AudioMessage{
+						Payload:    []byte("audio"),
+						Type:       protobuf.AudioMessage_AMR,
+						DurationMs: 0,
+					},
+				},
+				MessageType: protobuf.ChatMessage_ONE_TO_ONE,
+				ContentType: protobuf.ChatMessage_AUDIO,
+			},
+		},
+		{
+			Name:             "Valid message with link previews",
+			WhisperTimestamp: 2,
+			Valid:            true,
+			Message: protobuf.ChatMessage{
+				ChatId:    "a",
+				Text:      "https://status.im",
+				Clock:     2,
+				Timestamp: 3,
+				Links: []*protobuf.UnfurledLink{
+					{
+						Url:              "https://status.im",
+						Title:            "Status",
+						ThumbnailPayload: []byte("thumbnail"),
+					},
+				},
+				MessageType: protobuf.ChatMessage_ONE_TO_ONE,
+				ContentType: protobuf.ChatMessage_TEXT_PLAIN,
+			},
+		},
+		{
+			Name:             "Invalid message with too many link previews",
+			WhisperTimestamp: 2,
+			Valid:            false,
+			Message: protobuf.ChatMessage{
+				ChatId:    "a",
+				Text:      "https://status.im",
+				Clock:     2,
+				Timestamp: 3,
+				Links: []*protobuf.UnfurledLink{
+					{Url: "https://status.im/1"},
+					{Url: "https://status.im/2"},
+					{Url: "https://status.im/3"},
+					{Url: "https://status.im/4"},
+				},
+				MessageType: protobuf.ChatMessage_ONE_TO_ONE,
+				ContentType: protobuf.ChatMessage_TEXT_PLAIN,
+			},
+		},
+		{
+			Name:             "Invalid link preview without url",
+			WhisperTimestamp: 2,
+			Valid:            false,
+			Message: protobuf.ChatMessage{
+				ChatId:    "a",
+				Text:      "https://status.im",
+				Clock:     2,
+				Timestamp: 3,
+				Links: []*protobuf.UnfurledLink{
+					{Title: "Status"},
+				},
+				MessageType: protobuf.ChatMessage_ONE_TO_ONE,
+				ContentType: protobuf.ChatMessage_TEXT_PLAIN,
+			},
+		},
+		{
+			Name:             "Invalid link preview with a thumbnail too large",
+			WhisperTimestamp: 2,
+			Valid:            false,
+			Message: protobuf.ChatMessage{
+				ChatId:    "a",
+				Text:      "https://status.im",
+				Clock:     2,
+				Timestamp: 3,
+				Links: []*protobuf.UnfurledLink{
+					{
+						Url:              "https://status.im",
+						ThumbnailPayload: make([]byte, linkpreview.MaxThumbnailSize+1),
+					},
+				},
+				MessageType: protobuf.ChatMessage_ONE_TO_ONE,
+				ContentType: protobuf.ChatMessage_TEXT_PLAIN,
+			},
+		},
+		{
+			Name:             "Valid disappearing message",
+			WhisperTimestamp: 2,
+			Valid:            true,
+			Message: protobuf.ChatMessage{
+				ChatId:      "a",
+				Text:        "valid",
+				Clock:       2,
+				Timestamp:   3,
+				ExpiresAt:   4,
+				MessageType: protobuf.ChatMessage_ONE_TO_ONE,
+				ContentType: protobuf.ChatMessage_TEXT_PLAIN,
+			},
+		},
+		{
+			Name:             "Invalid message expiring before being sent",
+			WhisperTimestamp: 2,
+			Valid:            false,
+			Message: protobuf.ChatMessage{
+				ChatId:      "a",
+				Text:        "valid",
+				Clock:       2,
+				Timestamp:   3,
+				ExpiresAt:   3,
+				MessageType: protobuf.ChatMessage_ONE_TO_ONE,
+				ContentType: protobuf.ChatMessage_TEXT_PLAIN,
+			},
+		},
+		{
+			Name:             "Valid forwarded message",
+			WhisperTimestamp: 2,
+			Valid:            true,
+			Message: protobuf.ChatMessage{
+				ChatId:    "a",
+				Text:      "forwarded",
+				Clock:     2,
+				Timestamp: 3,
+				ForwardedFrom: &protobuf.ForwardedFrom{
+					MessageId: "0x01",
+					From:      testPK,
+					Timestamp: 1,
+				},
+				MessageType: protobuf.ChatMessage_ONE_TO_ONE,
+				ContentType: protobuf.ChatMessage_TEXT_PLAIN,
+			},
+		},
+		{
+			Name:             "Valid forwarded transaction result",
+			WhisperTimestamp: 2,
+			Valid:            true,
+			Message: protobuf.ChatMessage{
+				ChatId:    "a",
+				Text:      "forwarded",
+				Clock:     2,
+				Timestamp: 3,
+				ForwardedFrom: &protobuf.ForwardedFrom{
+					MessageId:       "0x01",
+					From:            testPK,
+					Timestamp:       1,
+					TransactionHash: "0xabababababababababababababababababababababababababababababababab",
+				},
+				MessageType: protobuf.ChatMessage_ONE_TO_ONE,
+				ContentType: protobuf.ChatMessage_TEXT_PLAIN,
+			},
+		},
+		{
+			Name:             "Valid forwarded sticker",
+			WhisperTimestamp: 2,
+			Valid:            true,
+			Message: protobuf.ChatMessage{
+				ChatId:    "a",
+				Text:      "forwarded",
+				Clock:     2,
+				Timestamp: 3,
+				Payload: &protobuf.ChatMessage_Sticker{
+					Sticker: &protobuf.StickerMessage{Hash: "some-hash"},
+				},
+				ForwardedFrom: &protobuf.ForwardedFrom{
+					MessageId: "0x01",
+					From:      testPK,
+					Timestamp: 1,
+				},
+				MessageType: protobuf.ChatMessage_ONE_TO_ONE,
+				ContentType: protobuf.ChatMessage_STICKER,
+			},
+		},
+		{
+			Name:             "Invalid forwarded message without message id",
+			WhisperTimestamp: 2,
+			Valid:            false,
+			Message: protobuf.ChatMessage{
+				ChatId:    "a",
+				Text:      "forwarded",
+				Clock:     2,
+				Timestamp: 3,
+				ForwardedFrom: &protobuf.ForwardedFrom{
+					From:      testPK,
+					Timestamp: 1,
+				},
+				MessageType: protobuf.ChatMessage_ONE_TO_ONE,
+				ContentType: protobuf.ChatMessage_TEXT_PLAIN,
+			},
+		},
+		{
+			Name:             "Invalid forwarded message without timestamp",
+			WhisperTimestamp: 2,
+			Valid:            false,
+			Message: protobuf.ChatMessage{
+				ChatId:    "a",
+				Text:      "forwarded",
+				Clock:     2,
+				Timestamp: 3,
+				ForwardedFrom: &protobuf.ForwardedFrom{
+					MessageId: "0x01",
+					From:      testPK,
+				},
+				MessageType: protobuf.ChatMessage_ONE_TO_ONE,
+				ContentType: protobuf.ChatMessage_TEXT_PLAIN,
+			},
+		},
+		{
+			Name:             "Invalid forwarded message with an invalid author",
+			WhisperTimestamp: 2,
+			Valid:            false,
+			Message: protobuf.ChatMessage{
+				ChatId:    "a",
+				Text:      "forwarded",
+				Clock:     2,
+				Timestamp: 3,
+				ForwardedFrom: &protobuf.ForwardedFrom{
+					MessageId: "0x01",
+					From:      "0x04",
+					Timestamp: 1,
+				},
+				MessageType: protobuf.ChatMessage_ONE_TO_ONE,
+				ContentType: protobuf.ChatMessage_TEXT_PLAIN,
+			},
+		},
+		{
+			Name:             "Invalid forwarded message with an invalid transaction hash",
+			WhisperTimestamp: 2,
+			Valid:            false,
+			Message: protobuf.ChatMessage{
+				ChatId:    "a",
+				Text:      "forwarded",
+				Clock:     2,
+				Timestamp: 3,
+				ForwardedFrom: &protobuf.ForwardedFrom{
+					MessageId:       "0x01",
+					From:            testPK,
+					Timestamp:       1,
+					TransactionHash: "0xab",
+				},
+				MessageType: protobuf.ChatMessage_ONE_TO_ONE,
+				ContentType: protobuf.ChatMessage_TEXT_PLAIN,
+			},
+		},
+		{
+			Name:             "Invalid forwarded reply",
+			WhisperTimestamp: 2,
+			Valid:            false,
+			Message: protobuf.ChatMessage{
+				ChatId:     "a",
+				Text:       "forwarded",
+				Clock:      2,
+				Timestamp:  3,
+				ResponseTo: "0x02",
+				ForwardedFrom: &protobuf.ForwardedFrom{
+					MessageId: "0x01",
+					From:      testPK,
+					Timestamp: 1,
+				},
+				MessageType: protobuf.ChatMessage_ONE_TO_ONE,
+				ContentType: protobuf.ChatMessage_TEXT_PLAIN,
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.Name, func() {
+			err := ValidateReceivedChatMessage(&tc.Message, tc.WhisperTimestamp)
+			if tc.Valid {
+				s.Nil(err)
+			} else {
+				s.NotNil(err)
+			}
+		})
+	}
+}
+
+func (s *MessageValidatorSuite) TestValidateEmojiReaction() {
+	testCases := []struct {
+		Name             string
+		WhisperTimestamp uint64
+		Valid            bool
+		Message          protobuf.EmojiReaction
+	}{
+		{
+			Name:             "valid emoji reaction",
+			WhisperTimestamp: 30,
+			Valid:            true,
+			Message: protobuf.EmojiReaction{
+				Clock:       30,
+				ChatId:      "chat-id",
+				MessageId:   "message-id",
+				Type:        protobuf.EmojiReaction_LOVE,
+				MessageType: protobuf.ChatMessage_ONE_TO_ONE,
+			},
+		},
+		{
+			Name:             "valid emoji retraction",
+			WhisperTimestamp: 30,
+			Valid:            true,
+			Message: protobuf.EmojiReaction{
+				Clock:       30,
+				ChatId:      "chat-id",
+				MessageId:   "message-id",
+				Type:        protobuf.EmojiReaction_LOVE,
+				MessageType: protobuf.ChatMessage_PUBLIC_GROUP,
+				Retracted:   true,
+			},
+		},
+		{
+			Name:             "missing clock",
+			WhisperTimestamp: 30,
+			Valid:            false,
+			Message: protobuf.EmojiReaction{
+				ChatId:      "chat-id",
+				MessageId:   "message-id",
+				Type:        protobuf.EmojiReaction_LOVE,
+				MessageType: protobuf.ChatMessage_ONE_TO_ONE,
+			},
+		},
+		{
+			Name:             "missing chat id",
+			WhisperTimestamp: 30,
+			Valid:            false,
+			Message: protobuf.EmojiReaction{
+				Clock:       30,
+				MessageId:   "message-id",
+				Type:        protobuf.EmojiReaction_LOVE,
+				MessageType: protobuf.ChatMessage_ONE_TO_ONE,
+			},
+		},
+		{
+			Name:             "missing message id",
+			WhisperTimestamp: 30,
+			Valid:            false,
+			Message: protobuf.EmojiReaction{
+				Clock:       30,
+				ChatId:      "chat-id",
+				Type:        protobuf.EmojiReaction_LOVE,
+				MessageType: protobuf.ChatMessage_ONE_TO_ONE,
+			},
+		},
+		{
+			Name:             "unknown emoji",
+			WhisperTimestamp: 30,
+			Valid:            false,
+			Message: protobuf.EmojiReaction{
+				Clock:       30,
+				ChatId:      "chat-id",
+				MessageId:   "message-id",
+				Type:        protobuf.EmojiReaction_Type(42),
+				MessageType: protobuf.ChatMessage_ONE_TO_ONE,
+			},
+		},
+		{
+			Name:             "system message type",
+			WhisperTimestamp: 30,
+			Valid:            false,
+			Message: protobuf.EmojiReaction{
+				Clock:       30,
+				ChatId:      "chat-id",
+				MessageId:   "message-id",
+				Type:        protobuf.EmojiReaction_LOVE,
+				MessageType: protobuf.ChatMessage_SYSTEM_MESSAGE_PRIVATE_GROUP,
+			},
+		},
+	}
+	for _, tc := range testCases {
+		s.Run(tc.Name, func() {
+			err := ValidateReceivedEmojiReaction(&tc.Message, tc.WhisperTimestamp)
+			if tc.Valid {
+				s.Nil(err)
+			} else {
+				s.NotNil(err)
+			}
+		})
+	}
+}
+
+func (s *MessageValidatorSuite) TestValidateEditMessage() {
+	testCases := []struct {
+		Name             string
+		WhisperTimestamp uint64
+		Valid            bool
+		Message          protobuf.EditMessage
+	}{
+		{
+			Name:             "valid edit",
+			WhisperTimestamp: 30,
+			Valid:            true,
+			Message: protobuf.EditMessage{
+				Clock:       30,
+				Text:        "edited-text",
+				ChatId:      "chat-id",
+				MessageId:   "message-id",
+				MessageType: protobuf.ChatMessage_ONE_TO_ONE,
+			},
+		},
+		{
+			Name:             "missing clock",
+			WhisperTimestamp: 30,
+			Valid:            false,
+			Message: protobuf.EditMessage{
+				Text:        "edited-text",
+				ChatId:      "chat-id",
+				MessageId:   "message-id",
+				MessageType: protobuf.ChatMessage_ONE_TO_ONE,
+			},
+		},
+		{
+			Name:             "empty text",
+			WhisperTimestamp: 30,
+			Valid:            false,
+			Message: protobuf.EditMessage{
+				Clock:       30,
+				Text:        "  ",
+				ChatId:      "chat-id",
+				MessageId:   "message-id",
+				MessageType: protobuf.ChatMessage_ONE_TO_ONE,
+			},
+		},
+		{
+			Name:             "missing chat id",
+			WhisperTimestamp: 30,
+			Valid:            false,
+			Message: protobuf.EditMessage{
+				Clock:       30,
+				Text:        "edited-text",
+				MessageId:   "message-id",
+				MessageType: protobuf.ChatMessage_ONE_TO_ONE,
+			},
+		},
+		{
+			Name:             "missing message id",
+			WhisperTimestamp: 30,
+			Valid:            false,
+			Message: protobuf.EditMessage{
+				Clock:       30,
+				Text:        "edited-text",
+				ChatId:      "chat-id",
+				MessageType: protobuf.ChatMessage_ONE_TO_ONE,
+			},
+		},
+		{
+			Name:             "unknown message type",
+			WhisperTimestamp: 30,
+			Valid:            false,
+			Message: protobuf.EditMessage{
+				Clock:     30,
+				Text:      "edited-text",
+				ChatId:    "chat-id",
+				MessageId: "message-id",
+			},
+		},
+	}
+	for _, tc := range testCases {
+		s.Run(tc.Name, func() {
+			err := ValidateReceivedEditMessage(&tc.Message, tc.WhisperTimestamp)
+			if tc.Valid {
+				s.Nil(err)
+			} else {
+				s.NotNil(err)
+			}
+		})
+	}
+}
+
+func (s *MessageValidatorSuite) TestValidateDeleteMessage() {
+	testCases := []struct {
+		Name             string
+		WhisperTimestamp uint64
+		Valid            bool
+		Message          protobuf.DeleteMessage
+	}{
+		{
+			Name:             "valid deletion",
+			WhisperTimestamp: 30,
+			Valid:            true,
+			Message: protobuf.DeleteMessage{
+				Clock:       30,
+				ChatId:      "chat-id",
+				MessageId:   "message-id",
+				MessageType: protobuf.ChatMessage_PUBLIC_GROUP,
+			},
+		},
+		{
+			Name:             "missing clock",
+			WhisperTimestamp: 30,
+			Valid:            false,
+			Message: protobuf.DeleteMessage{
+				ChatId:      "chat-id",
+				MessageId:   "message-id",
+				MessageType: protobuf.ChatMessage_PUBLIC_GROUP,
+			},
+		},
+		{
+			Name:             "missing chat id",
+			WhisperTimestamp: 30,
+			Valid:            false,
+			Message: protobuf.DeleteMessage{
+				Clock:       30,
+				MessageId:   "message-id",
+				MessageType: protobuf.ChatMessage_PUBLIC_GROUP,
+			},
+		},
+		{
+			Name:             "missing message id",
+			WhisperTimestamp: 30,
+			Valid:            false,
+			Message: protobuf.DeleteMessage{
+				Clock:       30,
+				ChatId:      "chat-id",
+				MessageType: protobuf.ChatMessage_PUBLIC_GROUP,
+			},
+		},
+		{
+			Name:             "unknown message type",
+			WhisperTimestamp: 30,
+			Valid:            false,
+			Message: protobuf.DeleteMessage{
+				Clock:     30,
+				ChatId:    "chat-id",
+				MessageId: "message-id",
+			},
+		},
+	}
+	for _, tc := range testCases {
+		s.Run(tc.Name, func() {
+			err := ValidateReceivedDeleteMessage(&tc.Message, tc.WhisperTimestamp)
+			if tc.Valid {
+				s.Nil(err)
+			} else {
+				s.NotNil(err)
+			}
+		})
+	}
+}
+
+func (s *MessageValidatorSuite) TestValidatePinMessage() {
+	testCases := []struct {
+		Name             string
+		WhisperTimestamp uint64
+		Valid            bool
+		Message          protobuf.PinMessage
+	}{
+		{
+			Name:             "valid pin",
+			WhisperTimestamp: 30,
+			Valid:            true,
+			Message: protobuf.PinMessage{
+				Clock:       30,
+				ChatId:      "chat-id",
+				MessageId:   "message-id",
+				Pinned:      true,
+				MessageType: protobuf.ChatMessage_PUBLIC_GROUP,
+			},
+		},
+		{
+			Name:             "valid unpin",
+			WhisperTimestamp: 30,
+			Valid:            true,
+			Message: protobuf.PinMessage{
+				Clock:       30,
+				ChatId:      "chat-id",
+				MessageId:   "message-id",
+				Pinned:      false,
+				MessageType: protobuf.ChatMessage_PUBLIC_GROUP,
+			},
+		},
+		{
+			Name:             "missing clock",
+			WhisperTimestamp: 30,
+			Valid:            false,
+			Message: protobuf.PinMessage{
+				ChatId:      "chat-id",
+				MessageId:   "message-id",
+				MessageType: protobuf.ChatMessage_PUBLIC_GROUP,
+			},
+		},
+		{
+			Name:             "missing chat id",
+			WhisperTimestamp: 30,
+			Valid:            false,
+			Message: protobuf.PinMessage{
+				Clock:       30,
+				MessageId:   "message-id",
+				MessageType: protobuf.ChatMessage_PUBLIC_GROUP,
+			},
+		},
+		{
+			Name:             "missing message id",
+			WhisperTimestamp: 30,
+			Valid:            false,
+			Message: protobuf.PinMessage{
+				Clock:       30,
+				ChatId:      "chat-id",
+				MessageType: protobuf.ChatMessage_PUBLIC_GROUP,
+			},
+		},
+		{
+			Name:             "unknown message type",
+			WhisperTimestamp: 30,
+			Valid:            false,
+			Message: protobuf.PinMessage{
+				Clock:     30,
+				ChatId:    "chat-id",
+				MessageId: "message-id",
+			},
+		},
+	}
+	for _, tc := range testCases {
+		s.Run(tc.Name, func() {
+			err := ValidateReceivedPinMessage(&tc.Message, tc.WhisperTimestamp)
+			if tc.Valid {
+				s.Nil(err)
+			} else {
+				s.NotNil(err)
+			}
+		})
+	}
+}
+
+func (s *MessageValidatorSuite) TestValidateReadReceipt() {
+	testCases := []struct {
+		Name             string
+		WhisperTimestamp uint64
+		Valid            bool
+		Message          protobuf.ReadReceipt
+	}{
+		{
+			Name:             "valid read receipt",
+			WhisperTimestamp: 30,
+			Valid:            true,
+			Message: protobuf.ReadReceipt{
+				Clock:      30,
+				MessageIds: []string{"message-id-1", "message-id-2"},
+			},
+		},
+		{
+			Name:             "clock value 0",
+			WhisperTimestamp: 30,
+			Valid:            false,
+			Message: protobuf.ReadReceipt{
+				MessageIds: []string{"message-id"},
+			},
+		},
+		{
+			Name:             "no message ids",
+			WhisperTimestamp: 30,
+			Valid:            false,
+			Message: protobuf.ReadReceipt{
+				Clock: 30,
+			},
+		},
+		{
+			Name:             "empty message id",
+			WhisperTimestamp: 30,
+			Valid:            false,
+			Message: protobuf.ReadReceipt{
+				Clock:      30,
+				MessageIds: []string{"message-id", ""},
+			},
+		},
+	}
+	for _, tc := range testCases {
+		s.Run(tc.Name, func() {
+			err := ValidateReceivedReadReceipt(&tc.Message, tc.WhisperTimestamp)
+			if tc.Valid {
+				s.Nil(err)
+			} else {
+				s.NotNil(err)
+			}
+		})
+	}
+}
+
+func (s *MessageValidatorSuite) TestValidateTypingStatus() {
+	testCases := []struct {
+		Name             string
+		WhisperTimestamp uint64
+		Valid            bool
+		Message          protobuf.TypingStatus
+	}{
+		{
+			Name:             "valid one to one typing status",
+			WhisperTimestamp: 30,
+			Valid:            true,
+			Message: protobuf.TypingStatus{
+				Clock:       30,
+				ChatId:      "chat-id",
+				Typing:      true,
+				MessageType: protobuf.ChatMessage_ONE_TO_ONE,
+			},
+		},
+		{
+			Name:             "valid private group typing status",
+			WhisperTimestamp: 30,
+			Valid:            true,
+			Message: protobuf.TypingStatus{
+				Clock:       30,
+				ChatId:      "chat-id",
+				MessageType: protobuf.ChatMessage_PRIVATE_GROUP,
+			},
+		},
+		{
+			Name:             "clock value 0",
+			WhisperTimestamp: 30,
+			Valid:            false,
+			Message: protobuf.TypingStatus{
+				ChatId:      "chat-id",
+				MessageType: protobuf.ChatMessage_ONE_TO_ONE,
+			},
+		},
+		{
+			Name:             "missing chat id",
+			WhisperTimestamp: 30,
+			Valid:            false,
+			Message: protobuf.TypingStatus{
+				Clock:       30,
+				MessageType: protobuf.ChatMessage_ONE_TO_ONE,
+			},
+		},
+		{
+			Name:             "public chat",
+			WhisperTimestamp: 30,
+			Valid:            false,
+			Message: protobuf.TypingStatus{
+				Clock:       30,
+				ChatId:      "chat-id",
+				MessageType: protobuf.ChatMessage_PUBLIC_GROUP,
+			},
+		},
+	}
+	for _, tc := range testCases {
+		s.Run(tc.Name, func() {
+			err := ValidateReceivedTypingStatus(&tc.Message, tc.WhisperTimestamp)
+			if tc.Valid {
+				s.Nil(err)
+			} else {
+				s.NotNil(err)
+			}
+		})
+	}
+}
+
+func (s *MessageValidatorSuite) TestValidateDisappearingMessagesSetting() {
+	testCases := []struct {
+		Name             string
+		WhisperTimestamp uint64
+		Valid            bool
+		Message          protobuf.DisappearingMessagesSetting
+	}{
+		{
+			Name:             "valid setting",
+			WhisperTimestamp: 30,
+			Valid:            true,
+			Message: protobuf.DisappearingMessagesSetting{
+				Clock:       30,
+				ChatId:      "chat-id",
+				Timer:       60000,
+				MessageType: protobuf.ChatMessage_PRIVATE_GROUP,
+			},
+		},
+		{
+			Name:             "valid setting turning disappearing messages off",
+			WhisperTimestamp: 30,
+			Valid:            true,
+			Message: protobuf.DisappearingMessagesSetting{
+				Clock:       30,
+				ChatId:      "chat-id",
+				MessageType: protobuf.ChatMessage_ONE_TO_ONE,
+			},
+		},
+		{
+			Name:             "clock value 0",
+			WhisperTimestamp: 30,
+			Valid:            false,
+			Message: protobuf.DisappearingMessagesSetting{
+				ChatId:      "chat-id",
+				MessageType: protobuf.ChatMessage_ONE_TO_ONE,
+			},
+		},
+		{
+			Name:             "missing chat id",
+			WhisperTimestamp: 30,
+			Valid:            false,
+			Message: protobuf.DisappearingMessagesSetting{
+				Clock:       30,
+				MessageType: protobuf.ChatMessage_ONE_TO_ONE,
+			},
+		},
+		{
+			Name:             "public chat",
+			WhisperTimestamp: 30,
+			Valid:            false,
+			Message: protobuf.DisappearingMessagesSetting{
+				Clock:       30,
+				ChatId:      "chat-id",
+				Timer:       60000,
+				MessageType: protobuf.ChatMessage_PUBLIC_GROUP,
+			},
+		},
+	}
+	for _, tc := range testCases {
+		s.Run(tc.Name, func() {
+			err := ValidateReceivedDisappearingMessagesSetting(&tc.Message, tc.WhisperTimestamp)
+			if tc.Valid {
+				s.Nil(err)
+			} else {
+				s.NotNil(err)
+			}
+		})
+	}
+}
+
+func (s *MessageValidatorSuite) TestValidateContactRequest() {
+	testCases := []struct {
+		Name             string
+		WhisperTimestamp uint64
+		Valid            bool
+		Message          protobuf.ContactRequest
+	}{
+		{
+			Name:             "valid request",
+			WhisperTimestamp: 30,
+			Valid:            true,
+			Message: protobuf.ContactRequest{
+				Clock: 30,
+				Text:  "hi, it's me",
+			},
+		},
+		{
+			Name:             "valid request without text",
+			WhisperTimestamp: 30,
+			Valid:            true,
+			Message: protobuf.ContactRequest{
+				Clock: 30,
+			},
+		},
+		{
+			Name:             "clock value 0",
+			WhisperTimestamp: 30,
+			Valid:            false,
+			Message: protobuf.ContactRequest{
+				Text: "hi, it's me",
+			},
+		},
+		{
+			Name:             "text too long",
+			WhisperTimestamp: 30,
+			Valid:            false,
+			Message: protobuf.ContactRequest{
+				Clock: 30,
+				Text:  strings.Repeat("a", maxContactRequestTextLength+1),
+			},
+		},
+	}
+	for _, tc := range testCases {
+		s.Run(tc.Name, func() {
+			err := ValidateReceivedContactRequest(&tc.Message, tc.WhisperTimestamp)
+			if tc.Valid {
+				s.Nil(err)
+			} else {
+				s.NotNil(err)
+			}
+		})
+	}
+
+	s.Nil(ValidateReceivedContactRequestAnswer(30, 30))
+	s.NotNil(ValidateReceivedContactRequestAnswer(0, 30))
+}
+
+func (s *MessageValidatorSuite) TestValidateContactUpdate() {
+	testCases := []struct {
+		Name             string
+		WhisperTimestamp uint64
+		Valid            bool
+		Message          protobuf.ContactUpdate
+	}{
+		{
+			Name:             "valid update",
+			WhisperTimestamp: 30,
+			Valid:            true,
+			Message: protobuf.ContactUpdate{
+				Clock:       30,
+				EnsName:     "name.stateofus.eth",
+				DisplayName: "name",
+				Bio:         "about me",
+				Images: []*protobuf.ProfileImage{
+					{Name: "thumbnail", Payload: "payload", Width: 80, Height: 80},
+					{Name: "large", Payload: "payload", Width: 240, Height: 240},
+				},
+				ProfileLink: "https://status.im",
+			},
+		},
+		{
+			Name:             "valid update without profile",
+			WhisperTimestamp: 30,
+			Valid:            true,
+			Message: protobuf.ContactUpdate{
+				Clock: 30,
+			},
+		},
+		{
+			Name:             "clock value 0",
+			WhisperTimestamp: 30,
+			Valid:            false,
+			Message:          protobuf.ContactUpdate{},
+		},
+		{
+			Name:             "display name too long",
+			WhisperTimestamp: 30,
+			Valid:            false,
+			Message: protobuf.ContactUpdate{
+				Clock:       30,
+				DisplayName: strings.Repeat("a", maxDisplayNameLength+1),
+			},
+		},
+		{
+			Name:             "bio too long",
+			WhisperTimestamp: 30,
+			Valid:            false,
+			Message: protobuf.ContactUpdate{
+				Clock: 30,
+				Bio:   strings.Repeat("a", maxBioLength+1),
+			},
+		},
+		{
+			Name:             "image without payload",
+			WhisperTimestamp: 30,
+			Valid:            false,
+			Message: protobuf.ContactUpdate{
+				Clock:  30,
+				Images: []*protobuf.ProfileImage{{Name: "thumbnail"}},
+			},
+		},
+		{
+			Name:             "duplicate image size",
+			WhisperTimestamp: 30,
+			Valid:            false,
+			Message: protobuf.ContactUpdate{
+				Clock: 30,
+				Images: []*protobuf.ProfileImage{
+					{Name: "thumbnail", Payload: "payload"},
+					{Name: "thumbnail", Payload: "payload"},
+				},
+			},
+		},
+		{
+			Name:             "profile link not a url",
+			WhisperTimestamp: 30,
+			Valid:            false,
+			Message: protobuf.ContactUpdate{
+				Clock:       30,
+				ProfileLink: "javascript:alert(1)",
+			},
+		},
+		{
+			Name:             "images too large together",
+			WhisperTimestamp: 30,
+			Valid:            false,
+			Message: protobuf.ContactUpdate{
+				Clock: 30,
+				Images: []*protobuf.ProfileImage{
+					{Name: "thumbnail", Payload: strings.Repeat("a", 300000)},
+					{Name: "large", Payload: strings.Repeat("a", 300000)},
+				},
+			},
+		},
+		{
+			Name:             "images too large along with the profile image",
+			WhisperTimestamp: 30,
+			Valid:            false,
+			Message: protobuf.ContactUpdate{
+				Clock:        30,
+				ProfileImage: strings.Repeat("a", 300000),
+				Images:       []*protobuf.ProfileImage{{Name: "large", Payload: strings.Repeat("a", 300000)}},
+			},
+		},
+	}
+	for _, tc := range testCases {
+		s.Run(tc.Name, func() {
+			err := ValidateReceivedContactUpdate(&tc.Message, tc.WhisperTimestamp)
+			if tc.Valid {
+				s.Nil(err)
+			} else {
+				s.NotNil(err)
+			}
+		})
 	}
+}
 
+func (s *MessageValidatorSuite) TestValidateGroupChatJoinRequest() {
+	testCases := []struct {
+		Name             string
+		WhisperTimestamp uint64
+		Valid            bool
+		Message          protobuf.GroupChatJoinRequest
+	}{
+		{
+			Name:             "valid request",
+			WhisperTimestamp: 30,
+			Valid:            true,
+			Message: protobuf.GroupChatJoinRequest{
+				Clock: 30,
+				Invitation: &protobuf.SignedGroupChatInvitation{
+					Payload:   []byte("payload"),
+					Signature: []byte("signature"),
+				},
+			},
+		},
+		{
+			Name:             "clock value 0",
+			WhisperTimestamp: 30,
+			Valid:            false,
+			Message: protobuf.GroupChatJoinRequest{
+				Invitation: &protobuf.SignedGroupChatInvitation{
+					Payload:   []byte("payload"),
+					Signature: []byte("signature"),
+				},
+			},
+		},
+		{
+			Name:             "no invitation",
+			WhisperTimestamp: 30,
+			Valid:            false,
+			Message: protobuf.GroupChatJoinRequest{
+				Clock: 30,
+			},
+		},
+		{
+			Name:             "no signature",
+			WhisperTimestamp: 30,
+			Valid:            false,
+			Message: protobuf.GroupChatJoinRequest{
+				Clock: 30,
+				Invitation: &protobuf.SignedGroupChatInvitation{
+					Payload: []byte("payload"),
+				},
+			},
+		},
+	}
 	for _, tc := range testCases {
 		s.Run(tc.Name, func() {
-			err := ValidateReceivedChatMessage(&tc.Message, tc.WhisperTimestamp)
+			err := ValidateReceivedGroupChatJoinRequest(&tc.Message, tc.WhisperTimestamp)
+			if tc.Valid {
+				s.Nil(err)
+			} else {
+				s.NotNil(err)
+			}
+		})
+	}
+}
+
+func (s *MessageValidatorSuite) TestValidateGroupChatJoinRequestRejected() {
+	testCases := []struct {
+		Name             string
+		WhisperTimestamp uint64
+		Valid            bool
+		Message          protobuf.GroupChatJoinRequestRejected
+	}{
+		{
+			Name:             "valid rejection",
+			WhisperTimestamp: 30,
+			Valid:            true,
+			Message: protobuf.GroupChatJoinRequestRejected{
+				Clock:     30,
+				ChatId:    "chat-id",
+				Requester: testPK,
+			},
+		},
+		{
+			Name:             "clock value 0",
+			WhisperTimestamp: 30,
+			Valid:            false,
+			Message: protobuf.GroupChatJoinRequestRejected{
+				ChatId:    "chat-id",
+				Requester: testPK,
+			},
+		},
+		{
+			Name:             "no chat id",
+			WhisperTimestamp: 30,
+			Valid:            false,
+			Message: protobuf.GroupChatJoinRequestRejected{
+				Clock:     30,
+				Requester: testPK,
+			},
+		},
+		{
+			Name:             "invalid requester",
+			WhisperTimestamp: 30,
+			Valid:            false,
+			Message: protobuf.GroupChatJoinRequestRejected{
+				Clock:     30,
+				ChatId:    "chat-id",
+				Requester: "0x04",
+			},
+		},
+	}
+	for _, tc := range testCases {
+		s.Run(tc.Name, func() {
+			err := ValidateReceivedGroupChatJoinRequestRejected(&tc.Message, tc.WhisperTimestamp)
+			if tc.Valid {
+				s.Nil(err)
+			} else {
+				s.NotNil(err)
+			}
+		})
+	}
+}
+
+func (s *MessageValidatorSuite) TestValidateGroupChatInvitationRevoked() {
+	testCases := []struct {
+		Name             string
+		WhisperTimestamp uint64
+		Valid            bool
+		Message          protobuf.GroupChatInvitationRevoked
+	}{
+		{
+			Name:             "valid revocation",
+			WhisperTimestamp: 30,
+			Valid:            true,
+			Message: protobuf.GroupChatInvitationRevoked{
+				Clock:        30,
+				ChatId:       "chat-id",
+				InvitationId: "invitation-id",
+			},
+		},
+		{
+			Name:             "clock value 0",
+			WhisperTimestamp: 30,
+			Valid:            false,
+			Message: protobuf.GroupChatInvitationRevoked{
+				ChatId:       "chat-id",
+				InvitationId: "invitation-id",
+			},
+		},
+		{
+			Name:             "no chat id",
+			WhisperTimestamp: 30,
+			Valid:            false,
+			Message: protobuf.GroupChatInvitationRevoked{
+				Clock:        30,
+				InvitationId: "invitation-id",
+			},
+		},
+		{
+			Name:             "no invitation id",
+			WhisperTimestamp: 30,
+			Valid:            false,
+			Message: protobuf.GroupChatInvitationRevoked{
+				Clock:  30,
+				ChatId: "chat-id",
+			},
+		},
+	}
+	for _, tc := range testCases {
+		s.Run(tc.Name, func() {
+			err := ValidateReceivedGroupChatInvitationRevoked(&tc.Message, tc.WhisperTimestamp)
+			if tc.Valid {
+				s.Nil(err)
+			} else {
+				s.NotNil(err)
+			}
+		})
+	}
+}
+
+func (s *MessageValidatorSuite) TestValidateCommunityDescription() {
+	owner := "0x04" + strings.Repeat("a", 128)
+	channel := &protobuf.CommunityChannel{Id: "channel-id", Name: "general"}
+
+	testCases := []struct {
+		Name    string
+		Valid   bool
+		Message protobuf.CommunityDescription
+	}{
+		{
+			Name:  "valid description",
+			Valid: true,
+			Message: protobuf.CommunityDescription{
+				Id:       "community-id-" + owner,
+				Name:     "status",
+				Owner:    owner,
+				Access:   protobuf.CommunityDescription_OPEN,
+				KeyId:    1,
+				Channels: []*protobuf.CommunityChannel{channel},
+			},
+		},
+		{
+			Name:  "id not ending with the owner key",
+			Valid: false,
+			Message: protobuf.CommunityDescription{
+				Id:     "community-id",
+				Name:   "status",
+				Owner:  owner,
+				Access: protobuf.CommunityDescription_OPEN,
+				KeyId:  1,
+			},
+		},
+		{
+			Name:  "empty name",
+			Valid: false,
+			Message: protobuf.CommunityDescription{
+				Id:     "community-id-" + owner,
+				Name:   " ",
+				Owner:  owner,
+				Access: protobuf.CommunityDescription_OPEN,
+				KeyId:  1,
+			},
+		},
+		{
+			Name:  "unknown access",
+			Valid: false,
+			Message: protobuf.CommunityDescription{
+				Id:    "community-id-" + owner,
+				Name:  "status",
+				Owner: owner,
+				KeyId: 1,
+			},
+		},
+		{
+			Name:  "no key id",
+			Valid: false,
+			Message: protobuf.CommunityDescription{
+				Id:     "community-id-" + owner,
+				Name:   "status",
+				Owner:  owner,
+				Access: protobuf.CommunityDescription_REQUEST_TO_JOIN,
+			},
+		},
+		{
+			Name:  "duplicate channel",
+			Valid: false,
+			Message: protobuf.CommunityDescription{
+				Id:       "community-id-" + owner,
+				Name:     "status",
+				Owner:    owner,
+				Access:   protobuf.CommunityDescription_OPEN,
+				KeyId:    1,
+				Channels: []*protobuf.CommunityChannel{channel, channel},
+			},
+		},
+		{
+			Name:  "channel description too long",
+			Valid: false,
+			Message: protobuf.CommunityDescription{
+				Id:     "community-id-" + owner,
+				Name:   "status",
+				Owner:  owner,
+				Access: protobuf.CommunityDescription_OPEN,
+				KeyId:  1,
+				Channels: []*protobuf.CommunityChannel{{
+					Id:          "channel-id",
+					Name:        "general",
+					Description: strings.Repeat("a", maxCommunityDescriptionLength+1),
+				}},
+			},
+		},
+	}
+	for _, tc := range testCases {
+		s.Run(tc.Name, func() {
+			err := ValidateCommunityDescription(&tc.Message)
+			if tc.Valid {
+				s.Nil(err)
+			} else {
+				s.NotNil(err)
+			}
+		})
+	}
+}
+
+func (s *MessageValidatorSuite) TestValidateCommunityKey() {
+	description := &protobuf.SignedCommunityDescription{
+		Payload:   []byte("payload"),
+		Signature: []byte("signature"),
+	}
+
+	testCases := []struct {
+		Name             string
+		WhisperTimestamp uint64
+		Valid            bool
+		Message          protobuf.CommunityKey
+	}{
+		{
+			Name:             "valid key",
+			WhisperTimestamp: 30,
+			Valid:            true,
+			Message: protobuf.CommunityKey{
+				Clock:       30,
+				KeyId:       1,
+				Key:         make([]byte, communityKeyLength),
+				Description: description,
+			},
+		},
+		{
+			Name:             "no key id",
+			WhisperTimestamp: 30,
+			Valid:            false,
+			Message: protobuf.CommunityKey{
+				Clock:       30,
+				Key:         make([]byte, communityKeyLength),
+				Description: description,
+			},
+		},
+		{
+			Name:             "invalid key length",
+			WhisperTimestamp: 30,
+			Valid:            false,
+			Message: protobuf.CommunityKey{
+				Clock:       30,
+				KeyId:       1,
+				Key:         []byte("key"),
+				Description: description,
+			},
+		},
+		{
+			Name:             "no description",
+			WhisperTimestamp: 30,
+			Valid:            false,
+			Message: protobuf.CommunityKey{
+				Clock: 30,
+				KeyId: 1,
+				Key:   make([]byte, communityKeyLength),
+			},
+		},
+	}
+	for _, tc := range testCases {
+		s.Run(tc.Name, func() {
+			err := ValidateReceivedCommunityKey(&tc.Message, tc.WhisperTimestamp)
+			if tc.Valid {
+				s.Nil(err)
+			} else {
+				s.NotNil(err)
+			}
+		})
+	}
+}
+
+func (s *MessageValidatorSuite) TestValidatePollVote() {
+	testCases := []struct {
+		Name             string
+		WhisperTimestamp uint64
+		Valid            bool
+		Message          protobuf.PollVote
+	}{
+		{
+			Name:             "valid vote",
+			WhisperTimestamp: 30,
+			Valid:            true,
+			Message: protobuf.PollVote{
+				Clock:       30,
+				ChatId:      "chat-id",
+				MessageId:   "message-id",
+				MessageType: protobuf.ChatMessage_PUBLIC_GROUP,
+				Options:     []uint32{0},
+			},
+		},
+		{
+			Name:             "no message id",
+			WhisperTimestamp: 30,
+			Valid:            false,
+			Message: protobuf.PollVote{
+				Clock:       30,
+				ChatId:      "chat-id",
+				MessageType: protobuf.ChatMessage_PUBLIC_GROUP,
+				Options:     []uint32{0},
+			},
+		},
+		{
+			Name:             "unknown message type",
+			WhisperTimestamp: 30,
+			Valid:            false,
+			Message: protobuf.PollVote{
+				Clock:     30,
+				ChatId:    "chat-id",
+				MessageId: "message-id",
+				Options:   []uint32{0},
+			},
+		},
+		{
+			Name:             "no options",
+			WhisperTimestamp: 30,
+			Valid:            false,
+			Message: protobuf.PollVote{
+				Clock:       30,
+				ChatId:      "chat-id",
+				MessageId:   "message-id",
+				MessageType: protobuf.ChatMessage_PUBLIC_GROUP,
+			},
+		},
+	}
+	for _, tc := range testCases {
+		s.Run(tc.Name, func() {
+			err := ValidateReceivedPollVote(&tc.Message, tc.WhisperTimestamp)
+			if tc.Valid {
+				s.Nil(err)
+			} else {
+				s.NotNil(err)
+			}
+		})
+	}
+}
+
+func (s *MessageValidatorSuite) TestValidateMessageChunk() {
+	testCases := []struct {
+		Name    string
+		Valid   bool
+		Message protobuf.MessageChunk
+	}{
+		{
+			Name:  "valid chunk",
+			Valid: true,
+			Message: protobuf.MessageChunk{
+				Id:      "message-id",
+				Index:   1,
+				Total:   2,
+				Payload: []byte("payload"),
+				Type:    protobuf.ApplicationMetadataMessage_CHAT_MESSAGE,
+			},
+		},
+		{
+			Name:  "missing id",
+			Valid: false,
+			Message: protobuf.MessageChunk{
+				Index:   1,
+				Total:   2,
+				Payload: []byte("payload"),
+				Type:    protobuf.ApplicationMetadataMessage_CHAT_MESSAGE,
+			},
+		},
+		{
+			Name:  "index out of range",
+			Valid: false,
+			Message: protobuf.MessageChunk{
+				Id:      "message-id",
+				Index:   2,
+				Total:   2,
+				Payload: []byte("payload"),
+				Type:    protobuf.ApplicationMetadataMessage_CHAT_MESSAGE,
+			},
+		},
+		{
+			Name:  "too many chunks",
+			Valid: false,
+			Message: protobuf.MessageChunk{
+				Id:      "message-id",
+				Index:   1,
+				Total:   maxMessageChunks + 1,
+				Payload: []byte("payload"),
+				Type:    protobuf.ApplicationMetadataMessage_CHAT_MESSAGE,
+			},
+		},
+		{
+			Name:  "empty payload",
+			Valid: false,
+			Message: protobuf.MessageChunk{
+				Id:    "message-id",
+				Index: 1,
+				Total: 2,
+				Type:  protobuf.ApplicationMetadataMessage_CHAT_MESSAGE,
+			},
+		},
+		{
+			Name:  "nested chunk",
+			Valid: false,
+			Message: protobuf.MessageChunk{
+				Id:      "message-id",
+				Index:   1,
+				Total:   2,
+				Payload: []byte("payload"),
+				Type:    protobuf.ApplicationMetadataMessage_MESSAGE_CHUNK,
+			},
+		},
+	}
+	for _, tc := range testCases {
+		s.Run(tc.Name, func() {
+			err := ValidateReceivedMessageChunk(&tc.Message)
 			if tc.Valid {
 				s.Nil(err)
 			} else {
@@ -5,8 +5,11 @@ import (
 	"crypto/ecdsa"
 	"database/sql"
 	"math/rand"
+	"net/http"
+	"strings"
 	"sync"
 	"time"
+	"unicode/utf8"
 
 	"github.com/pkg/errors"
 	"go.uber.org/zap"
@@ -33,6 +36,25 @@ const PubKeyStringLength = 132
 
 const transactionSentTxt = "Transaction sent"
 
+// defaultMaxMessageChunkSize is the default size of the chunks big messages
+// are split in, it leaves room for the encryption and transport overhead
+// within the default maximum envelope size of 1MB
+const defaultMaxMessageChunkSize = 256 * 1024
+
+// messageChunkOverhead is the room left in an envelope for the layers
+// wrapping a chunk: metadata, signature, encryption headers, bundle and
+// padding
+const messageChunkOverhead = 16 * 1024
+
+// messageChunkCopies is how many times a chunk can be found in an envelope.
+// One-to-one messages are encrypted in the same envelope for each of the
+// active installations of the recipient
+const messageChunkCopies = 3
+
+// staleMessageChunksAge is how long the chunks of a message that has not
+// been fully received are kept
+const staleMessageChunksAge = 7 * 24 * time.Hour
+
 var (
 	ErrChatIDEmpty    = errors.New("chat ID is empty")
 	ErrNotImplemented = errors.New("not implemented")
@@ -64,6 +86,22 @@ type Messenger struct {
 	allInstallations           map[string]*multidevice.Installation
 	modifiedInstallations      map[string]bool
 	installationID             string
+	maxMessageChunkSize        int
+	linkPreviewAllowedDomains  func() ([]string, error)
+	httpClient                 *http.Client
+	readReceiptsSettings       ReadReceiptsSettings
+	contactRequestsSettings    ContactRequestsSettings
+	sentScheduledMessages      []*Message
+	failedScheduledMessages    []*ScheduledMessage
+	expiredMessageIDs          []string
+	expiredMessagesChats       map[string]bool
+	// pendingChatNotificationSettings are the notification settings synced
+	// by paired devices for chats we don't have yet, by chat id
+	pendingChatNotificationSettings map[string]*protobuf.SyncChatNotificationSettings
+	quit                            chan struct{}
+	// loops are the background loops started along with the messenger,
+	// they stop once quit is closed
+	loops sync.WaitGroup
 
 	mutex sync.Mutex
 }
@@ -74,14 +112,38 @@ type RawResponse struct {
 }
 
 type MessengerResponse struct {
-	Chats         []*Chat                     `json:"chats,omitempty"`
-	Messages      []*Message                  `json:"messages,omitempty"`
-	Contacts      []*Contact                  `json:"contacts,omitempty"`
-	Installations []*multidevice.Installation `json:"installations,omitempty"`
+	Chats          []*Chat                     `json:"chats,omitempty"`
+	Messages       []*Message                  `json:"messages,omitempty"`
+	Contacts       []*Contact                  `json:"contacts,omitempty"`
+	Installations  []*multidevice.Installation `json:"installations,omitempty"`
+	EmojiReactions []*EmojiReaction            `json:"emojiReactions,omitempty"`
+	PinMessages    []*PinMessage               `json:"pinMessages,omitempty"`
+	// GroupChatJoinRequests are the join requests received for the group
+	// chats we are an admin of
+	GroupChatJoinRequests []*GroupChatJoinRequest `json:"groupChatJoinRequests,omitempty"`
+	// Communities are the communities created, joined or updated
+	Communities []*Community `json:"communities,omitempty"`
+	// CommunityRequestsToJoin are the requests to join the communities we
+	// own that need to be accepted or declined
+	CommunityRequestsToJoin []*CommunityRequestToJoin `json:"communityRequestsToJoin,omitempty"`
+	// PollResults are the tallies of the polls that received votes
+	PollResults []*PollResults `json:"pollResults,omitempty"`
+	// Notifications indicates, for each chat with new messages, whether the
+	// user should be notified of them
+	Notifications map[string]bool `json:"notifications,omitempty"`
+	// TypingStatuses are delivered through a dedicated signal rather than
+	// along with messages
+	TypingStatuses []*TypingStatus `json:"-"`
+	// RemovedMessages are the ids of the messages that have been deleted
+	// as they expired
+	RemovedMessages []string `json:"removedMessages,omitempty"`
+	// FailedScheduledMessages are the scheduled messages that can't be
+	// sent, they are no longer retried until they are edited
+	FailedScheduledMessages []*ScheduledMessage `json:"failedScheduledMessages,omitempty"`
 }
 
 func (m *MessengerResponse) IsEmpty() bool {
-	return len(m.Chats) == 0 && len(m.Messages) == 0 && len(m.Contacts) == 0 && len(m.Installations) == 0
+	return len(m.Chats) == 0 && len(m.Messages) == 0 && len(m.Contacts) == 0 && len(m.Installations) == 0 && len(m.EmojiReactions) == 0 && len(m.PinMessages) == 0 && len(m.GroupChatJoinRequests) == 0 && len(m.Communities) == 0 && len(m.CommunityRequestsToJoin) == 0 && len(m.PollResults) == 0 && len(m.RemovedMessages) == 0 && len(m.FailedScheduledMessages) == 0
 }
 
 type featureFlags struct {
@@ -119,6 +181,22 @@ type config struct {
 
 	verifyTransactionClient EthClient
 
+	// Messages with a payload bigger than maxMessageChunkSize are split
+	// in chunks, so that they fit in an envelope
+	maxMessageChunkSize int
+
+	// linkPreviewAllowedDomains returns the domains for which links are
+	// unfurled when sending messages, link previews are disabled if nil
+	linkPreviewAllowedDomains func() ([]string, error)
+
+	// readReceiptsSettings stores whether read receipts are sent, they are
+	// never sent if nil
+	readReceiptsSettings ReadReceiptsSettings
+
+	// contactRequestsSettings stores whether one-to-one messages from
+	// non-contacts are hidden, they are never hidden if nil
+	contactRequestsSettings ContactRequestsSettings
+
 	logger *zap.Logger
 }
 
@@ -182,6 +260,51 @@ func WithDatasync() func(c *config) error {
 	}
 }
 
+func WithMaxMessageChunkSize(size int) Option {
+	return func(c *config) error {
+		c.maxMessageChunkSize = size
+		return nil
+	}
+}
+
+// MaxMessageChunkSize returns the size of the chunks big messages are split
+// in so that they fit in envelopes of maxMessageSize bytes
+func MaxMessageChunkSize(maxMessageSize uint32) int {
+	size := (int(maxMessageSize) - messageChunkOverhead) / messageChunkCopies
+	if size <= 0 {
+		return defaultMaxMessageChunkSize
+	}
+	return size
+}
+
+// WithLinkPreviews enables the unfurling of links to the allowed domains
+// when sending text messages
+func WithLinkPreviews(allowedDomains func() ([]string, error)) Option {
+	return func(c *config) error {
+		c.linkPreviewAllowedDomains = allowedDomains
+		return nil
+	}
+}
+
+// WithReadReceiptsSettings enables read receipts in one-to-one chats, when
+// turned on in the settings
+func WithReadReceiptsSettings(settings ReadReceiptsSettings) Option {
+	return func(c *config) error {
+		c.readReceiptsSettings = settings
+		return nil
+	}
+}
+
+// WithContactRequestsSettings enables hiding one-to-one messages from
+// non-contacts until their contact request is accepted, when turned on in the
+// settings
+func WithContactRequestsSettings(settings ContactRequestsSettings) Option {
+	return func(c *config) error {
+		c.contactRequestsSettings = settings
+		return nil
+	}
+}
+
 func WithEnvelopesMonitorConfig(emc *transport.EnvelopesMonitorConfig) Option {
 	return func(c *config) error {
 		c.envelopesMonitorConfig = emc
@@ -205,6 +328,10 @@ func NewMessenger(
 		}
 	}
 
+	if c.maxMessageChunkSize == 0 {
+		c.maxMessageChunkSize = defaultMaxMessageChunkSize
+	}
+
 	logger := c.logger
 	if c.logger == nil {
 		var err error
@@ -339,23 +466,37 @@ func NewMessenger(
 	handler := newMessageHandler(identity, logger, &sqlitePersistence{db: database})
 
 	messenger = &Messenger{
-		node:                       node,
-		identity:                   identity,
-		persistence:                &sqlitePersistence{db: database},
-		transport:                  transp,
-		encryptor:                  encryptionProtocol,
-		processor:                  processor,
-		handler:                    handler,
-		featureFlags:               c.featureFlags,
-		systemMessagesTranslations: c.systemMessagesTranslations,
-		allChats:                   make(map[string]*Chat),
-		allContacts:                make(map[string]*Contact),
-		allInstallations:           make(map[string]*multidevice.Installation),
-		installationID:             installationID,
-		modifiedInstallations:      make(map[string]bool),
-		messagesPersistenceEnabled: c.messagesPersistenceEnabled,
-		verifyTransactionClient:    c.verifyTransactionClient,
+		node:                            node,
+		identity:                        identity,
+		persistence:                     &sqlitePersistence{db: database},
+		transport:                       transp,
+		encryptor:                       encryptionProtocol,
+		processor:                       processor,
+		handler:                         handler,
+		featureFlags:                    c.featureFlags,
+		systemMessagesTranslations:      c.systemMessagesTranslations,
+		allChats:                        make(map[string]*Chat),
+		allContacts:                     make(map[string]*Contact),
+		allInstallations:                make(map[string]*multidevice.Installation),
+		installationID:                  installationID,
+		modifiedInstallations:           make(map[string]bool),
+		messagesPersistenceEnabled:      c.messagesPersistenceEnabled,
+		verifyTransactionClient:         c.verifyTransactionClient,
+		maxMessageChunkSize:             c.maxMessageChunkSize,
+		linkPreviewAllowedDomains:       c.linkPreviewAllowedDomains,
+		httpClient:                      &http.Client{Timeout: linkPreviewTimeout},
+		readReceiptsSettings:            c.readReceiptsSettings,
+		contactRequestsSettings:         c.contactRequestsSettings,
+		expiredMessagesChats:            make(map[string]bool),
+		pendingChatNotificationSettings: make(map[string]*protobuf.SyncChatNotificationSettings),
+		quit:                            make(chan struct{}),
 		shutdownTasks: []func() error{
+			// Stop the background loops before closing the database
+			func() error {
+				close(messenger.quit)
+				messenger.loops.Wait()
+				return nil
+			},
 			database.Close,
 			transp.ResetFilters,
 			transp.Stop,
@@ -373,7 +514,13 @@ func NewMessenger(
 }
 
 func (m *Messenger) Start() error {
-	return m.encryptor.Start(m.identity)
+	if err := m.encryptor.Start(m.identity); err != nil {
+		return err
+	}
+	m.loops.Add(2)
+	go m.scheduledMessagesLoop()
+	go m.expiredMessagesLoop()
+	return nil
 }
 
 // Init analyzes chats and contacts in order to setup filters
@@ -390,8 +537,14 @@ func (m *Messenger) Init() error {
 	var (
 		publicChatIDs []string
 		publicKeys    []*ecdsa.PublicKey
+		err           error
 	)
 
+	m.pendingChatNotificationSettings, err = m.persistence.PendingChatNotificationSettings()
+	if err != nil {
+		return err
+	}
+
 	// Get chat IDs and public keys from the existing chats.
 	// TODO: Get only active chats by the query.
 	chats, err := m.persistence.Chats()
@@ -409,7 +562,7 @@ func (m *Messenger) Init() error {
 			continue
 		}
 		switch chat.ChatType {
-		case ChatTypePublic:
+		case ChatTypePublic, ChatTypeCommunityChat, ChatTypeTimeline:
 			publicChatIDs = append(publicChatIDs, chat.ID)
 		case ChatTypeOneToOne:
 			pk, err := chat.PublicKey()
@@ -430,6 +583,30 @@ func (m *Messenger) Init() error {
 		}
 	}
 
+	// Get the topics of the communities we know of, the descriptions of
+	// the communities are published there
+	communities, err := m.persistence.Communities()
+	if err != nil {
+		return err
+	}
+	for _, community := range communities {
+		publicChatIDs = append(publicChatIDs, community.Id)
+	}
+
+	// We follow the timelines of the contacts added by us, timelines not
+	// followed yet are activated here. Ours is created along with our first
+	// status update
+	followTimeline := func(publicKey *ecdsa.PublicKey) error {
+		chat := m.timelineChat(publicKey)
+		if _, ok := m.allChats[chat.ID]; ok && chat.Active {
+			// Its filter is loaded along with the other chats
+			return nil
+		}
+		chat.Active = true
+		publicChatIDs = append(publicChatIDs, chat.ID)
+		return m.saveChat(chat)
+	}
+
 	// Get chat IDs and public keys from the contacts.
 	contacts, err := m.persistence.Contacts()
 	if err != nil {
@@ -447,6 +624,9 @@ func (m *Messenger) Init() error {
 			continue
 		}
 		publicKeys = append(publicKeys, publicKey)
+		if err := followTimeline(publicKey); err != nil {
+			return err
+		}
 	}
 
 	installations, err := m.encryptor.GetOurInstallations(&m.identity.PublicKey)
@@ -597,7 +777,7 @@ func (m *Messenger) Join(chat Chat) error {
 			return err
 		}
 		return m.transport.JoinGroup(members)
-	case ChatTypePublic:
+	case ChatTypePublic, ChatTypeCommunityChat, ChatTypeTimeline:
 		return m.transport.JoinPublic(chat.ID)
 	default:
 		return errors.New("chat is neither public nor private")
@@ -622,6 +802,8 @@ func (m *Messenger) Leave(chat Chat) error {
 		return m.transport.LeaveGroup(members)
 	case ChatTypePublic:
 		return m.transport.LeavePublic(chat.Name)
+	case ChatTypeCommunityChat, ChatTypeTimeline:
+		return m.transport.LeavePublic(chat.ID)
 	default:
 		return errors.New("chat is neither public nor private")
 	}
@@ -761,6 +943,10 @@ func (m *Messenger) AddMembersToGroupChat(ctx context.Context, chatID string, me
 	m.mutex.Lock()
 	defer m.mutex.Unlock()
 
+	return m.addMembersToGroupChat(ctx, chatID, members)
+}
+
+func (m *Messenger) addMembersToGroupChat(ctx context.Context, chatID string, members []string) (*MessengerResponse, error) {
 	var response MessengerResponse
 	logger := m.logger.With(zap.String("site", "AddMembersFromGroupChat"))
 	logger.Info("Adding members form group chat", zap.String("chatID", chatID), zap.Any("members", members))
@@ -810,6 +996,9 @@ func (m *Messenger) AddMembersToGroupChat(ctx context.Context, chatID string, me
 	}
 
 	chat.updateChatFromProtocolGroup(group)
+	if _, err := approveGroupChatJoinRequests(m.persistence, chat); err != nil {
+		m.logger.Warn("failed to approve join requests", zap.Error(err))
+	}
 
 	response.Chats = []*Chat{chat}
 	response.Messages = buildSystemMessages([]v1protocol.MembershipUpdateEvent{event}, m.systemMessagesTranslations)
@@ -886,6 +1075,151 @@ func (m *Messenger) AddAdminsToGroupChat(ctx context.Context, chatID string, mem
 	return &response, m.saveChat(chat)
 }
 
+// RemoveAdminFromGroupChat removes an admin of a group chat, who stays a
+// member. Admins can remove themselves, only the owner can remove other admins
+func (m *Messenger) RemoveAdminFromGroupChat(ctx context.Context, chatID string, admin string) (*MessengerResponse, error) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	logger := m.logger.With(zap.String("site", "RemoveAdminFromGroupChat"))
+	logger.Info("Remove admin from group chat", zap.String("chatID", chatID), zap.String("admin", admin))
+
+	chat, ok := m.allChats[chatID]
+	if !ok {
+		return nil, errors.New("can't find chat")
+	}
+
+	clock, _ := chat.NextClockAndTimestamp(m.getTimesource())
+	event := v1protocol.NewAdminRemovedEvent(admin, clock)
+	return m.sendGroupChatEvent(ctx, chat, event)
+}
+
+// TransferGroupChatOwnership makes a member of a group chat its owner, and an
+// admin if they are not one already. Only the owner can transfer the ownership
+func (m *Messenger) TransferGroupChatOwnership(ctx context.Context, chatID string, owner string) (*MessengerResponse, error) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	logger := m.logger.With(zap.String("site", "TransferGroupChatOwnership"))
+	logger.Info("Transfer group chat ownership", zap.String("chatID", chatID), zap.String("owner", owner))
+
+	chat, ok := m.allChats[chatID]
+	if !ok {
+		return nil, errors.New("can't find chat")
+	}
+
+	clock, _ := chat.NextClockAndTimestamp(m.getTimesource())
+	event := v1protocol.NewOwnershipTransferredEvent(owner, clock)
+	return m.sendGroupChatEvent(ctx, chat, event)
+}
+
+// ChangeGroupChatDescription changes the description of a group chat, only
+// admins can change it
+func (m *Messenger) ChangeGroupChatDescription(ctx context.Context, chatID string, description string) (*MessengerResponse, error) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	logger := m.logger.With(zap.String("site", "ChangeGroupChatDescription"))
+	logger.Info("Change group chat description", zap.String("chatID", chatID))
+
+	if utf8.RuneCountInString(description) > maxGroupChatDescriptionLength {
+		return nil, errors.New("group chat description too long")
+	}
+
+	chat, ok := m.allChats[chatID]
+	if !ok {
+		return nil, errors.New("can't find chat")
+	}
+
+	clock, _ := chat.NextClockAndTimestamp(m.getTimesource())
+	event := v1protocol.NewDescriptionChangedEvent(description, clock)
+	return m.sendGroupChatEvent(ctx, chat, event)
+}
+
+// ChangeGroupChatImage changes the image of a group chat to the one at
+// imagePath, an empty path removing it. Only admins can change it
+func (m *Messenger) ChangeGroupChatImage(ctx context.Context, chatID string, imagePath string) (*MessengerResponse, error) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	logger := m.logger.With(zap.String("site", "ChangeGroupChatImage"))
+	logger.Info("Change group chat image", zap.String("chatID", chatID))
+
+	chat, ok := m.allChats[chatID]
+	if !ok {
+		return nil, errors.New("can't find chat")
+	}
+
+	var payload []byte
+	if len(imagePath) != 0 {
+		image, err := loadImage(imagePath)
+		if err != nil {
+			return nil, err
+		}
+		payload = image.Payload
+	}
+	if err := validateGroupChatImage(payload); err != nil {
+		return nil, err
+	}
+
+	clock, _ := chat.NextClockAndTimestamp(m.getTimesource())
+	event := v1protocol.NewImageChangedEvent(payload, clock)
+	return m.sendGroupChatEvent(ctx, chat, event)
+}
+
+// sendGroupChatEvent signs the event and sends it to the members of the group
+// chat, along with the previous events
+func (m *Messenger) sendGroupChatEvent(ctx context.Context, chat *Chat, event v1protocol.MembershipUpdateEvent) (*MessengerResponse, error) {
+	var response MessengerResponse
+
+	group, err := newProtocolGroupFromChat(chat)
+	if err != nil {
+		return nil, err
+	}
+
+	event.ChatID = chat.ID
+	err = event.Sign(m.identity)
+	if err != nil {
+		return nil, err
+	}
+
+	err = group.ProcessEvent(event)
+	if err != nil {
+		return nil, err
+	}
+
+	recipients, err := stringSliceToPublicKeys(group.Members(), true)
+	if err != nil {
+		return nil, err
+	}
+
+	encodedMessage, err := m.processor.EncodeMembershipUpdate(group, nil)
+	if err != nil {
+		return nil, err
+	}
+	_, err = m.dispatchMessage(ctx, &RawMessage{
+		LocalChatID:         chat.ID,
+		Payload:             encodedMessage,
+		MessageType:         protobuf.ApplicationMetadataMessage_MEMBERSHIP_UPDATE_MESSAGE,
+		Recipients:          recipients,
+		ResendAutomatically: true,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	chat.updateChatFromProtocolGroup(group)
+
+	response.Chats = []*Chat{chat}
+	response.Messages = buildSystemMessages([]v1protocol.MembershipUpdateEvent{event}, m.systemMessagesTranslations)
+	err = m.persistence.SaveMessagesLegacy(response.Messages)
+	if err != nil {
+		return nil, err
+	}
+
+	return &response, m.saveChat(chat)
+}
+
 func (m *Messenger) ConfirmJoiningGroup(ctx context.Context, chatID string) (*MessengerResponse, error) {
 	m.mutex.Lock()
 	defer m.mutex.Unlock()
@@ -1031,6 +1365,10 @@ func (m *Messenger) saveChat(chat *Chat) error {
 		}
 	}
 
+	if err := m.applyPendingChatNotificationSettings(chat); err != nil {
+		return err
+	}
+
 	err := m.persistence.SaveChat(*chat)
 	if err != nil {
 		return err
@@ -1041,6 +1379,12 @@ func (m *Messenger) saveChat(chat *Chat) error {
 }
 
 func (m *Messenger) saveChats(chats []*Chat) error {
+	for _, chat := range chats {
+		if err := m.applyPendingChatNotificationSettings(chat); err != nil {
+			return err
+		}
+	}
+
 	err := m.persistence.SaveChats(chats)
 	if err != nil {
 		return err
@@ -1053,6 +1397,19 @@ func (m *Messenger) saveChats(chats []*Chat) error {
 
 }
 
+// applyPendingChatNotificationSettings applies the notification settings
+// synced by a paired device before the chat was created
+func (m *Messenger) applyPendingChatNotificationSettings(chat *Chat) error {
+	settings, ok := m.pendingChatNotificationSettings[chat.ID]
+	if !ok {
+		return nil
+	}
+
+	chat.applyNotificationSettings(settings)
+	delete(m.pendingChatNotificationSettings, chat.ID)
+	return m.persistence.DeletePendingChatNotificationSettings(chat.ID)
+}
+
 func (m *Messenger) SaveChat(chat *Chat) error {
 	m.mutex.Lock()
 	defer m.mutex.Unlock()
@@ -1085,6 +1442,82 @@ func (m *Messenger) DeleteChat(chatID string) error {
 	return nil
 }
 
+// MuteChat silences the notifications of the chat until mutedUntil, a
+// timestamp in ms, or until it's unmuted if mutedUntil is 0
+func (m *Messenger) MuteChat(ctx context.Context, chatID string, mutedUntil uint64) (*MessengerResponse, error) {
+	return m.updateChatNotificationSettings(ctx, chatID, func(chat *Chat) {
+		chat.Muted = true
+		chat.MutedUntil = mutedUntil
+	})
+}
+
+// UnmuteChat restores the notifications of the chat
+func (m *Messenger) UnmuteChat(ctx context.Context, chatID string) (*MessengerResponse, error) {
+	return m.updateChatNotificationSettings(ctx, chatID, func(chat *Chat) {
+		chat.Muted = false
+		chat.MutedUntil = 0
+	})
+}
+
+// SetChatNotificationLevel sets which messages of the chat notify the user
+func (m *Messenger) SetChatNotificationLevel(ctx context.Context, chatID string, level ChatNotificationLevel) (*MessengerResponse, error) {
+	if level < ChatNotificationLevelAll || level > ChatNotificationLevelNone {
+		return nil, errors.New("invalid notification level")
+	}
+	return m.updateChatNotificationSettings(ctx, chatID, func(chat *Chat) {
+		chat.NotificationLevel = level
+	})
+}
+
+func (m *Messenger) updateChatNotificationSettings(ctx context.Context, chatID string, update func(*Chat)) (*MessengerResponse, error) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	chat, ok := m.allChats[chatID]
+	if !ok {
+		return nil, errors.New("Chat not found")
+	}
+
+	update(chat)
+
+	clock := m.getTimesource().GetCurrentTime()
+	if clock <= chat.NotificationSettingsClock {
+		clock = chat.NotificationSettingsClock + 1
+	}
+	chat.NotificationSettingsClock = clock
+
+	err := m.saveChat(chat)
+	if err != nil {
+		return nil, err
+	}
+
+	err = m.syncChatNotificationSettings(ctx, chat)
+	if err != nil {
+		return nil, err
+	}
+
+	return &MessengerResponse{Chats: []*Chat{chat}}, nil
+}
+
+// setNotifications reports, for each chat with new messages in the response,
+// whether the user should be notified of them
+func (m *Messenger) setNotifications(response *MessengerResponse, chats map[string]*Chat) {
+	now := m.getTimesource().GetCurrentTime()
+	myID := contactIDFromPublicKey(&m.identity.PublicKey)
+
+	for _, message := range response.Messages {
+		chat, ok := chats[message.LocalChatID]
+		if !ok {
+			continue
+		}
+		if response.Notifications == nil {
+			response.Notifications = make(map[string]bool)
+		}
+		notify := message.From != myID && !message.Seen && chat.ShouldNotify(message, now)
+		response.Notifications[chat.ID] = response.Notifications[chat.ID] || notify
+	}
+}
+
 func (m *Messenger) isNewContact(contact *Contact) bool {
 	previousContact, ok := m.allContacts[contact.ID]
 	return contact.IsAdded() && (!ok || !previousContact.IsAdded())
@@ -1105,7 +1538,9 @@ func (m *Messenger) saveContact(contact *Contact) error {
 
 	contact.Alias = name
 
-	if m.isNewContact(contact) {
+	localNicknameChanged := m.updateLocalNicknameClock(contact)
+
+	if m.isNewContact(contact) || localNicknameChanged {
 		err := m.syncContact(context.Background(), contact)
 		if err != nil {
 			return err
@@ -1118,7 +1553,13 @@ func (m *Messenger) saveContact(contact *Contact) error {
 	}
 
 	m.allContacts[contact.ID] = contact
-	return nil
+
+	err = m.showMessagesFromAddedContact(contact)
+	if err != nil {
+		return err
+	}
+
+	return m.updateTimelineSubscription(contact)
 
 }
 func (m *Messenger) SaveContact(contact *Contact) error {
@@ -1139,7 +1580,7 @@ func (m *Messenger) BlockContact(contact *Contact) ([]*Chat, error) {
 		m.allChats[chat.ID] = chat
 	}
 	delete(m.allChats, contact.ID)
-	return chats, nil
+	return chats, m.updateTimelineSubscription(contact)
 }
 
 func (m *Messenger) Contacts() []*Contact {
@@ -1238,6 +1679,10 @@ func (m *Messenger) dispatchMessage(ctx context.Context, spec *RawMessage) ([]by
 		return nil, errors.New("no chat found")
 	}
 
+	if spec.MessageType != protobuf.ApplicationMetadataMessage_MESSAGE_CHUNK && len(spec.Payload) > m.maxMessageChunkSize {
+		return m.dispatchChunkedMessage(ctx, chat, spec)
+	}
+
 	switch chat.ChatType {
 	case ChatTypeOneToOne:
 		publicKey, err := chat.PublicKey()
@@ -1258,7 +1703,7 @@ func (m *Messenger) dispatchMessage(ctx context.Context, spec *RawMessage) ([]by
 			return nil, err
 		}
 
-	case ChatTypePublic:
+	case ChatTypePublic, ChatTypeTimeline:
 		logger.Debug("sending public message", zap.String("chatName", chat.Name))
 		id, err = m.processor.SendPublicRaw(ctx, chat.ID, spec.Payload, spec.MessageType)
 		if err != nil {
@@ -1287,8 +1732,27 @@ func (m *Messenger) dispatchMessage(ctx context.Context, spec *RawMessage) ([]by
 			spec.Recipients = spec.Recipients[:n]
 		}
 
-		// We always wrap in group information
-		id, err = m.processor.SendGroupRaw(ctx, spec.Recipients, spec.Payload, protobuf.ApplicationMetadataMessage_MEMBERSHIP_UPDATE_MESSAGE)
+		id, err = m.processor.SendGroupRaw(ctx, spec.Recipients, spec.Payload, groupMessageType(spec.MessageType))
+		if err != nil {
+			return nil, err
+		}
+
+	case ChatTypeCommunityChat:
+		logger.Debug("sending community message", zap.String("chatName", chat.Name))
+		community, err := m.persistence.Community(chat.CommunityID)
+		if err != nil {
+			return nil, err
+		}
+		if !community.Joined {
+			return nil, errors.New("not a member of the community")
+		}
+
+		key, err := m.persistence.CommunityKey(community.Id, community.KeyId)
+		if err != nil {
+			return nil, err
+		}
+
+		id, err = m.processor.SendCommunityRaw(ctx, chat.ID, community.Id, community.KeyId, key, spec.Payload, spec.MessageType)
 		if err != nil {
 			return nil, err
 		}
@@ -1306,27 +1770,152 @@ func (m *Messenger) dispatchMessage(ctx context.Context, spec *RawMessage) ([]by
 	return id, nil
 }
 
-// SendChatMessage takes a minimal message and sends it based on the corresponding chat
-func (m *Messenger) SendChatMessage(ctx context.Context, message *Message) (*MessengerResponse, error) {
-	m.mutex.Lock()
-	defer m.mutex.Unlock()
-
-	logger := m.logger.With(zap.String("site", "Send"), zap.String("chatID", message.ChatId))
-	var response MessengerResponse
-
-	// A valid added chat is required.
-	chat, ok := m.allChats[message.ChatId]
-	if !ok {
-		return nil, errors.New("Chat not found")
+// dispatchChunkedMessage splits a message too big to fit in an envelope in
+// chunks, each of them sent as a separate message. The returned id is the
+// one of the whole message, once reassembled by the recipients
+func (m *Messenger) dispatchChunkedMessage(ctx context.Context, chat *Chat, spec *RawMessage) ([]byte, error) {
+	messageType := spec.MessageType
+	if chat.PrivateGroupChat() {
+		messageType = groupMessageType(messageType)
 	}
 
-	err := extendMessageFromChat(message, chat, &m.identity.PublicKey, m.getTimesource())
-	if err != nil {
-		return nil, err
+	id := v1protocol.MessageID(&m.identity.PublicKey, spec.Payload)
+	chunkSize := m.maxMessageChunkSize
+	total := (len(spec.Payload) + chunkSize - 1) / chunkSize
+	if total > maxMessageChunks {
+		return nil, errors.New("message too big")
 	}
 
-	var encodedMessage []byte
-	switch chat.ChatType {
+	for i := 0; i < total; i++ {
+		end := (i + 1) * chunkSize
+		if end > len(spec.Payload) {
+			end = len(spec.Payload)
+		}
+
+		chunk := &protobuf.MessageChunk{
+			Id:      id.String(),
+			Index:   uint32(i),
+			Total:   uint32(total),
+			Payload: spec.Payload[i*chunkSize : end],
+			Type:    messageType,
+		}
+		encodedChunk, err := proto.Marshal(chunk)
+		if err != nil {
+			return nil, err
+		}
+
+		_, err = m.dispatchMessage(ctx, &RawMessage{
+			LocalChatID:         spec.LocalChatID,
+			Payload:             encodedChunk,
+			MessageType:         protobuf.ApplicationMetadataMessage_MESSAGE_CHUNK,
+			ResendAutomatically: spec.ResendAutomatically,
+			Recipients:          spec.Recipients,
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return id, nil
+}
+
+// deleteStaleMessageChunks deletes the chunks of the messages that have not
+// been fully received in time
+func (m *Messenger) deleteStaleMessageChunks() error {
+	now := m.getTimesource().GetCurrentTime()
+	age := uint64(staleMessageChunksAge / time.Millisecond)
+	if now <= age {
+		return nil
+	}
+	return m.persistence.DeleteMessageChunksReceivedBefore(now - age)
+}
+
+// groupMessageType returns the type a message is sent with in a private
+// group chat, where chat messages are always wrapped in group information
+func groupMessageType(messageType protobuf.ApplicationMetadataMessage_Type) protobuf.ApplicationMetadataMessage_Type {
+	if messageType == protobuf.ApplicationMetadataMessage_CHAT_MESSAGE {
+		return protobuf.ApplicationMetadataMessage_MEMBERSHIP_UPDATE_MESSAGE
+	}
+	return messageType
+}
+
+// SendChatMessage takes a minimal message and sends it based on the corresponding chat
+func (m *Messenger) SendChatMessage(ctx context.Context, message *Message) (*MessengerResponse, error) {
+	// Links are unfurled before acquiring the lock, as fetching the
+	// previews might take a while
+	if message.ContentType == protobuf.ChatMessage_TEXT_PLAIN && len(message.Links) == 0 {
+		message.Links = m.unfurlLinks(ctx, message.Text)
+	}
+
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	return m.sendChatMessage(ctx, message)
+}
+
+// SendAudioMessage sends a voice message of durationMs milliseconds, encoded
+// with the given codec, to the chat. Payloads larger than a single message
+// are split in chunks and reassembled on the receiving side
+func (m *Messenger) SendAudioMessage(ctx context.Context, chatID string, payload []byte, audioType protobuf.AudioMessage_AudioType, durationMs uint64) (*MessengerResponse, error) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	if len(payload) > maxAudioPayloadSize {
+		return nil, errors.New("audio payload too large")
+	}
+
+	message := &Message{}
+	message.ChatId = chatID
+	message.ContentType = protobuf.ChatMessage_AUDIO
+	message.Payload = &protobuf.ChatMessage_Audio{
+		Audio: &protobuf.AudioMessage{
+			Payload:    payload,
+			Type:       audioType,
+			DurationMs: durationMs,
+		},
+	}
+
+	return m.sendChatMessage(ctx, message)
+}
+
+func (m *Messenger) sendChatMessage(ctx context.Context, message *Message) (*MessengerResponse, error) {
+	// A valid added chat is required.
+	chat, ok := m.allChats[message.ChatId]
+	if !ok {
+		return nil, errors.New("Chat not found")
+	}
+
+	if message.ContentType == protobuf.ChatMessage_IMAGE && len(message.ImagePath) != 0 {
+		image, err := loadImage(message.ImagePath)
+		if err != nil {
+			return nil, err
+		}
+		message.Payload = &protobuf.ChatMessage_Image{Image: image}
+	}
+
+	if chat.Timeline() {
+		if err := m.checkTimelineMessage(chat, message); err != nil {
+			return nil, err
+		}
+	}
+
+	err := extendMessageFromChat(message, chat, &m.identity.PublicKey, m.getTimesource())
+	if err != nil {
+		return nil, err
+	}
+
+	return m.dispatchChatMessage(ctx, chat, message)
+}
+
+// dispatchChatMessage encodes a message extended from chat for the type of
+// the chat, sends it and saves it
+func (m *Messenger) dispatchChatMessage(ctx context.Context, chat *Chat, message *Message) (*MessengerResponse, error) {
+	logger := m.logger.With(zap.String("site", "Send"), zap.String("chatID", message.ChatId))
+	var response MessengerResponse
+
+	var err error
+	var encodedMessage []byte
+	switch chat.ChatType {
 	case ChatTypeOneToOne:
 		logger.Debug("sending private message")
 		message.MessageType = protobuf.ChatMessage_ONE_TO_ONE
@@ -1341,59 +1930,492 @@ func (m *Messenger) SendChatMessage(ctx context.Context, message *Message) (*Mes
 		if err != nil {
 			return nil, err
 		}
+	case ChatTypeCommunityChat:
+		logger.Debug("sending community message", zap.String("chatName", chat.Name))
+		message.MessageType = protobuf.ChatMessage_COMMUNITY_CHAT
+		encodedMessage, err = proto.Marshal(message)
+		if err != nil {
+			return nil, err
+		}
+	case ChatTypeTimeline:
+		logger.Debug("sending timeline message", zap.String("chatName", chat.Name))
+		message.MessageType = protobuf.ChatMessage_TIMELINE
+		encodedMessage, err = proto.Marshal(message)
+		if err != nil {
+			return nil, err
+		}
 	case ChatTypePrivateGroupChat:
 		message.MessageType = protobuf.ChatMessage_PRIVATE_GROUP
 		logger.Debug("sending group message", zap.String("chatName", chat.Name))
 
-		group, err := newProtocolGroupFromChat(chat)
-		if err != nil {
-			return nil, err
-		}
+		group, err := newProtocolGroupFromChat(chat)
+		if err != nil {
+			return nil, err
+		}
+
+		encodedMessage, err = m.processor.EncodeMembershipUpdate(group, &message.ChatMessage)
+		if err != nil {
+			return nil, err
+		}
+
+	default:
+		return nil, errors.New("chat type not supported")
+	}
+
+	id, err := m.dispatchMessage(ctx, &RawMessage{
+		LocalChatID: chat.ID,
+		Payload:     encodedMessage,
+		MessageType: protobuf.ApplicationMetadataMessage_CHAT_MESSAGE,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	message.ID = types.EncodeHex(id)
+	err = message.prepareContent(m.allContacts)
+	if err != nil {
+		return nil, err
+	}
+
+	err = chat.UpdateFromMessage(message, m.getTimesource())
+	if err != nil {
+		return nil, err
+	}
+
+	err = m.persistence.SaveMessagesLegacy([]*Message{message})
+	if err != nil {
+		return nil, err
+	}
+
+	response.Chats = []*Chat{chat}
+	response.Messages = []*Message{message}
+	return &response, m.saveChat(chat)
+}
+
+// EditMessage replaces the text of a message sent by us. The edit is sent to
+// the chat, so that all the participants and our own devices update it
+func (m *Messenger) EditMessage(ctx context.Context, messageID, newText string) (*MessengerResponse, error) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	var response MessengerResponse
+
+	if len(strings.TrimSpace(newText)) == 0 {
+		return nil, errors.New("text can't be empty")
+	}
+
+	message, err := m.persistence.MessageByID(messageID)
+	if err != nil {
+		return nil, err
+	}
+
+	myID := contactIDFromPublicKey(&m.identity.PublicKey)
+	if message.From != myID {
+		return nil, errors.New("can't edit someone else's message")
+	}
+
+	if message.ContentType != protobuf.ChatMessage_TEXT_PLAIN {
+		return nil, errors.New("only text messages can be edited")
+	}
+
+	if message.Deleted {
+		return nil, errors.New("can't edit a deleted message")
+	}
+
+	chat, ok := m.allChats[message.LocalChatID]
+	if !ok {
+		return nil, errors.New("Chat not found")
+	}
+
+	clock, _ := chat.NextClockAndTimestamp(m.getTimesource())
+	if clock <= message.Clock {
+		clock = message.Clock + 1
+	}
+	if clock <= message.EditedAt {
+		clock = message.EditedAt + 1
+	}
+
+	edit := &EditMessage{
+		EditMessage: protobuf.EditMessage{
+			Clock:       clock,
+			Text:        newText,
+			ChatId:      message.ChatId,
+			MessageId:   messageID,
+			MessageType: chat.MessageType(),
+		},
+		From:        myID,
+		LocalChatID: chat.ID,
+		SigPubKey:   &m.identity.PublicKey,
+	}
+
+	encodedMessage, err := proto.Marshal(&edit.EditMessage)
+	if err != nil {
+		return nil, err
+	}
+
+	id, err := m.dispatchMessage(ctx, &RawMessage{
+		LocalChatID: chat.ID,
+		Payload:     encodedMessage,
+		MessageType: protobuf.ApplicationMetadataMessage_EDIT_MESSAGE,
+	})
+	if err != nil {
+		return nil, err
+	}
+	edit.ID = types.EncodeHex(id)
+
+	_, err = m.handler.applyEdit(message, edit)
+	if err != nil {
+		return nil, err
+	}
+
+	response.Messages = []*Message{message}
+
+	// Update the last message of the chat if it's the one edited
+	if chat.LastClockValue <= message.Clock {
+		err = chat.UpdateFromMessage(message, m.getTimesource())
+		if err != nil {
+			return nil, err
+		}
+		response.Chats = []*Chat{chat}
+		return &response, m.saveChat(chat)
+	}
+
+	return &response, nil
+}
+
+// DeleteMessageForEveryone deletes a message sent by us on all the devices
+// of the participants of the chat, including our own. The message is replaced
+// by a tombstone
+func (m *Messenger) DeleteMessageForEveryone(ctx context.Context, messageID string) (*MessengerResponse, error) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	var response MessengerResponse
+
+	message, err := m.persistence.MessageByID(messageID)
+	if err != nil {
+		return nil, err
+	}
+
+	myID := contactIDFromPublicKey(&m.identity.PublicKey)
+	if message.From != myID {
+		return nil, errors.New("can't delete someone else's message")
+	}
+
+	if !isDeletable(message) {
+		return nil, errors.New("message can't be deleted")
+	}
+
+	if message.Deleted {
+		return nil, errors.New("message already deleted")
+	}
+
+	chat, ok := m.allChats[message.LocalChatID]
+	if !ok {
+		return nil, errors.New("Chat not found")
+	}
+
+	clock, _ := chat.NextClockAndTimestamp(m.getTimesource())
+	if clock <= message.Clock {
+		clock = message.Clock + 1
+	}
+
+	deletion := &protobuf.DeleteMessage{
+		Clock:       clock,
+		ChatId:      message.ChatId,
+		MessageId:   messageID,
+		MessageType: chat.MessageType(),
+	}
+
+	encodedMessage, err := proto.Marshal(deletion)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = m.dispatchMessage(ctx, &RawMessage{
+		LocalChatID: chat.ID,
+		Payload:     encodedMessage,
+		MessageType: protobuf.ApplicationMetadataMessage_DELETE_MESSAGE,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	err = m.handler.applyDelete(message)
+	if err != nil {
+		return nil, err
+	}
+
+	// Messages quoting the deleted one need to be refreshed
+	quotingMessages, err := m.persistence.MessagesByResponseTo(messageID)
+	if err != nil {
+		return nil, err
+	}
+
+	response.Messages = append([]*Message{message}, quotingMessages...)
+
+	// Update the last message of the chat if it's the one deleted
+	if chat.LastClockValue <= message.Clock {
+		err = chat.UpdateFromMessage(message, m.getTimesource())
+		if err != nil {
+			return nil, err
+		}
+		response.Chats = []*Chat{chat}
+		return &response, m.saveChat(chat)
+	}
+
+	return &response, nil
+}
+
+// MessageEditHistory returns all the versions of a message, starting from
+// the original one
+func (m *Messenger) MessageEditHistory(messageID string) ([]*EditMessage, error) {
+	return m.persistence.MessageEditHistory(messageID)
+}
+
+// SendEmojiReaction sends a reaction with the given emoji to a message in a chat
+func (m *Messenger) SendEmojiReaction(ctx context.Context, chatID, messageID string, emojiID protobuf.EmojiReaction_Type) (*MessengerResponse, error) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	var response MessengerResponse
+
+	chat, ok := m.allChats[chatID]
+	if !ok {
+		return nil, errors.New("Chat not found")
+	}
+
+	// Reactions on a timeline are scoped to its status updates
+	if chat.Timeline() {
+		if _, err := m.timelinePost(chat, messageID); err != nil {
+			return nil, err
+		}
+	}
+
+	clock, _ := chat.NextClockAndTimestamp(m.getTimesource())
+	myID := contactIDFromPublicKey(&m.identity.PublicKey)
+
+	emojiR := &EmojiReaction{
+		EmojiReaction: protobuf.EmojiReaction{
+			Clock:       clock,
+			MessageId:   messageID,
+			ChatId:      chatID,
+			MessageType: chat.MessageType(),
+			Type:        emojiID,
+		},
+		From:        myID,
+		LocalChatID: chatID,
+		SigPubKey:   &m.identity.PublicKey,
+	}
+
+	// Sending a reaction that was previously retracted reuses its ID
+	existingEmoji, err := m.persistence.emojiReactionBy(messageID, myID, emojiID)
+	if err != nil && err != errRecordNotFound {
+		return nil, err
+	}
+	if existingEmoji != nil && existingEmoji.Clock >= emojiR.Clock {
+		emojiR.Clock = existingEmoji.Clock + 1
+	}
+
+	encodedMessage, err := proto.Marshal(&emojiR.EmojiReaction)
+	if err != nil {
+		return nil, err
+	}
+
+	id, err := m.dispatchMessage(ctx, &RawMessage{
+		LocalChatID: chatID,
+		Payload:     encodedMessage,
+		MessageType: protobuf.ApplicationMetadataMessage_EMOJI_REACTION,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if existingEmoji != nil {
+		emojiR.ID = existingEmoji.ID
+	} else {
+		emojiR.ID = types.EncodeHex(id)
+	}
+
+	err = m.persistence.SaveEmojiReaction(emojiR)
+	if err != nil {
+		return nil, err
+	}
+
+	response.EmojiReactions = []*EmojiReaction{emojiR}
+	return &response, nil
+}
+
+// SendEmojiReactionRetraction retracts a reaction previously sent by us
+func (m *Messenger) SendEmojiReactionRetraction(ctx context.Context, emojiReactionID string) (*MessengerResponse, error) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	var response MessengerResponse
+
+	emojiR, err := m.persistence.EmojiReactionByID(emojiReactionID)
+	if err != nil {
+		return nil, err
+	}
+
+	if emojiR.From != contactIDFromPublicKey(&m.identity.PublicKey) {
+		return nil, errors.New("can't retract someone else's emoji reaction")
+	}
+
+	if emojiR.Retracted {
+		return nil, errors.New("emoji reaction already retracted")
+	}
+
+	chat, ok := m.allChats[emojiR.LocalChatID]
+	if !ok {
+		return nil, errors.New("Chat not found")
+	}
+
+	clock, _ := chat.NextClockAndTimestamp(m.getTimesource())
+	if clock <= emojiR.Clock {
+		clock = emojiR.Clock + 1
+	}
+	emojiR.Clock = clock
+	emojiR.MessageType = chat.MessageType()
+	emojiR.Retracted = true
+	emojiR.SigPubKey = &m.identity.PublicKey
+
+	encodedMessage, err := proto.Marshal(&emojiR.EmojiReaction)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = m.dispatchMessage(ctx, &RawMessage{
+		LocalChatID: emojiR.LocalChatID,
+		Payload:     encodedMessage,
+		MessageType: protobuf.ApplicationMetadataMessage_EMOJI_REACTION,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	err = m.persistence.SaveEmojiReaction(emojiR)
+	if err != nil {
+		return nil, err
+	}
+
+	response.EmojiReactions = []*EmojiReaction{emojiR}
+	return &response, nil
+}
+
+// PinMessage pins a message in its chat for all the members of the chat
+func (m *Messenger) PinMessage(ctx context.Context, messageID string) (*MessengerResponse, error) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	return m.sendPinMessage(ctx, messageID, true)
+}
+
+// UnpinMessage unpins a message previously pinned in its chat
+func (m *Messenger) UnpinMessage(ctx context.Context, messageID string) (*MessengerResponse, error) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	return m.sendPinMessage(ctx, messageID, false)
+}
+
+func (m *Messenger) sendPinMessage(ctx context.Context, messageID string, pinned bool) (*MessengerResponse, error) {
+	var response MessengerResponse
+
+	message, err := m.persistence.MessageByID(messageID)
+	if err != nil {
+		return nil, err
+	}
+
+	if message.Deleted {
+		return nil, errors.New("can't pin a deleted message")
+	}
+
+	chat, ok := m.allChats[message.LocalChatID]
+	if !ok {
+		return nil, errors.New("Chat not found")
+	}
 
-		encodedMessage, err = m.processor.EncodeMembershipUpdate(group, &message.ChatMessage)
-		if err != nil {
-			return nil, err
-		}
+	myID := contactIDFromPublicKey(&m.identity.PublicKey)
+	if chat.PrivateGroupChat() && !chat.IsAdmin(myID) {
+		return nil, errors.New("only admins can pin messages")
+	}
 
-	default:
-		return nil, errors.New("chat type not supported")
+	clock, _ := chat.NextClockAndTimestamp(m.getTimesource())
+
+	existingPin, err := m.persistence.pinMessageBy(messageID, chat.ID)
+	if err != nil && err != errRecordNotFound {
+		return nil, err
+	}
+	if existingPin != nil && existingPin.Clock >= clock {
+		clock = existingPin.Clock + 1
 	}
 
-	id, err := m.dispatchMessage(ctx, &RawMessage{
+	pinMessage := &PinMessage{
+		PinMessage: protobuf.PinMessage{
+			Clock:       clock,
+			ChatId:      message.ChatId,
+			MessageId:   messageID,
+			Pinned:      pinned,
+			MessageType: chat.MessageType(),
+		},
+		From:        myID,
 		LocalChatID: chat.ID,
-		Payload:     encodedMessage,
-		MessageType: protobuf.ApplicationMetadataMessage_CHAT_MESSAGE,
-	})
-	if err != nil {
-		return nil, err
+		SigPubKey:   &m.identity.PublicKey,
 	}
 
-	message.ID = types.EncodeHex(id)
-	err = message.PrepareContent()
+	encodedMessage, err := proto.Marshal(&pinMessage.PinMessage)
 	if err != nil {
 		return nil, err
 	}
 
-	err = chat.UpdateFromMessage(message, m.getTimesource())
+	id, err := m.dispatchMessage(ctx, &RawMessage{
+		LocalChatID: chat.ID,
+		Payload:     encodedMessage,
+		MessageType: protobuf.ApplicationMetadataMessage_PIN_MESSAGE,
+	})
 	if err != nil {
 		return nil, err
 	}
 
-	err = m.persistence.SaveMessagesLegacy([]*Message{message})
+	pinMessage.ID = types.EncodeHex(id)
+
+	err = m.persistence.SavePinMessage(pinMessage)
 	if err != nil {
 		return nil, err
 	}
 
-	response.Chats = []*Chat{chat}
-	response.Messages = []*Message{message}
-	return &response, m.saveChat(chat)
+	response.PinMessages = []*PinMessage{pinMessage}
+	return &response, nil
 }
 
-// Send contact updates to all contacts added by us
+// SendContactUpdates sends our profile to our contacts and paired devices,
+// with the given ENS name and profile image
 func (m *Messenger) SendContactUpdates(ctx context.Context, ensName, profileImage string) error {
 	m.mutex.Lock()
 	defer m.mutex.Unlock()
 
+	profile := m.profile()
+	profile.ENSName = ensName
+	profile.ProfileImage = profileImage
+	return m.sendContactUpdates(ctx, profile)
+}
+
+// sendContactUpdates validates the profile before saving and sending it,
+// the profile image set along with the ENS name counts towards its size
+func (m *Messenger) sendContactUpdates(ctx context.Context, profile Profile) error {
+	err := ValidateProfile(profile.ProfileImage, profile.DisplayName, profile.Bio, protobufProfileImages(profile.Images), profile.ProfileLink)
+	if err != nil {
+		return err
+	}
+
+	err = m.saveProfile(profile)
+	if err != nil {
+		return err
+	}
+
+	ensName := profile.ENSName
+	profileImage := profile.ProfileImage
 	myID := contactIDFromPublicKey(&m.identity.PublicKey)
 
 	if _, err := m.sendContactUpdate(ctx, myID, ensName, profileImage); err != nil {
@@ -1453,10 +2475,16 @@ func (m *Messenger) sendContactUpdate(ctx context.Context, chatID, ensName, prof
 	m.allChats[chat.ID] = chat
 	clock, _ := chat.NextClockAndTimestamp(m.getTimesource())
 
+	profile := m.profile()
 	contactUpdate := &protobuf.ContactUpdate{
 		Clock:        clock,
 		EnsName:      ensName,
-		ProfileImage: profileImage}
+		ProfileImage: profileImage,
+		DisplayName:  profile.DisplayName,
+		Bio:          profile.Bio,
+		Images:       protobufProfileImages(profile.Images),
+		ProfileLink:  profile.ProfileLink,
+	}
 	encodedMessage, err := proto.Marshal(contactUpdate)
 	if err != nil {
 		return nil, err
@@ -1476,6 +2504,11 @@ func (m *Messenger) sendContactUpdate(ctx context.Context, chatID, ensName, prof
 		contact.SystemTags = append(contact.SystemTags, contactAdded)
 	}
 
+	// The version of our profile synced with paired devices
+	if contact.ID == contactIDFromPublicKey(&m.identity.PublicKey) && contact.LastUpdated < clock {
+		contact.LastUpdated = clock
+	}
+
 	response.Contacts = []*Contact{contact}
 	response.Chats = []*Chat{chat}
 
@@ -1498,6 +2531,10 @@ func (m *Messenger) SyncDevices(ctx context.Context, ensName, photoPath string)
 		return err
 	}
 
+	if err := m.syncAccount(ctx, photoPath); err != nil {
+		return err
+	}
+
 	for _, chat := range m.allChats {
 		if chat.Public() && chat.Active {
 			if err := m.syncPublicChat(ctx, chat); err != nil {
@@ -1506,8 +2543,32 @@ func (m *Messenger) SyncDevices(ctx context.Context, ensName, photoPath string)
 		}
 	}
 
+	if m.readReceiptsSettings != nil {
+		enabled, err := m.readReceiptsSettings.GetSendReadReceipts()
+		if err != nil {
+			return err
+		}
+		clock, err := m.readReceiptsSettings.GetSendReadReceiptsClock()
+		if err != nil {
+			return err
+		}
+		if clock != 0 {
+			if err := m.syncReadReceiptsSetting(ctx, enabled, clock); err != nil {
+				return err
+			}
+		}
+	}
+
+	for _, chat := range m.allChats {
+		if chat.Active && chat.NotificationSettingsClock != 0 {
+			if err := m.syncChatNotificationSettings(ctx, chat); err != nil {
+				return err
+			}
+		}
+	}
+
 	for _, contact := range m.allContacts {
-		if contact.IsAdded() && contact.ID != myID {
+		if (contact.IsAdded() || contact.ContactRequestClock != 0 || len(contact.LocalNickname) != 0) && contact.ID != myID {
 			if err := m.syncContact(ctx, contact); err != nil {
 				return err
 			}
@@ -1617,6 +2678,51 @@ func (m *Messenger) syncPublicChat(ctx context.Context, publicChat *Chat) error
 	return m.saveChat(chat)
 }
 
+// syncChatNotificationSettings sync the notification settings of a chat
+// with paired devices
+func (m *Messenger) syncChatNotificationSettings(ctx context.Context, settingsChat *Chat) error {
+	var err error
+	if !m.hasPairedDevices() {
+		return nil
+	}
+	chatID := contactIDFromPublicKey(&m.identity.PublicKey)
+
+	chat, ok := m.allChats[chatID]
+	if !ok {
+		chat = OneToOneFromPublicKey(&m.identity.PublicKey, m.getTimesource())
+		// We don't want to show the chat to the user
+		chat.Active = false
+	}
+
+	m.allChats[chat.ID] = chat
+	clock, _ := chat.NextClockAndTimestamp(m.getTimesource())
+
+	syncMessage := &protobuf.SyncChatNotificationSettings{
+		Clock:             settingsChat.NotificationSettingsClock,
+		ChatId:            settingsChat.ID,
+		Muted:             settingsChat.Muted,
+		MutedUntil:        settingsChat.MutedUntil,
+		NotificationLevel: protobuf.SyncChatNotificationSettings_NotificationLevel(settingsChat.NotificationLevel),
+	}
+	encodedMessage, err := proto.Marshal(syncMessage)
+	if err != nil {
+		return err
+	}
+
+	_, err = m.dispatchMessage(ctx, &RawMessage{
+		LocalChatID:         chatID,
+		Payload:             encodedMessage,
+		MessageType:         protobuf.ApplicationMetadataMessage_SYNC_CHAT_NOTIFICATION_SETTINGS,
+		ResendAutomatically: true,
+	})
+	if err != nil {
+		return err
+	}
+
+	chat.LastClockValue = clock
+	return m.saveChat(chat)
+}
+
 // syncContact sync as contact with paired devices
 func (m *Messenger) syncContact(ctx context.Context, contact *Contact) error {
 	var err error
@@ -1636,10 +2742,20 @@ func (m *Messenger) syncContact(ctx context.Context, contact *Contact) error {
 	clock, _ := chat.NextClockAndTimestamp(m.getTimesource())
 
 	syncMessage := &protobuf.SyncInstallationContact{
-		Clock:        clock,
-		Id:           contact.ID,
-		EnsName:      contact.Name,
-		ProfileImage: contact.Photo,
+		Clock:               clock,
+		Id:                  contact.ID,
+		EnsName:             contact.Name,
+		ProfileImage:        contact.Photo,
+		ContactRequestState: protobuf.SyncInstallationContact_ContactRequestState(contact.ContactRequestState),
+		ContactRequestClock: contact.ContactRequestClock,
+		ContactRequestText:  contact.ContactRequestText,
+		LocalNickname:       contact.LocalNickname,
+		LocalNicknameClock:  contact.LocalNicknameClock,
+		DisplayName:         contact.DisplayName,
+		Bio:                 contact.Bio,
+		Images:              protobufProfileImages(contact.Images),
+		ProfileLink:         contact.ProfileLink,
+		NotAdded:            !contact.IsAdded(),
 	}
 	encodedMessage, err := proto.Marshal(syncMessage)
 	if err != nil {
@@ -1705,26 +2821,72 @@ type ReceivedMessageState struct {
 	Response *MessengerResponse
 	// Timesource is a time source for clock values/timestamps.
 	Timesource TimeSource
+	// HideNonContactMessages indicates whether one-to-one messages from
+	// non-contacts are hidden
+	HideNonContactMessages bool
+	// HiddenMessages are the messages received from non-contacts, saved
+	// but not returned to the client
+	HiddenMessages []*Message
+	// MessagesAwaitingPost are the comments on status updates of timelines
+	// not received yet, saved but not returned to the client until they are
+	MessagesAwaitingPost []*Message
+	// ModifiedCommunities are the communities whose description or key
+	// changed, by id
+	ModifiedCommunities map[string]*Community
+	// CommunityRequestsToJoin are the requests to join the communities we
+	// own, the ones to open communities are accepted automatically
+	CommunityRequestsToJoin []*CommunityRequestToJoin
+	// CommunityMembersLeft are the members who left the communities we own,
+	// by community id
+	CommunityMembersLeft map[string][]string
+	// ModifiedPolls are the ids of the polls that received votes
+	ModifiedPolls map[string]bool
+	// PendingChatNotificationSettings are the notification settings synced
+	// for chats we don't have yet, by chat id
+	PendingChatNotificationSettings map[string]*protobuf.SyncChatNotificationSettings
 }
 
 func (m *Messenger) handleRetrievedMessages(chatWithMessages map[transport.Filter][]*types.Message) (*MessengerResponse, error) {
 	m.mutex.Lock()
 	defer m.mutex.Unlock()
+
+	hideNonContactMessages, err := m.hideNonContactMessagesEnabled()
+	if err != nil {
+		return nil, err
+	}
+
 	messageState := &ReceivedMessageState{
-		AllChats:              m.allChats,
-		ModifiedChats:         make(map[string]bool),
-		AllContacts:           m.allContacts,
-		ModifiedContacts:      make(map[string]bool),
-		AllInstallations:      m.allInstallations,
-		ModifiedInstallations: m.modifiedInstallations,
-		ExistingMessagesMap:   make(map[string]bool),
-		Response:              &MessengerResponse{},
-		Timesource:            m.getTimesource(),
+		AllChats:                        m.allChats,
+		ModifiedChats:                   make(map[string]bool),
+		AllContacts:                     m.allContacts,
+		ModifiedContacts:                make(map[string]bool),
+		AllInstallations:                m.allInstallations,
+		ModifiedInstallations:           m.modifiedInstallations,
+		ExistingMessagesMap:             make(map[string]bool),
+		Response:                        &MessengerResponse{},
+		Timesource:                      m.getTimesource(),
+		HideNonContactMessages:          hideNonContactMessages,
+		ModifiedCommunities:             make(map[string]*Community),
+		CommunityMembersLeft:            make(map[string][]string),
+		ModifiedPolls:                   make(map[string]bool),
+		PendingChatNotificationSettings: m.pendingChatNotificationSettings,
 	}
 
 	logger := m.logger.With(zap.String("site", "RetrieveAll"))
-	for _, messages := range chatWithMessages {
+	for filter, messages := range chatWithMessages {
+		// The messages of community chats are encrypted with the key of
+		// the community
+		chat, ok := m.allChats[filter.ChatID]
+		isCommunityChat := ok && chat.CommunityChat()
+
 		for _, shhMessage := range messages {
+			if isCommunityChat {
+				if err := m.decryptCommunityMessage(chat, shhMessage); err != nil {
+					logger.Info("failed to decrypt community message", zap.Error(err))
+					continue
+				}
+			}
+
 			// TODO: fix this to use an exported method.
 			statusMessages, err := m.processor.handleMessages(shhMessage, true)
 			if err != nil {
@@ -1742,6 +2904,19 @@ func (m *Messenger) handleRetrievedMessages(chatWithMessages map[transport.Filte
 				if _, ok := messageState.AllContacts[senderID]; ok && messageState.AllContacts[senderID].IsBlocked() {
 					continue
 				}
+
+				// Chunked messages are processed once all their chunks
+				// have been received and reassembled
+				if chunk, ok := msg.ParsedMessage.(protobuf.MessageChunk); ok {
+					msg, err = m.handler.HandleMessageChunk(msg, chunk, m.getTimesource().GetCurrentTime())
+					if err != nil {
+						logger.Warn("failed to handle MessageChunk", zap.Error(err))
+						continue
+					}
+					if msg == nil {
+						continue
+					}
+				}
 				// Don't process duplicates
 				messageID := types.EncodeHex(msg.ID)
 				exists, err := m.handler.messageExists(messageID, messageState.ExistingMessagesMap)
@@ -1820,6 +2995,19 @@ func (m *Messenger) handleRetrievedMessages(chatWithMessages map[transport.Filte
 							logger.Warn("failed to handle SyncInstallationContact", zap.Error(err))
 							continue
 						}
+					case protobuf.SyncInstallationAccount:
+						if !isPubKeyEqual(messageState.CurrentMessageState.PublicKey, &m.identity.PublicKey) {
+							logger.Warn("not coming from us, ignoring")
+							continue
+						}
+
+						p := msg.ParsedMessage.(protobuf.SyncInstallationAccount)
+						logger.Debug("Handling SyncInstallationAccount")
+						err = m.handler.HandleSyncInstallationAccount(messageState, p)
+						if err != nil {
+							logger.Warn("failed to handle SyncInstallationAccount", zap.Error(err))
+							continue
+						}
 					case protobuf.SyncInstallationPublicChat:
 						if !isPubKeyEqual(messageState.CurrentMessageState.PublicKey, &m.identity.PublicKey) {
 							logger.Warn("not coming from us, ignoring")
@@ -1833,6 +3021,40 @@ func (m *Messenger) handleRetrievedMessages(chatWithMessages map[transport.Filte
 							logger.Warn("failed to handle SyncInstallationPublicChat", zap.Error(err))
 							continue
 						}
+					case protobuf.SyncChatNotificationSettings:
+						if !isPubKeyEqual(messageState.CurrentMessageState.PublicKey, &m.identity.PublicKey) {
+							logger.Warn("not coming from us, ignoring")
+							continue
+						}
+
+						p := msg.ParsedMessage.(protobuf.SyncChatNotificationSettings)
+						logger.Debug("Handling SyncChatNotificationSettings", zap.Any("message", p))
+						err = m.handler.HandleSyncChatNotificationSettings(messageState, p)
+						if err != nil {
+							logger.Warn("failed to handle SyncChatNotificationSettings", zap.Error(err))
+							continue
+						}
+					case protobuf.SyncReadReceiptsSetting:
+						if !isPubKeyEqual(messageState.CurrentMessageState.PublicKey, &m.identity.PublicKey) {
+							logger.Warn("not coming from us, ignoring")
+							continue
+						}
+
+						p := msg.ParsedMessage.(protobuf.SyncReadReceiptsSetting)
+						logger.Debug("Handling SyncReadReceiptsSetting", zap.Any("message", p))
+						err = m.handleSyncReadReceiptsSetting(p)
+						if err != nil {
+							logger.Warn("failed to handle SyncReadReceiptsSetting", zap.Error(err))
+							continue
+						}
+					case protobuf.ReadReceipt:
+						receipt := msg.ParsedMessage.(protobuf.ReadReceipt)
+						logger.Debug("Handling ReadReceipt", zap.Any("receipt", receipt))
+						err = m.handler.HandleReadReceipt(messageState, receipt)
+						if err != nil {
+							logger.Warn("failed to handle ReadReceipt", zap.Error(err))
+							continue
+						}
 					case protobuf.RequestAddressForTransaction:
 						command := msg.ParsedMessage.(protobuf.RequestAddressForTransaction)
 						logger.Debug("Handling RequestAddressForTransaction", zap.Any("message", command))
@@ -1894,6 +3116,134 @@ func (m *Messenger) handleRetrievedMessages(chatWithMessages map[transport.Filte
 							logger.Warn("failed to handle ContactUpdate", zap.Error(err))
 							continue
 						}
+					case protobuf.EditMessage:
+						logger.Debug("Handling EditMessage")
+						err = m.handler.HandleEditMessage(messageState, msg.ParsedMessage.(protobuf.EditMessage))
+						if err != nil {
+							logger.Warn("failed to handle EditMessage", zap.Error(err))
+							continue
+						}
+					case protobuf.DeleteMessage:
+						logger.Debug("Handling DeleteMessage")
+						err = m.handler.HandleDeleteMessage(messageState, msg.ParsedMessage.(protobuf.DeleteMessage))
+						if err != nil {
+							logger.Warn("failed to handle DeleteMessage", zap.Error(err))
+							continue
+						}
+					case protobuf.PinMessage:
+						logger.Debug("Handling PinMessage")
+						err = m.handler.HandlePinMessage(messageState, msg.ParsedMessage.(protobuf.PinMessage))
+						if err != nil {
+							logger.Warn("failed to handle PinMessage", zap.Error(err))
+							continue
+						}
+					case protobuf.TypingStatus:
+						logger.Debug("Handling TypingStatus")
+						status := msg.ParsedMessage.(protobuf.TypingStatus)
+						err = m.handler.HandleTypingStatus(messageState, status)
+						if err != nil {
+							logger.Warn("failed to handle TypingStatus", zap.Error(err))
+							continue
+						}
+					case protobuf.DisappearingMessagesSetting:
+						logger.Debug("Handling DisappearingMessagesSetting")
+						setting := msg.ParsedMessage.(protobuf.DisappearingMessagesSetting)
+						err = m.handler.HandleDisappearingMessagesSetting(messageState, setting)
+						if err != nil {
+							logger.Warn("failed to handle DisappearingMessagesSetting", zap.Error(err))
+							continue
+						}
+					case protobuf.ContactRequest:
+						logger.Debug("Handling ContactRequest")
+						err = m.handler.HandleContactRequest(messageState, msg.ParsedMessage.(protobuf.ContactRequest))
+						if err != nil {
+							logger.Warn("failed to handle ContactRequest", zap.Error(err))
+							continue
+						}
+					case protobuf.AcceptContactRequest:
+						logger.Debug("Handling AcceptContactRequest")
+						err = m.handler.HandleAcceptContactRequest(messageState, msg.ParsedMessage.(protobuf.AcceptContactRequest))
+						if err != nil {
+							logger.Warn("failed to handle AcceptContactRequest", zap.Error(err))
+							continue
+						}
+					case protobuf.DeclineContactRequest:
+						logger.Debug("Handling DeclineContactRequest")
+						err = m.handler.HandleDeclineContactRequest(messageState, msg.ParsedMessage.(protobuf.DeclineContactRequest))
+						if err != nil {
+							logger.Warn("failed to handle DeclineContactRequest", zap.Error(err))
+							continue
+						}
+					case protobuf.RetractContactRequest:
+						logger.Debug("Handling RetractContactRequest")
+						err = m.handler.HandleRetractContactRequest(messageState, msg.ParsedMessage.(protobuf.RetractContactRequest))
+						if err != nil {
+							logger.Warn("failed to handle RetractContactRequest", zap.Error(err))
+							continue
+						}
+					case protobuf.GroupChatJoinRequest:
+						logger.Debug("Handling GroupChatJoinRequest")
+						err = m.handler.HandleGroupChatJoinRequest(messageState, msg.ParsedMessage.(protobuf.GroupChatJoinRequest))
+						if err != nil {
+							logger.Warn("failed to handle GroupChatJoinRequest", zap.Error(err))
+							continue
+						}
+					case protobuf.GroupChatJoinRequestRejected:
+						logger.Debug("Handling GroupChatJoinRequestRejected")
+						err = m.handler.HandleGroupChatJoinRequestRejected(messageState, msg.ParsedMessage.(protobuf.GroupChatJoinRequestRejected))
+						if err != nil {
+							logger.Warn("failed to handle GroupChatJoinRequestRejected", zap.Error(err))
+							continue
+						}
+					case protobuf.GroupChatInvitationRevoked:
+						logger.Debug("Handling GroupChatInvitationRevoked")
+						err = m.handler.HandleGroupChatInvitationRevoked(messageState, msg.ParsedMessage.(protobuf.GroupChatInvitationRevoked))
+						if err != nil {
+							logger.Warn("failed to handle GroupChatInvitationRevoked", zap.Error(err))
+							continue
+						}
+					case protobuf.SignedCommunityDescription:
+						logger.Debug("Handling SignedCommunityDescription")
+						err = m.handler.HandleCommunityDescription(messageState, msg.ParsedMessage.(protobuf.SignedCommunityDescription))
+						if err != nil {
+							logger.Warn("failed to handle SignedCommunityDescription", zap.Error(err))
+							continue
+						}
+					case protobuf.CommunityRequestToJoin:
+						logger.Debug("Handling CommunityRequestToJoin")
+						err = m.handler.HandleCommunityRequestToJoin(messageState, msg.ParsedMessage.(protobuf.CommunityRequestToJoin))
+						if err != nil {
+							logger.Warn("failed to handle CommunityRequestToJoin", zap.Error(err))
+							continue
+						}
+					case protobuf.CommunityRequestToLeave:
+						logger.Debug("Handling CommunityRequestToLeave")
+						err = m.handler.HandleCommunityRequestToLeave(messageState, msg.ParsedMessage.(protobuf.CommunityRequestToLeave))
+						if err != nil {
+							logger.Warn("failed to handle CommunityRequestToLeave", zap.Error(err))
+							continue
+						}
+					case protobuf.CommunityKey:
+						logger.Debug("Handling CommunityKey")
+						err = m.handler.HandleCommunityKey(messageState, msg.ParsedMessage.(protobuf.CommunityKey))
+						if err != nil {
+							logger.Warn("failed to handle CommunityKey", zap.Error(err))
+							continue
+						}
+					case protobuf.PollVote:
+						logger.Debug("Handling PollVote")
+						err = m.handler.HandlePollVote(messageState, msg.ParsedMessage.(protobuf.PollVote))
+						if err != nil {
+							logger.Warn("failed to handle PollVote", zap.Error(err))
+							continue
+						}
+					case protobuf.EmojiReaction:
+						logger.Debug("Handling EmojiReaction")
+						err = m.handler.HandleEmojiReaction(messageState, msg.ParsedMessage.(protobuf.EmojiReaction))
+						if err != nil {
+							logger.Warn("failed to handle EmojiReaction", zap.Error(err))
+							continue
+						}
 					default:
 						logger.Debug("message not handled")
 
@@ -1907,6 +3257,8 @@ func (m *Messenger) handleRetrievedMessages(chatWithMessages map[transport.Filte
 		messageState.Response.Chats = append(messageState.Response.Chats, messageState.AllChats[id])
 	}
 
+	m.setNotifications(messageState.Response, messageState.AllChats)
+
 	for id := range messageState.ModifiedContacts {
 		messageState.Response.Contacts = append(messageState.Response.Contacts, messageState.AllContacts[id])
 	}
@@ -1922,7 +3274,6 @@ func (m *Messenger) handleRetrievedMessages(chatWithMessages map[transport.Filte
 		}
 	}
 
-	var err error
 	if len(messageState.Response.Chats) > 0 {
 		err = m.saveChats(messageState.Response.Chats)
 		if err != nil {
@@ -1936,6 +3287,28 @@ func (m *Messenger) handleRetrievedMessages(chatWithMessages map[transport.Filte
 		}
 	}
 
+	if len(messageState.HiddenMessages) > 0 {
+		err = m.saveHiddenMessages(messageState.HiddenMessages)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if len(messageState.MessagesAwaitingPost) > 0 {
+		err = m.saveMessagesAwaitingPost(messageState.MessagesAwaitingPost)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// Comments and reactions received before the status updates they refer
+	// to are returned once those and the ones received in this batch have
+	// been saved
+	err = m.showCommentsAndReactionsOnPosts(messageState.Response)
+	if err != nil {
+		return nil, err
+	}
+
 	if len(messageState.Response.Contacts) > 0 {
 		err = m.persistence.SaveContacts(messageState.Response.Contacts)
 		if err != nil {
@@ -1943,6 +3316,54 @@ func (m *Messenger) handleRetrievedMessages(chatWithMessages map[transport.Filte
 		}
 	}
 
+	for _, contact := range messageState.Response.Contacts {
+		err = m.updateTimelineSubscription(contact)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// Polls are tallied once the votes and the polls received in this
+	// batch have been saved
+	for id := range messageState.ModifiedPolls {
+		message, poll, err := m.pollMessage(id)
+		if err != nil {
+			// The votes arrived before the poll
+			continue
+		}
+		results, err := m.pollResults(message, poll)
+		if err != nil {
+			return nil, err
+		}
+		messageState.Response.PollResults = append(messageState.Response.PollResults, results)
+	}
+
+	// Changes to communities are applied once all the messages have been
+	// handled, as they might require sending messages and joining topics
+	m.handleCommunityChanges(messageState)
+
+	// Scheduled messages sent since the last call are returned along with
+	// the retrieved ones, they have already been saved
+	for _, message := range m.sentScheduledMessages {
+		messageState.Response.Messages = append(messageState.Response.Messages, message)
+		if !chatsContain(messageState.Response.Chats, message.LocalChatID) {
+			messageState.Response.Chats = append(messageState.Response.Chats, m.allChats[message.LocalChatID])
+		}
+	}
+	m.sentScheduledMessages = nil
+	messageState.Response.FailedScheduledMessages = m.failedScheduledMessages
+	m.failedScheduledMessages = nil
+
+	// Messages that expired since the last call
+	messageState.Response.RemovedMessages = m.expiredMessageIDs
+	for id := range m.expiredMessagesChats {
+		if !chatsContain(messageState.Response.Chats, id) {
+			messageState.Response.Chats = append(messageState.Response.Chats, m.allChats[id])
+		}
+	}
+	m.expiredMessageIDs = nil
+	m.expiredMessagesChats = make(map[string]bool)
+
 	// Reset installations
 	m.modifiedInstallations = make(map[string]bool)
 
@@ -1987,6 +3408,25 @@ func (m *Messenger) MessageByChatID(chatID, cursor string, limit int) ([]*Messag
 	return m.persistence.MessageByChatID(chatID, cursor, limit)
 }
 
+// EmojiReactionsByChatID returns the emoji reactions to the page of messages
+// of a chat identified by cursor and limit
+func (m *Messenger) EmojiReactionsByChatID(chatID string, cursor string, limit int) ([]*EmojiReaction, error) {
+	return m.persistence.EmojiReactionsByChatID(chatID, cursor, limit)
+}
+
+// PinnedMessagesByChatID returns the messages pinned in a chat, with the
+// same cursor based pagination as MessageByChatID
+func (m *Messenger) PinnedMessagesByChatID(chatID, cursor string, limit int) ([]*PinnedMessage, string, error) {
+	return m.persistence.PinnedMessagesByChatID(chatID, cursor, limit)
+}
+
+// SearchMessages returns the messages matching all the words of the query,
+// best matches first. chatIDs restricts the search to some chats, from and
+// to to a range of timestamps, when set
+func (m *Messenger) SearchMessages(query string, chatIDs []string, from, to uint64, cursor string, limit int) ([]*SearchResult, string, error) {
+	return m.persistence.SearchMessages(query, chatIDs, from, to, cursor, limit)
+}
+
 func (m *Messenger) SaveMessages(messages []*Message) error {
 	return m.persistence.SaveMessagesLegacy(messages)
 }
@@ -2003,7 +3443,14 @@ func (m *Messenger) MarkMessagesSeen(chatID string, ids []string) error {
 	m.mutex.Lock()
 	defer m.mutex.Unlock()
 
-	err := m.persistence.MarkMessagesSeen(chatID, ids)
+	// The messages to acknowledge need to be found before they are marked
+	// as seen
+	receiptIDs, err := m.readReceiptMessageIDs(chatID, ids)
+	if err != nil {
+		return err
+	}
+
+	err = m.persistence.MarkMessagesSeen(chatID, ids)
 	if err != nil {
 		return err
 	}
@@ -2012,7 +3459,8 @@ func (m *Messenger) MarkMessagesSeen(chatID string, ids []string) error {
 		return err
 	}
 	m.allChats[chatID] = chat
-	return nil
+
+	return m.sendReadReceipt(context.Background(), chat, receiptIDs)
 }
 
 func (m *Messenger) MarkAllRead(chatID string) error {
@@ -2029,6 +3477,7 @@ func (m *Messenger) MarkAllRead(chatID string) error {
 	}
 
 	chat.UnviewedMessagesCount = 0
+	chat.UnviewedMentionsCount = 0
 	m.allChats[chat.ID] = chat
 	return nil
 }
@@ -6,6 +6,7 @@ import (
 	"errors"
 	"io/ioutil"
 	"os"
+	"strings"
 	"testing"
 
 	"github.com/google/uuid"
@@ -142,3 +143,75 @@ func (s *MessengerContactUpdateSuite) TestReceiveContactUpdate() {
 	s.Require().True(receivedContact.HasBeenAdded())
 	s.Require().NotEmpty(receivedContact.LastUpdated)
 }
+
+func (s *MessengerContactUpdateSuite) TestReceiveProfileUpdate() {
+	contactID := types.EncodeHex(crypto.FromECDSAPub(&s.m.identity.PublicKey))
+
+	theirMessenger := s.newMessenger(s.shh)
+	theirContactID := types.EncodeHex(crypto.FromECDSAPub(&theirMessenger.identity.PublicKey))
+
+	_, err := theirMessenger.SendContactUpdate(context.Background(), contactID, "", "")
+	s.Require().NoError(err)
+
+	profile := Profile{
+		ENSName:      "ens-name.stateofus.eth",
+		ProfileImage: "their-picture",
+		DisplayName:  "their name",
+		Bio:          "their bio",
+		Images: []ContactImage{
+			{Name: "thumbnail", Payload: "thumbnail-payload", Width: 80, Height: 80},
+			{Name: "large", Payload: "large-payload", Width: 240, Height: 240},
+		},
+		ProfileLink: "https://status.im",
+	}
+
+	invalidProfile := profile
+	invalidProfile.ProfileLink = "not a link"
+	s.Require().Error(theirMessenger.SetProfile(context.Background(), invalidProfile))
+
+	// The profile image counts towards the size of the images
+	invalidProfile = profile
+	invalidProfile.ProfileImage = strings.Repeat("a", maxProfileImagesSize)
+	s.Require().Error(theirMessenger.SetProfile(context.Background(), invalidProfile))
+
+	err = theirMessenger.SetProfile(context.Background(), profile)
+	s.Require().NoError(err)
+	s.Require().Equal(profile, theirMessenger.Profile())
+
+	// Wait for the profile to reach its destination
+	var receivedContact *Contact
+	err = tt.RetryWithBackOff(func() error {
+		response, err := s.m.RetrieveAll()
+		if err != nil {
+			return err
+		}
+		for _, contact := range response.Contacts {
+			if contact.ID == theirContactID && contact.DisplayName == profile.DisplayName {
+				receivedContact = contact
+				return nil
+			}
+		}
+		return errors.New("profile not received")
+	})
+	s.Require().NoError(err)
+
+	s.Require().Equal(profile.ENSName, receivedContact.Name)
+	s.Require().Equal(profile.ProfileImage, receivedContact.Photo)
+	s.Require().Equal(profile.Bio, receivedContact.Bio)
+	s.Require().Equal(profile.Images, receivedContact.Images)
+	s.Require().Equal(profile.ProfileLink, receivedContact.ProfileLink)
+	s.Require().Equal(profile.DisplayName, receivedContact.displayName())
+
+	// The profile is persisted
+	contacts, err := s.m.persistence.Contacts()
+	s.Require().NoError(err)
+	var savedContact *Contact
+	for _, contact := range contacts {
+		if contact.ID == theirContactID {
+			savedContact = contact
+		}
+	}
+	s.Require().NotNil(savedContact)
+	s.Require().Equal(profile.Images, savedContact.Images)
+	s.Require().Equal(profile.Bio, savedContact.Bio)
+}
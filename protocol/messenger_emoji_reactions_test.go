@@ -0,0 +1,167 @@
+package protocol
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"errors"
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/zap"
+
+	gethbridge "github.com/status-im/status-go/eth-node/bridge/geth"
+	"github.com/status-im/status-go/eth-node/crypto"
+	"github.com/status-im/status-go/eth-node/types"
+	"github.com/status-im/status-go/protocol/protobuf"
+	"github.com/status-im/status-go/protocol/tt"
+	"github.com/status-im/status-go/whisper/v6"
+)
+
+func TestMessengerEmojiReactionsSuite(t *testing.T) {
+	suite.Run(t, new(MessengerEmojiReactionsSuite))
+}
+
+type MessengerEmojiReactionsSuite struct {
+	suite.Suite
+	m          *Messenger        // main instance of Messenger
+	privateKey *ecdsa.PrivateKey // private key for the main instance of Messenger
+	// If one wants to send messages between different instances of Messenger,
+	// a single Whisper service should be shared.
+	shh      types.Whisper
+	tmpFiles []*os.File // files to clean up
+	logger   *zap.Logger
+}
+
+func (s *MessengerEmojiReactionsSuite) SetupTest() {
+	s.logger = tt.MustCreateTestLogger()
+
+	config := whisper.DefaultConfig
+	config.MinimumAcceptedPOW = 0
+	shh := whisper.New(&config)
+	s.shh = gethbridge.NewGethWhisperWrapper(shh)
+	s.Require().NoError(shh.Start(nil))
+
+	s.m = s.newMessenger(s.shh)
+	s.privateKey = s.m.identity
+}
+
+func (s *MessengerEmojiReactionsSuite) newMessengerWithKey(shh types.Whisper, privateKey *ecdsa.PrivateKey) *Messenger {
+	tmpFile, err := ioutil.TempFile("", "")
+	s.Require().NoError(err)
+
+	options := []Option{
+		WithCustomLogger(s.logger),
+		WithMessagesPersistenceEnabled(),
+		WithDatabaseConfig(tmpFile.Name(), "some-key"),
+		WithDatasync(),
+	}
+	m, err := NewMessenger(
+		privateKey,
+		&testNode{shh: shh},
+		uuid.New().String(),
+		options...,
+	)
+	s.Require().NoError(err)
+
+	err = m.Init()
+	s.Require().NoError(err)
+
+	s.tmpFiles = append(s.tmpFiles, tmpFile)
+
+	return m
+}
+
+func (s *MessengerEmojiReactionsSuite) newMessenger(shh types.Whisper) *Messenger {
+	privateKey, err := crypto.GenerateKey()
+	s.Require().NoError(err)
+
+	return s.newMessengerWithKey(s.shh, privateKey)
+}
+
+func (s *MessengerEmojiReactionsSuite) TestSendAndRetractEmojiReaction() {
+	theirMessenger := s.newMessenger(s.shh)
+	theirChat := CreatePublicChat("status", s.m.transport)
+	err := theirMessenger.SaveChat(&theirChat)
+	s.Require().NoError(err)
+
+	chat := CreatePublicChat("status", s.m.transport)
+	err = s.m.SaveChat(&chat)
+	s.Require().NoError(err)
+
+	err = s.m.Join(chat)
+	s.Require().NoError(err)
+
+	sendResponse, err := theirMessenger.SendChatMessage(context.Background(), buildTestMessage(theirChat))
+	s.Require().NoError(err)
+	messageID := sendResponse.Messages[0].ID
+
+	// Wait for the message to reach its destination
+	err = tt.RetryWithBackOff(func() error {
+		response, err := s.m.RetrieveAll()
+		if err == nil && len(response.Messages) == 0 {
+			err = errors.New("no messages")
+		}
+		return err
+	})
+	s.Require().NoError(err)
+
+	sendResponse, err = theirMessenger.SendEmojiReaction(context.Background(), theirChat.ID, messageID, protobuf.EmojiReaction_LOVE)
+	s.Require().NoError(err)
+	s.Require().Len(sendResponse.EmojiReactions, 1)
+	sentEmojiReaction := sendResponse.EmojiReactions[0]
+
+	// Wait for the emoji reaction to reach its destination
+	var response *MessengerResponse
+	err = tt.RetryWithBackOff(func() error {
+		var err error
+		response, err = s.m.RetrieveAll()
+		if err == nil && len(response.EmojiReactions) == 0 {
+			err = errors.New("no emoji reaction")
+		}
+		return err
+	})
+	s.Require().NoError(err)
+
+	s.Require().Len(response.EmojiReactions, 1)
+	receivedEmojiReaction := response.EmojiReactions[0]
+	s.Require().Equal(sentEmojiReaction.ID, receivedEmojiReaction.ID)
+	s.Require().Equal(messageID, receivedEmojiReaction.MessageId)
+	s.Require().Equal(chat.ID, receivedEmojiReaction.LocalChatID)
+	s.Require().Equal(protobuf.EmojiReaction_LOVE, receivedEmojiReaction.Type)
+	s.Require().False(receivedEmojiReaction.Retracted)
+
+	emojiReactions, err := s.m.EmojiReactionsByChatID(chat.ID, "", 10)
+	s.Require().NoError(err)
+	s.Require().Len(emojiReactions, 1)
+
+	sendResponse, err = theirMessenger.SendEmojiReactionRetraction(context.Background(), sentEmojiReaction.ID)
+	s.Require().NoError(err)
+	s.Require().Len(sendResponse.EmojiReactions, 1)
+	s.Require().True(sendResponse.EmojiReactions[0].Retracted)
+
+	// Wait for the retraction to reach its destination
+	err = tt.RetryWithBackOff(func() error {
+		var err error
+		response, err = s.m.RetrieveAll()
+		if err == nil && len(response.EmojiReactions) == 0 {
+			err = errors.New("no emoji reaction retraction")
+		}
+		return err
+	})
+	s.Require().NoError(err)
+
+	s.Require().Len(response.EmojiReactions, 1)
+	s.Require().Equal(sentEmojiReaction.ID, response.EmojiReactions[0].ID)
+	s.Require().True(response.EmojiReactions[0].Retracted)
+
+	emojiReactions, err = s.m.EmojiReactionsByChatID(chat.ID, "", 10)
+	s.Require().NoError(err)
+	s.Require().Len(emojiReactions, 0)
+
+	// We can't retract someone else's reaction
+	_, err = s.m.SendEmojiReactionRetraction(context.Background(), sentEmojiReaction.ID)
+	s.Require().Error(err)
+}
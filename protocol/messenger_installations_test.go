@@ -8,6 +8,7 @@ import (
 	"os"
 	"testing"
 
+	"github.com/golang/protobuf/proto"
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/suite"
 	"go.uber.org/zap"
@@ -16,6 +17,7 @@ import (
 	"github.com/status-im/status-go/eth-node/crypto"
 	"github.com/status-im/status-go/eth-node/types"
 	"github.com/status-im/status-go/protocol/encryption/multidevice"
+	"github.com/status-im/status-go/protocol/protobuf"
 	"github.com/status-im/status-go/protocol/tt"
 	"github.com/status-im/status-go/whisper/v6"
 )
@@ -181,6 +183,16 @@ func (s *MessengerInstallationSuite) TestSyncInstallation() {
 	err = s.m.SaveChat(&chat)
 	s.Require().NoError(err)
 
+	// set profile
+	profileImages := []ContactImage{{Name: "thumbnail", Payload: "payload", Width: 80, Height: 80}}
+	err = s.m.SetProfile(context.Background(), Profile{
+		DisplayName: "our-name",
+		Bio:         "our-bio",
+		Images:      profileImages,
+		ProfileLink: "https://status.im",
+	})
+	s.Require().NoError(err)
+
 	// pair
 	theirMessenger := s.newMessengerWithKey(s.shh, s.privateKey)
 
@@ -273,5 +285,577 @@ func (s *MessengerInstallationSuite) TestSyncInstallation() {
 	s.Require().NotNil(ourContact)
 	s.Require().Equal("ens-name", ourContact.Name)
 	s.Require().Equal("profile-image", ourContact.Photo)
+	s.Require().Equal("our-name", ourContact.DisplayName)
+	s.Require().Equal("our-bio", ourContact.Bio)
+	s.Require().Equal(profileImages, ourContact.Images)
+	s.Require().Equal("https://status.im", ourContact.ProfileLink)
+
+}
+
+func (s *MessengerInstallationSuite) TestSyncChatNotificationSettings() {
+	// pair
+	theirMessenger := s.newMessengerWithKey(s.shh, s.privateKey)
+
+	err := theirMessenger.SetInstallationMetadata(theirMessenger.installationID, &multidevice.InstallationMetadata{
+		Name:       "their-name",
+		DeviceType: "their-device-type",
+	})
+	s.Require().NoError(err)
+	response, err := theirMessenger.SendPairInstallation(context.Background())
+	s.Require().NoError(err)
+	s.Require().NotNil(response)
+
+	// Wait for the message to reach its destination
+	err = tt.RetryWithBackOff(func() error {
+		var err error
+		response, err = s.m.RetrieveAll()
+		if err == nil && len(response.Installations) == 0 {
+			err = errors.New("installation not received")
+		}
+		return err
+	})
+	s.Require().NoError(err)
+
+	err = s.m.EnableInstallation(theirMessenger.installationID)
+	s.Require().NoError(err)
+
+	chat := CreatePublicChat("status", s.m.transport)
+	err = s.m.SaveChat(&chat)
+	s.Require().NoError(err)
+
+	// Wait for the public chat to be synced
+	err = tt.RetryWithBackOff(func() error {
+		var err error
+		response, err = theirMessenger.RetrieveAll()
+		if err == nil && len(response.Chats) == 0 {
+			err = errors.New("sync chat not received")
+		}
+		return err
+	})
+	s.Require().NoError(err)
+
+	response, err = s.m.MuteChat(context.Background(), chat.ID, 0)
+	s.Require().NoError(err)
+	s.Require().Len(response.Chats, 1)
+	s.Require().True(response.Chats[0].Muted)
+
+	// Wait for the notification settings to be synced
+	var syncedChat *Chat
+	err = tt.RetryWithBackOff(func() error {
+		response, err := theirMessenger.RetrieveAll()
+		if err != nil {
+			return err
+		}
+		for _, c := range response.Chats {
+			if c.ID == chat.ID && c.Muted {
+				syncedChat = c
+				return nil
+			}
+		}
+		return errors.New("notification settings not received")
+	})
+	s.Require().NoError(err)
+	s.Require().Equal(uint64(0), syncedChat.MutedUntil)
+	s.Require().Equal(ChatNotificationLevelAll, syncedChat.NotificationLevel)
+
+	_, err = s.m.UnmuteChat(context.Background(), chat.ID)
+	s.Require().NoError(err)
+	_, err = s.m.SetChatNotificationLevel(context.Background(), chat.ID, ChatNotificationLevelMentions)
+	s.Require().NoError(err)
+
+	err = tt.RetryWithBackOff(func() error {
+		response, err := theirMessenger.RetrieveAll()
+		if err != nil {
+			return err
+		}
+		for _, c := range response.Chats {
+			if c.ID == chat.ID && c.NotificationLevel == ChatNotificationLevelMentions {
+				syncedChat = c
+				return nil
+			}
+		}
+		return errors.New("notification settings not received")
+	})
+	s.Require().NoError(err)
+	s.Require().False(syncedChat.Muted)
+
+	// The settings are persisted
+	storedChat, err := theirMessenger.persistence.Chat(chat.ID)
+	s.Require().NoError(err)
+	s.Require().False(storedChat.Muted)
+	s.Require().Equal(ChatNotificationLevelMentions, storedChat.NotificationLevel)
+	s.Require().Equal(syncedChat.NotificationSettingsClock, storedChat.NotificationSettingsClock)
+}
+
+func (s *MessengerInstallationSuite) TestSyncChatNotificationSettingsBeforeChat() {
+	// pair
+	theirMessenger := s.newMessengerWithKey(s.shh, s.privateKey)
+
+	err := theirMessenger.SetInstallationMetadata(theirMessenger.installationID, &multidevice.InstallationMetadata{
+		Name:       "their-name",
+		DeviceType: "their-device-type",
+	})
+	s.Require().NoError(err)
+	response, err := theirMessenger.SendPairInstallation(context.Background())
+	s.Require().NoError(err)
+	s.Require().NotNil(response)
+
+	// Wait for the message to reach its destination
+	err = tt.RetryWithBackOff(func() error {
+		var err error
+		response, err = s.m.RetrieveAll()
+		if err == nil && len(response.Installations) == 0 {
+			err = errors.New("installation not received")
+		}
+		return err
+	})
+	s.Require().NoError(err)
+
+	err = s.m.EnableInstallation(theirMessenger.installationID)
+	s.Require().NoError(err)
+
+	// One-to-one chats are not synced, the paired device doesn't have it
+	contactKey, err := crypto.GenerateKey()
+	s.Require().NoError(err)
+	chat := OneToOneFromPublicKey(&contactKey.PublicKey, s.m.transport)
+	err = s.m.SaveChat(chat)
+	s.Require().NoError(err)
+
+	_, err = s.m.MuteChat(context.Background(), chat.ID, 0)
+	s.Require().NoError(err)
+
+	// Wait for the notification settings to be synced
+	err = tt.RetryWithBackOff(func() error {
+		_, err := theirMessenger.RetrieveAll()
+		if err != nil {
+			return err
+		}
+		if _, ok := theirMessenger.pendingChatNotificationSettings[chat.ID]; !ok {
+			return errors.New("notification settings not received")
+		}
+		return nil
+	})
+	s.Require().NoError(err)
+
+	// They are kept until the chat is created
+	pending, err := theirMessenger.persistence.PendingChatNotificationSettings()
+	s.Require().NoError(err)
+	s.Require().Contains(pending, chat.ID)
+
+	theirChat := OneToOneFromPublicKey(&contactKey.PublicKey, theirMessenger.transport)
+	err = theirMessenger.SaveChat(theirChat)
+	s.Require().NoError(err)
+	s.Require().True(theirChat.Muted)
+
+	storedChat, err := theirMessenger.persistence.Chat(chat.ID)
+	s.Require().NoError(err)
+	s.Require().True(storedChat.Muted)
+	s.Require().NotZero(storedChat.NotificationSettingsClock)
+
+	pending, err = theirMessenger.persistence.PendingChatNotificationSettings()
+	s.Require().NoError(err)
+	s.Require().Empty(pending)
+}
+
+func (s *MessengerInstallationSuite) TestSyncReadReceiptsSetting() {
+	ourSettings := &testReadReceiptsSettings{}
+	s.m.readReceiptsSettings = ourSettings
+
+	// pair
+	theirMessenger := s.newMessengerWithKey(s.shh, s.privateKey)
+	theirSettings := &testReadReceiptsSettings{}
+	theirMessenger.readReceiptsSettings = theirSettings
+
+	err := theirMessenger.SetInstallationMetadata(theirMessenger.installationID, &multidevice.InstallationMetadata{
+		Name:       "their-name",
+		DeviceType: "their-device-type",
+	})
+	s.Require().NoError(err)
+	_, err = theirMessenger.SendPairInstallation(context.Background())
+	s.Require().NoError(err)
+
+	// Wait for the message to reach its destination
+	err = tt.RetryWithBackOff(func() error {
+		response, err := s.m.RetrieveAll()
+		if err == nil && len(response.Installations) == 0 {
+			err = errors.New("installation not received")
+		}
+		return err
+	})
+	s.Require().NoError(err)
+
+	err = s.m.EnableInstallation(theirMessenger.installationID)
+	s.Require().NoError(err)
+
+	err = s.m.SetSendReadReceipts(context.Background(), true)
+	s.Require().NoError(err)
+	s.Require().True(ourSettings.enabled)
+
+	// Wait for the setting to be synced
+	err = tt.RetryWithBackOff(func() error {
+		_, err := theirMessenger.RetrieveAll()
+		if err == nil && !theirSettings.enabled {
+			err = errors.New("setting not synced")
+		}
+		return err
+	})
+	s.Require().NoError(err)
+	s.Require().Equal(ourSettings.clock, theirSettings.clock)
+
+	// A change older than the last one is ignored
+	err = theirMessenger.handleSyncReadReceiptsSetting(protobuf.SyncReadReceiptsSetting{
+		Clock:   ourSettings.clock - 1,
+		Enabled: false,
+	})
+	s.Require().NoError(err)
+	s.Require().True(theirSettings.enabled)
+	s.Require().Equal(ourSettings.clock, theirSettings.clock)
+}
+
+func (s *MessengerInstallationSuite) TestSyncContactRequest() {
+	// pair
+	theirMessenger := s.newMessengerWithKey(s.shh, s.privateKey)
+
+	err := theirMessenger.SetInstallationMetadata(theirMessenger.installationID, &multidevice.InstallationMetadata{
+		Name:       "their-name",
+		DeviceType: "their-device-type",
+	})
+	s.Require().NoError(err)
+	_, err = theirMessenger.SendPairInstallation(context.Background())
+	s.Require().NoError(err)
+
+	// Wait for the message to reach its destination
+	err = tt.RetryWithBackOff(func() error {
+		response, err := s.m.RetrieveAll()
+		if err == nil && len(response.Installations) == 0 {
+			err = errors.New("installation not received")
+		}
+		return err
+	})
+	s.Require().NoError(err)
+
+	err = s.m.EnableInstallation(theirMessenger.installationID)
+	s.Require().NoError(err)
+
+	contactKey, err := crypto.GenerateKey()
+	s.Require().NoError(err)
+	contactID := types.EncodeHex(crypto.FromECDSAPub(&contactKey.PublicKey))
+
+	_, err = s.m.SendContactRequest(context.Background(), contactID, "hi, it's me")
+	s.Require().NoError(err)
+
+	// Wait for the contact to be synced
+	err = tt.RetryWithBackOff(func() error {
+		_, err := theirMessenger.RetrieveAll()
+		if err != nil {
+			return err
+		}
+		contact, err := theirMessenger.GetContactByID(contactID)
+		if err == nil && contact.ContactRequestState != ContactRequestStateSent {
+			err = errors.New("contact request not synced")
+		}
+		return err
+	})
+	s.Require().NoError(err)
+
+	contact, err := theirMessenger.GetContactByID(contactID)
+	s.Require().NoError(err)
+	s.Require().True(contact.IsAdded())
+	s.Require().Equal("hi, it's me", contact.ContactRequestText)
+
+	_, err = s.m.RetractContactRequest(context.Background(), contactID)
+	s.Require().NoError(err)
+
+	err = tt.RetryWithBackOff(func() error {
+		_, err := theirMessenger.RetrieveAll()
+		if err != nil {
+			return err
+		}
+		contact, err := theirMessenger.GetContactByID(contactID)
+		if err == nil && contact.ContactRequestState != ContactRequestStateNone {
+			err = errors.New("retraction not synced")
+		}
+		return err
+	})
+	s.Require().NoError(err)
+
+	contact, err = theirMessenger.GetContactByID(contactID)
+	s.Require().NoError(err)
+	s.Require().False(contact.IsAdded())
+}
+
+func (s *MessengerInstallationSuite) TestSyncAddedContactShowsHiddenMessages() {
+	// pair
+	theirMessenger := s.newMessengerWithKey(s.shh, s.privateKey)
+
+	err := theirMessenger.SetInstallationMetadata(theirMessenger.installationID, &multidevice.InstallationMetadata{
+		Name:       "their-name",
+		DeviceType: "their-device-type",
+	})
+	s.Require().NoError(err)
+	_, err = theirMessenger.SendPairInstallation(context.Background())
+	s.Require().NoError(err)
+
+	// Wait for the message to reach its destination
+	err = tt.RetryWithBackOff(func() error {
+		response, err := s.m.RetrieveAll()
+		if err == nil && len(response.Installations) == 0 {
+			err = errors.New("installation not received")
+		}
+		return err
+	})
+	s.Require().NoError(err)
+
+	err = s.m.EnableInstallation(theirMessenger.installationID)
+	s.Require().NoError(err)
+
+	s.m.contactRequestsSettings = &testContactRequestsSettings{}
+	s.Require().NoError(s.m.SetHideNonContactMessages(true))
+	theirMessenger.contactRequestsSettings = &testContactRequestsSettings{}
+	s.Require().NoError(theirMessenger.SetHideNonContactMessages(true))
+
+	// Both devices receive a message from a non-contact, hidden
+	contactMessenger := s.newMessenger(s.shh)
+	contactChat := CreateOneToOneChat("XXX", &s.privateKey.PublicKey, contactMessenger.transport)
+	s.Require().NoError(contactMessenger.SaveChat(&contactChat))
+	sendResponse, err := contactMessenger.SendChatMessage(context.Background(), buildTestMessage(contactChat))
+	s.Require().NoError(err)
+	sentMessage := sendResponse.Messages[0]
+
+	for _, messenger := range []*Messenger{s.m, theirMessenger} {
+		messenger := messenger
+		err = tt.RetryWithBackOff(func() error {
+			_, err := messenger.RetrieveAll()
+			if err != nil {
+				return err
+			}
+			exist, err := messenger.persistence.MessagesExist([]string{sentMessage.ID})
+			if err == nil && !exist[sentMessage.ID] {
+				err = errors.New("no messages")
+			}
+			return err
+		})
+		s.Require().NoError(err)
+	}
+
+	// Adding the contact shows its messages, on the paired device as well
+	contact, err := buildContact(&contactMessenger.identity.PublicKey)
+	s.Require().NoError(err)
+	contact.SystemTags = append(contact.SystemTags, contactAdded)
+	s.Require().NoError(s.m.SaveContact(contact))
+
+	messages, _, err := s.m.MessageByChatID(contact.ID, "", 10)
+	s.Require().NoError(err)
+	s.Require().Len(messages, 1)
+	s.Require().Equal(sentMessage.ID, messages[0].ID)
 
+	err = tt.RetryWithBackOff(func() error {
+		_, err := theirMessenger.RetrieveAll()
+		if err != nil {
+			return err
+		}
+		messages, _, err := theirMessenger.MessageByChatID(contact.ID, "", 10)
+		if err == nil && len(messages) == 0 {
+			err = errors.New("messages not shown")
+		}
+		return err
+	})
+	s.Require().NoError(err)
+
+	chat, ok := theirMessenger.allChats[contact.ID]
+	s.Require().True(ok)
+	s.Require().True(chat.Active)
+	s.Require().NoError(contactMessenger.Shutdown())
+}
+
+func (s *MessengerInstallationSuite) TestSyncLocalNickname() {
+	contactKey, err := crypto.GenerateKey()
+	s.Require().NoError(err)
+	contact, err := buildContact(&contactKey.PublicKey)
+	s.Require().NoError(err)
+	contact.SystemTags = append(contact.SystemTags, contactAdded)
+	err = s.m.SaveContact(contact)
+	s.Require().NoError(err)
+
+	// pair
+	theirMessenger := s.newMessengerWithKey(s.shh, s.privateKey)
+
+	err = theirMessenger.SetInstallationMetadata(theirMessenger.installationID, &multidevice.InstallationMetadata{
+		Name:       "their-name",
+		DeviceType: "their-device-type",
+	})
+	s.Require().NoError(err)
+	_, err = theirMessenger.SendPairInstallation(context.Background())
+	s.Require().NoError(err)
+
+	// Wait for the message to reach its destination
+	err = tt.RetryWithBackOff(func() error {
+		response, err := s.m.RetrieveAll()
+		if err == nil && len(response.Installations) == 0 {
+			err = errors.New("installation not received")
+		}
+		return err
+	})
+	s.Require().NoError(err)
+
+	err = s.m.EnableInstallation(theirMessenger.installationID)
+	s.Require().NoError(err)
+
+	updatedContact, err := s.m.SetContactLocalNickname(contact.ID, " bob ")
+	s.Require().NoError(err)
+	s.Require().Equal("bob", updatedContact.LocalNickname)
+	s.Require().NotZero(updatedContact.LocalNicknameClock)
+
+	// Wait for the nickname to be synced
+	err = tt.RetryWithBackOff(func() error {
+		_, err := theirMessenger.RetrieveAll()
+		if err != nil {
+			return err
+		}
+		syncedContact, err := theirMessenger.GetContactByID(contact.ID)
+		if err == nil && syncedContact.LocalNickname != "bob" {
+			err = errors.New("nickname not synced")
+		}
+		return err
+	})
+	s.Require().NoError(err)
+
+	syncedContact, err := theirMessenger.GetContactByID(contact.ID)
+	s.Require().NoError(err)
+	s.Require().Equal(updatedContact.LocalNicknameClock, syncedContact.LocalNicknameClock)
+	s.Require().Equal("bob", syncedContact.displayName())
+
+	// Saving the contact without changing the nickname keeps its clock
+	contactWithoutClock := *updatedContact
+	contactWithoutClock.LocalNicknameClock = 0
+	err = s.m.SaveContact(&contactWithoutClock)
+	s.Require().NoError(err)
+	s.Require().Equal(updatedContact.LocalNicknameClock, contactWithoutClock.LocalNicknameClock)
+}
+
+func (s *MessengerInstallationSuite) TestSyncLocalNicknameOfContactNotAdded() {
+	contactKey, err := crypto.GenerateKey()
+	s.Require().NoError(err)
+	contact, err := buildContact(&contactKey.PublicKey)
+	s.Require().NoError(err)
+	err = s.m.SaveContact(contact)
+	s.Require().NoError(err)
+
+	// pair
+	theirMessenger := s.newMessengerWithKey(s.shh, s.privateKey)
+
+	err = theirMessenger.SetInstallationMetadata(theirMessenger.installationID, &multidevice.InstallationMetadata{
+		Name:       "their-name",
+		DeviceType: "their-device-type",
+	})
+	s.Require().NoError(err)
+	_, err = theirMessenger.SendPairInstallation(context.Background())
+	s.Require().NoError(err)
+
+	// Wait for the message to reach its destination
+	err = tt.RetryWithBackOff(func() error {
+		response, err := s.m.RetrieveAll()
+		if err == nil && len(response.Installations) == 0 {
+			err = errors.New("installation not received")
+		}
+		return err
+	})
+	s.Require().NoError(err)
+
+	err = s.m.EnableInstallation(theirMessenger.installationID)
+	s.Require().NoError(err)
+
+	updatedContact, err := s.m.SetContactLocalNickname(contact.ID, "bob")
+	s.Require().NoError(err)
+	s.Require().False(updatedContact.IsAdded())
+
+	// Wait for the nickname to be synced
+	err = tt.RetryWithBackOff(func() error {
+		_, err := theirMessenger.RetrieveAll()
+		if err != nil {
+			return err
+		}
+		syncedContact, err := theirMessenger.GetContactByID(contact.ID)
+		if err == nil && syncedContact.LocalNickname != "bob" {
+			err = errors.New("nickname not synced")
+		}
+		return err
+	})
+	s.Require().NoError(err)
+
+	// The contact is not added on the paired device
+	syncedContact, err := theirMessenger.GetContactByID(contact.ID)
+	s.Require().NoError(err)
+	s.Require().False(syncedContact.IsAdded())
+
+	storedContacts, err := theirMessenger.persistence.Contacts()
+	s.Require().NoError(err)
+	for _, c := range storedContacts {
+		if c.ID == contact.ID {
+			s.Require().False(c.IsAdded())
+		}
+	}
+}
+
+func (s *MessengerInstallationSuite) TestSyncAddedContactFromOlderDevice() {
+	// pair
+	theirMessenger := s.newMessengerWithKey(s.shh, s.privateKey)
+
+	err := theirMessenger.SetInstallationMetadata(theirMessenger.installationID, &multidevice.InstallationMetadata{
+		Name:       "their-name",
+		DeviceType: "their-device-type",
+	})
+	s.Require().NoError(err)
+	_, err = theirMessenger.SendPairInstallation(context.Background())
+	s.Require().NoError(err)
+
+	// Wait for the message to reach its destination
+	err = tt.RetryWithBackOff(func() error {
+		response, err := s.m.RetrieveAll()
+		if err == nil && len(response.Installations) == 0 {
+			err = errors.New("installation not received")
+		}
+		return err
+	})
+	s.Require().NoError(err)
+
+	err = s.m.EnableInstallation(theirMessenger.installationID)
+	s.Require().NoError(err)
+
+	// Older devices only sync added contacts, without any contact request
+	// or not_added flag
+	contactKey, err := crypto.GenerateKey()
+	s.Require().NoError(err)
+	contactID := types.EncodeHex(crypto.FromECDSAPub(&contactKey.PublicKey))
+
+	encodedMessage, err := proto.Marshal(&protobuf.SyncInstallationContact{
+		Clock:   1,
+		Id:      contactID,
+		EnsName: "contact.eth",
+	})
+	s.Require().NoError(err)
+	ourChat := OneToOneFromPublicKey(&s.m.identity.PublicKey, s.m.getTimesource())
+	ourChat.Active = false
+	s.Require().NoError(s.m.SaveChat(ourChat))
+	_, err = s.m.dispatchMessage(context.Background(), &RawMessage{
+		LocalChatID: ourChat.ID,
+		Payload:     encodedMessage,
+		MessageType: protobuf.ApplicationMetadataMessage_SYNC_INSTALLATION_CONTACT,
+	})
+	s.Require().NoError(err)
+
+	err = tt.RetryWithBackOff(func() error {
+		_, err := theirMessenger.RetrieveAll()
+		if err != nil {
+			return err
+		}
+		_, err = theirMessenger.GetContactByID(contactID)
+		return err
+	})
+	s.Require().NoError(err)
+
+	syncedContact, err := theirMessenger.GetContactByID(contactID)
+	s.Require().NoError(err)
+	s.Require().True(syncedContact.IsAdded())
+	s.Require().Equal("contact.eth", syncedContact.Name)
 }
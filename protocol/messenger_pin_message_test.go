@@ -0,0 +1,322 @@
+package protocol
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"encoding/hex"
+	"errors"
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/zap"
+
+	gethbridge "github.com/status-im/status-go/eth-node/bridge/geth"
+	"github.com/status-im/status-go/eth-node/crypto"
+	"github.com/status-im/status-go/eth-node/types"
+	"github.com/status-im/status-go/protocol/protobuf"
+	"github.com/status-im/status-go/protocol/tt"
+	"github.com/status-im/status-go/whisper/v6"
+)
+
+func TestMessengerPinMessageSuite(t *testing.T) {
+	suite.Run(t, new(MessengerPinMessageSuite))
+}
+
+type MessengerPinMessageSuite struct {
+	suite.Suite
+	m          *Messenger        // main instance of Messenger
+	privateKey *ecdsa.PrivateKey // private key for the main instance of Messenger
+	// If one wants to send messages between different instances of Messenger,
+	// a single Whisper service should be shared.
+	shh      types.Whisper
+	tmpFiles []*os.File // files to clean up
+	logger   *zap.Logger
+}
+
+func (s *MessengerPinMessageSuite) SetupTest() {
+	s.logger = tt.MustCreateTestLogger()
+
+	config := whisper.DefaultConfig
+	config.MinimumAcceptedPOW = 0
+	shh := whisper.New(&config)
+	s.shh = gethbridge.NewGethWhisperWrapper(shh)
+	s.Require().NoError(shh.Start(nil))
+
+	s.m = s.newMessenger(s.shh)
+	s.privateKey = s.m.identity
+}
+
+func (s *MessengerPinMessageSuite) newMessengerWithKey(shh types.Whisper, privateKey *ecdsa.PrivateKey) *Messenger {
+	tmpFile, err := ioutil.TempFile("", "")
+	s.Require().NoError(err)
+
+	options := []Option{
+		WithCustomLogger(s.logger),
+		WithMessagesPersistenceEnabled(),
+		WithDatabaseConfig(tmpFile.Name(), "some-key"),
+		WithDatasync(),
+	}
+	m, err := NewMessenger(
+		privateKey,
+		&testNode{shh: shh},
+		uuid.New().String(),
+		options...,
+	)
+	s.Require().NoError(err)
+
+	err = m.Init()
+	s.Require().NoError(err)
+
+	s.tmpFiles = append(s.tmpFiles, tmpFile)
+
+	return m
+}
+
+func (s *MessengerPinMessageSuite) newMessenger(shh types.Whisper) *Messenger {
+	privateKey, err := crypto.GenerateKey()
+	s.Require().NoError(err)
+
+	return s.newMessengerWithKey(s.shh, privateKey)
+}
+
+func (s *MessengerPinMessageSuite) TestPinAndUnpinMessage() {
+	theirMessenger := s.newMessenger(s.shh)
+	theirChat := CreatePublicChat("status", s.m.transport)
+	err := theirMessenger.SaveChat(&theirChat)
+	s.Require().NoError(err)
+
+	chat := CreatePublicChat("status", s.m.transport)
+	err = s.m.SaveChat(&chat)
+	s.Require().NoError(err)
+
+	err = s.m.Join(chat)
+	s.Require().NoError(err)
+
+	var messageIDs []string
+	for i := 0; i < 2; i++ {
+		sendResponse, err := theirMessenger.SendChatMessage(context.Background(), buildTestMessage(theirChat))
+		s.Require().NoError(err)
+		messageIDs = append(messageIDs, sendResponse.Messages[0].ID)
+	}
+
+	// Wait for the messages to reach their destination
+	err = tt.RetryWithBackOff(func() error {
+		_, err := s.m.RetrieveAll()
+		if err != nil {
+			return err
+		}
+		messages, _, err := s.m.MessageByChatID(chat.ID, "", 10)
+		if err == nil && len(messages) != 2 {
+			err = errors.New("not all messages received")
+		}
+		return err
+	})
+	s.Require().NoError(err)
+
+	for _, messageID := range messageIDs {
+		sendResponse, err := theirMessenger.PinMessage(context.Background(), messageID)
+		s.Require().NoError(err)
+		s.Require().Len(sendResponse.PinMessages, 1)
+		s.Require().True(sendResponse.PinMessages[0].Pinned)
+	}
+
+	// Wait for the pins to reach their destination
+	err = tt.RetryWithBackOff(func() error {
+		_, err := s.m.RetrieveAll()
+		if err != nil {
+			return err
+		}
+		pinnedMessages, _, err := s.m.PinnedMessagesByChatID(chat.ID, "", 10)
+		if err == nil && len(pinnedMessages) != 2 {
+			err = errors.New("not all pin messages received")
+		}
+		return err
+	})
+	s.Require().NoError(err)
+
+	// Pinned messages are paginated, the most recently pinned first
+	pinnedMessages, cursor, err := s.m.PinnedMessagesByChatID(chat.ID, "", 1)
+	s.Require().NoError(err)
+	s.Require().Len(pinnedMessages, 1)
+	s.Require().NotEmpty(cursor)
+	s.Require().Equal(messageIDs[1], pinnedMessages[0].Message.ID)
+	s.Require().Equal(contactIDFromPublicKey(&theirMessenger.identity.PublicKey), pinnedMessages[0].PinnedBy)
+
+	pinnedMessages, cursor, err = s.m.PinnedMessagesByChatID(chat.ID, cursor, 1)
+	s.Require().NoError(err)
+	s.Require().Len(pinnedMessages, 1)
+	s.Require().Empty(cursor)
+	s.Require().Equal(messageIDs[0], pinnedMessages[0].Message.ID)
+
+	sendResponse, err := theirMessenger.UnpinMessage(context.Background(), messageIDs[0])
+	s.Require().NoError(err)
+	s.Require().Len(sendResponse.PinMessages, 1)
+	s.Require().False(sendResponse.PinMessages[0].Pinned)
+
+	// Wait for the unpin to reach its destination
+	var response *MessengerResponse
+	err = tt.RetryWithBackOff(func() error {
+		var err error
+		response, err = s.m.RetrieveAll()
+		if err == nil && len(response.PinMessages) == 0 {
+			err = errors.New("no pin message")
+		}
+		return err
+	})
+	s.Require().NoError(err)
+	s.Require().Len(response.PinMessages, 1)
+	s.Require().Equal(messageIDs[0], response.PinMessages[0].MessageId)
+	s.Require().False(response.PinMessages[0].Pinned)
+
+	pinnedMessages, _, err = s.m.PinnedMessagesByChatID(chat.ID, "", 10)
+	s.Require().NoError(err)
+	s.Require().Len(pinnedMessages, 1)
+	s.Require().Equal(messageIDs[1], pinnedMessages[0].Message.ID)
+}
+
+func (s *MessengerPinMessageSuite) TestPinReceivedInAnotherChatBeforeMessage() {
+	theirMessenger := s.newMessenger(s.shh)
+	theirChat := CreateOneToOneChat("XXX", &s.privateKey.PublicKey, s.m.transport)
+	err := theirMessenger.SaveChat(&theirChat)
+	s.Require().NoError(err)
+
+	publicChat := CreatePublicChat("status", s.m.transport)
+	err = s.m.SaveChat(&publicChat)
+	s.Require().NoError(err)
+
+	sendResponse, err := theirMessenger.SendChatMessage(context.Background(), buildTestMessage(theirChat))
+	s.Require().NoError(err)
+	message := sendResponse.Messages[0]
+
+	// Someone pinned the message in a public chat before we received it
+	err = s.m.persistence.SavePinMessage(&PinMessage{
+		PinMessage: protobuf.PinMessage{
+			Clock:     message.Clock + 1000,
+			ChatId:    publicChat.ID,
+			MessageId: message.ID,
+			Pinned:    true,
+		},
+		ID:          "pin-elsewhere",
+		From:        "someone",
+		LocalChatID: publicChat.ID,
+	})
+	s.Require().NoError(err)
+
+	err = tt.RetryWithBackOff(func() error {
+		response, err := s.m.RetrieveAll()
+		if err == nil && len(response.Messages) == 0 {
+			err = errors.New("no messages")
+		}
+		return err
+	})
+	s.Require().NoError(err)
+
+	// The pin is dropped once the message is received in its own chat
+	_, err = s.m.persistence.pinMessageBy(message.ID, publicChat.ID)
+	s.Require().Equal(errRecordNotFound, err)
+
+	pinnedMessages, _, err := s.m.PinnedMessagesByChatID(publicChat.ID, "", 10)
+	s.Require().NoError(err)
+	s.Require().Len(pinnedMessages, 0)
+
+	// And it doesn't prevent the message from being pinned in its chat
+	_, err = theirMessenger.PinMessage(context.Background(), message.ID)
+	s.Require().NoError(err)
+
+	err = tt.RetryWithBackOff(func() error {
+		response, err := s.m.RetrieveAll()
+		if err == nil && len(response.PinMessages) == 0 {
+			err = errors.New("no pin message")
+		}
+		return err
+	})
+	s.Require().NoError(err)
+
+	ourChatID := contactIDFromPublicKey(&theirMessenger.identity.PublicKey)
+	pinnedMessages, _, err = s.m.PinnedMessagesByChatID(ourChatID, "", 10)
+	s.Require().NoError(err)
+	s.Require().Len(pinnedMessages, 1)
+	s.Require().Equal(message.ID, pinnedMessages[0].Message.ID)
+
+	pinnedMessages, _, err = s.m.PinnedMessagesByChatID(publicChat.ID, "", 10)
+	s.Require().NoError(err)
+	s.Require().Len(pinnedMessages, 0)
+}
+
+func (s *MessengerPinMessageSuite) TestPinMessageInGroupChatRequiresAdmin() {
+	theirMessenger := s.newMessenger(s.shh)
+	response, err := s.m.CreateGroupChatWithMembers(context.Background(), "id", []string{})
+	s.Require().NoError(err)
+	s.Require().Len(response.Chats, 1)
+	ourChat := response.Chats[0]
+
+	members := []string{"0x" + hex.EncodeToString(crypto.FromECDSAPub(&theirMessenger.identity.PublicKey))}
+	_, err = s.m.AddMembersToGroupChat(context.Background(), ourChat.ID, members)
+	s.Require().NoError(err)
+
+	// Retrieve their messages so that the chat is created
+	err = tt.RetryWithBackOff(func() error {
+		var err error
+		response, err = theirMessenger.RetrieveAll()
+		if err == nil && len(response.Chats) == 0 {
+			err = errors.New("chat invitation not received")
+		}
+		return err
+	})
+	s.Require().NoError(err)
+
+	_, err = theirMessenger.ConfirmJoiningGroup(context.Background(), ourChat.ID)
+	s.Require().NoError(err)
+
+	err = tt.RetryWithBackOff(func() error {
+		var err error
+		response, err = s.m.RetrieveAll()
+		if err == nil && len(response.Chats) == 0 {
+			err = errors.New("no joining group event received")
+		}
+		return err
+	})
+	s.Require().NoError(err)
+
+	sendResponse, err := theirMessenger.SendChatMessage(context.Background(), buildTestMessage(*ourChat))
+	s.Require().NoError(err)
+	messageID := sendResponse.Messages[0].ID
+
+	err = tt.RetryWithBackOff(func() error {
+		var err error
+		response, err = s.m.RetrieveAll()
+		if err == nil && len(response.Messages) == 0 {
+			err = errors.New("no messages")
+		}
+		return err
+	})
+	s.Require().NoError(err)
+
+	// They are not an admin of the group
+	_, err = theirMessenger.PinMessage(context.Background(), messageID)
+	s.Require().Error(err)
+
+	// We are
+	sendResponse, err = s.m.PinMessage(context.Background(), messageID)
+	s.Require().NoError(err)
+	s.Require().Len(sendResponse.PinMessages, 1)
+
+	err = tt.RetryWithBackOff(func() error {
+		var err error
+		response, err = theirMessenger.RetrieveAll()
+		if err == nil && len(response.PinMessages) == 0 {
+			err = errors.New("no pin message")
+		}
+		return err
+	})
+	s.Require().NoError(err)
+
+	pinnedMessages, _, err := theirMessenger.PinnedMessagesByChatID(ourChat.ID, "", 10)
+	s.Require().NoError(err)
+	s.Require().Len(pinnedMessages, 1)
+	s.Require().Equal(messageID, pinnedMessages[0].Message.ID)
+	s.Require().Equal(contactIDFromPublicKey(&s.privateKey.PublicKey), pinnedMessages[0].PinnedBy)
+}
@@ -0,0 +1,266 @@
+package protocol
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"errors"
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/zap"
+
+	gethbridge "github.com/status-im/status-go/eth-node/bridge/geth"
+	"github.com/status-im/status-go/eth-node/crypto"
+	"github.com/status-im/status-go/eth-node/types"
+	"github.com/status-im/status-go/protocol/protobuf"
+	"github.com/status-im/status-go/protocol/tt"
+	"github.com/status-im/status-go/whisper/v6"
+)
+
+func TestMessengerPollsSuite(t *testing.T) {
+	suite.Run(t, new(MessengerPollsSuite))
+}
+
+type MessengerPollsSuite struct {
+	suite.Suite
+	m          *Messenger        // main instance of Messenger
+	privateKey *ecdsa.PrivateKey // private key for the main instance of Messenger
+	// If one wants to send messages between different instances of Messenger,
+	// a single Whisper service should be shared.
+	shh      types.Whisper
+	tmpFiles []*os.File // files to clean up
+	logger   *zap.Logger
+}
+
+func (s *MessengerPollsSuite) SetupTest() {
+	s.logger = tt.MustCreateTestLogger()
+
+	config := whisper.DefaultConfig
+	config.MinimumAcceptedPOW = 0
+	shh := whisper.New(&config)
+	s.shh = gethbridge.NewGethWhisperWrapper(shh)
+	s.Require().NoError(shh.Start(nil))
+
+	s.m = s.newMessenger(s.shh)
+	s.privateKey = s.m.identity
+}
+
+func (s *MessengerPollsSuite) newMessengerWithKey(shh types.Whisper, privateKey *ecdsa.PrivateKey) *Messenger {
+	tmpFile, err := ioutil.TempFile("", "")
+	s.Require().NoError(err)
+
+	options := []Option{
+		WithCustomLogger(s.logger),
+		WithMessagesPersistenceEnabled(),
+		WithDatabaseConfig(tmpFile.Name(), "some-key"),
+		WithDatasync(),
+	}
+	m, err := NewMessenger(
+		privateKey,
+		&testNode{shh: shh},
+		uuid.New().String(),
+		options...,
+	)
+	s.Require().NoError(err)
+
+	err = m.Init()
+	s.Require().NoError(err)
+
+	s.tmpFiles = append(s.tmpFiles, tmpFile)
+
+	return m
+}
+
+func (s *MessengerPollsSuite) newMessenger(shh types.Whisper) *Messenger {
+	privateKey, err := crypto.GenerateKey()
+	s.Require().NoError(err)
+
+	return s.newMessengerWithKey(s.shh, privateKey)
+}
+
+func (s *MessengerPollsSuite) TestVoteOnPoll() {
+	theirMessenger := s.newMessenger(s.shh)
+	theirChat := CreatePublicChat("status", s.m.transport)
+	err := theirMessenger.SaveChat(&theirChat)
+	s.Require().NoError(err)
+
+	chat := CreatePublicChat("status", s.m.transport)
+	err = s.m.SaveChat(&chat)
+	s.Require().NoError(err)
+
+	err = s.m.Join(chat)
+	s.Require().NoError(err)
+
+	_, err = theirMessenger.CreatePoll(context.Background(), theirChat.ID, "question", []string{"only one"}, false, 0)
+	s.Require().Error(err)
+
+	sendResponse, err := theirMessenger.CreatePoll(context.Background(), theirChat.ID, "question", []string{"yes", "no"}, false, 0)
+	s.Require().NoError(err)
+	s.Require().Len(sendResponse.Messages, 1)
+	messageID := sendResponse.Messages[0].ID
+
+	// Wait for the poll to reach its destination
+	var response *MessengerResponse
+	err = tt.RetryWithBackOff(func() error {
+		var err error
+		response, err = s.m.RetrieveAll()
+		if err == nil && len(response.Messages) == 0 {
+			err = errors.New("no messages")
+		}
+		return err
+	})
+	s.Require().NoError(err)
+	s.Require().Equal(protobuf.ChatMessage_POLL, response.Messages[0].ContentType)
+	s.Require().Equal([]string{"yes", "no"}, response.Messages[0].GetPoll().Options)
+
+	// Single choice polls accept one existing option
+	_, err = s.m.Vote(context.Background(), messageID, []uint32{0, 1})
+	s.Require().Error(err)
+	_, err = s.m.Vote(context.Background(), messageID, []uint32{2})
+	s.Require().Error(err)
+
+	sendResponse, err = s.m.Vote(context.Background(), messageID, []uint32{1})
+	s.Require().NoError(err)
+	s.Require().Len(sendResponse.PollResults, 1)
+	s.Require().Equal([]uint32{1}, sendResponse.PollResults[0].OurVote)
+
+	err = tt.RetryWithBackOff(func() error {
+		var err error
+		response, err = theirMessenger.RetrieveAll()
+		if err == nil && len(response.PollResults) == 0 {
+			err = errors.New("no votes")
+		}
+		return err
+	})
+	s.Require().NoError(err)
+	s.Require().Equal([]uint{0, 1}, response.PollResults[0].Votes)
+
+	// Only the latest vote counts
+	_, err = s.m.Vote(context.Background(), messageID, []uint32{0})
+	s.Require().NoError(err)
+
+	err = tt.RetryWithBackOff(func() error {
+		var err error
+		response, err = theirMessenger.RetrieveAll()
+		if err == nil && len(response.PollResults) == 0 {
+			err = errors.New("no votes")
+		}
+		return err
+	})
+	s.Require().NoError(err)
+
+	results, err := theirMessenger.PollResults(messageID)
+	s.Require().NoError(err)
+	s.Require().Equal([]uint{1, 0}, results.Votes)
+	s.Require().Equal(uint(1), results.Voters)
+	s.Require().Empty(results.OurVote)
+}
+
+func (s *MessengerPollsSuite) TestPollInGroupChatRequiresMembership() {
+	theirMessenger := s.newMessenger(s.shh)
+	theirID := contactIDFromPublicKey(&theirMessenger.identity.PublicKey)
+
+	response, err := s.m.CreateGroupChatWithMembers(context.Background(), "id", []string{theirID})
+	s.Require().NoError(err)
+	ourChat := response.Chats[0]
+
+	err = tt.RetryWithBackOff(func() error {
+		var err error
+		response, err = theirMessenger.RetrieveAll()
+		if err == nil && len(response.Chats) == 0 {
+			err = errors.New("chat invitation not received")
+		}
+		return err
+	})
+	s.Require().NoError(err)
+
+	_, err = theirMessenger.ConfirmJoiningGroup(context.Background(), ourChat.ID)
+	s.Require().NoError(err)
+
+	err = tt.RetryWithBackOff(func() error {
+		var err error
+		response, err = s.m.RetrieveAll()
+		if err == nil && len(response.Chats) == 0 {
+			err = errors.New("no joining group event received")
+		}
+		return err
+	})
+	s.Require().NoError(err)
+
+	sendResponse, err := s.m.CreatePoll(context.Background(), ourChat.ID, "question", []string{"a", "b", "c"}, true, 0)
+	s.Require().NoError(err)
+	messageID := sendResponse.Messages[0].ID
+
+	err = tt.RetryWithBackOff(func() error {
+		var err error
+		response, err = theirMessenger.RetrieveAll()
+		if err == nil && len(response.Messages) == 0 {
+			err = errors.New("no messages")
+		}
+		return err
+	})
+	s.Require().NoError(err)
+
+	// Multiple choice polls accept several options
+	_, err = theirMessenger.Vote(context.Background(), messageID, []uint32{0, 2})
+	s.Require().NoError(err)
+
+	err = tt.RetryWithBackOff(func() error {
+		var err error
+		response, err = s.m.RetrieveAll()
+		if err == nil && len(response.PollResults) == 0 {
+			err = errors.New("no votes")
+		}
+		return err
+	})
+	s.Require().NoError(err)
+	s.Require().Equal([]uint{1, 0, 1}, response.PollResults[0].Votes)
+
+	// Once removed from the chat they can't vote anymore
+	_, err = s.m.RemoveMemberFromGroupChat(context.Background(), ourChat.ID, theirID)
+	s.Require().NoError(err)
+
+	err = tt.RetryWithBackOff(func() error {
+		var err error
+		response, err = theirMessenger.RetrieveAll()
+		if err == nil && len(response.Chats) == 0 {
+			err = errors.New("removal not received")
+		}
+		return err
+	})
+	s.Require().NoError(err)
+
+	_, err = theirMessenger.Vote(context.Background(), messageID, []uint32{1})
+	s.Require().Error(err)
+}
+
+func TestTallyPoll(t *testing.T) {
+	poll := &protobuf.PollMessage{
+		Question: "question",
+		Options:  []string{"a", "b"},
+		ClosesAt: 100,
+	}
+	votes := []*PollVote{
+		{PollVote: protobuf.PollVote{Options: []uint32{0}}, From: "0x01", Timestamp: 10},
+		{PollVote: protobuf.PollVote{Options: []uint32{1}}, From: "0x02", Timestamp: 20},
+		// Sent after the poll closed
+		{PollVote: protobuf.PollVote{Options: []uint32{1}}, From: "0x03", Timestamp: 200},
+		// Unknown option
+		{PollVote: protobuf.PollVote{Options: []uint32{2}}, From: "0x04", Timestamp: 30},
+		// Several options in a single choice poll
+		{PollVote: protobuf.PollVote{Options: []uint32{0, 1}}, From: "0x05", Timestamp: 40},
+	}
+
+	results := tallyPoll("message-id", poll, votes, "0x02", 50)
+	require.Equal(t, []uint{1, 1}, results.Votes)
+	require.Equal(t, uint(2), results.Voters)
+	require.Equal(t, []uint32{1}, results.OurVote)
+	require.False(t, results.Closed)
+
+	results = tallyPoll("message-id", poll, votes, "0x02", 150)
+	require.True(t, results.Closed)
+}
@@ -6,8 +6,15 @@ import (
 	"encoding/hex"
 	"encoding/json"
 	"errors"
+	"fmt"
+	"image"
+	"image/color"
+	"image/png"
 	"io/ioutil"
 	"math/big"
+	"math/rand"
+	"net/http"
+	"net/http/httptest"
 	"os"
 	"strconv"
 	"strings"
@@ -24,6 +31,7 @@ import (
 	"github.com/status-im/status-go/eth-node/crypto"
 	"github.com/status-im/status-go/eth-node/types"
 	enstypes "github.com/status-im/status-go/eth-node/types/ens"
+	"github.com/status-im/status-go/protocol/identity/alias"
 	"github.com/status-im/status-go/protocol/protobuf"
 	"github.com/status-im/status-go/protocol/tt"
 	v1protocol "github.com/status-im/status-go/protocol/v1"
@@ -235,7 +243,8 @@ func (s *MessengerSuite) TestInit() {
 				err = s.m.SaveContact(&contact)
 				s.Require().NoError(err)
 			},
-			AddedFilters: 1,
+			// The contact's own filter and the one of their timeline
+			AddedFilters: 2,
 		},
 		{
 			Name: "added and blocked contact",
@@ -299,6 +308,8 @@ func buildTestMessage(chat Chat) *Message {
 		message.MessageType = protobuf.ChatMessage_ONE_TO_ONE
 	case ChatTypePrivateGroupChat:
 		message.MessageType = protobuf.ChatMessage_PRIVATE_GROUP
+	case ChatTypeCommunityChat:
+		message.MessageType = protobuf.ChatMessage_COMMUNITY_CHAT
 	}
 
 	return message
@@ -307,11 +318,13 @@ func buildTestMessage(chat Chat) *Message {
 func (s *MessengerSuite) TestMarkMessagesSeen() {
 	chat := CreatePublicChat("test-chat", s.m.transport)
 	chat.UnviewedMessagesCount = 2
+	chat.UnviewedMentionsCount = 1
 	err := s.m.SaveChat(&chat)
 	s.Require().NoError(err)
 	inputMessage1 := buildTestMessage(chat)
 	inputMessage1.ID = "1"
 	inputMessage1.Seen = false
+	inputMessage1.Mentioned = true
 	inputMessage2 := buildTestMessage(chat)
 	inputMessage2.ID = "2"
 	inputMessage2.Seen = false
@@ -325,16 +338,159 @@ func (s *MessengerSuite) TestMarkMessagesSeen() {
 	chats := s.m.Chats()
 	s.Require().Len(chats, 1)
 	s.Require().Equal(uint(1), chats[0].UnviewedMessagesCount)
+	s.Require().Equal(uint(0), chats[0].UnviewedMentionsCount)
+}
+
+type testReadReceiptsSettings struct {
+	enabled bool
+	clock   uint64
+}
+
+func (s *testReadReceiptsSettings) GetSendReadReceipts() (bool, error) {
+	return s.enabled, nil
+}
+
+func (s *testReadReceiptsSettings) GetSendReadReceiptsClock() (uint64, error) {
+	return s.clock, nil
+}
+
+func (s *testReadReceiptsSettings) SetSendReadReceipts(enabled bool, clock uint64) error {
+	s.enabled = enabled
+	s.clock = clock
+	return nil
+}
+
+func (s *MessengerSuite) TestReadReceipts() {
+	theirMessenger := s.newMessenger(s.shh)
+	theirChat := CreateOneToOneChat("XXX", &s.privateKey.PublicKey, s.m.transport)
+	err := theirMessenger.SaveChat(&theirChat)
+	s.Require().NoError(err)
+
+	s.m.readReceiptsSettings = &testReadReceiptsSettings{enabled: true}
+
+	var sentIDs []string
+	for i := 0; i < 2; i++ {
+		sendResponse, err := theirMessenger.SendChatMessage(context.Background(), buildTestMessage(theirChat))
+		s.Require().NoError(err)
+		sentIDs = append(sentIDs, sendResponse.Messages[0].ID)
+	}
+
+	var receivedMessages []*Message
+	err = tt.RetryWithBackOff(func() error {
+		response, err := s.m.RetrieveAll()
+		if err != nil {
+			return err
+		}
+		receivedMessages = append(receivedMessages, response.Messages...)
+		if len(receivedMessages) != 2 {
+			return errors.New("not all messages received")
+		}
+		return nil
+	})
+	s.Require().NoError(err)
+
+	chatID := receivedMessages[0].LocalChatID
+	err = s.m.MarkMessagesSeen(chatID, sentIDs)
+	s.Require().NoError(err)
+
+	// Wait for the read receipt to reach its destination
+	var seenMessages []*Message
+	err = tt.RetryWithBackOff(func() error {
+		response, err := theirMessenger.RetrieveAll()
+		if err != nil {
+			return err
+		}
+		seenMessages = append(seenMessages, response.Messages...)
+		if len(seenMessages) != 2 {
+			return errors.New("no read receipt received")
+		}
+		return nil
+	})
+	s.Require().NoError(err)
+
+	for _, message := range seenMessages {
+		s.Require().Equal(OutgoingStatusSeen, message.OutgoingStatus)
+	}
+	for _, id := range sentIDs {
+		storedMessage, err := theirMessenger.MessageByID(id)
+		s.Require().NoError(err)
+		s.Require().Equal(OutgoingStatusSeen, storedMessage.OutgoingStatus)
+	}
+
+	// Read receipts are only sent for messages not seen yet
+	receiptIDs, err := s.m.readReceiptMessageIDs(chatID, sentIDs)
+	s.Require().NoError(err)
+	s.Require().Empty(receiptIDs)
+
+	// Nor when they are disabled
+	err = s.m.SetSendReadReceipts(context.Background(), false)
+	s.Require().NoError(err)
+	sendResponse, err := theirMessenger.SendChatMessage(context.Background(), buildTestMessage(theirChat))
+	s.Require().NoError(err)
+	err = tt.RetryWithBackOff(func() error {
+		response, err := s.m.RetrieveAll()
+		if err == nil && len(response.Messages) == 0 {
+			err = errors.New("no messages")
+		}
+		return err
+	})
+	s.Require().NoError(err)
+	receiptIDs, err = s.m.readReceiptMessageIDs(chatID, []string{sendResponse.Messages[0].ID})
+	s.Require().NoError(err)
+	s.Require().Empty(receiptIDs)
+}
+
+func (s *MessengerSuite) TestSendTypingStatus() {
+	theirMessenger := s.newMessenger(s.shh)
+	theirChat := CreateOneToOneChat("XXX", &s.privateKey.PublicKey, s.m.transport)
+	err := theirMessenger.SaveChat(&theirChat)
+	s.Require().NoError(err)
+
+	ourChat := CreateOneToOneChat("YYY", &theirMessenger.identity.PublicKey, s.m.transport)
+	err = s.m.SaveChat(&ourChat)
+	s.Require().NoError(err)
+
+	err = theirMessenger.SendTypingStatus(theirChat.ID, true)
+	s.Require().NoError(err)
+
+	var response *MessengerResponse
+	err = tt.RetryWithBackOff(func() error {
+		var err error
+		response, err = s.m.RetrieveAll()
+		if err == nil && len(response.TypingStatuses) == 0 {
+			err = errors.New("no typing status")
+		}
+		return err
+	})
+	s.Require().NoError(err)
+
+	s.Require().Len(response.TypingStatuses, 1)
+	status := response.TypingStatuses[0]
+	s.Require().Equal(ourChat.ID, status.LocalChatID)
+	s.Require().Equal(contactIDFromPublicKey(&theirMessenger.identity.PublicKey), status.From)
+	s.Require().True(status.Typing)
+
+	// Typing statuses are never persisted as messages
+	s.Require().Len(response.Messages, 0)
+
+	// Typing statuses are not supported in public chats
+	publicChat := CreatePublicChat("status", s.m.transport)
+	err = theirMessenger.SaveChat(&publicChat)
+	s.Require().NoError(err)
+	err = theirMessenger.SendTypingStatus(publicChat.ID, true)
+	s.Require().Error(err)
 }
 
 func (s *MessengerSuite) TestMarkAllRead() {
 	chat := CreatePublicChat("test-chat", s.m.transport)
 	chat.UnviewedMessagesCount = 2
+	chat.UnviewedMentionsCount = 1
 	err := s.m.SaveChat(&chat)
 	s.Require().NoError(err)
 	inputMessage1 := buildTestMessage(chat)
 	inputMessage1.ID = "1"
 	inputMessage1.Seen = false
+	inputMessage1.Mentioned = true
 	inputMessage2 := buildTestMessage(chat)
 	inputMessage2.ID = "2"
 	inputMessage2.Seen = false
@@ -348,6 +504,13 @@ func (s *MessengerSuite) TestMarkAllRead() {
 	chats := s.m.Chats()
 	s.Require().Len(chats, 1)
 	s.Require().Equal(uint(0), chats[0].UnviewedMessagesCount)
+	s.Require().Equal(uint(0), chats[0].UnviewedMentionsCount)
+
+	// The counters are persisted as well
+	chat2, err := s.m.persistence.Chat(chat.ID)
+	s.Require().NoError(err)
+	s.Require().Equal(uint(0), chat2.UnviewedMessagesCount)
+	s.Require().Equal(uint(0), chat2.UnviewedMentionsCount)
 }
 
 func (s *MessengerSuite) TestSendPublic() {
@@ -542,6 +705,146 @@ func (s *MessengerSuite) TestRetrieveTheirPublic() {
 	s.Require().NotNil(actualChat.LastMessage)
 }
 
+func (s *MessengerSuite) TestRetrieveTheirPublicChatNotifications() {
+	theirMessenger := s.newMessenger(s.shh)
+	theirChat := CreatePublicChat("status", s.m.transport)
+	err := theirMessenger.SaveChat(&theirChat)
+	s.Require().NoError(err)
+
+	chat := CreatePublicChat("status", s.m.transport)
+	err = s.m.SaveChat(&chat)
+	s.Require().NoError(err)
+
+	err = s.m.Join(chat)
+	s.Require().NoError(err)
+
+	retrieveNotification := func() bool {
+		var response *MessengerResponse
+		err := tt.RetryWithBackOff(func() error {
+			var err error
+			response, err = s.m.RetrieveAll()
+			if err == nil && len(response.Messages) == 0 {
+				err = errors.New("no messages")
+			}
+			return err
+		})
+		s.Require().NoError(err)
+		notify, ok := response.Notifications[chat.ID]
+		s.Require().True(ok)
+		return notify
+	}
+
+	_, err = theirMessenger.SendChatMessage(context.Background(), buildTestMessage(theirChat))
+	s.Require().NoError(err)
+	s.Require().True(retrieveNotification())
+
+	_, err = s.m.MuteChat(context.Background(), chat.ID, 0)
+	s.Require().NoError(err)
+
+	_, err = theirMessenger.SendChatMessage(context.Background(), buildTestMessage(theirChat))
+	s.Require().NoError(err)
+	s.Require().False(retrieveNotification())
+
+	_, err = s.m.UnmuteChat(context.Background(), chat.ID)
+	s.Require().NoError(err)
+	_, err = s.m.SetChatNotificationLevel(context.Background(), chat.ID, ChatNotificationLevelMentions)
+	s.Require().NoError(err)
+
+	_, err = theirMessenger.SendChatMessage(context.Background(), buildTestMessage(theirChat))
+	s.Require().NoError(err)
+	s.Require().False(retrieveNotification())
+
+	inputMessage := buildTestMessage(theirChat)
+	inputMessage.Text = "hey @" + contactIDFromPublicKey(&s.privateKey.PublicKey)
+	_, err = theirMessenger.SendChatMessage(context.Background(), inputMessage)
+	s.Require().NoError(err)
+	s.Require().True(retrieveNotification())
+
+	// Invalid levels are rejected
+	_, err = s.m.SetChatNotificationLevel(context.Background(), chat.ID, ChatNotificationLevel(10))
+	s.Require().Error(err)
+}
+
+func (s *MessengerSuite) TestRetrieveTheirPublicMention() {
+	theirMessenger := s.newMessenger(s.shh)
+	theirChat := CreatePublicChat("status", s.m.transport)
+	err := theirMessenger.SaveChat(&theirChat)
+	s.Require().NoError(err)
+
+	chat := CreatePublicChat("status", s.m.transport)
+	err = s.m.SaveChat(&chat)
+	s.Require().NoError(err)
+
+	err = s.m.Join(chat)
+	s.Require().NoError(err)
+
+	myID := contactIDFromPublicKey(&s.privateKey.PublicKey)
+	myAlias, err := alias.GenerateFromPublicKeyString(myID)
+	s.Require().NoError(err)
+
+	inputMessage := buildTestMessage(theirChat)
+	inputMessage.Text = "hey @" + myID + " look at this"
+	_, err = theirMessenger.SendChatMessage(context.Background(), inputMessage)
+	s.Require().NoError(err)
+
+	inputMessage = buildTestMessage(theirChat)
+	inputMessage.Text = "not for you"
+	_, err = theirMessenger.SendChatMessage(context.Background(), inputMessage)
+	s.Require().NoError(err)
+
+	// Wait for the messages to reach their destination
+	var messages []*Message
+	err = tt.RetryWithBackOff(func() error {
+		response, err := s.m.RetrieveAll()
+		if err != nil {
+			return err
+		}
+		messages = append(messages, response.Messages...)
+		if len(messages) != 2 {
+			return errors.New("not all messages received")
+		}
+		return nil
+	})
+	s.Require().NoError(err)
+
+	var mentionMessage *Message
+	for _, message := range messages {
+		if message.Mentioned {
+			s.Require().Nil(mentionMessage)
+			mentionMessage = message
+		}
+	}
+	s.Require().NotNil(mentionMessage)
+
+	// The mention is resolved to our name in the parsed text
+	var parsedText []map[string]interface{}
+	s.Require().NoError(json.Unmarshal(mentionMessage.ParsedText, &parsedText))
+	s.Require().Len(parsedText, 1)
+	children := parsedText[0]["children"].([]interface{})
+	s.Require().Len(children, 3)
+	mention := children[1].(map[string]interface{})
+	s.Require().Equal("mention", mention["type"])
+	s.Require().Equal(myID, mention["literal"])
+	s.Require().Equal(myAlias, mention["name"])
+
+	storedMessage, err := s.m.MessageByID(mentionMessage.ID)
+	s.Require().NoError(err)
+	s.Require().True(storedMessage.Mentioned)
+
+	actualChat, err := s.m.persistence.Chat(chat.ID)
+	s.Require().NoError(err)
+	s.Require().Equal(uint(2), actualChat.UnviewedMessagesCount)
+	s.Require().Equal(uint(1), actualChat.UnviewedMentionsCount)
+
+	err = s.m.MarkMessagesSeen(chat.ID, []string{mentionMessage.ID})
+	s.Require().NoError(err)
+
+	actualChat, err = s.m.persistence.Chat(chat.ID)
+	s.Require().NoError(err)
+	s.Require().Equal(uint(1), actualChat.UnviewedMessagesCount)
+	s.Require().Equal(uint(0), actualChat.UnviewedMentionsCount)
+}
+
 func (s *MessengerSuite) TestDeletedAtClockValue() {
 	theirMessenger := s.newMessenger(s.shh)
 	theirChat := CreatePublicChat("status", s.m.transport)
@@ -709,26 +1012,18 @@ func (s *MessengerSuite) TestRetrieveTheirPrivateChatExisting() {
 	s.Require().True(actualChat.Active)
 }
 
-// Test receiving a message on an non-existing private chat
-func (s *MessengerSuite) TestRetrieveTheirPrivateChatNonExisting() {
+func (s *MessengerSuite) TestForwardMessage() {
 	theirMessenger := s.newMessenger(s.shh)
-	chat := CreateOneToOneChat("XXX", &s.privateKey.PublicKey, s.m.transport)
-	err := theirMessenger.SaveChat(&chat)
-	s.NoError(err)
-
-	inputMessage := buildTestMessage(chat)
-
-	sendResponse, err := theirMessenger.SendChatMessage(context.Background(), inputMessage)
-	s.NoError(err)
-	s.Require().Len(sendResponse.Messages, 1)
+	theirChat := CreateOneToOneChat("XXX", &s.privateKey.PublicKey, s.m.transport)
+	err := theirMessenger.SaveChat(&theirChat)
+	s.Require().NoError(err)
 
+	sendResponse, err := theirMessenger.SendChatMessage(context.Background(), buildTestMessage(theirChat))
+	s.Require().NoError(err)
 	sentMessage := sendResponse.Messages[0]
 
-	// Wait for the message to reach its destination
-	var response *MessengerResponse
 	err = tt.RetryWithBackOff(func() error {
-		var err error
-		response, err = s.m.RetrieveAll()
+		response, err := s.m.RetrieveAll()
 		if err == nil && len(response.Messages) == 0 {
 			err = errors.New("no messages")
 		}
@@ -736,701 +1031,2327 @@ func (s *MessengerSuite) TestRetrieveTheirPrivateChatNonExisting() {
 	})
 	s.Require().NoError(err)
 
-	s.Require().Len(response.Chats, 1)
-	actualChat := response.Chats[0]
-	// It updates the unviewed messages count
-	s.Require().Equal(uint(1), actualChat.UnviewedMessagesCount)
-	// It updates the last message clock value
-	s.Require().Equal(sentMessage.Clock, actualChat.LastClockValue)
-	// It sets the last message
-	s.Require().NotNil(actualChat.LastMessage)
-	// It sets the chat as active
-	s.Require().True(actualChat.Active)
-}
+	recipient := s.newMessenger(s.shh)
+	ourChat := CreateOneToOneChat("YYY", &recipient.identity.PublicKey, s.m.transport)
+	err = s.m.SaveChat(&ourChat)
+	s.Require().NoError(err)
 
-// Test receiving a message on an non-existing public chat
-func (s *MessengerSuite) TestRetrieveTheirPublicChatNonExisting() {
-	theirMessenger := s.newMessenger(s.shh)
-	chat := CreatePublicChat("test-chat", s.m.transport)
-	err := theirMessenger.SaveChat(&chat)
-	s.NoError(err)
+	// The message is not forwarded to unknown chats
+	_, err = s.m.ForwardMessage(context.Background(), sentMessage.ID, []string{ourChat.ID, "unknown"})
+	s.Require().Error(err)
 
-	inputMessage := buildTestMessage(chat)
+	forwardResponse, err := s.m.ForwardMessage(context.Background(), sentMessage.ID, []string{ourChat.ID})
+	s.Require().NoError(err)
+	s.Require().Len(forwardResponse.Messages, 1)
+	forwardedMessage := forwardResponse.Messages[0]
+	s.Require().NotEqual(sentMessage.ID, forwardedMessage.ID)
+	s.Require().Equal(sentMessage.Text, forwardedMessage.Text)
+	s.Require().NotNil(forwardedMessage.ForwardedFrom)
+	s.Require().Equal(sentMessage.ID, forwardedMessage.ForwardedFrom.MessageId)
+	s.Require().Equal(sentMessage.From, forwardedMessage.ForwardedFrom.From)
+	s.Require().True(forwardedMessage.ForwardedFromVerified)
+
+	var rendered map[string]interface{}
+	encoded, err := json.Marshal(forwardedMessage)
+	s.Require().NoError(err)
+	s.Require().NoError(json.Unmarshal(encoded, &rendered))
+	attribution, ok := rendered["forwardedFrom"].(map[string]interface{})
+	s.Require().True(ok)
+	s.Require().Equal(sentMessage.From, attribution["from"])
+	s.Require().NotEmpty(attribution["alias"])
+	s.Require().Equal(true, attribution["verified"])
 
-	sendResponse, err := theirMessenger.SendChatMessage(context.Background(), inputMessage)
-	s.NoError(err)
-	s.Require().Len(sendResponse.Messages, 1)
+	var response *MessengerResponse
+	err = tt.RetryWithBackOff(func() error {
+		var err error
+		response, err = recipient.RetrieveAll()
+		if err == nil && len(response.Messages) == 0 {
+			err = errors.New("no forwarded messages")
+		}
+		return err
+	})
+	s.Require().NoError(err)
 
-	// Wait for the message to reach its destination
-	time.Sleep(100 * time.Millisecond)
-	response, err := s.m.RetrieveAll()
-	s.NoError(err)
+	s.Require().Len(response.Messages, 1)
+	receivedMessage := response.Messages[0]
+	s.Require().Equal(forwardedMessage.ID, receivedMessage.ID)
+	s.Require().NotNil(receivedMessage.ForwardedFrom)
+	s.Require().Equal(sentMessage.ID, receivedMessage.ForwardedFrom.MessageId)
+	s.Require().Equal(sentMessage.From, receivedMessage.ForwardedFrom.From)
+	// The recipient doesn't know the original message
+	s.Require().False(receivedMessage.ForwardedFromVerified)
+
+	storedMessage, err := recipient.MessageByID(receivedMessage.ID)
+	s.Require().NoError(err)
+	s.Require().NotNil(storedMessage.ForwardedFrom)
+	s.Require().Equal(sentMessage.Timestamp, storedMessage.ForwardedFrom.Timestamp)
+	s.Require().False(storedMessage.ForwardedFromVerified)
 
-	s.Require().Equal(len(response.Messages), 0)
-	s.Require().Equal(len(response.Chats), 0)
+	markdown, err := recipient.ExportChat(storedMessage.LocalChatID, ExportFormatMarkdown)
+	s.Require().NoError(err)
+	s.Require().Contains(string(markdown), "(unverified)")
+	s.Require().NotContains(string(markdown), "Forwarded from")
 }
 
-// Test receiving a message on an non-existing private public chat
-func (s *MessengerSuite) TestRetrieveTheirGroupChatNonExisting() {
+func (s *MessengerSuite) TestForwardedMessageAttributionIsVerified() {
 	theirMessenger := s.newMessenger(s.shh)
-	response, err := s.m.CreateGroupChatWithMembers(context.Background(), "test", []string{})
-	s.NoError(err)
-	s.Require().Len(response.Chats, 1)
+	theirChat := CreateOneToOneChat("XXX", &s.privateKey.PublicKey, s.m.transport)
+	err := theirMessenger.SaveChat(&theirChat)
+	s.Require().NoError(err)
 
-	chat := response.Chats[0]
+	sendResponse, err := theirMessenger.SendChatMessage(context.Background(), buildTestMessage(theirChat))
+	s.Require().NoError(err)
+	original := sendResponse.Messages[0]
 
-	err = theirMessenger.SaveChat(chat)
-	s.NoError(err)
+	err = tt.RetryWithBackOff(func() error {
+		response, err := s.m.RetrieveAll()
+		if err == nil && len(response.Messages) == 0 {
+			err = errors.New("no messages")
+		}
+		return err
+	})
+	s.Require().NoError(err)
 
-	inputMessage := buildTestMessage(*chat)
+	// Someone else claims to forward the message, once with its content and
+	// once with a different one
+	forwarder := s.newMessenger(s.shh)
+	forwarderChat := CreateOneToOneChat("YYY", &s.privateKey.PublicKey, s.m.transport)
+	err = forwarder.SaveChat(&forwarderChat)
+	s.Require().NoError(err)
 
-	sendResponse, err := theirMessenger.SendChatMessage(context.Background(), inputMessage)
-	s.NoError(err)
-	s.Require().Len(sendResponse.Messages, 1)
+	var forwardedIDs []string
+	for _, text := range []string{original.Text, "words never written"} {
+		message := buildTestMessage(forwarderChat)
+		message.Text = text
+		message.ForwardedFrom = &protobuf.ForwardedFrom{
+			MessageId: original.ID,
+			From:      original.From,
+			Timestamp: original.Timestamp,
+		}
+		sendResponse, err := forwarder.SendChatMessage(context.Background(), message)
+		s.Require().NoError(err)
+		forwardedIDs = append(forwardedIDs, sendResponse.Messages[0].ID)
+	}
 
-	// Retrieve their messages so that the chat is created
+	received := 0
 	err = tt.RetryWithBackOff(func() error {
-		var err error
-		response, err = s.m.RetrieveAll()
-		if err == nil && len(response.Chats) == 1 {
-			err = errors.New("chat membership update not received")
+		response, err := s.m.RetrieveAll()
+		if err != nil {
+			return err
 		}
-		return err
+		received += len(response.Messages)
+		if received < 2 {
+			return errors.New("no forwarded messages")
+		}
+		return nil
 	})
 	s.Require().NoError(err)
 
-	// The message is discarded
-	s.Require().Equal(0, len(response.Messages))
-	s.Require().Equal(0, len(response.Chats))
+	matching, err := s.m.MessageByID(forwardedIDs[0])
+	s.Require().NoError(err)
+	s.Require().NotNil(matching.ForwardedFrom)
+	s.Require().True(matching.ForwardedFromVerified)
+
+	forged, err := s.m.MessageByID(forwardedIDs[1])
+	s.Require().NoError(err)
+	s.Require().NotNil(forged.ForwardedFrom)
+	s.Require().False(forged.ForwardedFromVerified)
 }
 
-// Test receiving a message on an existing private group chat
-func (s *MessengerSuite) TestRetrieveTheirPrivateGroupChat() {
-	var response *MessengerResponse
+func (s *MessengerSuite) TestScheduleChatMessage() {
 	theirMessenger := s.newMessenger(s.shh)
-	response, err := s.m.CreateGroupChatWithMembers(context.Background(), "id", []string{})
-	s.NoError(err)
-	s.Require().Len(response.Chats, 1)
+	theirChat := CreateOneToOneChat("XXX", &s.privateKey.PublicKey, s.m.transport)
+	err := theirMessenger.SaveChat(&theirChat)
+	s.Require().NoError(err)
 
-	ourChat := response.Chats[0]
+	ourChat := CreateOneToOneChat("YYY", &theirMessenger.identity.PublicKey, s.m.transport)
+	err = s.m.SaveChat(&ourChat)
+	s.Require().NoError(err)
 
-	err = s.m.SaveChat(ourChat)
-	s.NoError(err)
+	now := s.m.getTimesource().GetCurrentTime()
 
-	members := []string{"0x" + hex.EncodeToString(crypto.FromECDSAPub(&theirMessenger.identity.PublicKey))}
-	_, err = s.m.AddMembersToGroupChat(context.Background(), ourChat.ID, members)
-	s.NoError(err)
+	// Messages can't be scheduled in the past
+	_, err = s.m.ScheduleChatMessage(buildTestMessage(ourChat), now-1000)
+	s.Require().Error(err)
 
-	// Retrieve their messages so that the chat is created
-	err = tt.RetryWithBackOff(func() error {
-		var err error
-		response, err = theirMessenger.RetrieveAll()
-		if err == nil && len(response.Chats) == 0 {
-			err = errors.New("chat invitation not received")
-		}
-		return err
-	})
+	scheduled, err := s.m.ScheduleChatMessage(buildTestMessage(ourChat), now+60000)
 	s.Require().NoError(err)
+	s.Require().NotEmpty(scheduled.ID)
 
-	_, err = theirMessenger.ConfirmJoiningGroup(context.Background(), ourChat.ID)
-	s.NoError(err)
+	cancelled, err := s.m.ScheduleChatMessage(buildTestMessage(ourChat), now+120000)
+	s.Require().NoError(err)
 
-	err = tt.RetryWithBackOff(func() error {
-		var err error
-		response, err = s.m.RetrieveAll()
-		if err == nil && len(response.Chats) == 0 {
-			err = errors.New("no joining group event received")
-		}
-		return err
-	})
+	scheduledMessages, err := s.m.ScheduledMessages()
 	s.Require().NoError(err)
+	s.Require().Len(scheduledMessages, 2)
+	s.Require().Equal(scheduled.ID, scheduledMessages[0].ID)
+	s.Require().Equal(cancelled.ID, scheduledMessages[1].ID)
 
-	inputMessage := buildTestMessage(*ourChat)
+	s.Require().NoError(s.m.CancelScheduledMessage(cancelled.ID))
+	s.Require().Error(s.m.CancelScheduledMessage(cancelled.ID))
 
-	sendResponse, err := theirMessenger.SendChatMessage(context.Background(), inputMessage)
-	s.NoError(err)
-	s.Require().Len(sendResponse.Messages, 1)
+	edited, err := s.m.EditScheduledMessage(scheduled.ID, "edited-text", now+30000)
+	s.Require().NoError(err)
+	s.Require().Equal("edited-text", edited.Message.Text)
+
+	// Nothing is sent before it's due
+	s.Require().NoError(s.m.sendScheduledMessages())
+	scheduledMessages, err = s.m.ScheduledMessages()
+	s.Require().NoError(err)
+	s.Require().Len(scheduledMessages, 1)
+	s.Require().Equal(uint64(now+30000), scheduledMessages[0].SendAt)
+	s.Require().Equal("edited-text", scheduledMessages[0].Message.Text)
 
+	// A message is sent in the meantime, the scheduled one is ordered after it
+	sendResponse, err := s.m.SendChatMessage(context.Background(), buildTestMessage(ourChat))
+	s.Require().NoError(err)
 	sentMessage := sendResponse.Messages[0]
 
+	// Make the scheduled message due, as if the messenger had been stopped
+	scheduledMessages[0].SendAt = now
+	s.Require().NoError(s.m.persistence.SaveScheduledMessage(scheduledMessages[0]))
+	s.Require().NoError(s.m.sendScheduledMessages())
+
+	scheduledMessages, err = s.m.ScheduledMessages()
+	s.Require().NoError(err)
+	s.Require().Len(scheduledMessages, 0)
+
+	response, err := s.m.RetrieveAll()
+	s.Require().NoError(err)
+	s.Require().Len(response.Messages, 1)
+	scheduledMessage := response.Messages[0]
+	s.Require().Equal("edited-text", scheduledMessage.Text)
+	s.Require().Greater(scheduledMessage.Clock, sentMessage.Clock)
+	s.Require().Len(response.Chats, 1)
+	s.Require().Equal(ourChat.ID, response.Chats[0].ID)
+
+	var receivedMessage *Message
 	err = tt.RetryWithBackOff(func() error {
-		var err error
-		response, err = s.m.RetrieveAll()
-		if err == nil && len(response.Messages) == 0 {
-			err = errors.New("no messages")
+		_, err := theirMessenger.RetrieveAll()
+		if err != nil {
+			return err
 		}
+		receivedMessage, err = theirMessenger.MessageByID(scheduledMessage.ID)
 		return err
 	})
 	s.Require().NoError(err)
+	s.Require().Equal("edited-text", receivedMessage.Text)
+}
+
+func (s *MessengerSuite) TestScheduledMessageFailing() {
+	// A group chat the message can't be sent to
+	chat := &Chat{
+		ID:       "invalid-group-chat",
+		Name:     "invalid",
+		ChatType: ChatTypePrivateGroupChat,
+		Active:   true,
+	}
+	s.Require().NoError(s.m.SaveChat(chat))
+
+	now := s.m.getTimesource().GetCurrentTime()
+	scheduled, err := s.m.ScheduleChatMessage(buildTestMessage(*chat), now+60000)
+	s.Require().NoError(err)
 
-	s.Require().Len(response.Chats, 1)
-	actualChat := response.Chats[0]
-	// It updates the unviewed messages count
-	s.Require().Equal(uint(1), actualChat.UnviewedMessagesCount)
-	// It updates the last message clock value
-	s.Require().Equal(sentMessage.Clock, actualChat.LastClockValue)
-	// It sets the last message
-	s.Require().NotNil(actualChat.LastMessage)
+	scheduled.SendAt = now
+	s.Require().NoError(s.m.persistence.SaveScheduledMessage(scheduled))
+
+	for i := 1; i <= scheduledMessagesMaxAttempts; i++ {
+		s.Require().NoError(s.m.sendScheduledMessages())
+
+		scheduledMessages, err := s.m.ScheduledMessages()
+		s.Require().NoError(err)
+		s.Require().Len(scheduledMessages, 1)
+		scheduled = scheduledMessages[0]
+		s.Require().Equal(i, scheduled.Attempts)
+		s.Require().Equal(i == scheduledMessagesMaxAttempts, scheduled.Failed)
+
+		if !scheduled.Failed {
+			// The next attempt is delayed
+			s.Require().Greater(scheduled.retryAt, now)
+			s.Require().NoError(s.m.sendScheduledMessages())
+			scheduledMessages, err = s.m.ScheduledMessages()
+			s.Require().NoError(err)
+			s.Require().Equal(i, scheduledMessages[0].Attempts)
+
+			scheduled.retryAt = now
+			s.Require().NoError(s.m.persistence.SaveScheduledMessage(scheduled))
+		}
+	}
+
+	// The message is reported once it's no longer retried
+	response, err := s.m.RetrieveAll()
+	s.Require().NoError(err)
+	s.Require().Len(response.FailedScheduledMessages, 1)
+	s.Require().Equal(scheduled.ID, response.FailedScheduledMessages[0].ID)
+
+	// Failed messages are no longer retried
+	s.Require().NoError(s.m.sendScheduledMessages())
+	scheduledMessages, err := s.m.ScheduledMessages()
+	s.Require().NoError(err)
+	s.Require().Len(scheduledMessages, 1)
+	s.Require().True(scheduledMessages[0].Failed)
+	s.Require().Equal(scheduledMessagesMaxAttempts, scheduledMessages[0].Attempts)
+
+	// Editing it schedules it again
+	edited, err := s.m.EditScheduledMessage(scheduled.ID, "", now+60000)
+	s.Require().NoError(err)
+	s.Require().False(edited.Failed)
+	s.Require().Equal(0, edited.Attempts)
 }
 
-// Test receiving a message on an existing private group chat, if messages
-// are not wrapped this will fail as they'll likely come out of order
-// NOTE: Disabling this as too flaky
-/*
-func (s *MessengerSuite) testRetrieveTheirPrivateGroupWrappedMessageChat() {
-	var response *MessengerResponse
+func (s *MessengerSuite) TestScheduledMessageChatGone() {
 	theirMessenger := s.newMessenger(s.shh)
-	response, err := s.m.CreateGroupChatWithMembers(context.Background(), "id", []string{})
-	s.NoError(err)
-	s.Require().Len(response.Chats, 1)
+	gone := CreateOneToOneChat("XXX", &theirMessenger.identity.PublicKey, s.m.transport)
+	s.Require().NoError(s.m.SaveChat(&gone))
+	kept := CreatePublicChat("status", s.m.transport)
+	s.Require().NoError(s.m.SaveChat(&kept))
 
-	ourChat := response.Chats[0]
+	now := s.m.getTimesource().GetCurrentTime()
+	dropped, err := s.m.ScheduleChatMessage(buildTestMessage(gone), now+60000)
+	s.Require().NoError(err)
+	sent, err := s.m.ScheduleChatMessage(buildTestMessage(kept), now+60000)
+	s.Require().NoError(err)
 
-	err = s.m.SaveChat(ourChat)
-	s.NoError(err)
+	s.Require().NoError(s.m.DeleteChat(gone.ID))
 
-	members := []string{"0x" + hex.EncodeToString(crypto.FromECDSAPub(&theirMessenger.identity.PublicKey))}
-	_, err = s.m.AddMembersToGroupChat(context.Background(), ourChat.ID, members)
-	s.NoError(err)
+	dropped.SendAt = now
+	s.Require().NoError(s.m.persistence.SaveScheduledMessage(dropped))
+	sent.SendAt = now
+	s.Require().NoError(s.m.persistence.SaveScheduledMessage(sent))
 
-	// Retrieve their messages so that the chat is created
+	s.Require().NoError(s.m.sendScheduledMessages())
+
+	// The message of the chat that's gone is kept as failed and reported,
+	// the other one is sent
+	scheduledMessages, err := s.m.ScheduledMessages()
+	s.Require().NoError(err)
+	s.Require().Len(scheduledMessages, 1)
+	s.Require().Equal(dropped.ID, scheduledMessages[0].ID)
+	s.Require().True(scheduledMessages[0].Failed)
+
+	response, err := s.m.RetrieveAll()
+	s.Require().NoError(err)
+	s.Require().Len(response.FailedScheduledMessages, 1)
+	s.Require().Equal(dropped.ID, response.FailedScheduledMessages[0].ID)
+	s.Require().Len(response.Messages, 1)
+	s.Require().Equal(kept.ID, response.Messages[0].LocalChatID)
+}
+
+func (s *MessengerSuite) TestDisappearingMessages() {
+	theirMessenger := s.newMessenger(s.shh)
+	theirChat := CreateOneToOneChat("XXX", &s.privateKey.PublicKey, s.m.transport)
+	err := theirMessenger.SaveChat(&theirChat)
+	s.Require().NoError(err)
+
+	ourChat := CreateOneToOneChat("YYY", &theirMessenger.identity.PublicKey, s.m.transport)
+	err = s.m.SaveChat(&ourChat)
+	s.Require().NoError(err)
+
+	// Disappearing messages are not supported in public chats
+	publicChat := CreatePublicChat("status", s.m.transport)
+	err = s.m.SaveChat(&publicChat)
+	s.Require().NoError(err)
+	_, err = s.m.SetDisappearingMessagesTimer(context.Background(), publicChat.ID, 1)
+	s.Require().Error(err)
+
+	// Messages disappear right away, so that they can be deleted during the test
+	settingResponse, err := s.m.SetDisappearingMessagesTimer(context.Background(), ourChat.ID, 1)
+	s.Require().NoError(err)
+	s.Require().Len(settingResponse.Chats, 1)
+	s.Require().Equal(uint64(1), settingResponse.Chats[0].DisappearingMessagesTimer)
+
+	var response *MessengerResponse
 	err = tt.RetryWithBackOff(func() error {
 		var err error
 		response, err = theirMessenger.RetrieveAll()
 		if err == nil && len(response.Chats) == 0 {
-			err = errors.New("chat invitation not received")
+			err = errors.New("no disappearing messages setting")
 		}
 		return err
 	})
 	s.Require().NoError(err)
+	s.Require().Len(response.Chats, 1)
+	s.Require().Equal(theirChat.ID, response.Chats[0].ID)
+	s.Require().Equal(uint64(1), response.Chats[0].DisappearingMessagesTimer)
 
-	_, err = theirMessenger.ConfirmJoiningGroup(context.Background(), ourChat.ID)
-	s.NoError(err)
-
-	inputMessage := buildTestMessage(*ourChat)
-
-	sendResponse, err := theirMessenger.SendChatMessage(context.Background(), inputMessage)
-	s.NoError(err)
-	s.Require().Len(sendResponse.Messages, 1)
-
+	sendResponse, err := theirMessenger.SendChatMessage(context.Background(), buildTestMessage(theirChat))
+	s.Require().NoError(err)
 	sentMessage := sendResponse.Messages[0]
+	s.Require().Equal(sentMessage.Timestamp+1, sentMessage.ExpiresAt)
 
 	err = tt.RetryWithBackOff(func() error {
 		var err error
 		response, err = s.m.RetrieveAll()
-		if err == nil && len(response.Chats) == 0 {
-			err = errors.New("no chats")
+		if err == nil && len(response.Messages) == 0 {
+			err = errors.New("no messages")
 		}
 		return err
 	})
 	s.Require().NoError(err)
-	s.Require().Len(response.Chats, 1)
-	actualChat := response.Chats[0]
-	// It updates the unviewed messages count
-	s.Require().Equal(uint(1), actualChat.UnviewedMessagesCount)
-	// It updates the last message clock value
-	s.Require().Equal(sentMessage.Clock, actualChat.LastClockValue)
-	// It sets the last message
-	s.Require().NotNil(actualChat.LastMessage)
-}
-*/
+	s.Require().Equal(sentMessage.ExpiresAt, response.Messages[0].ExpiresAt)
 
-func (s *MessengerSuite) TestChatPersistencePublic() {
-	chat := Chat{
-		ID:                    "chat-name",
-		Name:                  "chat-name",
-		Color:                 "#fffff",
-		Active:                true,
-		ChatType:              ChatTypePublic,
-		Timestamp:             10,
-		LastClockValue:        20,
-		DeletedAtClockValue:   30,
-		UnviewedMessagesCount: 40,
-		LastMessage:           []byte("test"),
+	chat, err := s.m.persistence.Chat(ourChat.ID)
+	s.Require().NoError(err)
+	s.Require().NotNil(chat.LastMessage)
+
+	// Reactions and pins go away along with the message
+	emojiReaction := &EmojiReaction{
+		EmojiReaction: protobuf.EmojiReaction{
+			Clock:     1,
+			MessageId: sentMessage.ID,
+			ChatId:    ourChat.ID,
+			Type:      protobuf.EmojiReaction_LOVE,
+		},
+		ID:          "reaction-id",
+		From:        sentMessage.From,
+		LocalChatID: ourChat.ID,
+	}
+	s.Require().NoError(s.m.persistence.SaveEmojiReaction(emojiReaction))
+	pinMessage := &PinMessage{
+		PinMessage: protobuf.PinMessage{
+			Clock:     1,
+			MessageId: sentMessage.ID,
+			ChatId:    ourChat.ID,
+			Pinned:    true,
+		},
+		ID:          "pin-id",
+		From:        sentMessage.From,
+		LocalChatID: ourChat.ID,
 	}
+	s.Require().NoError(s.m.persistence.SavePinMessage(pinMessage))
 
-	s.Require().NoError(s.m.SaveChat(&chat))
-	savedChats := s.m.Chats()
-	s.Require().Equal(1, len(savedChats))
+	s.Require().NoError(s.m.deleteExpiredMessages())
 
-	actualChat := savedChats[0]
-	expectedChat := &chat
+	_, err = s.m.MessageByID(sentMessage.ID)
+	s.Require().Error(err)
+	_, err = s.m.persistence.EmojiReactionByID(emojiReaction.ID)
+	s.Require().Error(err)
+	_, err = s.m.persistence.pinMessageBy(sentMessage.ID, ourChat.ID)
+	s.Require().Equal(errRecordNotFound, err)
 
-	s.Require().Equal(actualChat, expectedChat)
+	response, err = s.m.RetrieveAll()
+	s.Require().NoError(err)
+	s.Require().Equal([]string{sentMessage.ID}, response.RemovedMessages)
+	s.Require().Len(response.Chats, 1)
+	s.Require().Equal(ourChat.ID, response.Chats[0].ID)
+	s.Require().Nil(response.Chats[0].LastMessage)
+
+	// The sender deletes the message as well, along with its raw message
+	s.Require().NoError(theirMessenger.deleteExpiredMessages())
+	_, err = theirMessenger.MessageByID(sentMessage.ID)
+	s.Require().Error(err)
+	_, err = theirMessenger.persistence.RawMessageByID(sentMessage.ID)
+	s.Require().Error(err)
 }
 
-func (s *MessengerSuite) TestDeleteChat() {
-	chatID := "chatid"
-	chat := Chat{
-		ID:                    chatID,
-		Name:                  "chat-name",
-		Color:                 "#fffff",
-		Active:                true,
-		ChatType:              ChatTypePublic,
-		Timestamp:             10,
-		LastClockValue:        20,
-		DeletedAtClockValue:   30,
-		UnviewedMessagesCount: 40,
-		LastMessage:           []byte("test"),
-	}
+func (s *MessengerSuite) TestExportImportChat() {
+	chat := CreatePublicChat("status", s.m.transport)
+	err := s.m.SaveChat(&chat)
+	s.Require().NoError(err)
 
-	s.Require().NoError(s.m.SaveChat(&chat))
-	savedChats := s.m.Chats()
-	s.Require().Equal(1, len(savedChats))
+	sendResponse, err := s.m.SendChatMessage(context.Background(), buildTestMessage(chat))
+	s.Require().NoError(err)
+	firstMessage := sendResponse.Messages[0]
 
-	s.Require().NoError(s.m.DeleteChat(chatID))
-	savedChats = s.m.Chats()
-	s.Require().Equal(0, len(savedChats))
-}
+	reply := buildTestMessage(chat)
+	reply.Text = "<b>reply</b>"
+	reply.ResponseTo = firstMessage.ID
+	sendResponse, err = s.m.SendChatMessage(context.Background(), reply)
+	s.Require().NoError(err)
+	replyMessage := sendResponse.Messages[0]
 
-func (s *MessengerSuite) TestChatPersistenceUpdate() {
-	chat := Chat{
-		ID:                    "chat-name",
-		Name:                  "chat-name",
-		Color:                 "#fffff",
-		Active:                true,
-		ChatType:              ChatTypePublic,
-		Timestamp:             10,
-		LastClockValue:        20,
-		DeletedAtClockValue:   30,
-		UnviewedMessagesCount: 40,
-		LastMessage:           []byte("test"),
-	}
+	_, err = s.m.ExportChat(chat.ID, "pdf")
+	s.Require().Error(err)
 
-	s.Require().NoError(s.m.SaveChat(&chat))
-	savedChats := s.m.Chats()
-	s.Require().Equal(1, len(savedChats))
+	markdown, err := s.m.ExportChat(chat.ID, ExportFormatMarkdown)
+	s.Require().NoError(err)
+	s.Require().True(strings.HasPrefix(string(markdown), "# status\n"))
+	s.Require().Contains(string(markdown), "> **"+firstMessage.Alias+"**: text-input-message")
+	s.Require().Contains(string(markdown), "<b>reply</b>")
 
-	actualChat := savedChats[0]
-	expectedChat := &chat
+	html, err := s.m.ExportChat(chat.ID, ExportFormatHTML)
+	s.Require().NoError(err)
+	s.Require().Contains(string(html), "&lt;b&gt;reply&lt;/b&gt;")
+	s.Require().NotContains(string(html), "<b>reply</b>")
 
-	s.Require().Equal(expectedChat, actualChat)
+	export, err := s.m.ExportChat(chat.ID, ExportFormatJSON)
+	s.Require().NoError(err)
 
-	chat.Name = "updated-name-1"
-	s.Require().NoError(s.m.SaveChat(&chat))
-	updatedChats := s.m.Chats()
-	s.Require().Equal(1, len(updatedChats))
+	theirMessenger := s.newMessenger(s.shh)
+	importResponse, err := theirMessenger.ImportChat(export)
+	s.Require().NoError(err)
+	s.Require().Len(importResponse.Chats, 1)
+	s.Require().Equal(chat.ID, importResponse.Chats[0].ID)
+	s.Require().Len(importResponse.Messages, 2)
 
-	actualUpdatedChat := updatedChats[0]
-	expectedUpdatedChat := &chat
+	// Messages are deduplicated by id
+	importResponse, err = theirMessenger.ImportChat(export)
+	s.Require().NoError(err)
+	s.Require().Len(importResponse.Messages, 0)
 
-	s.Require().Equal(expectedUpdatedChat, actualUpdatedChat)
+	importedMessages, _, err := theirMessenger.MessageByChatID(chat.ID, "", 10)
+	s.Require().NoError(err)
+	s.Require().Len(importedMessages, 2)
+	importedReply := importedMessages[0]
+	s.Require().Equal(replyMessage.ID, importedReply.ID)
+	s.Require().Equal(replyMessage.Text, importedReply.Text)
+	s.Require().Equal(replyMessage.Clock, importedReply.Clock)
+	s.Require().Equal(replyMessage.From, importedReply.From)
+	s.Require().NotNil(importedReply.QuotedMessage)
+	s.Require().Equal("text-input-message", importedReply.QuotedMessage.Text)
+
+	// The import round-trips
+	reexport, err := theirMessenger.ExportChat(chat.ID, ExportFormatJSON)
+	s.Require().NoError(err)
+	var original, reexported ChatExport
+	s.Require().NoError(json.Unmarshal(export, &original))
+	s.Require().NoError(json.Unmarshal(reexport, &reexported))
+	s.Require().Equal(original.Messages, reexported.Messages)
 }
 
-func (s *MessengerSuite) TestChatPersistenceOneToOne() {
-	chat := Chat{
-		ID:                    testPK,
-		Name:                  testPK,
-		Color:                 "#fffff",
-		Active:                true,
-		ChatType:              ChatTypeOneToOne,
-		Timestamp:             10,
-		LastClockValue:        20,
-		DeletedAtClockValue:   30,
-		UnviewedMessagesCount: 40,
-		LastMessage:           []byte("test"),
-	}
-	publicKeyBytes, err := hex.DecodeString(testPK[2:])
-	s.Require().NoError(err)
+type testContactRequestsSettings struct {
+	enabled bool
+}
+
+func (s *testContactRequestsSettings) GetHideNonContactMessages() (bool, error) {
+	return s.enabled, nil
+}
+
+func (s *testContactRequestsSettings) SetHideNonContactMessages(enabled bool) error {
+	s.enabled = enabled
+	return nil
+}
+
+func (s *MessengerSuite) TestContactRequests() {
+	theirMessenger := s.newMessenger(s.shh)
+	theirChat := CreateOneToOneChat("XXX", &s.privateKey.PublicKey, s.m.transport)
+	err := theirMessenger.SaveChat(&theirChat)
+	s.Require().NoError(err)
+
+	s.m.contactRequestsSettings = &testContactRequestsSettings{}
+	err = s.m.SetHideNonContactMessages(true)
+	s.Require().NoError(err)
+
+	ourID := contactIDFromPublicKey(&s.m.identity.PublicKey)
+	theirID := contactIDFromPublicKey(&theirMessenger.identity.PublicKey)
+
+	// Messages from non-contacts are saved hidden
+	sendResponse, err := theirMessenger.SendChatMessage(context.Background(), buildTestMessage(theirChat))
+	s.Require().NoError(err)
+	sentMessage := sendResponse.Messages[0]
+
+	err = tt.RetryWithBackOff(func() error {
+		response, err := s.m.RetrieveAll()
+		if err != nil {
+			return err
+		}
+		if len(response.Messages) != 0 {
+			return errors.New("message from non-contact not hidden")
+		}
+		exist, err := s.m.persistence.MessagesExist([]string{sentMessage.ID})
+		if err == nil && !exist[sentMessage.ID] {
+			err = errors.New("no messages")
+		}
+		return err
+	})
+	s.Require().NoError(err)
+
+	messages, _, err := s.m.MessageByChatID(theirID, "", 10)
+	s.Require().NoError(err)
+	s.Require().Len(messages, 0)
+
+	// Their contact request lands in the pending requests
+	_, err = s.m.SendContactRequest(context.Background(), ourID, "")
+	s.Require().Error(err)
+
+	response, err := theirMessenger.SendContactRequest(context.Background(), ourID, "hi, it's me")
+	s.Require().NoError(err)
+	s.Require().Len(response.Contacts, 1)
+	s.Require().Equal(ContactRequestStateSent, response.Contacts[0].ContactRequestState)
+	s.Require().True(response.Contacts[0].IsAdded())
+
+	err = tt.RetryWithBackOff(func() error {
+		_, err := s.m.RetrieveAll()
+		if err == nil && len(s.m.PendingContactRequests()) == 0 {
+			err = errors.New("no contact request")
+		}
+		return err
+	})
+	s.Require().NoError(err)
+
+	pending := s.m.PendingContactRequests()
+	s.Require().Len(pending, 1)
+	s.Require().Equal(theirID, pending[0].ID)
+	s.Require().Equal("hi, it's me", pending[0].ContactRequestText)
+	s.Require().True(pending[0].HasBeenAdded())
+	s.Require().False(pending[0].IsAdded())
+
+	// Accepting it shows their messages
+	response, err = s.m.AcceptContactRequest(context.Background(), theirID)
+	s.Require().NoError(err)
+	s.Require().Len(response.Contacts, 1)
+	s.Require().Equal(ContactRequestStateAccepted, response.Contacts[0].ContactRequestState)
+	s.Require().True(response.Contacts[0].IsAdded())
+	s.Require().Len(response.Chats, 1)
+	s.Require().True(response.Chats[0].Active)
+	s.Require().Equal(uint(1), response.Chats[0].UnviewedMessagesCount)
+	s.Require().NotNil(response.Chats[0].LastMessage)
+	s.Require().Len(s.m.PendingContactRequests(), 0)
+
+	messages, _, err = s.m.MessageByChatID(theirID, "", 10)
+	s.Require().NoError(err)
+	s.Require().Len(messages, 1)
+	s.Require().Equal(sentMessage.ID, messages[0].ID)
+
+	err = tt.RetryWithBackOff(func() error {
+		_, err := theirMessenger.RetrieveAll()
+		if err != nil {
+			return err
+		}
+		contact, err := theirMessenger.GetContactByID(ourID)
+		if err == nil && contact.ContactRequestState != ContactRequestStateAccepted {
+			err = errors.New("contact request not accepted")
+		}
+		return err
+	})
+	s.Require().NoError(err)
+
+	// Retracting it removes them from the contacts who added us
+	_, err = theirMessenger.RetractContactRequest(context.Background(), ourID)
+	s.Require().NoError(err)
+
+	err = tt.RetryWithBackOff(func() error {
+		_, err := s.m.RetrieveAll()
+		if err != nil {
+			return err
+		}
+		contact, err := s.m.GetContactByID(theirID)
+		if err == nil && contact.ContactRequestState != ContactRequestStateNone {
+			err = errors.New("contact request not retracted")
+		}
+		return err
+	})
+	s.Require().NoError(err)
+	contact, err := s.m.GetContactByID(theirID)
+	s.Require().NoError(err)
+	s.Require().False(contact.HasBeenAdded())
+
+	// A new request can be declined
+	_, err = theirMessenger.SendContactRequest(context.Background(), ourID, "")
+	s.Require().NoError(err)
+
+	err = tt.RetryWithBackOff(func() error {
+		_, err := s.m.RetrieveAll()
+		if err == nil && len(s.m.PendingContactRequests()) == 0 {
+			err = errors.New("no contact request")
+		}
+		return err
+	})
+	s.Require().NoError(err)
+
+	_, err = s.m.DeclineContactRequest(context.Background(), theirID)
+	s.Require().NoError(err)
+	s.Require().Len(s.m.PendingContactRequests(), 0)
+
+	err = tt.RetryWithBackOff(func() error {
+		_, err := theirMessenger.RetrieveAll()
+		if err != nil {
+			return err
+		}
+		contact, err := theirMessenger.GetContactByID(ourID)
+		if err == nil && contact.ContactRequestState != ContactRequestStateDeclined {
+			err = errors.New("contact request not declined")
+		}
+		return err
+	})
+	s.Require().NoError(err)
+}
+
+func (s *MessengerSuite) TestLocalNicknameInMentions() {
+	contactKey, err := crypto.GenerateKey()
+	s.Require().NoError(err)
+	contact, err := buildContact(&contactKey.PublicKey)
+	s.Require().NoError(err)
+	err = s.m.SaveContact(contact)
+	s.Require().NoError(err)
+
+	_, err = s.m.SetContactLocalNickname("0x0", "bob")
+	s.Require().Error(err)
+
+	_, err = s.m.SetContactLocalNickname(contact.ID, "bob")
+	s.Require().NoError(err)
+
+	chat := CreatePublicChat("status", s.m.transport)
+	err = s.m.SaveChat(&chat)
+	s.Require().NoError(err)
+
+	message := buildTestMessage(chat)
+	message.Text = "hey @" + contact.ID
+	response, err := s.m.SendChatMessage(context.Background(), message)
+	s.Require().NoError(err)
+	s.Require().Len(response.Messages, 1)
+	s.Require().Contains(string(response.Messages[0].ParsedText), `"name":"bob"`)
+}
+
+func (s *MessengerSuite) TestEditMessage() {
+	theirMessenger := s.newMessenger(s.shh)
+	theirChat := CreateOneToOneChat("XXX", &s.privateKey.PublicKey, s.m.transport)
+	err := theirMessenger.SaveChat(&theirChat)
+	s.Require().NoError(err)
+
+	inputMessage := buildTestMessage(theirChat)
+
+	sendResponse, err := theirMessenger.SendChatMessage(context.Background(), inputMessage)
+	s.NoError(err)
+	s.Require().Len(sendResponse.Messages, 1)
+
+	sentMessage := sendResponse.Messages[0]
+
+	err = tt.RetryWithBackOff(func() error {
+		response, err := s.m.RetrieveAll()
+		if err == nil && len(response.Messages) == 0 {
+			err = errors.New("no messages")
+		}
+		return err
+	})
+	s.Require().NoError(err)
+
+	// We can't edit their message
+	_, err = s.m.EditMessage(context.Background(), sentMessage.ID, "not my message")
+	s.Require().Error(err)
+
+	editResponse, err := theirMessenger.EditMessage(context.Background(), sentMessage.ID, "edited-text")
+	s.Require().NoError(err)
+	s.Require().Len(editResponse.Messages, 1)
+	s.Require().Equal("edited-text", editResponse.Messages[0].Text)
+	s.Require().NotZero(editResponse.Messages[0].EditedAt)
+
+	var response *MessengerResponse
+	err = tt.RetryWithBackOff(func() error {
+		var err error
+		response, err = s.m.RetrieveAll()
+		if err == nil && len(response.Messages) == 0 {
+			err = errors.New("no edited messages")
+		}
+		return err
+	})
+	s.Require().NoError(err)
+
+	s.Require().Len(response.Messages, 1)
+	editedMessage := response.Messages[0]
+	s.Require().Equal(sentMessage.ID, editedMessage.ID)
+	s.Require().Equal("edited-text", editedMessage.Text)
+	s.Require().Equal(editResponse.Messages[0].EditedAt, editedMessage.EditedAt)
+
+	// The chat last message is updated
+	s.Require().Len(response.Chats, 1)
+	var lastMessage map[string]interface{}
+	s.Require().NoError(json.Unmarshal(response.Chats[0].LastMessage, &lastMessage))
+	s.Require().Equal("edited-text", lastMessage["text"])
+
+	storedMessage, err := s.m.MessageByID(sentMessage.ID)
+	s.Require().NoError(err)
+	s.Require().Equal("edited-text", storedMessage.Text)
+
+	history, err := s.m.MessageEditHistory(sentMessage.ID)
+	s.Require().NoError(err)
+	s.Require().Len(history, 2)
+	s.Require().Equal(inputMessage.Text, history[0].Text)
+	s.Require().Equal("edited-text", history[1].Text)
+}
+
+func (s *MessengerSuite) TestDeleteMessageForEveryone() {
+	theirMessenger := s.newMessenger(s.shh)
+	theirChat := CreateOneToOneChat("XXX", &s.privateKey.PublicKey, s.m.transport)
+	err := theirMessenger.SaveChat(&theirChat)
+	s.Require().NoError(err)
+
+	inputMessage := buildTestMessage(theirChat)
+
+	sendResponse, err := theirMessenger.SendChatMessage(context.Background(), inputMessage)
+	s.NoError(err)
+	s.Require().Len(sendResponse.Messages, 1)
+
+	sentMessage := sendResponse.Messages[0]
+
+	err = tt.RetryWithBackOff(func() error {
+		response, err := s.m.RetrieveAll()
+		if err == nil && len(response.Messages) == 0 {
+			err = errors.New("no messages")
+		}
+		return err
+	})
+	s.Require().NoError(err)
+
+	// We can't delete their message
+	_, err = s.m.DeleteMessageForEveryone(context.Background(), sentMessage.ID)
+	s.Require().Error(err)
+
+	deleteResponse, err := theirMessenger.DeleteMessageForEveryone(context.Background(), sentMessage.ID)
+	s.Require().NoError(err)
+	s.Require().Len(deleteResponse.Messages, 1)
+	s.Require().True(deleteResponse.Messages[0].Deleted)
+	s.Require().Empty(deleteResponse.Messages[0].Text)
+
+	var response *MessengerResponse
+	err = tt.RetryWithBackOff(func() error {
+		var err error
+		response, err = s.m.RetrieveAll()
+		if err == nil && len(response.Messages) == 0 {
+			err = errors.New("no deleted messages")
+		}
+		return err
+	})
+	s.Require().NoError(err)
+
+	s.Require().Len(response.Messages, 1)
+	deletedMessage := response.Messages[0]
+	s.Require().Equal(sentMessage.ID, deletedMessage.ID)
+	s.Require().True(deletedMessage.Deleted)
+	s.Require().Empty(deletedMessage.Text)
+
+	// The chat last message is the tombstone
+	s.Require().Len(response.Chats, 1)
+	var lastMessage map[string]interface{}
+	s.Require().NoError(json.Unmarshal(response.Chats[0].LastMessage, &lastMessage))
+	s.Require().Equal(true, lastMessage["deleted"])
+
+	// The tombstone keeps its place in the chat
+	messages, _, err := s.m.MessageByChatID(response.Chats[0].ID, "", 10)
+	s.Require().NoError(err)
+	s.Require().Len(messages, 1)
+	s.Require().Equal(sentMessage.ID, messages[0].ID)
+	s.Require().True(messages[0].Deleted)
+	s.Require().Empty(messages[0].Text)
+}
+
+func (s *MessengerSuite) TestEditAndDeleteBeforeMessageInAnotherChat() {
+	theirMessenger := s.newMessenger(s.shh)
+	theirChat := CreateOneToOneChat("XXX", &s.privateKey.PublicKey, s.m.transport)
+	err := theirMessenger.SaveChat(&theirChat)
+	s.Require().NoError(err)
+
+	theirID := contactIDFromPublicKey(&theirMessenger.identity.PublicKey)
+
+	firstResponse, err := theirMessenger.SendChatMessage(context.Background(), buildTestMessage(theirChat))
+	s.Require().NoError(err)
+	s.Require().Len(firstResponse.Messages, 1)
+	first := firstResponse.Messages[0]
+
+	secondResponse, err := theirMessenger.SendChatMessage(context.Background(), buildTestMessage(theirChat))
+	s.Require().NoError(err)
+	s.Require().Len(secondResponse.Messages, 1)
+	second := secondResponse.Messages[0]
+
+	// An edit and a deletion of the first message received in another chat
+	// before the message, and an edit of the second one received in its chat
+	err = s.m.persistence.SaveEdits([]*EditMessage{
+		{
+			EditMessage: protobuf.EditMessage{Clock: first.Clock + 1, Text: "edited-elsewhere", MessageId: first.ID},
+			ID:          "edit-1",
+			From:        theirID,
+			LocalChatID: "some-public-chat",
+		},
+		{
+			EditMessage: protobuf.EditMessage{Clock: second.Clock + 1, Text: "edited-text", MessageId: second.ID},
+			ID:          "edit-2",
+			From:        theirID,
+			LocalChatID: theirID,
+		},
+	})
+	s.Require().NoError(err)
+	err = s.m.persistence.SaveDeletes([]*DeleteMessage{
+		{
+			DeleteMessage: protobuf.DeleteMessage{Clock: first.Clock + 1, MessageId: first.ID},
+			ID:            "delete-1",
+			From:          theirID,
+			LocalChatID:   "some-public-chat",
+		},
+	})
+	s.Require().NoError(err)
+
+	received := 0
+	err = tt.RetryWithBackOff(func() error {
+		response, err := s.m.RetrieveAll()
+		if err != nil {
+			return err
+		}
+		received += len(response.Messages)
+		if received < 2 {
+			return errors.New("no messages")
+		}
+		return nil
+	})
+	s.Require().NoError(err)
+
+	storedFirst, err := s.m.MessageByID(first.ID)
+	s.Require().NoError(err)
+	s.Require().False(storedFirst.Deleted)
+	s.Require().Equal(first.Text, storedFirst.Text)
+	s.Require().Zero(storedFirst.EditedAt)
+
+	storedSecond, err := s.m.MessageByID(second.ID)
+	s.Require().NoError(err)
+	s.Require().Equal("edited-text", storedSecond.Text)
+}
+
+func (s *MessengerSuite) TestSendImageInChunks() {
+	theirMessenger := s.newMessenger(s.shh)
+	// Make sure the image is split in multiple chunks
+	theirMessenger.maxMessageChunkSize = 1024
+
+	theirChat := CreateOneToOneChat("XXX", &s.privateKey.PublicKey, s.m.transport)
+	err := theirMessenger.SaveChat(&theirChat)
+	s.Require().NoError(err)
+
+	// Noise does not compress well, so the image is bigger than a chunk
+	img := image.NewRGBA(image.Rect(0, 0, 64, 32))
+	for x := 0; x < 64; x++ {
+		for y := 0; y < 32; y++ {
+			img.Set(x, y, color.RGBA{uint8(rand.Intn(256)), uint8(rand.Intn(256)), uint8(rand.Intn(256)), 255})
+		}
+	}
+	imageFile, err := ioutil.TempFile("", "image")
+	s.Require().NoError(err)
+	s.tmpFiles = append(s.tmpFiles, imageFile)
+	s.Require().NoError(png.Encode(imageFile, img))
+
+	inputMessage := buildTestMessage(theirChat)
+	inputMessage.Text = ""
+	inputMessage.ContentType = protobuf.ChatMessage_IMAGE
+	inputMessage.ImagePath = imageFile.Name()
+
+	sendResponse, err := theirMessenger.SendChatMessage(context.Background(), inputMessage)
+	s.Require().NoError(err)
+	s.Require().Len(sendResponse.Messages, 1)
+
+	sentMessage := sendResponse.Messages[0]
+	sentImage := sentMessage.GetImage()
+	s.Require().NotNil(sentImage)
+	s.Require().True(len(sentImage.Payload) > theirMessenger.maxMessageChunkSize)
+	s.Require().Equal(protobuf.ImageMessage_PNG, sentImage.Format)
+	s.Require().Equal(uint32(64), sentImage.Width)
+	s.Require().Equal(uint32(32), sentImage.Height)
+
+	var response *MessengerResponse
+	err = tt.RetryWithBackOff(func() error {
+		var err error
+		response, err = s.m.RetrieveAll()
+		if err == nil && len(response.Messages) == 0 {
+			err = errors.New("no messages")
+		}
+		return err
+	})
+	s.Require().NoError(err)
+
+	s.Require().Len(response.Messages, 1)
+	receivedMessage := response.Messages[0]
+	s.Require().Equal(sentMessage.ID, receivedMessage.ID)
+	s.Require().Equal(protobuf.ChatMessage_IMAGE, receivedMessage.ContentType)
+	s.Require().Equal(sentImage.Payload, receivedMessage.GetImage().Payload)
+
+	// The image is stored with the message
+	storedMessage, err := s.m.MessageByID(sentMessage.ID)
+	s.Require().NoError(err)
+	storedImage := storedMessage.GetImage()
+	s.Require().NotNil(storedImage)
+	s.Require().Equal(sentImage.Payload, storedImage.Payload)
+	s.Require().Equal(sentImage.Format, storedImage.Format)
+	s.Require().Equal(sentImage.Width, storedImage.Width)
+	s.Require().Equal(sentImage.Height, storedImage.Height)
+}
+
+func (s *MessengerSuite) TestSendAudioMessage() {
+	theirMessenger := s.newMessenger(s.shh)
+	// Make sure the audio is split in multiple chunks
+	theirMessenger.maxMessageChunkSize = 1024
+
+	theirChat := CreateOneToOneChat("XXX", &s.privateKey.PublicKey, s.m.transport)
+	err := theirMessenger.SaveChat(&theirChat)
+	s.Require().NoError(err)
+
+	payload := make([]byte, 4096)
+	_, err = rand.Read(payload)
+	s.Require().NoError(err)
+
+	sendResponse, err := theirMessenger.SendAudioMessage(context.Background(), theirChat.ID, payload, protobuf.AudioMessage_AAC, 1500)
+	s.Require().NoError(err)
+	s.Require().Len(sendResponse.Messages, 1)
+
+	sentMessage := sendResponse.Messages[0]
+	s.Require().Equal(protobuf.ChatMessage_AUDIO, sentMessage.ContentType)
+
+	var response *MessengerResponse
+	err = tt.RetryWithBackOff(func() error {
+		var err error
+		response, err = s.m.RetrieveAll()
+		if err == nil && len(response.Messages) == 0 {
+			err = errors.New("no messages")
+		}
+		return err
+	})
+	s.Require().NoError(err)
+
+	s.Require().Len(response.Messages, 1)
+	receivedMessage := response.Messages[0]
+	s.Require().Equal(sentMessage.ID, receivedMessage.ID)
+	s.Require().Equal(protobuf.ChatMessage_AUDIO, receivedMessage.ContentType)
+
+	// The audio is stored with the message
+	storedMessage, err := s.m.MessageByID(sentMessage.ID)
+	s.Require().NoError(err)
+	storedAudio := storedMessage.GetAudio()
+	s.Require().NotNil(storedAudio)
+	s.Require().Equal(payload, storedAudio.Payload)
+	s.Require().Equal(protobuf.AudioMessage_AAC, storedAudio.Type)
+	s.Require().Equal(uint64(1500), storedAudio.DurationMs)
+
+	// Payloads too large are rejected before being sent
+	_, err = theirMessenger.SendAudioMessage(context.Background(), theirChat.ID, make([]byte, maxAudioPayloadSize+1), protobuf.AudioMessage_AAC, 1500)
+	s.Require().Error(err)
+}
+
+func (s *MessengerSuite) TestSendLinkPreview() {
+	fetches := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fetches++
+		w.Header().Set("Content-Type", "text/html")
+		fmt.Fprint(w, `<html><head><meta property="og:title" content="Status"><meta property="og:description" content="A private messenger"></head></html>`)
+	}))
+	defer server.Close()
+
+	theirMessenger := s.newMessenger(s.shh)
+	theirMessenger.linkPreviewAllowedDomains = func() ([]string, error) {
+		return []string{"127.0.0.1"}, nil
+	}
+
+	theirChat := CreateOneToOneChat("XXX", &s.privateKey.PublicKey, s.m.transport)
+	err := theirMessenger.SaveChat(&theirChat)
+	s.Require().NoError(err)
+
+	inputMessage := buildTestMessage(theirChat)
+	inputMessage.Text = "have a look at " + server.URL + "/page and https://example.com"
+
+	sendResponse, err := theirMessenger.SendChatMessage(context.Background(), inputMessage)
+	s.Require().NoError(err)
+	s.Require().Len(sendResponse.Messages, 1)
+
+	// Only links to allowed domains are unfurled
+	sentMessage := sendResponse.Messages[0]
+	s.Require().Len(sentMessage.Links, 1)
+	s.Require().Equal(server.URL+"/page", sentMessage.Links[0].Url)
+	s.Require().Equal("Status", sentMessage.Links[0].Title)
+	s.Require().Equal("A private messenger", sentMessage.Links[0].Description)
+
+	var response *MessengerResponse
+	err = tt.RetryWithBackOff(func() error {
+		var err error
+		response, err = s.m.RetrieveAll()
+		if err == nil && len(response.Messages) == 0 {
+			err = errors.New("no messages")
+		}
+		return err
+	})
+	s.Require().NoError(err)
+
+	s.Require().Len(response.Messages, 1)
+	receivedMessage := response.Messages[0]
+	s.Require().Len(receivedMessage.Links, 1)
+	s.Require().Equal("Status", receivedMessage.Links[0].Title)
+
+	// The preview is stored with the message
+	storedMessage, err := s.m.MessageByID(sentMessage.ID)
+	s.Require().NoError(err)
+	s.Require().Len(storedMessage.Links, 1)
+	s.Require().Equal("A private messenger", storedMessage.Links[0].Description)
+
+	// The preview is cached by the sender
+	inputMessage = buildTestMessage(theirChat)
+	inputMessage.Text = server.URL + "/page"
+	sendResponse, err = theirMessenger.SendChatMessage(context.Background(), inputMessage)
+	s.Require().NoError(err)
+	s.Require().Len(sendResponse.Messages[0].Links, 1)
+	s.Require().Equal(1, fetches)
+}
+
+// Test receiving a message on an non-existing private chat
+func (s *MessengerSuite) TestRetrieveTheirPrivateChatNonExisting() {
+	theirMessenger := s.newMessenger(s.shh)
+	chat := CreateOneToOneChat("XXX", &s.privateKey.PublicKey, s.m.transport)
+	err := theirMessenger.SaveChat(&chat)
+	s.NoError(err)
+
+	inputMessage := buildTestMessage(chat)
+
+	sendResponse, err := theirMessenger.SendChatMessage(context.Background(), inputMessage)
+	s.NoError(err)
+	s.Require().Len(sendResponse.Messages, 1)
+
+	sentMessage := sendResponse.Messages[0]
+
+	// Wait for the message to reach its destination
+	var response *MessengerResponse
+	err = tt.RetryWithBackOff(func() error {
+		var err error
+		response, err = s.m.RetrieveAll()
+		if err == nil && len(response.Messages) == 0 {
+			err = errors.New("no messages")
+		}
+		return err
+	})
+	s.Require().NoError(err)
+
+	s.Require().Len(response.Chats, 1)
+	actualChat := response.Chats[0]
+	// It updates the unviewed messages count
+	s.Require().Equal(uint(1), actualChat.UnviewedMessagesCount)
+	// It updates the last message clock value
+	s.Require().Equal(sentMessage.Clock, actualChat.LastClockValue)
+	// It sets the last message
+	s.Require().NotNil(actualChat.LastMessage)
+	// It sets the chat as active
+	s.Require().True(actualChat.Active)
+}
+
+// Test receiving a message on an non-existing public chat
+func (s *MessengerSuite) TestRetrieveTheirPublicChatNonExisting() {
+	theirMessenger := s.newMessenger(s.shh)
+	chat := CreatePublicChat("test-chat", s.m.transport)
+	err := theirMessenger.SaveChat(&chat)
+	s.NoError(err)
+
+	inputMessage := buildTestMessage(chat)
+
+	sendResponse, err := theirMessenger.SendChatMessage(context.Background(), inputMessage)
+	s.NoError(err)
+	s.Require().Len(sendResponse.Messages, 1)
+
+	// Wait for the message to reach its destination
+	time.Sleep(100 * time.Millisecond)
+	response, err := s.m.RetrieveAll()
+	s.NoError(err)
+
+	s.Require().Equal(len(response.Messages), 0)
+	s.Require().Equal(len(response.Chats), 0)
+}
+
+// Test receiving a message on an non-existing private public chat
+func (s *MessengerSuite) TestRetrieveTheirGroupChatNonExisting() {
+	theirMessenger := s.newMessenger(s.shh)
+	response, err := s.m.CreateGroupChatWithMembers(context.Background(), "test", []string{})
+	s.NoError(err)
+	s.Require().Len(response.Chats, 1)
+
+	chat := response.Chats[0]
+
+	err = theirMessenger.SaveChat(chat)
+	s.NoError(err)
+
+	inputMessage := buildTestMessage(*chat)
+
+	sendResponse, err := theirMessenger.SendChatMessage(context.Background(), inputMessage)
+	s.NoError(err)
+	s.Require().Len(sendResponse.Messages, 1)
+
+	// Retrieve their messages so that the chat is created
+	err = tt.RetryWithBackOff(func() error {
+		var err error
+		response, err = s.m.RetrieveAll()
+		if err == nil && len(response.Chats) == 1 {
+			err = errors.New("chat membership update not received")
+		}
+		return err
+	})
+	s.Require().NoError(err)
+
+	// The message is discarded
+	s.Require().Equal(0, len(response.Messages))
+	s.Require().Equal(0, len(response.Chats))
+}
+
+// Test receiving a message on an existing private group chat
+func (s *MessengerSuite) TestRetrieveTheirPrivateGroupChat() {
+	var response *MessengerResponse
+	theirMessenger := s.newMessenger(s.shh)
+	response, err := s.m.CreateGroupChatWithMembers(context.Background(), "id", []string{})
+	s.NoError(err)
+	s.Require().Len(response.Chats, 1)
+
+	ourChat := response.Chats[0]
+
+	err = s.m.SaveChat(ourChat)
+	s.NoError(err)
+
+	members := []string{"0x" + hex.EncodeToString(crypto.FromECDSAPub(&theirMessenger.identity.PublicKey))}
+	_, err = s.m.AddMembersToGroupChat(context.Background(), ourChat.ID, members)
+	s.NoError(err)
+
+	// Retrieve their messages so that the chat is created
+	err = tt.RetryWithBackOff(func() error {
+		var err error
+		response, err = theirMessenger.RetrieveAll()
+		if err == nil && len(response.Chats) == 0 {
+			err = errors.New("chat invitation not received")
+		}
+		return err
+	})
+	s.Require().NoError(err)
+
+	_, err = theirMessenger.ConfirmJoiningGroup(context.Background(), ourChat.ID)
+	s.NoError(err)
+
+	err = tt.RetryWithBackOff(func() error {
+		var err error
+		response, err = s.m.RetrieveAll()
+		if err == nil && len(response.Chats) == 0 {
+			err = errors.New("no joining group event received")
+		}
+		return err
+	})
+	s.Require().NoError(err)
+
+	inputMessage := buildTestMessage(*ourChat)
+
+	sendResponse, err := theirMessenger.SendChatMessage(context.Background(), inputMessage)
+	s.NoError(err)
+	s.Require().Len(sendResponse.Messages, 1)
+
+	sentMessage := sendResponse.Messages[0]
+
+	err = tt.RetryWithBackOff(func() error {
+		var err error
+		response, err = s.m.RetrieveAll()
+		if err == nil && len(response.Messages) == 0 {
+			err = errors.New("no messages")
+		}
+		return err
+	})
+	s.Require().NoError(err)
+
+	s.Require().Len(response.Chats, 1)
+	actualChat := response.Chats[0]
+	// It updates the unviewed messages count
+	s.Require().Equal(uint(1), actualChat.UnviewedMessagesCount)
+	// It updates the last message clock value
+	s.Require().Equal(sentMessage.Clock, actualChat.LastClockValue)
+	// It sets the last message
+	s.Require().NotNil(actualChat.LastMessage)
+}
+
+// Test receiving a message on an existing private group chat, if messages
+// are not wrapped this will fail as they'll likely come out of order
+// NOTE: Disabling this as too flaky
+/*
+func (s *MessengerSuite) testRetrieveTheirPrivateGroupWrappedMessageChat() {
+	var response *MessengerResponse
+	theirMessenger := s.newMessenger(s.shh)
+	response, err := s.m.CreateGroupChatWithMembers(context.Background(), "id", []string{})
+	s.NoError(err)
+	s.Require().Len(response.Chats, 1)
+
+	ourChat := response.Chats[0]
+
+	err = s.m.SaveChat(ourChat)
+	s.NoError(err)
+
+	members := []string{"0x" + hex.EncodeToString(crypto.FromECDSAPub(&theirMessenger.identity.PublicKey))}
+	_, err = s.m.AddMembersToGroupChat(context.Background(), ourChat.ID, members)
+	s.NoError(err)
+
+	// Retrieve their messages so that the chat is created
+	err = tt.RetryWithBackOff(func() error {
+		var err error
+		response, err = theirMessenger.RetrieveAll()
+		if err == nil && len(response.Chats) == 0 {
+			err = errors.New("chat invitation not received")
+		}
+		return err
+	})
+	s.Require().NoError(err)
+
+	_, err = theirMessenger.ConfirmJoiningGroup(context.Background(), ourChat.ID)
+	s.NoError(err)
+
+	inputMessage := buildTestMessage(*ourChat)
+
+	sendResponse, err := theirMessenger.SendChatMessage(context.Background(), inputMessage)
+	s.NoError(err)
+	s.Require().Len(sendResponse.Messages, 1)
+
+	sentMessage := sendResponse.Messages[0]
+
+	err = tt.RetryWithBackOff(func() error {
+		var err error
+		response, err = s.m.RetrieveAll()
+		if err == nil && len(response.Chats) == 0 {
+			err = errors.New("no chats")
+		}
+		return err
+	})
+	s.Require().NoError(err)
+	s.Require().Len(response.Chats, 1)
+	actualChat := response.Chats[0]
+	// It updates the unviewed messages count
+	s.Require().Equal(uint(1), actualChat.UnviewedMessagesCount)
+	// It updates the last message clock value
+	s.Require().Equal(sentMessage.Clock, actualChat.LastClockValue)
+	// It sets the last message
+	s.Require().NotNil(actualChat.LastMessage)
+}
+*/
+
+func (s *MessengerSuite) TestChatPersistencePublic() {
+	chat := Chat{
+		ID:                    "chat-name",
+		Name:                  "chat-name",
+		Color:                 "#fffff",
+		Active:                true,
+		ChatType:              ChatTypePublic,
+		Timestamp:             10,
+		LastClockValue:        20,
+		DeletedAtClockValue:   30,
+		UnviewedMessagesCount: 40,
+		LastMessage:           []byte("test"),
+	}
+
+	s.Require().NoError(s.m.SaveChat(&chat))
+	savedChats := s.m.Chats()
+	s.Require().Equal(1, len(savedChats))
+
+	actualChat := savedChats[0]
+	expectedChat := &chat
+
+	s.Require().Equal(actualChat, expectedChat)
+}
+
+func (s *MessengerSuite) TestDeleteChat() {
+	chatID := "chatid"
+	chat := Chat{
+		ID:                    chatID,
+		Name:                  "chat-name",
+		Color:                 "#fffff",
+		Active:                true,
+		ChatType:              ChatTypePublic,
+		Timestamp:             10,
+		LastClockValue:        20,
+		DeletedAtClockValue:   30,
+		UnviewedMessagesCount: 40,
+		LastMessage:           []byte("test"),
+	}
+
+	s.Require().NoError(s.m.SaveChat(&chat))
+	savedChats := s.m.Chats()
+	s.Require().Equal(1, len(savedChats))
+
+	s.Require().NoError(s.m.DeleteChat(chatID))
+	savedChats = s.m.Chats()
+	s.Require().Equal(0, len(savedChats))
+}
+
+func (s *MessengerSuite) TestChatPersistenceUpdate() {
+	chat := Chat{
+		ID:                    "chat-name",
+		Name:                  "chat-name",
+		Color:                 "#fffff",
+		Active:                true,
+		ChatType:              ChatTypePublic,
+		Timestamp:             10,
+		LastClockValue:        20,
+		DeletedAtClockValue:   30,
+		UnviewedMessagesCount: 40,
+		LastMessage:           []byte("test"),
+	}
+
+	s.Require().NoError(s.m.SaveChat(&chat))
+	savedChats := s.m.Chats()
+	s.Require().Equal(1, len(savedChats))
+
+	actualChat := savedChats[0]
+	expectedChat := &chat
+
+	s.Require().Equal(expectedChat, actualChat)
+
+	chat.Name = "updated-name-1"
+	s.Require().NoError(s.m.SaveChat(&chat))
+	updatedChats := s.m.Chats()
+	s.Require().Equal(1, len(updatedChats))
+
+	actualUpdatedChat := updatedChats[0]
+	expectedUpdatedChat := &chat
+
+	s.Require().Equal(expectedUpdatedChat, actualUpdatedChat)
+}
+
+func (s *MessengerSuite) TestChatPersistenceOneToOne() {
+	chat := Chat{
+		ID:                    testPK,
+		Name:                  testPK,
+		Color:                 "#fffff",
+		Active:                true,
+		ChatType:              ChatTypeOneToOne,
+		Timestamp:             10,
+		LastClockValue:        20,
+		DeletedAtClockValue:   30,
+		UnviewedMessagesCount: 40,
+		LastMessage:           []byte("test"),
+	}
+	publicKeyBytes, err := hex.DecodeString(testPK[2:])
+	s.Require().NoError(err)
 
 	pk, err := crypto.UnmarshalPubkey(publicKeyBytes)
 	s.Require().NoError(err)
 
-	s.Require().NoError(s.m.SaveChat(&chat))
-	savedChats := s.m.Chats()
-	s.Require().Equal(1, len(savedChats))
+	s.Require().NoError(s.m.SaveChat(&chat))
+	savedChats := s.m.Chats()
+	s.Require().Equal(1, len(savedChats))
+
+	actualChat := savedChats[0]
+	expectedChat := &chat
+
+	actualPk, err := actualChat.PublicKey()
+	s.Require().NoError(err)
+
+	s.Require().Equal(pk, actualPk)
+
+	s.Require().Equal(expectedChat, actualChat)
+}
+
+func (s *MessengerSuite) TestChatPersistencePrivateGroupChat() {
+
+	member1Key, err := crypto.GenerateKey()
+	s.Require().NoError(err)
+	member1ID := types.EncodeHex(crypto.FromECDSAPub(&member1Key.PublicKey))
+
+	member2Key, err := crypto.GenerateKey()
+	s.Require().NoError(err)
+	member2ID := types.EncodeHex(crypto.FromECDSAPub(&member2Key.PublicKey))
+
+	member3Key, err := crypto.GenerateKey()
+	s.Require().NoError(err)
+	member3ID := types.EncodeHex(crypto.FromECDSAPub(&member3Key.PublicKey))
+
+	chat := Chat{
+		ID:        "chat-id",
+		Name:      "chat-id",
+		Color:     "#fffff",
+		Active:    true,
+		ChatType:  ChatTypePrivateGroupChat,
+		Timestamp: 10,
+		Members: []ChatMember{
+			{
+				ID:     member1ID,
+				Admin:  false,
+				Joined: true,
+			},
+			{
+				ID:     member2ID,
+				Admin:  true,
+				Joined: false,
+			},
+			{
+				ID:     member3ID,
+				Admin:  true,
+				Joined: true,
+			},
+		},
+		MembershipUpdates: []v1protocol.MembershipUpdateEvent{
+			{
+				Type:       protobuf.MembershipUpdateEvent_CHAT_CREATED,
+				Name:       "name-1",
+				ClockValue: 1,
+				Signature:  []byte("signature-1"),
+				From:       "from-1",
+				Members:    []string{"member-1", "member-2"},
+			},
+			{
+				Type:       protobuf.MembershipUpdateEvent_MEMBERS_ADDED,
+				Name:       "name-2",
+				ClockValue: 2,
+				Signature:  []byte("signature-2"),
+				From:       "from-2",
+				Members:    []string{"member-2", "member-3"},
+			},
+		},
+		LastClockValue:        20,
+		DeletedAtClockValue:   30,
+		UnviewedMessagesCount: 40,
+		LastMessage:           []byte("test"),
+	}
+	s.Require().NoError(s.m.SaveChat(&chat))
+	savedChats := s.m.Chats()
+	s.Require().Equal(1, len(savedChats))
+
+	actualChat := savedChats[0]
+	expectedChat := &chat
+
+	s.Require().Equal(expectedChat, actualChat)
+}
+
+func (s *MessengerSuite) TestBlockContact() {
+	contact := Contact{
+		ID:          testPK,
+		Name:        "contact-name",
+		Photo:       "contact-photo",
+		LastUpdated: 20,
+		SystemTags:  []string{"1", "2"},
+		DeviceInfo: []ContactDeviceInfo{
+			{
+				InstallationID: "1",
+				Timestamp:      2,
+				FCMToken:       "token",
+			},
+			{
+				InstallationID: "2",
+				Timestamp:      3,
+				FCMToken:       "token-2",
+			},
+		},
+		TributeToTalk: "talk",
+	}
+
+	chat1 := Chat{
+		ID:                    contact.ID,
+		Name:                  "chat-name",
+		Color:                 "#fffff",
+		Active:                true,
+		ChatType:              ChatTypeOneToOne,
+		Timestamp:             1,
+		LastClockValue:        20,
+		DeletedAtClockValue:   30,
+		UnviewedMessagesCount: 40,
+	}
+
+	chat2 := Chat{
+		ID:                    "chat-2",
+		Name:                  "chat-name",
+		Color:                 "#fffff",
+		Active:                true,
+		ChatType:              ChatTypePublic,
+		Timestamp:             2,
+		LastClockValue:        20,
+		DeletedAtClockValue:   30,
+		UnviewedMessagesCount: 40,
+	}
+
+	chat3 := Chat{
+		ID:                    "chat-3",
+		Name:                  "chat-name",
+		Color:                 "#fffff",
+		Active:                true,
+		ChatType:              ChatTypePublic,
+		Timestamp:             3,
+		LastClockValue:        20,
+		DeletedAtClockValue:   30,
+		UnviewedMessagesCount: 40,
+	}
+
+	s.Require().NoError(s.m.SaveChat(&chat1))
+	s.Require().NoError(s.m.SaveChat(&chat2))
+	s.Require().NoError(s.m.SaveChat(&chat3))
+
+	s.Require().NoError(s.m.SaveContact(&contact))
+
+	contact.Name = "blocked"
+
+	messages := []*Message{
+		{
+			ID:          "test-1",
+			LocalChatID: chat2.ID,
+			ChatMessage: protobuf.ChatMessage{
+				ContentType: 1,
+				Text:        "test-1",
+				Clock:       1,
+			},
+			From: contact.ID,
+		},
+		{
+			ID:          "test-2",
+			LocalChatID: chat2.ID,
+			ChatMessage: protobuf.ChatMessage{
+				ContentType: 2,
+				Text:        "test-2",
+				Clock:       2,
+			},
+			From: contact.ID,
+		},
+		{
+			ID:          "test-3",
+			LocalChatID: chat2.ID,
+			ChatMessage: protobuf.ChatMessage{
+				ContentType: 3,
+				Text:        "test-3",
+				Clock:       3,
+			},
+			Seen: false,
+			From: "test",
+		},
+		{
+			ID:          "test-4",
+			LocalChatID: chat2.ID,
+			ChatMessage: protobuf.ChatMessage{
+				ContentType: 4,
+				Text:        "test-4",
+				Clock:       4,
+			},
+			Seen: false,
+			From: "test",
+		},
+		{
+			ID:          "test-5",
+			LocalChatID: chat2.ID,
+			ChatMessage: protobuf.ChatMessage{
+				ContentType: 5,
+				Text:        "test-5",
+				Clock:       5,
+			},
+			Seen: true,
+			From: "test",
+		},
+		{
+			ID:          "test-6",
+			LocalChatID: chat3.ID,
+			ChatMessage: protobuf.ChatMessage{
+				ContentType: 6,
+				Text:        "test-6",
+				Clock:       6,
+			},
+			Seen: false,
+			From: contact.ID,
+		},
+		{
+			ID:          "test-7",
+			LocalChatID: chat3.ID,
+			ChatMessage: protobuf.ChatMessage{
+				ContentType: 7,
+				Text:        "test-7",
+				Clock:       7,
+			},
+			Seen: false,
+			From: "test",
+		},
+	}
+
+	err := s.m.SaveMessages(messages)
+	s.Require().NoError(err)
+
+	response, err := s.m.BlockContact(&contact)
+	s.Require().NoError(err)
+
+	// The new unviewed count is updated
+	s.Require().Equal(uint(1), response[0].UnviewedMessagesCount)
+	s.Require().Equal(uint(2), response[1].UnviewedMessagesCount)
+
+	// The new message content is updated
+	decodedMessage := &Message{}
+	s.Require().NotNil(response[0].LastMessage)
+
+	s.Require().NoError(json.Unmarshal(response[0].LastMessage, decodedMessage))
+	s.Require().Equal("test-7", decodedMessage.ID)
+
+	decodedMessage = &Message{}
+	s.Require().NotNil(response[1].LastMessage)
+
+	s.Require().NoError(json.Unmarshal(response[1].LastMessage, decodedMessage))
+	s.Require().Equal("test-5", decodedMessage.ID)
+
+	// The contact is updated
+	savedContacts := s.m.Contacts()
+	s.Require().Equal(1, len(savedContacts))
+	s.Require().Equal("blocked", savedContacts[0].Name)
+
+	// The chat is deleted
+	actualChats := s.m.Chats()
+	s.Require().Equal(2, len(actualChats))
+
+	// The messages have been deleted
+	chat2Messages, _, err := s.m.MessageByChatID(chat2.ID, "", 20)
+	s.Require().NoError(err)
+	s.Require().Equal(3, len(chat2Messages))
+
+	chat3Messages, _, err := s.m.MessageByChatID(chat3.ID, "", 20)
+	s.Require().NoError(err)
+	s.Require().Equal(1, len(chat3Messages))
+
+}
+
+func (s *MessengerSuite) TestContactPersistence() {
+	contact := Contact{
+		ID: testPK,
+
+		Name:        "contact-name",
+		Photo:       "contact-photo",
+		LastUpdated: 20,
+		SystemTags:  []string{"1", "2"},
+		DeviceInfo: []ContactDeviceInfo{
+			{
+				InstallationID: "1",
+				Timestamp:      2,
+				FCMToken:       "token",
+			},
+			{
+				InstallationID: "2",
+				Timestamp:      3,
+				FCMToken:       "token-2",
+			},
+		},
+		TributeToTalk: "talk",
+	}
+
+	s.Require().NoError(s.m.SaveContact(&contact))
+	savedContacts := s.m.Contacts()
+	s.Require().Equal(1, len(savedContacts))
+
+	actualContact := savedContacts[0]
+	expectedContact := &contact
+	expectedContact.Alias = testAlias
+	expectedContact.Identicon = testIdenticon
+	s.Require().Equal(expectedContact, actualContact)
+}
+
+func (s *MessengerSuite) TestContactPersistenceUpdate() {
+	contactID := testPK
+
+	contact := Contact{
+		ID:          contactID,
+		Name:        "contact-name",
+		Photo:       "contact-photo",
+		LastUpdated: 20,
+		SystemTags:  []string{"1", "2"},
+		DeviceInfo: []ContactDeviceInfo{
+			{
+				InstallationID: "1",
+				Timestamp:      2,
+				FCMToken:       "token",
+			},
+			{
+				InstallationID: "2",
+				Timestamp:      3,
+				FCMToken:       "token-2",
+			},
+		},
+		TributeToTalk: "talk",
+	}
+
+	s.Require().NoError(s.m.SaveContact(&contact))
+	savedContacts := s.m.Contacts()
+	s.Require().Equal(1, len(savedContacts))
+
+	actualContact := savedContacts[0]
+	expectedContact := &contact
+
+	expectedContact.Alias = testAlias
+	expectedContact.Identicon = testIdenticon
+
+	s.Require().Equal(expectedContact, actualContact)
+
+	contact.Name = "updated-name-2"
+	s.Require().NoError(s.m.SaveContact(&contact))
+	updatedContact := s.m.Contacts()
+	s.Require().Equal(1, len(updatedContact))
+
+	actualUpdatedContact := updatedContact[0]
+	expectedUpdatedContact := &contact
+
+	s.Require().Equal(expectedUpdatedContact, actualUpdatedContact)
+}
+
+func (s *MessengerSuite) TestSharedSecretHandler() {
+	_, err := s.m.handleSharedSecrets(nil)
+	s.NoError(err)
+}
+
+func (s *MessengerSuite) TestCreateGroupChatWithMembers() {
+	members := []string{testPK}
+	response, err := s.m.CreateGroupChatWithMembers(context.Background(), "test", members)
+	s.NoError(err)
+	s.Require().Len(response.Chats, 1)
+
+	chat := response.Chats[0]
+
+	s.Require().Equal("test", chat.Name)
+	publicKeyHex := "0x" + hex.EncodeToString(crypto.FromECDSAPub(&s.m.identity.PublicKey))
+	s.Require().Contains(chat.ID, publicKeyHex)
+	s.EqualValues([]string{publicKeyHex}, []string{chat.Members[0].ID})
+	s.Equal(members[0], chat.Members[1].ID)
+}
+
+func (s *MessengerSuite) TestAddMembersToChat() {
+	response, err := s.m.CreateGroupChatWithMembers(context.Background(), "test", []string{})
+	s.Require().NoError(err)
+	s.Require().Len(response.Chats, 1)
+
+	chat := response.Chats[0]
+
+	key, err := crypto.GenerateKey()
+	s.Require().NoError(err)
+	members := []string{"0x" + hex.EncodeToString(crypto.FromECDSAPub(&key.PublicKey))}
+
+	response, err = s.m.AddMembersToGroupChat(context.Background(), chat.ID, members)
+	s.Require().NoError(err)
+	s.Require().Len(response.Chats, 1)
+	s.Require().Len(response.Messages, 1)
+
+	chat = response.Chats[0]
+
+	publicKeyHex := "0x" + hex.EncodeToString(crypto.FromECDSAPub(&s.m.identity.PublicKey))
+	keyHex := "0x" + hex.EncodeToString(crypto.FromECDSAPub(&key.PublicKey))
+	s.EqualValues([]string{publicKeyHex, keyHex}, []string{chat.Members[0].ID, chat.Members[1].ID})
+}
+
+func (s *MessengerSuite) TestChangeGroupChatDescriptionAndImage() {
+	theirMessenger := s.newMessenger(s.shh)
+	theirPkString := types.EncodeHex(crypto.FromECDSAPub(&theirMessenger.identity.PublicKey))
+
+	response, err := s.m.CreateGroupChatWithMembers(context.Background(), "test", []string{theirPkString})
+	s.Require().NoError(err)
+	s.Require().Len(response.Chats, 1)
+	chat := response.Chats[0]
+
+	// Wait for them to receive the group chat
+	err = tt.RetryWithBackOff(func() error {
+		_, err := theirMessenger.RetrieveAll()
+		if err != nil {
+			return err
+		}
+		if _, ok := theirMessenger.allChats[chat.ID]; !ok {
+			return errors.New("no group chat")
+		}
+		return nil
+	})
+	s.Require().NoError(err)
+
+	// Only admins can change the description
+	_, err = theirMessenger.ChangeGroupChatDescription(context.Background(), chat.ID, "their description")
+	s.Require().Error(err)
+
+	response, err = s.m.ChangeGroupChatDescription(context.Background(), chat.ID, "description")
+	s.Require().NoError(err)
+	s.Require().Len(response.Chats, 1)
+	s.Require().Equal("description", response.Chats[0].Description)
+	s.Require().Len(response.Messages, 1)
+	s.Require().Equal(protobuf.ChatMessage_SYSTEM_MESSAGE_CONTENT_PRIVATE_GROUP, response.Messages[0].ContentType)
+
+	img := image.NewRGBA(image.Rect(0, 0, 16, 16))
+	imageFile, err := ioutil.TempFile("", "image")
+	s.Require().NoError(err)
+	s.tmpFiles = append(s.tmpFiles, imageFile)
+	s.Require().NoError(png.Encode(imageFile, img))
+
+	response, err = s.m.ChangeGroupChatImage(context.Background(), chat.ID, imageFile.Name())
+	s.Require().NoError(err)
+	s.Require().Len(response.Chats, 1)
+	s.Require().NotEmpty(response.Chats[0].Image)
+	chatImage := response.Chats[0].Image
+
+	err = tt.RetryWithBackOff(func() error {
+		_, err := theirMessenger.RetrieveAll()
+		if err != nil {
+			return err
+		}
+		theirChat := theirMessenger.allChats[chat.ID]
+		if theirChat.Description != "description" || len(theirChat.Image) == 0 {
+			return errors.New("group chat not updated")
+		}
+		return nil
+	})
+	s.Require().NoError(err)
+	s.Require().Equal(chatImage, theirMessenger.allChats[chat.ID].Image)
+
+	// An empty path removes the image
+	response, err = s.m.ChangeGroupChatImage(context.Background(), chat.ID, "")
+	s.Require().NoError(err)
+	s.Require().Empty(response.Chats[0].Image)
+}
 
-	actualChat := savedChats[0]
-	expectedChat := &chat
+func (s *MessengerSuite) TestGroupChatOwnership() {
+	theirMessenger := s.newMessenger(s.shh)
+	ourID := contactIDFromPublicKey(&s.m.identity.PublicKey)
+	theirID := contactIDFromPublicKey(&theirMessenger.identity.PublicKey)
 
-	actualPk, err := actualChat.PublicKey()
+	response, err := s.m.CreateGroupChatWithMembers(context.Background(), "test", []string{theirID})
 	s.Require().NoError(err)
+	chat := response.Chats[0]
+	s.Require().True(chat.Members[0].Owner)
 
-	s.Require().Equal(pk, actualPk)
+	_, err = s.m.RemoveAdminFromGroupChat(context.Background(), chat.ID, theirID)
+	s.Require().Error(err)
 
-	s.Require().Equal(expectedChat, actualChat)
-}
+	// The owner can't stop being an admin
+	_, err = s.m.RemoveAdminFromGroupChat(context.Background(), chat.ID, ourID)
+	s.Require().Error(err)
 
-func (s *MessengerSuite) TestChatPersistencePrivateGroupChat() {
+	_, err = s.m.AddAdminsToGroupChat(context.Background(), chat.ID, []string{theirID})
+	s.Require().NoError(err)
+	response, err = s.m.RemoveAdminFromGroupChat(context.Background(), chat.ID, theirID)
+	s.Require().NoError(err)
+	s.Require().False(response.Chats[0].IsAdmin(theirID))
+	s.Require().Len(response.Messages, 1)
 
-	member1Key, err := crypto.GenerateKey()
+	response, err = s.m.TransferGroupChatOwnership(context.Background(), chat.ID, theirID)
 	s.Require().NoError(err)
-	member1ID := types.EncodeHex(crypto.FromECDSAPub(&member1Key.PublicKey))
+	chat = response.Chats[0]
+	s.Require().True(chat.IsAdmin(theirID))
+	s.Require().False(chat.Members[0].Owner)
+	s.Require().True(chat.Members[1].Owner)
 
-	member2Key, err := crypto.GenerateKey()
+	// We are not the owner anymore
+	_, err = s.m.TransferGroupChatOwnership(context.Background(), chat.ID, ourID)
+	s.Require().Error(err)
+}
+
+func (s *MessengerSuite) TestGroupChatOwnerLeaves() {
+	theirMessenger := s.newMessenger(s.shh)
+	theirID := contactIDFromPublicKey(&theirMessenger.identity.PublicKey)
+
+	response, err := s.m.CreateGroupChatWithMembers(context.Background(), "test", []string{theirID})
 	s.Require().NoError(err)
-	member2ID := types.EncodeHex(crypto.FromECDSAPub(&member2Key.PublicKey))
+	chat := response.Chats[0]
 
-	member3Key, err := crypto.GenerateKey()
+	err = tt.RetryWithBackOff(func() error {
+		_, err := theirMessenger.RetrieveAll()
+		if err != nil {
+			return err
+		}
+		if _, ok := theirMessenger.allChats[chat.ID]; !ok {
+			return errors.New("no group chat")
+		}
+		return nil
+	})
 	s.Require().NoError(err)
-	member3ID := types.EncodeHex(crypto.FromECDSAPub(&member3Key.PublicKey))
 
-	chat := Chat{
-		ID:        "chat-id",
-		Name:      "chat-id",
-		Color:     "#fffff",
-		Active:    true,
-		ChatType:  ChatTypePrivateGroupChat,
-		Timestamp: 10,
-		Members: []ChatMember{
-			{
-				ID:     member1ID,
-				Admin:  false,
-				Joined: true,
-			},
-			{
-				ID:     member2ID,
-				Admin:  true,
-				Joined: false,
-			},
-			{
-				ID:     member3ID,
-				Admin:  true,
-				Joined: true,
-			},
-		},
-		MembershipUpdates: []v1protocol.MembershipUpdateEvent{
-			{
-				Type:       protobuf.MembershipUpdateEvent_CHAT_CREATED,
-				Name:       "name-1",
-				ClockValue: 1,
-				Signature:  []byte("signature-1"),
-				From:       "from-1",
-				Members:    []string{"member-1", "member-2"},
-			},
-			{
-				Type:       protobuf.MembershipUpdateEvent_MEMBERS_ADDED,
-				Name:       "name-2",
-				ClockValue: 2,
-				Signature:  []byte("signature-2"),
-				From:       "from-2",
-				Members:    []string{"member-2", "member-3"},
-			},
-		},
-		LastClockValue:        20,
-		DeletedAtClockValue:   30,
-		UnviewedMessagesCount: 40,
-		LastMessage:           []byte("test"),
-	}
-	s.Require().NoError(s.m.SaveChat(&chat))
-	savedChats := s.m.Chats()
-	s.Require().Equal(1, len(savedChats))
+	// The last admin leaving, the oldest member is promoted
+	_, err = s.m.LeaveGroupChat(context.Background(), chat.ID)
+	s.Require().NoError(err)
 
-	actualChat := savedChats[0]
-	expectedChat := &chat
+	err = tt.RetryWithBackOff(func() error {
+		_, err := theirMessenger.RetrieveAll()
+		if err != nil {
+			return err
+		}
+		if !theirMessenger.allChats[chat.ID].IsAdmin(theirID) {
+			return errors.New("no admin promoted")
+		}
+		return nil
+	})
+	s.Require().NoError(err)
 
-	s.Require().Equal(expectedChat, actualChat)
+	theirChat := theirMessenger.allChats[chat.ID]
+	s.Require().Len(theirChat.Members, 1)
+	s.Require().True(theirChat.Members[0].Owner)
 }
 
-func (s *MessengerSuite) TestBlockContact() {
-	contact := Contact{
-		ID:          testPK,
-		Name:        "contact-name",
-		Photo:       "contact-photo",
-		LastUpdated: 20,
-		SystemTags:  []string{"1", "2"},
-		DeviceInfo: []ContactDeviceInfo{
-			{
-				InstallationID: "1",
-				Timestamp:      2,
-				FCMToken:       "token",
-			},
-			{
-				InstallationID: "2",
-				Timestamp:      3,
-				FCMToken:       "token-2",
-			},
-		},
-		TributeToTalk: "talk",
-	}
+func (s *MessengerSuite) TestGroupChatInvitations() {
+	theirMessenger := s.newMessenger(s.shh)
+	otherMessenger := s.newMessenger(s.shh)
+	theirID := contactIDFromPublicKey(&theirMessenger.identity.PublicKey)
+	otherID := contactIDFromPublicKey(&otherMessenger.identity.PublicKey)
 
-	chat1 := Chat{
-		ID:                    contact.ID,
-		Name:                  "chat-name",
-		Color:                 "#fffff",
-		Active:                true,
-		ChatType:              ChatTypeOneToOne,
-		Timestamp:             1,
-		LastClockValue:        20,
-		DeletedAtClockValue:   30,
-		UnviewedMessagesCount: 40,
-	}
+	response, err := s.m.CreateGroupChatWithMembers(context.Background(), "test", []string{})
+	s.Require().NoError(err)
+	chat := response.Chats[0]
 
-	chat2 := Chat{
-		ID:                    "chat-2",
-		Name:                  "chat-name",
-		Color:                 "#fffff",
-		Active:                true,
-		ChatType:              ChatTypePublic,
-		Timestamp:             2,
-		LastClockValue:        20,
-		DeletedAtClockValue:   30,
-		UnviewedMessagesCount: 40,
-	}
+	// Only admins can create invitations
+	_, err = theirMessenger.CreateGroupChatInvitation(chat.ID, 60000)
+	s.Require().Error(err)
 
-	chat3 := Chat{
-		ID:                    "chat-3",
-		Name:                  "chat-name",
-		Color:                 "#fffff",
-		Active:                true,
-		ChatType:              ChatTypePublic,
-		Timestamp:             3,
-		LastClockValue:        20,
-		DeletedAtClockValue:   30,
-		UnviewedMessagesCount: 40,
-	}
+	invitation, err := s.m.CreateGroupChatInvitation(chat.ID, 60000)
+	s.Require().NoError(err)
+	s.Require().NotEmpty(invitation.Code)
 
-	s.Require().NoError(s.m.SaveChat(&chat1))
-	s.Require().NoError(s.m.SaveChat(&chat2))
-	s.Require().NoError(s.m.SaveChat(&chat3))
+	_, err = s.m.CreateGroupChatInvitation(chat.ID, 0)
+	s.Require().Error(err)
+	s.Require().Error(theirMessenger.RedeemGroupChatInvitation(context.Background(), "invalid"))
 
-	s.Require().NoError(s.m.SaveContact(&contact))
+	// Redeeming the invitation sends a join request to the admin
+	s.Require().NoError(theirMessenger.RedeemGroupChatInvitation(context.Background(), invitation.Code))
 
-	contact.Name = "blocked"
+	err = tt.RetryWithBackOff(func() error {
+		response, err := s.m.RetrieveAll()
+		if err == nil && len(response.GroupChatJoinRequests) == 0 {
+			err = errors.New("no join requests")
+		}
+		return err
+	})
+	s.Require().NoError(err)
 
-	messages := []*Message{
-		{
-			ID:          "test-1",
-			LocalChatID: chat2.ID,
-			ChatMessage: protobuf.ChatMessage{
-				ContentType: 1,
-				Text:        "test-1",
-				Clock:       1,
-			},
-			From: contact.ID,
-		},
-		{
-			ID:          "test-2",
-			LocalChatID: chat2.ID,
-			ChatMessage: protobuf.ChatMessage{
-				ContentType: 2,
-				Text:        "test-2",
-				Clock:       2,
-			},
-			From: contact.ID,
-		},
-		{
-			ID:          "test-3",
-			LocalChatID: chat2.ID,
-			ChatMessage: protobuf.ChatMessage{
-				ContentType: 3,
-				Text:        "test-3",
-				Clock:       3,
-			},
-			Seen: false,
-			From: "test",
-		},
-		{
-			ID:          "test-4",
-			LocalChatID: chat2.ID,
-			ChatMessage: protobuf.ChatMessage{
-				ContentType: 4,
-				Text:        "test-4",
-				Clock:       4,
-			},
-			Seen: false,
-			From: "test",
-		},
-		{
-			ID:          "test-5",
-			LocalChatID: chat2.ID,
-			ChatMessage: protobuf.ChatMessage{
-				ContentType: 5,
-				Text:        "test-5",
-				Clock:       5,
-			},
-			Seen: true,
-			From: "test",
-		},
-		{
-			ID:          "test-6",
-			LocalChatID: chat3.ID,
-			ChatMessage: protobuf.ChatMessage{
-				ContentType: 6,
-				Text:        "test-6",
-				Clock:       6,
-			},
-			Seen: false,
-			From: contact.ID,
-		},
-		{
-			ID:          "test-7",
-			LocalChatID: chat3.ID,
-			ChatMessage: protobuf.ChatMessage{
-				ContentType: 7,
-				Text:        "test-7",
-				Clock:       7,
-			},
-			Seen: false,
-			From: "test",
-		},
+	requests, err := s.m.PendingGroupChatJoinRequests(chat.ID)
+	s.Require().NoError(err)
+	s.Require().Len(requests, 1)
+	s.Require().Equal(theirID, requests[0].From)
+	s.Require().Equal(invitation.ID, requests[0].InvitationID)
+
+	response, err = s.m.ApproveGroupChatJoinRequest(context.Background(), chat.ID, theirID)
+	s.Require().NoError(err)
+	s.Require().True(response.Chats[0].IsMember(theirID))
+
+	requests, err = s.m.PendingGroupChatJoinRequests(chat.ID)
+	s.Require().NoError(err)
+	s.Require().Len(requests, 0)
+
+	// Rejected requests can't be approved
+	s.Require().NoError(otherMessenger.RedeemGroupChatInvitation(context.Background(), invitation.Code))
+	err = tt.RetryWithBackOff(func() error {
+		response, err := s.m.RetrieveAll()
+		if err == nil && len(response.GroupChatJoinRequests) == 0 {
+			err = errors.New("no join requests")
+		}
+		return err
+	})
+	s.Require().NoError(err)
+
+	s.Require().NoError(s.m.RejectGroupChatJoinRequest(context.Background(), chat.ID, otherID))
+	_, err = s.m.ApproveGroupChatJoinRequest(context.Background(), chat.ID, otherID)
+	s.Require().Error(err)
+
+	// Requests sent with revoked invitations are ignored
+	s.Require().NoError(s.m.RevokeGroupChatInvitation(context.Background(), invitation.ID))
+	revoked, err := s.m.persistence.GroupChatInvitationRevoked(invitation.ID, chat.ID)
+	s.Require().NoError(err)
+	s.Require().True(revoked)
+}
+
+func (s *MessengerSuite) TestGroupChatInvitationsWithSeveralAdmins() {
+	adminMessenger := s.newMessenger(s.shh)
+	adminID := contactIDFromPublicKey(&adminMessenger.identity.PublicKey)
+
+	response, err := s.m.CreateGroupChatWithMembers(context.Background(), "test", []string{adminID})
+	s.Require().NoError(err)
+	chat := response.Chats[0]
+	_, err = s.m.AddAdminsToGroupChat(context.Background(), chat.ID, []string{adminID})
+	s.Require().NoError(err)
+
+	err = tt.RetryWithBackOff(func() error {
+		_, err := adminMessenger.RetrieveAll()
+		if err != nil {
+			return err
+		}
+		if adminChat, ok := adminMessenger.allChats[chat.ID]; !ok || !adminChat.IsAdmin(adminID) {
+			return errors.New("not an admin yet")
+		}
+		return nil
+	})
+	s.Require().NoError(err)
+
+	invitation, err := s.m.CreateGroupChatInvitation(chat.ID, 60000)
+	s.Require().NoError(err)
+
+	// The join requests are received by all the admins
+	redeem := func(code string) string {
+		requesterMessenger := s.newMessenger(s.shh)
+		s.Require().NoError(requesterMessenger.RedeemGroupChatInvitation(context.Background(), code))
+		requesterID := contactIDFromPublicKey(&requesterMessenger.identity.PublicKey)
+
+		for _, messenger := range []*Messenger{s.m, adminMessenger} {
+			err := tt.RetryWithBackOff(func() error {
+				_, err := messenger.RetrieveAll()
+				if err != nil {
+					return err
+				}
+				if _, err := messenger.pendingGroupChatJoinRequest(chat.ID, requesterID); err != nil {
+					return err
+				}
+				return nil
+			})
+			s.Require().NoError(err)
+		}
+		return requesterID
 	}
 
-	err := s.m.SaveMessages(messages)
+	// Approved by another admin, it's approved for us too
+	approvedID := redeem(invitation.Code)
+	_, err = adminMessenger.ApproveGroupChatJoinRequest(context.Background(), chat.ID, approvedID)
 	s.Require().NoError(err)
 
-	response, err := s.m.BlockContact(&contact)
+	err = tt.RetryWithBackOff(func() error {
+		_, err := s.m.RetrieveAll()
+		if err != nil {
+			return err
+		}
+		request, err := s.m.persistence.GroupChatJoinRequest(chat.ID, approvedID)
+		if err == nil && request.State != GroupChatJoinRequestStateApproved {
+			err = errors.New("request not approved")
+		}
+		return err
+	})
 	s.Require().NoError(err)
+	s.Require().True(s.m.allChats[chat.ID].IsMember(approvedID))
 
-	// The new unviewed count is updated
-	s.Require().Equal(uint(1), response[0].UnviewedMessagesCount)
-	s.Require().Equal(uint(2), response[1].UnviewedMessagesCount)
+	// Rejected by us, it's rejected for the other admin too
+	rejectedID := redeem(invitation.Code)
+	s.Require().NoError(s.m.RejectGroupChatJoinRequest(context.Background(), chat.ID, rejectedID))
 
-	// The new message content is updated
-	decodedMessage := &Message{}
-	s.Require().NotNil(response[0].LastMessage)
+	err = tt.RetryWithBackOff(func() error {
+		_, err := adminMessenger.RetrieveAll()
+		if err != nil {
+			return err
+		}
+		request, err := adminMessenger.persistence.GroupChatJoinRequest(chat.ID, rejectedID)
+		if err == nil && request.State != GroupChatJoinRequestStateRejected {
+			err = errors.New("request not rejected")
+		}
+		return err
+	})
+	s.Require().NoError(err)
 
-	s.Require().NoError(json.Unmarshal(response[0].LastMessage, decodedMessage))
-	s.Require().Equal("test-7", decodedMessage.ID)
+	_, err = adminMessenger.ApproveGroupChatJoinRequest(context.Background(), chat.ID, rejectedID)
+	s.Require().Error(err)
 
-	decodedMessage = &Message{}
-	s.Require().NotNil(response[1].LastMessage)
+	// Revoked by us, it's revoked for the other admin too
+	s.Require().NoError(s.m.RevokeGroupChatInvitation(context.Background(), invitation.ID))
 
-	s.Require().NoError(json.Unmarshal(response[1].LastMessage, decodedMessage))
-	s.Require().Equal("test-5", decodedMessage.ID)
+	err = tt.RetryWithBackOff(func() error {
+		_, err := adminMessenger.RetrieveAll()
+		if err != nil {
+			return err
+		}
+		revoked, err := adminMessenger.persistence.GroupChatInvitationRevoked(invitation.ID, chat.ID)
+		if err == nil && !revoked {
+			err = errors.New("invitation not revoked")
+		}
+		return err
+	})
+	s.Require().NoError(err)
 
-	// The contact is updated
-	savedContacts := s.m.Contacts()
-	s.Require().Equal(1, len(savedContacts))
-	s.Require().Equal("blocked", savedContacts[0].Name)
+	requesterMessenger := s.newMessenger(s.shh)
+	s.Require().NoError(requesterMessenger.RedeemGroupChatInvitation(context.Background(), invitation.Code))
+	requesterID := contactIDFromPublicKey(&requesterMessenger.identity.PublicKey)
 
-	// The chat is deleted
-	actualChats := s.m.Chats()
-	s.Require().Equal(2, len(actualChats))
+	// Wait for the request to reach its destination
+	time.Sleep(100 * time.Millisecond)
+	response, err = adminMessenger.RetrieveAll()
+	s.Require().NoError(err)
+	s.Require().Len(response.GroupChatJoinRequests, 0)
+	_, err = adminMessenger.persistence.GroupChatJoinRequest(chat.ID, requesterID)
+	s.Require().Equal(errRecordNotFound, err)
+}
 
-	// The messages have been deleted
-	chat2Messages, _, err := s.m.MessageByChatID(chat2.ID, "", 20)
+func (s *MessengerSuite) TestCommunities() {
+	theirMessenger := s.newMessenger(s.shh)
+	theirID := contactIDFromPublicKey(&theirMessenger.identity.PublicKey)
+
+	response, err := s.m.CreateCommunity(context.Background(), "status", "a community", protobuf.CommunityDescription_OPEN)
 	s.Require().NoError(err)
-	s.Require().Equal(3, len(chat2Messages))
+	s.Require().Len(response.Communities, 1)
+	community := response.Communities[0]
 
-	chat3Messages, _, err := s.m.MessageByChatID(chat3.ID, "", 20)
+	response, err = s.m.CreateCommunityChannel(context.Background(), community.Id, "general", "")
 	s.Require().NoError(err)
-	s.Require().Equal(1, len(chat3Messages))
+	s.Require().Len(response.Chats, 1)
+	chat := response.Chats[0]
+	s.Require().Equal(ChatTypeCommunityChat, chat.ChatType)
+	s.Require().Equal(community.Id, chat.CommunityID)
 
-}
+	// Only the owner can add channels
+	_, err = theirMessenger.CreateCommunityChannel(context.Background(), community.Id, "other", "")
+	s.Require().Error(err)
 
-func (s *MessengerSuite) TestContactPersistence() {
-	contact := Contact{
-		ID: testPK,
+	// Requests to join open communities are accepted straight away
+	s.Require().NoError(theirMessenger.RequestToJoinCommunity(context.Background(), community.Id))
+	err = tt.RetryWithBackOff(func() error {
+		response, err := s.m.RetrieveAll()
+		if err == nil && (len(response.Communities) == 0 || !response.Communities[0].IsMember(theirID)) {
+			err = errors.New("request to join not accepted")
+		}
+		return err
+	})
+	s.Require().NoError(err)
 
-		Name:        "contact-name",
-		Photo:       "contact-photo",
-		LastUpdated: 20,
-		SystemTags:  []string{"1", "2"},
-		DeviceInfo: []ContactDeviceInfo{
-			{
-				InstallationID: "1",
-				Timestamp:      2,
-				FCMToken:       "token",
-			},
-			{
-				InstallationID: "2",
-				Timestamp:      3,
-				FCMToken:       "token-2",
-			},
-		},
-		TributeToTalk: "talk",
-	}
+	// They receive the key of the community
+	err = tt.RetryWithBackOff(func() error {
+		response, err := theirMessenger.RetrieveAll()
+		if err == nil && (len(response.Communities) == 0 || !response.Communities[0].Joined) {
+			err = errors.New("community not joined")
+		}
+		return err
+	})
+	s.Require().NoError(err)
+	theirChat, ok := theirMessenger.allChats[chat.ID]
+	s.Require().True(ok)
+	s.Require().True(theirChat.Active)
 
-	s.Require().NoError(s.m.SaveContact(&contact))
-	savedContacts := s.m.Contacts()
-	s.Require().Equal(1, len(savedContacts))
+	// Their messages are decrypted with the key
+	_, err = theirMessenger.SendChatMessage(context.Background(), buildTestMessage(*theirChat))
+	s.Require().NoError(err)
 
-	actualContact := savedContacts[0]
-	expectedContact := &contact
-	expectedContact.Alias = testAlias
-	expectedContact.Identicon = testIdenticon
-	s.Require().Equal(expectedContact, actualContact)
+	err = tt.RetryWithBackOff(func() error {
+		response, err = s.m.RetrieveAll()
+		if err == nil && len(response.Messages) == 0 {
+			err = errors.New("no messages")
+		}
+		return err
+	})
+	s.Require().NoError(err)
+	s.Require().Equal(chat.ID, response.Messages[0].LocalChatID)
+	s.Require().Equal(protobuf.ChatMessage_COMMUNITY_CHAT, response.Messages[0].MessageType)
+
+	// Removing them rotates the key
+	response, err = s.m.RemoveUserFromCommunity(context.Background(), community.Id, theirID)
+	s.Require().NoError(err)
+	s.Require().False(response.Communities[0].IsMember(theirID))
+	s.Require().Equal(community.KeyId+1, response.Communities[0].KeyId)
+
+	err = tt.RetryWithBackOff(func() error {
+		response, err := theirMessenger.RetrieveAll()
+		if err == nil && (len(response.Communities) == 0 || response.Communities[0].Joined) {
+			err = errors.New("removal not received")
+		}
+		return err
+	})
+	s.Require().NoError(err)
+	s.Require().False(theirMessenger.allChats[chat.ID].Active)
+
+	_, err = theirMessenger.SendChatMessage(context.Background(), buildTestMessage(*theirChat))
+	s.Require().Error(err)
 }
 
-func (s *MessengerSuite) TestContactPersistenceUpdate() {
-	contactID := testPK
+func (s *MessengerSuite) TestCommunityRemovedMemberCantRead() {
+	response, err := s.m.CreateCommunity(context.Background(), "status", "", protobuf.CommunityDescription_OPEN)
+	s.Require().NoError(err)
+	community := response.Communities[0]
 
-	contact := Contact{
-		ID:          contactID,
-		Name:        "contact-name",
-		Photo:       "contact-photo",
-		LastUpdated: 20,
-		SystemTags:  []string{"1", "2"},
-		DeviceInfo: []ContactDeviceInfo{
-			{
-				InstallationID: "1",
-				Timestamp:      2,
-				FCMToken:       "token",
-			},
-			{
-				InstallationID: "2",
-				Timestamp:      3,
-				FCMToken:       "token-2",
-			},
-		},
-		TributeToTalk: "talk",
+	response, err = s.m.CreateCommunityChannel(context.Background(), community.Id, "general", "")
+	s.Require().NoError(err)
+	chat := response.Chats[0]
+
+	join := func() (*Messenger, string) {
+		messenger := s.newMessenger(s.shh)
+		id := contactIDFromPublicKey(&messenger.identity.PublicKey)
+		s.Require().NoError(messenger.RequestToJoinCommunity(context.Background(), community.Id))
+		err := tt.RetryWithBackOff(func() error {
+			_, err := s.m.RetrieveAll()
+			if err != nil {
+				return err
+			}
+			_, err = messenger.RetrieveAll()
+			if err != nil {
+				return err
+			}
+			if chat, ok := messenger.allChats[chat.ID]; !ok || !chat.Active {
+				return errors.New("community not joined")
+			}
+			return nil
+		})
+		s.Require().NoError(err)
+		return messenger, id
 	}
+	removedMessenger, removedID := join()
+	memberMessenger, _ := join()
 
-	s.Require().NoError(s.m.SaveContact(&contact))
-	savedContacts := s.m.Contacts()
-	s.Require().Equal(1, len(savedContacts))
+	community, err = s.m.persistence.Community(community.Id)
+	s.Require().NoError(err)
+	oldKeyID := community.KeyId
 
-	actualContact := savedContacts[0]
-	expectedContact := &contact
+	response, err = s.m.RemoveUserFromCommunity(context.Background(), community.Id, removedID)
+	s.Require().NoError(err)
+	newKeyID := response.Communities[0].KeyId
+	s.Require().Equal(oldKeyID+1, newKeyID)
 
-	expectedContact.Alias = testAlias
-	expectedContact.Identicon = testIdenticon
+	// The remaining member gets the new key, the removed one drops the
+	// ones it had
+	err = tt.RetryWithBackOff(func() error {
+		_, err := memberMessenger.RetrieveAll()
+		if err != nil {
+			return err
+		}
+		_, err = memberMessenger.persistence.CommunityKey(community.Id, newKeyID)
+		return err
+	})
+	s.Require().NoError(err)
 
-	s.Require().Equal(expectedContact, actualContact)
+	err = tt.RetryWithBackOff(func() error {
+		response, err := removedMessenger.RetrieveAll()
+		if err == nil && (len(response.Communities) == 0 || response.Communities[0].Joined) {
+			err = errors.New("removal not received")
+		}
+		return err
+	})
+	s.Require().NoError(err)
+	_, err = removedMessenger.persistence.CommunityKey(community.Id, oldKeyID)
+	s.Require().Equal(errRecordNotFound, err)
+	_, err = removedMessenger.persistence.CommunityKey(community.Id, newKeyID)
+	s.Require().Equal(errRecordNotFound, err)
 
-	contact.Name = "updated-name-2"
-	s.Require().NoError(s.m.SaveContact(&contact))
-	updatedContact := s.m.Contacts()
-	s.Require().Equal(1, len(updatedContact))
+	// The removed member keeps listening to the channel
+	removedChat := removedMessenger.allChats[chat.ID]
+	s.Require().NoError(removedMessenger.Join(*removedChat))
 
-	actualUpdatedContact := updatedContact[0]
-	expectedUpdatedContact := &contact
+	sendResponse, err := s.m.SendChatMessage(context.Background(), buildTestMessage(*chat))
+	s.Require().NoError(err)
+	messageID := sendResponse.Messages[0].ID
 
-	s.Require().Equal(expectedUpdatedContact, actualUpdatedContact)
-}
+	err = tt.RetryWithBackOff(func() error {
+		_, err := memberMessenger.RetrieveAll()
+		if err != nil {
+			return err
+		}
+		_, err = memberMessenger.MessageByID(messageID)
+		return err
+	})
+	s.Require().NoError(err)
 
-func (s *MessengerSuite) TestSharedSecretHandler() {
-	_, err := s.m.handleSharedSecrets(nil)
-	s.NoError(err)
+	response, err = removedMessenger.RetrieveAll()
+	s.Require().NoError(err)
+	s.Require().Len(response.Messages, 0)
+	_, err = removedMessenger.MessageByID(messageID)
+	s.Require().Error(err)
 }
 
-func (s *MessengerSuite) TestCreateGroupChatWithMembers() {
-	members := []string{testPK}
-	response, err := s.m.CreateGroupChatWithMembers(context.Background(), "test", members)
-	s.NoError(err)
-	s.Require().Len(response.Chats, 1)
+func (s *MessengerSuite) TestCommunityRequestToJoin() {
+	theirMessenger := s.newMessenger(s.shh)
+	theirID := contactIDFromPublicKey(&theirMessenger.identity.PublicKey)
 
-	chat := response.Chats[0]
+	response, err := s.m.CreateCommunity(context.Background(), "status", "", protobuf.CommunityDescription_REQUEST_TO_JOIN)
+	s.Require().NoError(err)
+	community := response.Communities[0]
 
-	s.Require().Equal("test", chat.Name)
-	publicKeyHex := "0x" + hex.EncodeToString(crypto.FromECDSAPub(&s.m.identity.PublicKey))
-	s.Require().Contains(chat.ID, publicKeyHex)
-	s.EqualValues([]string{publicKeyHex}, []string{chat.Members[0].ID})
-	s.Equal(members[0], chat.Members[1].ID)
-}
+	s.Require().NoError(theirMessenger.RequestToJoinCommunity(context.Background(), community.Id))
+	err = tt.RetryWithBackOff(func() error {
+		response, err := s.m.RetrieveAll()
+		if err == nil && len(response.CommunityRequestsToJoin) == 0 {
+			err = errors.New("no requests to join")
+		}
+		return err
+	})
+	s.Require().NoError(err)
 
-func (s *MessengerSuite) TestAddMembersToChat() {
-	response, err := s.m.CreateGroupChatWithMembers(context.Background(), "test", []string{})
+	requests, err := s.m.PendingCommunityRequestsToJoin(community.Id)
 	s.Require().NoError(err)
-	s.Require().Len(response.Chats, 1)
+	s.Require().Len(requests, 1)
+	s.Require().Equal(theirID, requests[0].From)
 
-	chat := response.Chats[0]
+	response, err = s.m.AcceptCommunityRequestToJoin(context.Background(), community.Id, theirID)
+	s.Require().NoError(err)
+	s.Require().True(response.Communities[0].IsMember(theirID))
 
-	key, err := crypto.GenerateKey()
+	err = tt.RetryWithBackOff(func() error {
+		response, err := theirMessenger.RetrieveAll()
+		if err == nil && (len(response.Communities) == 0 || !response.Communities[0].Joined) {
+			err = errors.New("community not joined")
+		}
+		return err
+	})
 	s.Require().NoError(err)
-	members := []string{"0x" + hex.EncodeToString(crypto.FromECDSAPub(&key.PublicKey))}
 
-	response, err = s.m.AddMembersToGroupChat(context.Background(), chat.ID, members)
+	// Leaving the community removes them and rotates the key
+	_, err = theirMessenger.LeaveCommunity(context.Background(), community.Id)
 	s.Require().NoError(err)
-	s.Require().Len(response.Chats, 1)
-	s.Require().Len(response.Messages, 1)
 
-	chat = response.Chats[0]
+	err = tt.RetryWithBackOff(func() error {
+		response, err := s.m.RetrieveAll()
+		if err == nil && (len(response.Communities) == 0 || response.Communities[0].IsMember(theirID)) {
+			err = errors.New("member not removed")
+		}
+		return err
+	})
+	s.Require().NoError(err)
 
-	publicKeyHex := "0x" + hex.EncodeToString(crypto.FromECDSAPub(&s.m.identity.PublicKey))
-	keyHex := "0x" + hex.EncodeToString(crypto.FromECDSAPub(&key.PublicKey))
-	s.EqualValues([]string{publicKeyHex, keyHex}, []string{chat.Members[0].ID, chat.Members[1].ID})
+	community, err = s.m.persistence.Community(community.Id)
+	s.Require().NoError(err)
+	s.Require().Equal(uint32(2), community.KeyId)
+
+	// The owner can't leave the community
+	_, err = s.m.LeaveCommunity(context.Background(), community.Id)
+	s.Require().Error(err)
 }
 
 func (s *MessengerSuite) TestDeclineRequestAddressForTransaction() {
@@ -2272,7 +4193,7 @@ func (s *MessageHandlerSuite) TestRun() {
 			s.Empty(message.LocalChatID)
 
 			message.ID = strconv.Itoa(idx) // manually set the ID because messages does not go through messageProcessor
-			chat, err := s.messageHandler.matchMessage(&message, chatsMap, &testTimeSource{})
+			chat, err := s.messageHandler.matchChatEntity(&message, chatsMap, &testTimeSource{})
 			if tc.Error {
 				s.Require().Error(err)
 			} else {
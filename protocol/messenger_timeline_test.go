@@ -0,0 +1,285 @@
+package protocol
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"errors"
+	"io/ioutil"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/zap"
+
+	gethbridge "github.com/status-im/status-go/eth-node/bridge/geth"
+	"github.com/status-im/status-go/eth-node/crypto"
+	"github.com/status-im/status-go/eth-node/types"
+	"github.com/status-im/status-go/protocol/protobuf"
+	"github.com/status-im/status-go/protocol/tt"
+	"github.com/status-im/status-go/whisper/v6"
+)
+
+func TestMessengerTimelineSuite(t *testing.T) {
+	suite.Run(t, new(MessengerTimelineSuite))
+}
+
+type MessengerTimelineSuite struct {
+	suite.Suite
+	m          *Messenger        // main instance of Messenger
+	privateKey *ecdsa.PrivateKey // private key for the main instance of Messenger
+	// If one wants to send messages between different instances of Messenger,
+	// a single Whisper service should be shared.
+	shh      types.Whisper
+	tmpFiles []*os.File // files to clean up
+	logger   *zap.Logger
+}
+
+func (s *MessengerTimelineSuite) SetupTest() {
+	s.logger = tt.MustCreateTestLogger()
+
+	config := whisper.DefaultConfig
+	config.MinimumAcceptedPOW = 0
+	shh := whisper.New(&config)
+	s.shh = gethbridge.NewGethWhisperWrapper(shh)
+	s.Require().NoError(shh.Start(nil))
+
+	s.m = s.newMessenger(s.shh)
+	s.privateKey = s.m.identity
+}
+
+func (s *MessengerTimelineSuite) newMessengerWithKey(shh types.Whisper, privateKey *ecdsa.PrivateKey) *Messenger {
+	tmpFile, err := ioutil.TempFile("", "")
+	s.Require().NoError(err)
+
+	options := []Option{
+		WithCustomLogger(s.logger),
+		WithMessagesPersistenceEnabled(),
+		WithDatabaseConfig(tmpFile.Name(), "some-key"),
+		WithDatasync(),
+	}
+	m, err := NewMessenger(
+		privateKey,
+		&testNode{shh: shh},
+		uuid.New().String(),
+		options...,
+	)
+	s.Require().NoError(err)
+
+	err = m.Init()
+	s.Require().NoError(err)
+
+	s.tmpFiles = append(s.tmpFiles, tmpFile)
+
+	return m
+}
+
+func (s *MessengerTimelineSuite) newMessenger(shh types.Whisper) *Messenger {
+	privateKey, err := crypto.GenerateKey()
+	s.Require().NoError(err)
+
+	return s.newMessengerWithKey(s.shh, privateKey)
+}
+
+func (s *MessengerTimelineSuite) addContact(m *Messenger, publicKey *ecdsa.PublicKey) {
+	contact := Contact{
+		ID:         types.EncodeHex(crypto.FromECDSAPub(publicKey)),
+		SystemTags: []string{contactAdded},
+	}
+	err := m.SaveContact(&contact)
+	s.Require().NoError(err)
+}
+
+func (s *MessengerTimelineSuite) TestTimeline() {
+	theirMessenger := s.newMessenger(s.shh)
+
+	// We follow their timeline once they are added as a contact
+	s.addContact(s.m, &theirMessenger.identity.PublicKey)
+	theirTimelineID := timelineChatID(&theirMessenger.identity.PublicKey)
+	s.Require().True(s.m.allChats[theirTimelineID].Active)
+
+	_, err := s.m.SendStatusUpdate(context.Background(), "our status")
+	s.Require().NoError(err)
+
+	sendResponse, err := theirMessenger.SendStatusUpdate(context.Background(), "their status")
+	s.Require().NoError(err)
+	s.Require().Len(sendResponse.Messages, 1)
+	postID := sendResponse.Messages[0].ID
+
+	// Wait for the status update to reach its destination
+	var response *MessengerResponse
+	err = tt.RetryWithBackOff(func() error {
+		var err error
+		response, err = s.m.RetrieveAll()
+		if err == nil && len(response.Messages) == 0 {
+			err = errors.New("no messages")
+		}
+		return err
+	})
+	s.Require().NoError(err)
+	s.Require().Len(response.Messages, 1)
+	s.Require().Equal(protobuf.ChatMessage_STATUS, response.Messages[0].ContentType)
+	s.Require().Equal(theirTimelineID, response.Messages[0].LocalChatID)
+
+	// Status updates are merged, most recent first, and paginated
+	timeline, cursor, err := s.m.Timeline("", 1)
+	s.Require().NoError(err)
+	s.Require().Len(timeline, 1)
+	s.Require().Equal(postID, timeline[0].ID)
+	s.Require().NotEmpty(cursor)
+
+	timeline, cursor, err = s.m.Timeline(cursor, 1)
+	s.Require().NoError(err)
+	s.Require().Len(timeline, 1)
+	s.Require().Equal("our status", timeline[0].Text)
+	s.Require().Empty(cursor)
+
+	// Only the owner of a timeline posts status updates on it
+	message := &Message{}
+	message.ChatId = theirTimelineID
+	message.Text = "not our timeline"
+	message.ContentType = protobuf.ChatMessage_STATUS
+	_, err = s.m.SendChatMessage(context.Background(), message)
+	s.Require().Error(err)
+
+	// Comments and reactions are scoped to a status update of the timeline
+	message = &Message{}
+	message.ChatId = theirTimelineID
+	message.Text = "not a comment"
+	message.ContentType = protobuf.ChatMessage_TEXT_PLAIN
+	_, err = s.m.SendChatMessage(context.Background(), message)
+	s.Require().Error(err)
+
+	_, err = s.m.SendEmojiReaction(context.Background(), theirTimelineID, timeline[0].ID, protobuf.EmojiReaction_LOVE)
+	s.Require().Error(err)
+
+	_, err = s.m.SendStatusUpdateComment(context.Background(), postID, "a comment")
+	s.Require().NoError(err)
+
+	_, err = s.m.SendEmojiReaction(context.Background(), theirTimelineID, postID, protobuf.EmojiReaction_LOVE)
+	s.Require().NoError(err)
+
+	// The comment and the reaction might be received in separate batches
+	var receivedMessages []*Message
+	var receivedReactions []*EmojiReaction
+	err = tt.RetryWithBackOff(func() error {
+		response, err := theirMessenger.RetrieveAll()
+		if err != nil {
+			return err
+		}
+		receivedMessages = append(receivedMessages, response.Messages...)
+		receivedReactions = append(receivedReactions, response.EmojiReactions...)
+		if len(receivedMessages) == 0 || len(receivedReactions) == 0 {
+			return errors.New("no comment or reaction")
+		}
+		return nil
+	})
+	s.Require().NoError(err)
+	s.Require().Equal(postID, receivedReactions[0].MessageId)
+
+	comments, err := theirMessenger.StatusUpdateComments(postID)
+	s.Require().NoError(err)
+	s.Require().Len(comments, 1)
+	s.Require().Equal("a comment", comments[0].Text)
+
+	// Their status updates are not followed anymore once they are removed
+	contact := s.m.allContacts[types.EncodeHex(crypto.FromECDSAPub(&theirMessenger.identity.PublicKey))]
+	contact.SystemTags = nil
+	err = s.m.SaveContact(contact)
+	s.Require().NoError(err)
+
+	timeline, _, err = s.m.Timeline("", 10)
+	s.Require().NoError(err)
+	s.Require().Len(timeline, 1)
+	s.Require().Equal("our status", timeline[0].Text)
+}
+
+func (s *MessengerTimelineSuite) TestCommentAndReactionBeforeStatusUpdate() {
+	theirMessenger := s.newMessenger(s.shh)
+	otherMessenger := s.newMessenger(s.shh)
+	theirTimelineID := timelineChatID(&theirMessenger.identity.PublicKey)
+
+	// We receive their status update, the other user doesn't follow them yet
+	s.addContact(s.m, &theirMessenger.identity.PublicKey)
+
+	sendResponse, err := theirMessenger.SendStatusUpdate(context.Background(), "their status")
+	s.Require().NoError(err)
+	postID := sendResponse.Messages[0].ID
+
+	err = tt.RetryWithBackOff(func() error {
+		response, err := s.m.RetrieveAll()
+		if err == nil && len(response.Messages) == 0 {
+			err = errors.New("no messages")
+		}
+		return err
+	})
+	s.Require().NoError(err)
+
+	s.addContact(otherMessenger, &theirMessenger.identity.PublicKey)
+
+	sendResponse, err = s.m.SendStatusUpdateComment(context.Background(), postID, "a comment")
+	s.Require().NoError(err)
+	commentID := sendResponse.Messages[0].ID
+	_, err = s.m.SendEmojiReaction(context.Background(), theirTimelineID, postID, protobuf.EmojiReaction_LOVE)
+	s.Require().NoError(err)
+
+	// The comment and the reaction are held until the status update is
+	// received
+	err = tt.RetryWithBackOff(func() error {
+		_, err := otherMessenger.RetrieveAll()
+		if err != nil {
+			return err
+		}
+		exist, err := otherMessenger.persistence.MessagesExist([]string{commentID})
+		if err == nil && !exist[commentID] {
+			err = errors.New("no comment")
+		}
+		return err
+	})
+	s.Require().NoError(err)
+
+	// Wait for the reaction to reach its destination
+	time.Sleep(100 * time.Millisecond)
+	response, err := otherMessenger.RetrieveAll()
+	s.Require().NoError(err)
+	s.Require().Len(response.Messages, 0)
+	s.Require().Len(response.EmojiReactions, 0)
+
+	messages, _, err := otherMessenger.MessageByChatID(theirTimelineID, "", 10)
+	s.Require().NoError(err)
+	s.Require().Len(messages, 0)
+
+	// They are shown along with the status update once it's received
+	s.Require().NoError(theirMessenger.ReSendChatMessage(context.Background(), postID))
+
+	var receivedMessages []*Message
+	var receivedReactions []*EmojiReaction
+	err = tt.RetryWithBackOff(func() error {
+		response, err := otherMessenger.RetrieveAll()
+		if err != nil {
+			return err
+		}
+		receivedMessages = append(receivedMessages, response.Messages...)
+		receivedReactions = append(receivedReactions, response.EmojiReactions...)
+		if len(receivedMessages) == 0 {
+			return errors.New("no status update")
+		}
+		return nil
+	})
+	s.Require().NoError(err)
+	s.Require().Len(receivedMessages, 2)
+	s.Require().Equal(postID, receivedMessages[0].ID)
+	s.Require().Equal(commentID, receivedMessages[1].ID)
+	s.Require().Len(receivedReactions, 1)
+	s.Require().Equal(postID, receivedReactions[0].MessageId)
+
+	comments, err := otherMessenger.StatusUpdateComments(postID)
+	s.Require().NoError(err)
+	s.Require().Len(comments, 1)
+	s.Require().Equal(commentID, comments[0].ID)
+
+	reactions, err := otherMessenger.persistence.EmojiReactionsByChatID(theirTimelineID, "", 10)
+	s.Require().NoError(err)
+	s.Require().Len(reactions, 1)
+}
@@ -4,6 +4,60 @@
 // 000001_init.up.db.sql (2.719kB)
 // 000002_add_last_ens_clock_value.down.sql (0)
 // 000002_add_last_ens_clock_value.up.sql (77B)
+// 000003_add_emoji_reactions.down.sql (28B)
+// 000003_add_emoji_reactions.up.sql (490B)
+// 000004_add_message_edits.down.sql (32B)
+// 000004_add_message_edits.up.sql (377B)
+// 000005_add_message_deletes.down.sql (34B)
+// 000005_add_message_deletes.up.sql (438B)
+// 000006_add_images.down.sql (27B)
+// 000006_add_images.up.sql (537B)
+// 000007_add_audio.down.sql (0)
+// 000007_add_audio.up.sql (208B)
+// 000008_add_pin_messages.down.sql (25B)
+// 000008_add_pin_messages.up.sql (345B)
+// 000009_add_user_messages_fts.down.sql (211B)
+// 000009_add_user_messages_fts.up.sql (1.019kB)
+// 000010_add_mentions.down.sql (0)
+// 000010_add_mentions.up.sql (148B)
+// 000011_add_link_previews.down.sql (26B)
+// 000011_add_link_previews.up.sql (253B)
+// 000012_add_chat_notification_settings.down.sql (0)
+// 000012_add_chat_notification_settings.up.sql (277B)
+// 000013_add_forwarded_from.down.sql (0)
+// 000013_add_forwarded_from.up.sql (58B)
+// 000014_add_scheduled_messages.down.sql (31B)
+// 000014_add_scheduled_messages.up.sql (256B)
+// 000015_add_disappearing_messages.down.sql (0)
+// 000015_add_disappearing_messages.up.sql (329B)
+// 000016_add_contact_requests.down.sql (0)
+// 000016_add_contact_requests.up.sql (328B)
+// 000017_add_contact_local_nickname.down.sql (0)
+// 000017_add_contact_local_nickname.up.sql (150B)
+// 000018_add_chat_description_image.down.sql (0)
+// 000018_add_chat_description_image.up.sql (108B)
+// 000019_add_group_chat_invitations.down.sql (72B)
+// 000019_add_group_chat_invitations.up.sql (441B)
+// 000020_add_communities.down.sql (90B)
+// 000020_add_communities.up.sql (635B)
+// 000021_add_polls.down.sql (23B)
+// 000021_add_polls.up.sql (282B)
+// 000022_add_contact_profile.down.sql (0)
+// 000022_add_contact_profile.up.sql (249B)
+// 000023_add_pending_chat_notification_settings.down.sql (47B)
+// 000023_add_pending_chat_notification_settings.up.sql (268B)
+// 000024_add_scheduled_messages_attempts.down.sql (0)
+// 000024_add_scheduled_messages_attempts.up.sql (231B)
+// 000025_add_edits_and_deletes_local_chat_id.down.sql (0)
+// 000025_add_edits_and_deletes_local_chat_id.up.sql (174B)
+// 000026_add_message_chunks_received_at.down.sql (0)
+// 000026_add_message_chunks_received_at.up.sql (74B)
+// 000027_add_pin_messages_local_chat_id_key.down.sql (599B)
+// 000027_add_pin_messages_local_chat_id_key.up.sql (605B)
+// 000028_add_forwarded_from_verified.down.sql (0)
+// 000028_add_forwarded_from_verified.up.sql (93B)
+// 000029_add_user_messages_awaiting_post.down.sql (0)
+// 000029_add_user_messages_awaiting_post.up.sql (83B)
 // doc.go (377B)
 
 package migrations
@@ -153,6 +207,1086 @@ func _000002_add_last_ens_clock_valueUpSql() (*asset, error) {
 	return a, nil
 }
 
+var __000003_add_emoji_reactionsDownSql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x00\x1c\x00\xe3\xff\x44\x52\x4f\x50\x20\x54\x41\x42\x4c\x45\x20\x65\x6d\x6f\x6a\x69\x5f\x72\x65\x61\x63\x74\x69\x6f\x6e\x73\x3b\x0a\x03\x00\xbb\x4e\x98\x5e\x1c\x00\x00\x00")
+
+func _000003_add_emoji_reactionsDownSqlBytes() ([]byte, error) {
+	return bindataRead(
+		__000003_add_emoji_reactionsDownSql,
+		"000003_add_emoji_reactions.down.sql",
+	)
+}
+
+func _000003_add_emoji_reactionsDownSql() (*asset, error) {
+	bytes, err := _000003_add_emoji_reactionsDownSqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "000003_add_emoji_reactions.down.sql", size: 28, mode: os.FileMode(0644), modTime: time.Unix(1792201230, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0xb9, 0x6, 0xbe, 0x14, 0xac, 0x59, 0xa3, 0x63, 0x12, 0x72, 0x97, 0x7a, 0x55, 0x21, 0x5b, 0xd6, 0x5c, 0x6f, 0x3, 0x29, 0xda, 0x4d, 0xb6, 0x29, 0x8b, 0xc5, 0x8f, 0x60, 0x7a, 0x22, 0x7f, 0xc7}}
+	return a, nil
+}
+
+var __000003_add_emoji_reactionsUpSql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x7c\x90\x51\x4f\xb3\x30\x18\x85\xef\xfb\x2b\xce\xe5\x48\xb8\xf8\xee\xb9\xea\xc7\x5e\x62\x63\x2d\xb3\x2b\x86\x5d\x35\x4d\x69\xb4\xca\x24\x01\x66\xfc\xf9\x66\x82\x6e\x4c\xe7\x2d\xe7\xa1\xcf\x7b\x4e\xae\x89\x1b\x82\xe1\xff\x25\x41\x14\x50\xa5\x01\xd5\x62\x6b\xb6\x08\xfb\xee\x39\xda\x3e\x38\x3f\xc6\xee\x75\xc0\x8a\x01\xb1\xc1\x03\xd7\xf9\x0d\xd7\xd8\x68\x71\xc7\xf5\x0e\xb7\xb4\x43\xa9\x90\x97\xaa\x90\x22\x37\xd0\xb4\x91\x3c\xa7\x94\x01\xbe\xed\xfc\x8b\x7d\x73\xed\x21\x40\x28\xf3\xf9\xb8\xaa\xa4\x3c\x66\x43\x77\xe8\x7d\x80\xa1\x7a\xf9\x7d\xb2\xc6\xe6\xc7\x0f\xfb\x30\x0c\xee\x31\xd8\xb3\x13\xce\x63\xff\xe4\xc6\x6b\x59\xdb\x79\xd7\xda\xbf\x88\x3e\x8c\xbd\xf3\x63\x98\xb4\x6b\x2a\x78\x25\x0d\xfe\xb1\x24\x63\x6c\x9e\xa8\x52\xe2\xbe\x22\x08\xb5\xa6\x1a\xb1\x79\xb7\x17\xfb\xd8\xd3\x81\x76\x2a\x37\x13\xb1\x39\xee\x73\x41\xaf\x4e\x74\x3a\x6f\x91\x7e\x77\x4f\xb2\x2f\xe9\x75\xdb\xb2\xd3\x2f\x82\x05\x90\x64\xec\x63\x00\xb3\x82\x5b\x9d\xea\x01\x00\x00")
+
+func _000003_add_emoji_reactionsUpSqlBytes() ([]byte, error) {
+	return bindataRead(
+		__000003_add_emoji_reactionsUpSql,
+		"000003_add_emoji_reactions.up.sql",
+	)
+}
+
+func _000003_add_emoji_reactionsUpSql() (*asset, error) {
+	bytes, err := _000003_add_emoji_reactionsUpSqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "000003_add_emoji_reactions.up.sql", size: 490, mode: os.FileMode(0644), modTime: time.Unix(1792201230, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x11, 0x13, 0x7b, 0x6f, 0x8a, 0xfb, 0x22, 0xc2, 0x4, 0x60, 0x4d, 0x6e, 0x6e, 0xc3, 0x5a, 0x2, 0xe6, 0x25, 0x28, 0x24, 0x5c, 0x37, 0xc2, 0x83, 0xd8, 0x81, 0x1c, 0x81, 0x80, 0x8, 0xfb, 0xe4}}
+	return a, nil
+}
+
+var __000004_add_message_editsDownSql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x00\x20\x00\xdf\xff\x44\x52\x4f\x50\x20\x54\x41\x42\x4c\x45\x20\x75\x73\x65\x72\x5f\x6d\x65\x73\x73\x61\x67\x65\x73\x5f\x65\x64\x69\x74\x73\x3b\x0a\x03\x00\x81\x9e\x3a\xa4\x20\x00\x00\x00")
+
+func _000004_add_message_editsDownSqlBytes() ([]byte, error) {
+	return bindataRead(
+		__000004_add_message_editsDownSql,
+		"000004_add_message_edits.down.sql",
+	)
+}
+
+func _000004_add_message_editsDownSql() (*asset, error) {
+	bytes, err := _000004_add_message_editsDownSqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "000004_add_message_edits.down.sql", size: 32, mode: os.FileMode(0644), modTime: time.Unix(1792201697, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x5b, 0x92, 0xe8, 0x6a, 0xba, 0x85, 0xd6, 0x97, 0x55, 0x2d, 0xb1, 0xcc, 0x50, 0x16, 0xcd, 0x4c, 0xc8, 0x1d, 0xb5, 0x8d, 0x3, 0x2e, 0x91, 0xba, 0x16, 0x71, 0xa0, 0x67, 0x17, 0x74, 0x57, 0x52}}
+	return a, nil
+}
+
+var __000004_add_message_editsUpSql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x6c\x8f\xc1\x6a\xeb\x30\x14\x44\xf7\xfa\x8a\x59\x26\x90\xc5\xdb\x67\xa5\x27\x5f\x53\x51\x45\x0a\x8a\x5c\x9c\x95\x30\xd6\xa5\x88\xa6\x18\x2c\xbb\xe4\xf3\x4b\x8a\xdb\xd4\x34\x4b\x69\x86\xb9\xe7\x48\x13\xc8\x23\xc8\xff\x86\x30\x17\x1e\xe3\x3b\x97\xd2\xbd\x72\x81\xac\x2a\x28\x67\x9a\x83\x05\xa7\x3c\x71\x8a\xdd\x04\x6d\x03\xac\x0b\xb0\x8d\x31\xa8\xa8\x96\x8d\x09\xf8\xb7\x17\x42\x79\x92\x81\x96\x21\x5d\x7f\x95\xa8\xd5\xa7\x70\x5a\xcf\xc6\xdb\x56\xc1\x46\x00\x39\xe1\x45\x7a\xf5\x24\x3d\x8e\x5e\x1f\xa4\x3f\xe3\x99\xce\x70\x16\xca\xd9\xda\x68\x15\xe0\xe9\x68\xa4\xa2\x9d\x00\xfa\xcb\xd0\xbf\xc5\x8f\xee\x32\xf3\x8a\xe2\x96\x95\x61\x1e\x7b\x46\xa0\x76\xfd\x3f\xf1\x75\xfa\xb9\xf1\x3b\x58\x68\x62\x4e\x7f\x62\xb1\xbd\xdb\x68\x5b\x51\x8b\x9c\xae\xf1\x81\xc3\xf7\x33\xe6\x14\x17\x00\x67\x1f\xc9\x6e\xee\xc5\x1d\xca\x30\x8f\x3d\x6f\xf7\xe2\x73\x00\x1c\xdf\xad\x9e\x79\x01\x00\x00")
+
+func _000004_add_message_editsUpSqlBytes() ([]byte, error) {
+	return bindataRead(
+		__000004_add_message_editsUpSql,
+		"000004_add_message_edits.up.sql",
+	)
+}
+
+func _000004_add_message_editsUpSql() (*asset, error) {
+	bytes, err := _000004_add_message_editsUpSqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "000004_add_message_edits.up.sql", size: 377, mode: os.FileMode(0644), modTime: time.Unix(1792201697, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x9b, 0xe6, 0xb4, 0x18, 0x77, 0xa3, 0x59, 0x4d, 0x7e, 0xe6, 0x44, 0x43, 0xc, 0xa3, 0xf9, 0xe2, 0x93, 0xea, 0xf7, 0x30, 0xb, 0x28, 0x9f, 0xad, 0x7d, 0xfc, 0x41, 0x4, 0xa0, 0xd3, 0xed, 0xe7}}
+	return a, nil
+}
+
+var __000005_add_message_deletesDownSql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x00\x22\x00\xdd\xff\x44\x52\x4f\x50\x20\x54\x41\x42\x4c\x45\x20\x75\x73\x65\x72\x5f\x6d\x65\x73\x73\x61\x67\x65\x73\x5f\x64\x65\x6c\x65\x74\x65\x73\x3b\x0a\x03\x00\xb0\xba\x20\x2b\x22\x00\x00\x00")
+
+func _000005_add_message_deletesDownSqlBytes() ([]byte, error) {
+	return bindataRead(
+		__000005_add_message_deletesDownSql,
+		"000005_add_message_deletes.down.sql",
+	)
+}
+
+func _000005_add_message_deletesDownSql() (*asset, error) {
+	bytes, err := _000005_add_message_deletesDownSqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "000005_add_message_deletes.down.sql", size: 34, mode: os.FileMode(0644), modTime: time.Unix(1792201911, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0xa5, 0x7f, 0x10, 0x2a, 0x7f, 0x1b, 0x6c, 0x46, 0xe8, 0x84, 0x8, 0xa2, 0x23, 0x5a, 0x1e, 0x29, 0x89, 0x92, 0x8a, 0x83, 0xbb, 0xad, 0xe0, 0x47, 0x5e, 0xa0, 0x35, 0xa3, 0x5b, 0xa6, 0x0, 0x63}}
+	return a, nil
+}
+
+var __000005_add_message_deletesUpSql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x84\x8f\xc1\x6a\xf3\x30\x10\x84\xef\x7a\x8a\x39\xda\x90\x37\xf0\x69\x23\xaf\xf9\xc5\xaf\x48\x41\x96\x8b\x73\x12\xc1\x16\xc5\xd4\xad\x8b\x15\x97\x3e\x7e\x69\xeb\x34\x09\x0d\xf4\xba\x33\xcc\xf7\x2d\x69\xcf\x0e\x9e\xb6\x9a\xb1\xa4\x38\x87\xe7\x98\xd2\xf1\x31\x26\x50\x59\x42\x5a\xdd\xec\x0c\xfa\x38\xc6\x53\xec\xb1\xb5\x56\x33\x19\x18\xeb\x61\x1a\xad\x51\x72\x45\x8d\xf6\xa8\x48\xd7\x5c\x08\x21\x1d\x93\xe7\x75\x4e\x55\x5f\x45\x6e\x55\xed\xeb\xdb\xf1\xf0\xbd\x98\x90\x09\x60\xe8\xf1\x40\x4e\xfe\x23\x87\xbd\x53\x3b\x72\x07\xfc\xe7\x03\xac\x81\xb4\xa6\xd2\x4a\x7a\x38\xde\x6b\x92\xbc\x11\x40\x37\x4e\xdd\x53\x78\x3b\x8e\x4b\x84\x32\xfe\xc7\xe5\x33\x4b\xd3\x32\x77\x11\x9e\xdb\xdb\xfb\x8a\x0d\x57\xa4\x73\x2c\xf2\x8b\xb6\x32\x25\xb7\x18\xfa\xf7\x70\x57\xf6\x7c\x08\x43\x1f\x56\x92\x35\xf7\xff\xca\x2e\xd5\xcd\x6a\x95\x17\x7f\x61\xe6\x98\x5e\xa7\x97\x14\xc3\x69\xfa\x35\x9c\x5d\x85\x79\x21\x3e\x06\x00\x52\x35\x96\x0e\xb6\x01\x00\x00")
+
+func _000005_add_message_deletesUpSqlBytes() ([]byte, error) {
+	return bindataRead(
+		__000005_add_message_deletesUpSql,
+		"000005_add_message_deletes.up.sql",
+	)
+}
+
+func _000005_add_message_deletesUpSql() (*asset, error) {
+	bytes, err := _000005_add_message_deletesUpSqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "000005_add_message_deletes.up.sql", size: 438, mode: os.FileMode(0644), modTime: time.Unix(1792201911, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x11, 0x8b, 0x52, 0x66, 0x9f, 0x8a, 0xc7, 0x60, 0xdc, 0x25, 0xf3, 0x97, 0xdd, 0x72, 0x63, 0x9d, 0x8, 0xbe, 0x60, 0x88, 0xb7, 0x86, 0x71, 0x60, 0xe4, 0x72, 0x70, 0xa8, 0xdd, 0x16, 0xf4, 0x31}}
+	return a, nil
+}
+
+var __000006_add_imagesDownSql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x00\x1b\x00\xe4\xff\x44\x52\x4f\x50\x20\x54\x41\x42\x4c\x45\x20\x6d\x65\x73\x73\x61\x67\x65\x5f\x63\x68\x75\x6e\x6b\x73\x3b\x0a\x03\x00\xe4\xa5\xe6\xab\x1b\x00\x00\x00")
+
+func _000006_add_imagesDownSqlBytes() ([]byte, error) {
+	return bindataRead(
+		__000006_add_imagesDownSql,
+		"000006_add_images.down.sql",
+	)
+}
+
+func _000006_add_imagesDownSql() (*asset, error) {
+	bytes, err := _000006_add_imagesDownSqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "000006_add_images.down.sql", size: 27, mode: os.FileMode(0644), modTime: time.Unix(1792202096, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x81, 0xcf, 0x41, 0x5, 0xc2, 0xe0, 0x15, 0x16, 0x57, 0xe0, 0xcb, 0x2b, 0x8d, 0xf4, 0x28, 0x86, 0xe5, 0xf9, 0x42, 0x9, 0xee, 0xe8, 0x1d, 0xc0, 0xb4, 0xf, 0xb4, 0xa3, 0x3c, 0x92, 0xfd, 0x7}}
+	return a, nil
+}
+
+var __000006_add_imagesUpSql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xac\x91\xc1\x6b\xc2\x30\x18\xc5\xef\xf9\x2b\xde\x51\xa1\x87\xdd\x3d\xa5\xe9\x57\x56\x16\x53\x89\xe9\xd0\x53\x09\x36\x6b\xc3\xac\x95\xa6\x65\xf3\xbf\x1f\x4a\xb7\x29\xb2\x83\xb0\xeb\xf7\x78\xbf\xf7\x83\x8f\x4b\x43\x1a\x86\xc7\x92\x30\x06\xd7\x97\xad\x0b\xc1\xd6\x2e\x80\x27\x09\x44\x2e\x8b\xa5\x82\x6f\x6d\xed\xca\xa3\x3d\xed\x3b\x5b\x21\x96\x79\xbc\x60\x0f\x14\xdf\xba\xbe\xb5\x03\x32\x65\xa0\x72\x03\x55\x48\x89\x84\x52\x5e\x48\x83\xa7\x87\x48\x1f\xbe\x1a\x9a\xff\x00\x35\xce\xd7\xcd\xdf\x4a\x4c\x68\xe2\x86\x26\x56\x96\x5e\xe6\x68\x93\xad\xcd\x1a\x13\xb4\xdc\x35\xe3\xe1\x3d\x60\xc6\x00\x5f\xe1\x95\x6b\xf1\xcc\xf5\x0f\x2d\x62\x40\xe8\xc6\x7e\xe7\x60\x68\xf3\xbb\x72\xbe\x5f\x9a\xa5\x3f\x54\xee\xf3\xc6\xe0\x9c\x0d\xdd\x60\xf7\x77\xd7\xef\xcd\xe1\x74\x74\x77\xe1\xf5\x5f\x6e\x82\x95\xce\x96\x5c\x6f\xf1\x42\x5b\xcc\x7c\x15\x4d\x42\xd1\xb5\xc0\x1c\xb9\x82\xc8\x55\x2a\x33\x61\xa0\x69\x25\xb9\x20\x36\x5f\xb0\xaf\x01\x00\xe9\xd2\x87\xce\x19\x02\x00\x00")
+
+func _000006_add_imagesUpSqlBytes() ([]byte, error) {
+	return bindataRead(
+		__000006_add_imagesUpSql,
+		"000006_add_images.up.sql",
+	)
+}
+
+func _000006_add_imagesUpSql() (*asset, error) {
+	bytes, err := _000006_add_imagesUpSqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "000006_add_images.up.sql", size: 537, mode: os.FileMode(0644), modTime: time.Unix(1792202096, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x83, 0xd1, 0x70, 0x58, 0xb0, 0xc8, 0xe5, 0x4c, 0xc3, 0x9b, 0xa8, 0xc2, 0x30, 0x31, 0x9b, 0xc0, 0x75, 0x4a, 0xcd, 0xda, 0x16, 0x2, 0x67, 0xb5, 0x68, 0x90, 0xb5, 0x95, 0x7d, 0x5d, 0x64, 0x4a}}
+	return a, nil
+}
+
+var __000007_add_audioDownSql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x03\x00\x00\x00\x00\x00\x00\x00\x00\x00")
+
+func _000007_add_audioDownSqlBytes() ([]byte, error) {
+	return bindataRead(
+		__000007_add_audioDownSql,
+		"000007_add_audio.down.sql",
+	)
+}
+
+func _000007_add_audioDownSql() (*asset, error) {
+	bytes, err := _000007_add_audioDownSqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "000007_add_audio.down.sql", size: 0, mode: os.FileMode(0644), modTime: time.Unix(1792202376, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0xe3, 0xb0, 0xc4, 0x42, 0x98, 0xfc, 0x1c, 0x14, 0x9a, 0xfb, 0xf4, 0xc8, 0x99, 0x6f, 0xb9, 0x24, 0x27, 0xae, 0x41, 0xe4, 0x64, 0x9b, 0x93, 0x4c, 0xa4, 0x95, 0x99, 0x1b, 0x78, 0x52, 0xb8, 0x55}}
+	return a, nil
+}
+
+var __000007_add_audioUpSql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x94\xcc\x41\x0a\xc2\x30\x10\x05\xd0\xbd\xa7\xf8\x47\x70\xef\x2a\x31\x11\x84\x31\x01\x99\xac\xc3\x40\x06\x29\x58\x53\x3a\xcd\xa2\xb7\xf7\x04\x5d\xf4\x00\xef\x39\xe2\xf8\x06\x3b\x4f\x11\xc3\x74\xad\xb3\x9a\xc9\x47\x0d\x2e\x04\xdc\x33\x95\x57\x82\x8c\x36\xf5\xba\xc8\xfe\xed\xd2\xe0\x29\xfb\xdb\xe5\x04\xdc\xf6\x45\xf1\x4c\x8c\x94\x19\xa9\x10\x21\xc4\x87\x2b\xc4\xb8\x9e\x7a\xda\x58\x65\x9b\xfa\xaf\xce\x76\xd8\xfd\x07\x00\xa7\xe4\xc2\xf2\xd0\x00\x00\x00")
+
+func _000007_add_audioUpSqlBytes() ([]byte, error) {
+	return bindataRead(
+		__000007_add_audioUpSql,
+		"000007_add_audio.up.sql",
+	)
+}
+
+func _000007_add_audioUpSql() (*asset, error) {
+	bytes, err := _000007_add_audioUpSqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "000007_add_audio.up.sql", size: 208, mode: os.FileMode(0644), modTime: time.Unix(1792202340, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x4d, 0x4a, 0x36, 0xab, 0xd3, 0x85, 0xb4, 0xba, 0xac, 0xf3, 0x40, 0x29, 0x1c, 0x7b, 0x44, 0x88, 0xa, 0xea, 0x1a, 0xf4, 0xd7, 0x8, 0xc9, 0x79, 0x62, 0x9e, 0x52, 0x24, 0x55, 0x68, 0x4a, 0xff}}
+	return a, nil
+}
+
+var __000008_add_pin_messagesDownSql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x00\x19\x00\xe6\xff\x44\x52\x4f\x50\x20\x54\x41\x42\x4c\x45\x20\x70\x69\x6e\x5f\x6d\x65\x73\x73\x61\x67\x65\x73\x3b\x0a\x03\x00\x97\xd5\xb8\xee\x19\x00\x00\x00")
+
+func _000008_add_pin_messagesDownSqlBytes() ([]byte, error) {
+	return bindataRead(
+		__000008_add_pin_messagesDownSql,
+		"000008_add_pin_messages.down.sql",
+	)
+}
+
+func _000008_add_pin_messagesDownSql() (*asset, error) {
+	bytes, err := _000008_add_pin_messagesDownSqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "000008_add_pin_messages.down.sql", size: 25, mode: os.FileMode(0644), modTime: time.Unix(1792202577, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0xbf, 0x5f, 0x6d, 0xa2, 0xad, 0x55, 0xd7, 0x85, 0x6b, 0x41, 0x96, 0xca, 0xae, 0xfb, 0x41, 0x55, 0x84, 0x3d, 0xf0, 0x57, 0xe3, 0x6b, 0xb0, 0x23, 0xf9, 0xdb, 0xbd, 0x73, 0x40, 0x7e, 0xae, 0xf7}}
+	return a, nil
+}
+
+var __000008_add_pin_messagesUpSql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x7c\x90\x31\x6b\xc3\x30\x10\x85\x77\xfd\x8a\x37\x26\xd0\xa1\x7b\x26\x55\x39\x53\x51\x55\x0e\xca\xa5\x38\x93\x30\xb2\x68\x45\x55\x3b\x54\x4d\xe9\xcf\x2f\x01\x0f\xd2\x92\xf1\xde\x7b\xdc\x07\x9f\x72\x24\x99\xc0\xf2\xc9\x10\x74\x07\xdb\x33\x68\xd0\x47\x3e\xe2\x92\x66\xff\x15\x4b\x19\xdf\x63\xc1\x46\x00\xeb\xe1\xd3\x84\x37\xe9\xd4\xb3\x74\x38\x38\xfd\x2a\xdd\x19\x2f\x74\x46\x6f\xa1\x7a\xdb\x19\xad\x18\x8e\x0e\x46\x2a\x7a\x10\x40\xb5\xbe\x3d\xb7\x27\x63\x6e\x71\xc8\x4b\xf8\xf4\xbf\x63\xbe\x46\x68\xcb\x4d\x57\x96\xeb\x77\x88\x60\x1a\xda\x3c\x7c\x8c\x3f\x35\xbd\xee\xf2\x12\xc6\xec\xef\x2d\x2e\x69\x9e\xe3\xd4\xc0\xb0\xa7\x4e\x9e\x0c\xe3\x51\x6c\x77\x42\xac\x32\xb4\xdd\xd3\x80\x34\xfd\xf9\x5a\x81\x6f\x09\xbd\x6d\x04\x6d\x9a\x76\xbb\x13\xff\x03\x00\x6c\x0d\x45\xb8\x59\x01\x00\x00")
+
+func _000008_add_pin_messagesUpSqlBytes() ([]byte, error) {
+	return bindataRead(
+		__000008_add_pin_messagesUpSql,
+		"000008_add_pin_messages.up.sql",
+	)
+}
+
+func _000008_add_pin_messagesUpSql() (*asset, error) {
+	bytes, err := _000008_add_pin_messagesUpSqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "000008_add_pin_messages.up.sql", size: 345, mode: os.FileMode(0644), modTime: time.Unix(1792202577, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x9d, 0x69, 0x23, 0xd3, 0xb6, 0xf1, 0xbb, 0x68, 0x5a, 0x28, 0xc0, 0x15, 0x2e, 0x46, 0x28, 0x22, 0x1f, 0x45, 0x1, 0x8d, 0xb8, 0x7a, 0xf9, 0xb0, 0x8, 0x2d, 0x11, 0x16, 0xfd, 0x1a, 0x0, 0xe}}
+	return a, nil
+}
+
+var __000009_add_user_messages_ftsDownSql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x72\x09\xf2\x0f\x50\x08\x09\xf2\x74\x77\x77\x0d\x52\x28\x2d\x4e\x2d\x8a\xcf\x4d\x2d\x2e\x4e\x4c\x4f\x2d\x8e\x4f\x2b\x29\x8e\x4f\x4a\x4d\xcb\x2f\x4a\x8d\xcf\xcc\x2b\x4e\x2d\x2a\xb1\xe6\x22\xa0\x3a\x31\xad\x24\xb5\x88\x34\xc5\xa5\x05\x29\x89\x25\xa9\x44\x2a\x4e\x49\xcd\x49\x45\x28\x76\x74\xf2\x71\xc5\x54\x6a\xcd\x05\x18\x00\x18\x43\x8d\x67\xd3\x00\x00\x00")
+
+func _000009_add_user_messages_ftsDownSqlBytes() ([]byte, error) {
+	return bindataRead(
+		__000009_add_user_messages_ftsDownSql,
+		"000009_add_user_messages_fts.down.sql",
+	)
+}
+
+func _000009_add_user_messages_ftsDownSql() (*asset, error) {
+	bytes, err := _000009_add_user_messages_ftsDownSqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "000009_add_user_messages_fts.down.sql", size: 211, mode: os.FileMode(0644), modTime: time.Unix(1792202956, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0xb6, 0x93, 0x56, 0x7c, 0x51, 0xd2, 0xdd, 0x87, 0xad, 0x7c, 0x14, 0xa8, 0x10, 0xac, 0x14, 0x28, 0x4a, 0xbb, 0x35, 0xf7, 0xec, 0x75, 0x9f, 0xd8, 0xa9, 0x39, 0x60, 0xd, 0xd9, 0x41, 0x52, 0xf7}}
+	return a, nil
+}
+
+var __000009_add_user_messages_ftsUpSql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xc4\x92\x41\x6b\x1b\x31\x14\x84\xef\xfa\x15\x73\xab\x0d\x4e\xa1\x50\x7a\x59\x7c\x58\x67\x9f\xdd\x85\xad\xb6\xc8\x72\x72\x34\xdb\xd5\x5b\x5b\xd4\x91\x82\x24\x37\x6d\x7f\x7d\x51\xbc\x81\xba\x0d\x84\x80\x21\x37\xf1\xd0\x8c\xbe\x99\xa7\x6b\x45\xa5\x26\xdc\xd4\x4a\x6f\xca\x06\xba\x5c\x34\x84\x63\xe4\xb0\xbd\xe3\x18\xbb\x1d\xc7\xed\x90\x22\x36\xeb\x5a\xae\x30\xa4\xf8\x71\x92\xf8\x67\x9a\x21\xf9\xef\xec\xec\x6f\x9e\x1f\x9d\xed\xbd\xe1\x4f\x1f\xa6\x85\x10\xb5\x5c\x93\xd2\xa8\xa5\x6e\xff\x37\x99\x18\xdf\x5b\x33\x43\x36\x98\x62\x4d\x0d\x5d\x6b\x04\xff\xf0\x34\xc3\x52\xb5\x5f\xce\x65\x85\x10\x57\x57\xe7\xa3\xac\x88\xe8\x02\x23\xf0\xfd\xa1\xeb\xd9\xc0\x3b\xf4\xde\x0d\x07\xdb\xa7\x19\x1e\xf6\xb6\xdf\xc3\x78\x8e\xee\x5d\xc2\x60\x03\xc3\xf0\x81\x13\x67\xa7\x14\xec\x6e\xc7\x21\xce\x10\x3d\xd2\x9e\x11\x53\x77\x60\xb0\x4b\xe1\x17\x6c\x44\xe0\x3b\xff\x83\x0d\xbe\xf1\xe0\x03\xc3\xba\xc8\x21\x59\xb7\x13\x63\x4d\x5a\xd5\xab\x15\xa9\x73\xa2\x9c\x6d\x7b\x52\x6c\x4f\x0a\x2c\x68\xd9\x2a\xc2\x58\x47\x2b\xff\x89\xb0\xa0\x55\x2d\x05\x50\x51\x43\x9a\x9e\xc9\x9d\x2d\x71\xfb\x99\x14\xe1\xb1\x34\xcc\x31\xf9\xbb\xb0\x67\x24\xe3\x75\x6b\x30\x87\xa4\xdb\xf7\xd6\x4c\x0b\x41\xb2\x2a\xc4\xcb\xf0\xdd\x90\x38\x3c\xb1\x97\x4b\x4d\xea\x45\xf4\x57\x6c\xfa\xa6\x6c\x36\xb4\xc6\x24\x53\x8d\xeb\xce\xc7\xbc\xf2\xd7\x22\x1e\xef\x4d\x97\x78\x44\xdc\x7c\xad\xb2\xa6\x5d\x3e\xbe\x73\x99\x96\xdb\xa6\x3a\x31\x16\x6f\x96\xf1\xf4\x5b\xc7\x8c\x23\xfb\xa5\xb3\x91\xac\x0a\xf1\x67\x00\xf1\x53\x75\xa5\xfb\x03\x00\x00")
+
+func _000009_add_user_messages_ftsUpSqlBytes() ([]byte, error) {
+	return bindataRead(
+		__000009_add_user_messages_ftsUpSql,
+		"000009_add_user_messages_fts.up.sql",
+	)
+}
+
+func _000009_add_user_messages_ftsUpSql() (*asset, error) {
+	bytes, err := _000009_add_user_messages_ftsUpSqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "000009_add_user_messages_fts.up.sql", size: 1019, mode: os.FileMode(0644), modTime: time.Unix(1792202956, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x7f, 0x32, 0xc9, 0x99, 0xc5, 0x7b, 0x16, 0xca, 0x9c, 0xf5, 0x1d, 0xfd, 0xf9, 0x1d, 0x7c, 0x2e, 0x68, 0x2d, 0xa7, 0x1f, 0x76, 0x4d, 0x57, 0x26, 0xcb, 0xb1, 0x98, 0xb, 0x50, 0xcd, 0xc4, 0x77}}
+	return a, nil
+}
+
+var __000010_add_mentionsDownSql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x03\x00\x00\x00\x00\x00\x00\x00\x00\x00")
+
+func _000010_add_mentionsDownSqlBytes() ([]byte, error) {
+	return bindataRead(
+		__000010_add_mentionsDownSql,
+		"000010_add_mentions.down.sql",
+	)
+}
+
+func _000010_add_mentionsDownSql() (*asset, error) {
+	bytes, err := _000010_add_mentionsDownSqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "000010_add_mentions.down.sql", size: 0, mode: os.FileMode(0644), modTime: time.Unix(1792203192, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0xe3, 0xb0, 0xc4, 0x42, 0x98, 0xfc, 0x1c, 0x14, 0x9a, 0xfb, 0xf4, 0xc8, 0x99, 0x6f, 0xb9, 0x24, 0x27, 0xae, 0x41, 0xe4, 0x64, 0x9b, 0x93, 0x4c, 0xa4, 0x95, 0x99, 0x1b, 0x78, 0x52, 0xb8, 0x55}}
+	return a, nil
+}
+
+var __000010_add_mentionsUpSql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x72\xf4\x09\x71\x0d\x52\x08\x71\x74\xf2\x71\x55\x28\x2d\x4e\x2d\x8a\xcf\x4d\x2d\x2e\x4e\x4c\x4f\x2d\x56\x70\x74\x71\x51\x70\xf6\xf7\x09\xf5\xf5\x53\xc8\x4d\xcd\x2b\xc9\xcc\xcf\x4b\x4d\x51\xf0\xf4\x0b\x51\xf0\xf3\x0f\x51\xf0\x0b\xf5\xf1\x51\x70\x71\x75\x73\x0c\xf5\x09\x51\x30\xb0\xe6\x42\x36\x26\x39\x23\xb1\x04\x45\x7b\x69\x5e\x59\x66\x6a\x79\x6a\x4a\x3c\xd4\x9c\xe2\xf8\xe4\xfc\xd2\xbc\x12\x9c\x86\x01\x06\x00\x1d\x8e\x26\x3c\x94\x00\x00\x00")
+
+func _000010_add_mentionsUpSqlBytes() ([]byte, error) {
+	return bindataRead(
+		__000010_add_mentionsUpSql,
+		"000010_add_mentions.up.sql",
+	)
+}
+
+func _000010_add_mentionsUpSql() (*asset, error) {
+	bytes, err := _000010_add_mentionsUpSqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "000010_add_mentions.up.sql", size: 148, mode: os.FileMode(0644), modTime: time.Unix(1792203192, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x6f, 0xcb, 0x72, 0xe5, 0xe3, 0x54, 0x47, 0x17, 0x8, 0x94, 0x2d, 0x4b, 0xf0, 0x69, 0x46, 0x58, 0x32, 0x5c, 0x89, 0x5a, 0x53, 0x51, 0x46, 0x4a, 0x82, 0xab, 0x2f, 0x59, 0x1, 0x27, 0x92, 0x4d}}
+	return a, nil
+}
+
+var __000011_add_link_previewsDownSql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x00\x1a\x00\xe5\xff\x44\x52\x4f\x50\x20\x54\x41\x42\x4c\x45\x20\x6c\x69\x6e\x6b\x5f\x70\x72\x65\x76\x69\x65\x77\x73\x3b\x0a\x03\x00\x42\x98\x01\xf9\x1a\x00\x00\x00")
+
+func _000011_add_link_previewsDownSqlBytes() ([]byte, error) {
+	return bindataRead(
+		__000011_add_link_previewsDownSql,
+		"000011_add_link_previews.down.sql",
+	)
+}
+
+func _000011_add_link_previewsDownSql() (*asset, error) {
+	bytes, err := _000011_add_link_previewsDownSqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "000011_add_link_previews.down.sql", size: 26, mode: os.FileMode(0644), modTime: time.Unix(1792203419, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0xe8, 0x7d, 0x6b, 0xa, 0x39, 0xfe, 0x3c, 0xf5, 0xa4, 0xec, 0xfa, 0xab, 0xd9, 0x4, 0x27, 0x8f, 0xf0, 0x6e, 0xfa, 0x51, 0xed, 0xaa, 0x8c, 0x67, 0x56, 0x1, 0x2, 0x96, 0xec, 0xc8, 0xcd, 0x2a}}
+	return a, nil
+}
+
+var __000011_add_link_previewsUpSql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x6c\xce\x41\x4e\xc3\x30\x10\x85\xe1\xbd\x4f\xf1\x96\x20\x71\x83\xae\x1c\xd7\x15\x16\x53\xbb\x72\x5d\x44\x57\x91\x69\x06\x6a\xe1\xa6\x51\xec\x80\xb8\x3d\x4a\x85\xb2\xea\xfa\x9f\x6f\xf4\x24\x05\xed\x11\x64\x43\x1a\x53\xe1\xb1\xbd\x70\x29\xf1\x93\x0b\xe4\x7a\x0d\xe5\xe8\xb0\xb5\xc8\xa9\xff\x2a\x68\xc8\x35\x2b\x21\x94\xd7\x32\xe8\x7f\x62\x36\xb0\x2e\x40\xbf\x99\x7d\xd8\xdf\xee\xda\x61\xe4\xef\xc4\x3f\x05\x0f\x02\x98\xc6\x8c\x57\xe9\xd5\xb3\xf4\xd8\x79\xb3\x95\xfe\x88\x17\x7d\x84\xb3\x50\xce\x6e\xc8\xa8\x00\xaf\x77\x24\x95\x7e\x12\x40\x4d\x35\xf3\x02\xe6\xcf\xf6\x40\x34\x97\x8e\xcb\x69\x4c\x43\x4d\xd7\xfe\x6e\xaf\xe7\xe9\xf2\xde\xc7\x94\xdb\x21\xfe\xe6\x6b\xec\x6e\x73\x67\xf9\xc1\xf5\x74\xe6\xae\x8d\x15\xc6\x86\x05\x89\xc7\x95\xf8\x1b\x00\x15\x4b\xa5\x69\xfd\x00\x00\x00")
+
+func _000011_add_link_previewsUpSqlBytes() ([]byte, error) {
+	return bindataRead(
+		__000011_add_link_previewsUpSql,
+		"000011_add_link_previews.up.sql",
+	)
+}
+
+func _000011_add_link_previewsUpSql() (*asset, error) {
+	bytes, err := _000011_add_link_previewsUpSqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "000011_add_link_previews.up.sql", size: 253, mode: os.FileMode(0644), modTime: time.Unix(1792203419, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0xe1, 0x52, 0xaf, 0x9e, 0x80, 0x51, 0x88, 0xab, 0x1a, 0xe2, 0x89, 0x3e, 0x59, 0x0, 0x62, 0x8d, 0xe6, 0x44, 0x2a, 0x5f, 0xb2, 0xab, 0xfe, 0xe7, 0xe1, 0x47, 0x81, 0xaa, 0x3d, 0xdf, 0x35, 0x31}}
+	return a, nil
+}
+
+var __000012_add_chat_notification_settingsDownSql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x03\x00\x00\x00\x00\x00\x00\x00\x00\x00")
+
+func _000012_add_chat_notification_settingsDownSqlBytes() ([]byte, error) {
+	return bindataRead(
+		__000012_add_chat_notification_settingsDownSql,
+		"000012_add_chat_notification_settings.down.sql",
+	)
+}
+
+func _000012_add_chat_notification_settingsDownSql() (*asset, error) {
+	bytes, err := _000012_add_chat_notification_settingsDownSqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "000012_add_chat_notification_settings.down.sql", size: 0, mode: os.FileMode(0644), modTime: time.Unix(1792203779, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0xe3, 0xb0, 0xc4, 0x42, 0x98, 0xfc, 0x1c, 0x14, 0x9a, 0xfb, 0xf4, 0xc8, 0x99, 0x6f, 0xb9, 0x24, 0x27, 0xae, 0x41, 0xe4, 0x64, 0x9b, 0x93, 0x4c, 0xa4, 0x95, 0x99, 0x1b, 0x78, 0x52, 0xb8, 0x55}}
+	return a, nil
+}
+
+var __000012_add_chat_notification_settingsUpSql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xac\xcc\xc1\x09\x02\x31\x10\x05\xd0\xbb\x55\xfc\x12\xbc\x7b\x8a\x26\x82\x30\x66\x41\x26\xe7\xb0\xc4\xa8\x83\x71\x72\xc8\xac\xf5\x5b\x81\x08\xb2\x05\xbc\xe7\x88\xc3\x05\xec\xf6\x14\x50\x1e\xb3\x0d\x38\xef\x71\x98\x28\x9d\x23\x5e\x8b\xd5\x2b\x4e\x91\x11\x27\x46\x4c\x44\xf0\xe1\xe8\x12\x31\xb6\xbb\xcd\x6f\x9a\x17\x35\x69\xff\x05\xda\x4d\x6e\x52\x66\x93\xae\xb9\xd5\x77\x5d\xe3\x19\xd5\x4c\xf4\x3e\x72\x69\xbd\x3c\xbf\x86\x9f\x01\x00\x8d\xa7\x80\xa9\x15\x01\x00\x00")
+
+func _000012_add_chat_notification_settingsUpSqlBytes() ([]byte, error) {
+	return bindataRead(
+		__000012_add_chat_notification_settingsUpSql,
+		"000012_add_chat_notification_settings.up.sql",
+	)
+}
+
+func _000012_add_chat_notification_settingsUpSql() (*asset, error) {
+	bytes, err := _000012_add_chat_notification_settingsUpSqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "000012_add_chat_notification_settings.up.sql", size: 277, mode: os.FileMode(0644), modTime: time.Unix(1792203779, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x41, 0x39, 0x8a, 0x39, 0xee, 0xaf, 0xec, 0xc7, 0xc3, 0x47, 0x19, 0x77, 0x67, 0xdf, 0x76, 0xa6, 0x95, 0x20, 0x26, 0xf3, 0x1f, 0xd5, 0x33, 0x83, 0xbc, 0x30, 0xcd, 0x8e, 0xaf, 0xa, 0x78, 0xb0}}
+	return a, nil
+}
+
+var __000013_add_forwarded_fromDownSql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x03\x00\x00\x00\x00\x00\x00\x00\x00\x00")
+
+func _000013_add_forwarded_fromDownSqlBytes() ([]byte, error) {
+	return bindataRead(
+		__000013_add_forwarded_fromDownSql,
+		"000013_add_forwarded_from.down.sql",
+	)
+}
+
+func _000013_add_forwarded_fromDownSql() (*asset, error) {
+	bytes, err := _000013_add_forwarded_fromDownSqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "000013_add_forwarded_from.down.sql", size: 0, mode: os.FileMode(0644), modTime: time.Unix(1792204476, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0xe3, 0xb0, 0xc4, 0x42, 0x98, 0xfc, 0x1c, 0x14, 0x9a, 0xfb, 0xf4, 0xc8, 0x99, 0x6f, 0xb9, 0x24, 0x27, 0xae, 0x41, 0xe4, 0x64, 0x9b, 0x93, 0x4c, 0xa4, 0x95, 0x99, 0x1b, 0x78, 0x52, 0xb8, 0x55}}
+	return a, nil
+}
+
+var __000013_add_forwarded_fromUpSql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x00\x3a\x00\xc5\xff\x41\x4c\x54\x45\x52\x20\x54\x41\x42\x4c\x45\x20\x75\x73\x65\x72\x5f\x6d\x65\x73\x73\x61\x67\x65\x73\x20\x41\x44\x44\x20\x43\x4f\x4c\x55\x4d\x4e\x20\x66\x6f\x72\x77\x61\x72\x64\x65\x64\x5f\x66\x72\x6f\x6d\x20\x42\x4c\x4f\x42\x3b\x0a\x03\x00\xbf\x8a\x1c\x22\x3a\x00\x00\x00")
+
+func _000013_add_forwarded_fromUpSqlBytes() ([]byte, error) {
+	return bindataRead(
+		__000013_add_forwarded_fromUpSql,
+		"000013_add_forwarded_from.up.sql",
+	)
+}
+
+func _000013_add_forwarded_fromUpSql() (*asset, error) {
+	bytes, err := _000013_add_forwarded_fromUpSqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "000013_add_forwarded_from.up.sql", size: 58, mode: os.FileMode(0644), modTime: time.Unix(1792204476, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x44, 0x7b, 0x64, 0xf8, 0xba, 0x4a, 0x2a, 0xfc, 0xc3, 0x22, 0x95, 0x74, 0x86, 0xfc, 0x5a, 0x9, 0x6, 0xd7, 0x58, 0x85, 0xb3, 0x9, 0xa4, 0xd0, 0xef, 0xb3, 0x5a, 0x97, 0x82, 0xa4, 0xa, 0xcf}}
+	return a, nil
+}
+
+var __000014_add_scheduled_messagesDownSql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x00\x1f\x00\xe0\xff\x44\x52\x4f\x50\x20\x54\x41\x42\x4c\x45\x20\x73\x63\x68\x65\x64\x75\x6c\x65\x64\x5f\x6d\x65\x73\x73\x61\x67\x65\x73\x3b\x0a\x03\x00\x1d\x58\xd0\xca\x1f\x00\x00\x00")
+
+func _000014_add_scheduled_messagesDownSqlBytes() ([]byte, error) {
+	return bindataRead(
+		__000014_add_scheduled_messagesDownSql,
+		"000014_add_scheduled_messages.down.sql",
+	)
+}
+
+func _000014_add_scheduled_messagesDownSql() (*asset, error) {
+	bytes, err := _000014_add_scheduled_messagesDownSqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "000014_add_scheduled_messages.down.sql", size: 31, mode: os.FileMode(0644), modTime: time.Unix(1792204726, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0xf4, 0x13, 0x27, 0x65, 0xe3, 0x36, 0xa8, 0x0, 0xe1, 0x2, 0xa9, 0x76, 0xa8, 0x69, 0x17, 0xf, 0x5a, 0x30, 0x5a, 0x10, 0xdd, 0x79, 0x28, 0x83, 0x9e, 0x11, 0xa3, 0x99, 0x3c, 0xd6, 0x7a, 0x47}}
+	return a, nil
+}
+
+var __000014_add_scheduled_messagesUpSql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x6c\x8f\xcd\x6a\x84\x30\x14\x85\xf7\x79\x8a\xb3\x54\xe8\x1b\xb8\x8a\xe9\x95\x86\xa6\x89\xc4\xb4\xe8\x2a\x04\x13\xaa\x60\xed\x22\x0e\xcc\xe3\x0f\x82\x33\xcc\x30\xb3\xbd\x3f\xe7\xfb\x8e\xb0\xc4\x1d\xc1\xf1\x5a\x11\x64\x03\x6d\x1c\xa8\x97\x9d\xeb\x90\xc7\x29\xc5\xd3\x92\xa2\xff\x4b\x39\x87\xdf\x94\x51\x30\x60\x8e\xf8\xe1\x56\x7c\x70\x8b\xd6\xca\x2f\x6e\x07\x7c\xd2\x00\xa3\x21\x8c\x6e\x94\x14\x0e\x96\x5a\xc5\x05\xbd\x31\x60\xf9\x1f\xc3\xe2\xc7\x29\x6c\xfe\xee\x71\xa7\xe8\x6f\xa5\xf6\x8b\x9c\xd6\xe8\xc3\x06\xa9\xdd\xc3\xfc\x80\xa2\x56\xa6\xbe\x2d\x58\x59\x31\x76\x38\x4b\xfd\x4e\x3d\xe6\x78\xf6\xcf\xa6\xfe\x9a\x6a\xf4\x8b\x1e\x45\x4e\x6b\xf4\x61\x2b\x2b\x76\x19\x00\x6b\xb9\x5e\x5c\x00\x01\x00\x00")
+
+func _000014_add_scheduled_messagesUpSqlBytes() ([]byte, error) {
+	return bindataRead(
+		__000014_add_scheduled_messagesUpSql,
+		"000014_add_scheduled_messages.up.sql",
+	)
+}
+
+func _000014_add_scheduled_messagesUpSql() (*asset, error) {
+	bytes, err := _000014_add_scheduled_messagesUpSqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "000014_add_scheduled_messages.up.sql", size: 256, mode: os.FileMode(0644), modTime: time.Unix(1792204726, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x11, 0x3d, 0x66, 0x8f, 0xc2, 0x53, 0xa7, 0x4b, 0xfd, 0x9, 0x6e, 0xa8, 0x98, 0x97, 0x6d, 0x90, 0xc0, 0x88, 0x1e, 0x39, 0x63, 0x80, 0x6d, 0x8f, 0x73, 0xa1, 0x61, 0x10, 0x18, 0x98, 0xd, 0x3d}}
+	return a, nil
+}
+
+var __000015_add_disappearing_messagesDownSql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x03\x00\x00\x00\x00\x00\x00\x00\x00\x00")
+
+func _000015_add_disappearing_messagesDownSqlBytes() ([]byte, error) {
+	return bindataRead(
+		__000015_add_disappearing_messagesDownSql,
+		"000015_add_disappearing_messages.down.sql",
+	)
+}
+
+func _000015_add_disappearing_messagesDownSql() (*asset, error) {
+	bytes, err := _000015_add_disappearing_messagesDownSqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "000015_add_disappearing_messages.down.sql", size: 0, mode: os.FileMode(0644), modTime: time.Unix(1792204977, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0xe3, 0xb0, 0xc4, 0x42, 0x98, 0xfc, 0x1c, 0x14, 0x9a, 0xfb, 0xf4, 0xc8, 0x99, 0x6f, 0xb9, 0x24, 0x27, 0xae, 0x41, 0xe4, 0x64, 0x9b, 0x93, 0x4c, 0xa4, 0x95, 0x99, 0x1b, 0x78, 0x52, 0xb8, 0x55}}
+	return a, nil
+}
+
+var __000015_add_disappearing_messagesUpSql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x9c\xcd\xb1\x0a\xc2\x30\x14\x85\xe1\xbd\x4f\x71\xdc\x74\xeb\x5e\x1c\x62\x73\xc5\x42\x4c\xa1\xa4\xe8\x16\x42\x1b\x6a\xd0\x6a\xc9\xad\xd0\xc7\x77\xd3\x76\x10\xc4\xf9\xe7\x7c\x47\x28\x43\x15\x8c\xd8\x29\x42\x73\x71\x23\x43\x48\x89\xbc\x54\xf5\x51\xa3\x0d\xec\x86\xc1\xbb\x18\xee\x9d\xed\x3d\xb3\xeb\x3c\xdb\x31\xf4\x3e\xa2\xd0\x06\xba\x34\xd0\xb5\x52\x90\xb4\x17\xb5\x32\x48\xb3\xe4\x0f\xb0\xb9\x3d\x9a\xeb\x4f\xe0\x93\x7d\x7c\xef\xe6\xb0\x9f\x86\x10\x3d\x5b\x37\x7e\x75\x92\xbc\x22\x61\x08\x85\x96\x74\x46\x68\x27\xbb\xd0\xec\x8c\x28\x35\x16\x6d\xfd\x69\x1b\x9c\x0e\x54\xd1\xfc\x70\xb5\x45\x9a\x25\xaf\x01\x00\x48\x68\x16\x24\x49\x01\x00\x00")
+
+func _000015_add_disappearing_messagesUpSqlBytes() ([]byte, error) {
+	return bindataRead(
+		__000015_add_disappearing_messagesUpSql,
+		"000015_add_disappearing_messages.up.sql",
+	)
+}
+
+func _000015_add_disappearing_messagesUpSql() (*asset, error) {
+	bytes, err := _000015_add_disappearing_messagesUpSqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "000015_add_disappearing_messages.up.sql", size: 329, mode: os.FileMode(0644), modTime: time.Unix(1792204977, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0xff, 0x3e, 0x22, 0x87, 0x37, 0xd8, 0x12, 0xb8, 0x2, 0x2d, 0xa2, 0xcc, 0x7b, 0xce, 0x16, 0x7, 0x37, 0x6b, 0x41, 0x18, 0x98, 0xe2, 0x98, 0xf9, 0xa4, 0xe6, 0x5a, 0xc0, 0xb8, 0x17, 0xee, 0x48}}
+	return a, nil
+}
+
+var __000016_add_contact_requestsDownSql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x03\x00\x00\x00\x00\x00\x00\x00\x00\x00")
+
+func _000016_add_contact_requestsDownSqlBytes() ([]byte, error) {
+	return bindataRead(
+		__000016_add_contact_requestsDownSql,
+		"000016_add_contact_requests.down.sql",
+	)
+}
+
+func _000016_add_contact_requestsDownSql() (*asset, error) {
+	bytes, err := _000016_add_contact_requestsDownSqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "000016_add_contact_requests.down.sql", size: 0, mode: os.FileMode(0644), modTime: time.Unix(1792205516, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0xe3, 0xb0, 0xc4, 0x42, 0x98, 0xfc, 0x1c, 0x14, 0x9a, 0xfb, 0xf4, 0xc8, 0x99, 0x6f, 0xb9, 0x24, 0x27, 0xae, 0x41, 0xe4, 0x64, 0x9b, 0x93, 0x4c, 0xa4, 0x95, 0x99, 0x1b, 0x78, 0x52, 0xb8, 0x55}}
+	return a, nil
+}
+
+var __000016_add_contact_requestsUpSql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xac\xcd\xbf\x0a\xc2\x30\x10\x80\xf1\xbd\x4f\x71\x5b\x57\xf7\x4e\xa9\x4d\x41\x38\x13\xd0\x14\xdc\x8e\x92\x9e\x7f\xd0\x26\xd8\xbb\x82\x8f\xef\xa2\x43\xd1\x45\x70\xfd\x86\xdf\x67\x30\xd8\x1d\x04\x53\xa3\x85\x98\x93\xf6\x51\x05\x4c\xd3\xc0\xda\x63\xb7\x75\xef\x46\x13\xdf\x67\x16\x25\xd1\x5e\x19\x36\x2e\x80\xf3\x01\x5c\x87\x08\x8d\x6d\x4d\x87\x01\x56\x55\xf1\xab\x16\x6f\x39\x5e\xff\xa6\x29\x3f\x14\x82\x3d\x7c\xd1\xca\x72\xc9\xcd\xc2\x13\x8d\x2c\xd2\x9f\x78\x61\x9e\x2f\xc3\xc0\x89\x8e\x53\x1e\x29\xe5\x44\xaf\x07\xd4\xde\xa3\x35\xee\x53\x6e\x0d\xee\x6d\x55\x3c\x07\x00\x57\xb6\x3a\x56\x48\x01\x00\x00")
+
+func _000016_add_contact_requestsUpSqlBytes() ([]byte, error) {
+	return bindataRead(
+		__000016_add_contact_requestsUpSql,
+		"000016_add_contact_requests.up.sql",
+	)
+}
+
+func _000016_add_contact_requestsUpSql() (*asset, error) {
+	bytes, err := _000016_add_contact_requestsUpSqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "000016_add_contact_requests.up.sql", size: 328, mode: os.FileMode(0644), modTime: time.Unix(1792205516, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x47, 0xfb, 0x76, 0x46, 0xc2, 0x49, 0x42, 0x6e, 0x33, 0xbf, 0x72, 0x0, 0xa8, 0x3a, 0x91, 0x41, 0x60, 0x29, 0xcf, 0x9f, 0x8, 0xaa, 0xad, 0x3b, 0x7b, 0xdf, 0xa0, 0xe2, 0xf9, 0x88, 0xe8, 0x4c}}
+	return a, nil
+}
+
+var __000017_add_contact_local_nicknameDownSql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x03\x00\x00\x00\x00\x00\x00\x00\x00\x00")
+
+func _000017_add_contact_local_nicknameDownSqlBytes() ([]byte, error) {
+	return bindataRead(
+		__000017_add_contact_local_nicknameDownSql,
+		"000017_add_contact_local_nickname.down.sql",
+	)
+}
+
+func _000017_add_contact_local_nicknameDownSql() (*asset, error) {
+	bytes, err := _000017_add_contact_local_nicknameDownSqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "000017_add_contact_local_nickname.down.sql", size: 0, mode: os.FileMode(0644), modTime: time.Unix(1792205969, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0xe3, 0xb0, 0xc4, 0x42, 0x98, 0xfc, 0x1c, 0x14, 0x9a, 0xfb, 0xf4, 0xc8, 0x99, 0x6f, 0xb9, 0x24, 0x27, 0xae, 0x41, 0xe4, 0x64, 0x9b, 0x93, 0x4c, 0xa4, 0x95, 0x99, 0x1b, 0x78, 0x52, 0xb8, 0x55}}
+	return a, nil
+}
+
+var __000017_add_contact_local_nicknameUpSql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x72\xf4\x09\x71\x0d\x52\x08\x71\x74\xf2\x71\x55\x48\xce\xcf\x2b\x49\x4c\x2e\x29\x56\x70\x74\x71\x51\x70\xf6\xf7\x09\xf5\xf5\x53\xc8\xc9\x4f\x4e\xcc\x89\xcf\xcb\x4c\xce\xce\x4b\xcc\x4d\x55\x08\x71\x8d\x08\x51\xf0\xf3\x0f\x51\xf0\x0b\xf5\xf1\x51\x70\x71\x75\x73\x0c\xf5\x09\x51\x50\x57\xb7\xe6\x22\xcd\xa0\xf8\xe4\x9c\xfc\xe4\x6c\x05\x4f\x3f\x2c\xa6\x19\x58\x73\x01\x06\x00\x1d\x6d\x68\x03\x96\x00\x00\x00")
+
+func _000017_add_contact_local_nicknameUpSqlBytes() ([]byte, error) {
+	return bindataRead(
+		__000017_add_contact_local_nicknameUpSql,
+		"000017_add_contact_local_nickname.up.sql",
+	)
+}
+
+func _000017_add_contact_local_nicknameUpSql() (*asset, error) {
+	bytes, err := _000017_add_contact_local_nicknameUpSqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "000017_add_contact_local_nickname.up.sql", size: 150, mode: os.FileMode(0644), modTime: time.Unix(1792205969, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x40, 0xb8, 0xf0, 0x8b, 0x3f, 0x5c, 0x6b, 0x26, 0x73, 0x95, 0xf1, 0x3e, 0xe8, 0xe0, 0xcb, 0xc6, 0xa9, 0xe0, 0xe0, 0xf3, 0xe6, 0x22, 0x79, 0xa3, 0x93, 0x29, 0x6c, 0x81, 0xb2, 0xc, 0xb2, 0x72}}
+	return a, nil
+}
+
+var __000018_add_chat_description_imageDownSql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x03\x00\x00\x00\x00\x00\x00\x00\x00\x00")
+
+func _000018_add_chat_description_imageDownSqlBytes() ([]byte, error) {
+	return bindataRead(
+		__000018_add_chat_description_imageDownSql,
+		"000018_add_chat_description_image.down.sql",
+	)
+}
+
+func _000018_add_chat_description_imageDownSql() (*asset, error) {
+	bytes, err := _000018_add_chat_description_imageDownSqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "000018_add_chat_description_image.down.sql", size: 0, mode: os.FileMode(0644), modTime: time.Unix(1792206181, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0xe3, 0xb0, 0xc4, 0x42, 0x98, 0xfc, 0x1c, 0x14, 0x9a, 0xfb, 0xf4, 0xc8, 0x99, 0x6f, 0xb9, 0x24, 0x27, 0xae, 0x41, 0xe4, 0x64, 0x9b, 0x93, 0x4c, 0xa4, 0x95, 0x99, 0x1b, 0x78, 0x52, 0xb8, 0x55}}
+	return a, nil
+}
+
+var __000018_add_chat_description_imageUpSql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x00\x6c\x00\x93\xff\x41\x4c\x54\x45\x52\x20\x54\x41\x42\x4c\x45\x20\x63\x68\x61\x74\x73\x20\x41\x44\x44\x20\x43\x4f\x4c\x55\x4d\x4e\x20\x64\x65\x73\x63\x72\x69\x70\x74\x69\x6f\x6e\x20\x54\x45\x58\x54\x20\x4e\x4f\x54\x20\x4e\x55\x4c\x4c\x20\x44\x45\x46\x41\x55\x4c\x54\x20\x27\x27\x3b\x0a\x41\x4c\x54\x45\x52\x20\x54\x41\x42\x4c\x45\x20\x63\x68\x61\x74\x73\x20\x41\x44\x44\x20\x43\x4f\x4c\x55\x4d\x4e\x20\x69\x6d\x61\x67\x65\x20\x42\x4c\x4f\x42\x3b\x0a\x03\x00\x83\x23\x80\x19\x6c\x00\x00\x00")
+
+func _000018_add_chat_description_imageUpSqlBytes() ([]byte, error) {
+	return bindataRead(
+		__000018_add_chat_description_imageUpSql,
+		"000018_add_chat_description_image.up.sql",
+	)
+}
+
+func _000018_add_chat_description_imageUpSql() (*asset, error) {
+	bytes, err := _000018_add_chat_description_imageUpSqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "000018_add_chat_description_image.up.sql", size: 108, mode: os.FileMode(0644), modTime: time.Unix(1792206181, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x41, 0x25, 0xdd, 0x29, 0x5e, 0x96, 0x81, 0x63, 0x52, 0x60, 0xc1, 0x20, 0xa3, 0xcc, 0x2a, 0x16, 0x64, 0x38, 0x3, 0x6d, 0x21, 0x58, 0xdd, 0x96, 0xee, 0x5e, 0x2f, 0x15, 0x24, 0x16, 0x24, 0x5e}}
+	return a, nil
+}
+
+var __000019_add_group_chat_invitationsDownSql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x03\x00\x00\x00\x00\x00\x00\x00\x00\x00")
+
+func _000019_add_group_chat_invitationsDownSqlBytes() ([]byte, error) {
+	return bindataRead(
+		__000019_add_group_chat_invitationsDownSql,
+		"000019_add_group_chat_invitations.down.sql",
+	)
+}
+
+func _000019_add_group_chat_invitationsDownSql() (*asset, error) {
+	bytes, err := _000019_add_group_chat_invitationsDownSqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "000019_add_group_chat_invitations.down.sql", size: 72, mode: os.FileMode(0644), modTime: time.Unix(1792211078, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0xb3, 0x7b, 0x91, 0xc2, 0xf2, 0x3f, 0x47, 0x44, 0xaf, 0x3e, 0xb7, 0x5d, 0x3a, 0x9b, 0x80, 0x8e, 0x6d, 0xf4, 0x7e, 0xfc, 0x13, 0x88, 0x45, 0x4, 0x29, 0x64, 0xae, 0xa2, 0xa0, 0x39, 0x60, 0x7c}}
+	return a, nil
+}
+
+var __000019_add_group_chat_invitationsUpSql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x8c\x90\xc1\x6a\xeb\x30\x14\x44\xf7\xfa\x8a\x59\xc6\x90\x3f\xc8\x4a\xf1\xbb\xe6\x89\xaa\x52\x90\x95\xd2\xac\x84\x70\x44\xab\x26\x58\xa9\x2c\x9b\x7e\x7e\xa1\x09\x75\x4a\xda\xd2\xed\x3d\x33\x70\xee\xd4\x86\xb8\x25\x58\xbe\x96\x04\xd1\x40\x69\x0b\x7a\x14\xad\x6d\xf1\x94\xd3\x78\x72\xdd\xb3\x2f\x2e\xf6\x53\x2c\xbe\xc4\xd4\x0f\x58\x30\x20\xee\xf1\xc0\x4d\xfd\x9f\x1b\x6c\x8c\xb8\xe7\x66\x87\x3b\xda\x7d\x94\xd5\x56\xca\x25\x03\xce\xbd\x39\x77\xcd\xc2\xdb\x29\xe6\x30\x38\x5f\x20\x94\xfd\x82\x72\x98\xd2\x21\xec\xb1\xd6\x5a\x12\x57\x9f\x0c\xff\xa8\xe1\x5b\x69\xd1\x70\xd9\x12\xab\x56\x8c\xfd\x4d\xfd\x25\xc5\xde\xe5\xf0\x3a\x86\xa1\x9c\xe5\x7f\x33\xbb\x04\x43\xfe\x96\xce\x33\xfc\xd4\xef\x8e\xa9\x3b\xb8\xc9\x1f\xc7\x70\xf3\xda\x50\x7c\xb9\xbd\x5e\xef\xb7\xb8\xa8\x2d\x67\x8f\x0a\x5a\xa1\xd6\xaa\x91\xa2\xb6\x30\xb4\x91\xbc\x26\x56\xad\xd8\xfb\x00\x79\xd3\x0a\x5f\xb9\x01\x00\x00")
+
+func _000019_add_group_chat_invitationsUpSqlBytes() ([]byte, error) {
+	return bindataRead(
+		__000019_add_group_chat_invitationsUpSql,
+		"000019_add_group_chat_invitations.up.sql",
+	)
+}
+
+func _000019_add_group_chat_invitationsUpSql() (*asset, error) {
+	bytes, err := _000019_add_group_chat_invitationsUpSqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "000019_add_group_chat_invitations.up.sql", size: 441, mode: os.FileMode(0644), modTime: time.Unix(1792206710, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x8d, 0x9f, 0xff, 0x12, 0xd2, 0xa0, 0xce, 0x79, 0xc9, 0x79, 0x85, 0x53, 0x73, 0xb5, 0x13, 0x55, 0x8c, 0xfa, 0x77, 0x87, 0x15, 0xab, 0x96, 0x22, 0x0, 0xc0, 0x3f, 0xc2, 0x8b, 0xf9, 0x83, 0x4c}}
+	return a, nil
+}
+
+var __000020_add_communitiesDownSql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x03\x00\x00\x00\x00\x00\x00\x00\x00\x00")
+
+func _000020_add_communitiesDownSqlBytes() ([]byte, error) {
+	return bindataRead(
+		__000020_add_communitiesDownSql,
+		"000020_add_communities.down.sql",
+	)
+}
+
+func _000020_add_communitiesDownSql() (*asset, error) {
+	bytes, err := _000020_add_communitiesDownSqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "000020_add_communities.down.sql", size: 90, mode: os.FileMode(0644), modTime: time.Unix(1792211078, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0xd9, 0x82, 0x84, 0xc2, 0xad, 0x85, 0xd1, 0x36, 0x74, 0xaa, 0xa9, 0xef, 0x3f, 0x84, 0xdb, 0x52, 0xdb, 0xac, 0x62, 0x66, 0x1d, 0x58, 0xab, 0x7, 0x4f, 0xcc, 0x91, 0x87, 0xd2, 0xef, 0x20, 0x65}}
+	return a, nil
+}
+
+var __000020_add_communitiesUpSql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x94\x91\xd1\x6a\x83\x30\x14\x86\xef\xf3\x14\xff\x5d\x5b\xe8\x1b\xf4\x2a\xa6\x91\xc9\xd2\xa4\xa4\x71\xac\x57\x22\x1a\x58\x66\xab\x9b\x89\x03\xdf\x7e\xac\x13\x67\x71\x2b\xdd\xed\xf9\x39\xe7\xff\xbe\x84\x69\x4e\x0d\x87\xa1\x91\xe0\x48\x62\x48\x65\xc0\x9f\x93\x83\x39\xa0\x68\xce\xe7\xae\x76\xc1\x59\x8f\x25\x01\x5c\x89\x27\xaa\xd9\x03\xd5\xd8\xeb\x64\x47\xf5\x11\x8f\xfc\x78\xd9\x90\xa9\x10\x6b\x02\x94\xd6\x17\xad\x7b\x0b\xae\xa9\x11\x09\x15\x5d\x85\xaf\x8d\xab\x6d\x89\x48\x29\xc1\xa9\x1c\x23\x6c\x79\x4c\x53\x61\x10\x53\x71\xe0\x64\xb5\x21\xe4\x0e\xa8\x3e\xab\x6c\xff\xcd\xf5\x33\x9a\x10\x4e\x8b\x2b\x7b\x89\x12\x69\xae\x78\x2a\xdb\xcf\x21\xa7\x66\xcb\xe9\xe5\xf5\x70\x66\x05\x25\xc1\x94\x8c\x45\xc2\x0c\x34\xdf\x0b\xca\xfe\x41\xdd\xda\xf7\xce\xfa\xe0\xb3\xd0\x64\x5f\x0f\x72\x9f\xc1\xb0\x65\xdb\x5f\xd3\xe2\xd4\x14\x55\xf6\x91\x9f\x3a\x3b\x93\xf4\x21\x0f\xf3\xe9\x0d\xcb\xb1\xea\x6f\x51\x2a\x0c\xd7\x83\x67\xf1\x92\x07\x0f\xba\xdd\x82\x29\x91\xee\xe4\x6d\x97\xf1\xaf\x17\x8b\x0d\xf9\x1c\x00\xf9\xd3\x2e\xf4\x7b\x02\x00\x00")
+
+func _000020_add_communitiesUpSqlBytes() ([]byte, error) {
+	return bindataRead(
+		__000020_add_communitiesUpSql,
+		"000020_add_communities.up.sql",
+	)
+}
+
+func _000020_add_communitiesUpSql() (*asset, error) {
+	bytes, err := _000020_add_communitiesUpSqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "000020_add_communities.up.sql", size: 635, mode: os.FileMode(0644), modTime: time.Unix(1792207396, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x33, 0x54, 0xcd, 0xe0, 0x7d, 0xb0, 0x6b, 0x4a, 0xc2, 0xc7, 0x42, 0x2, 0x45, 0x9b, 0x8c, 0x4, 0x3c, 0xd2, 0xab, 0xf4, 0x3f, 0x7d, 0x15, 0x9c, 0x2c, 0x7, 0x7c, 0x67, 0x2, 0xaf, 0xf5, 0x99}}
+	return a, nil
+}
+
+var __000021_add_pollsDownSql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x03\x00\x00\x00\x00\x00\x00\x00\x00\x00")
+
+func _000021_add_pollsDownSqlBytes() ([]byte, error) {
+	return bindataRead(
+		__000021_add_pollsDownSql,
+		"000021_add_polls.down.sql",
+	)
+}
+
+func _000021_add_pollsDownSql() (*asset, error) {
+	bytes, err := _000021_add_pollsDownSqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "000021_add_polls.down.sql", size: 23, mode: os.FileMode(0644), modTime: time.Unix(1792211079, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x4d, 0x2f, 0xf7, 0x7b, 0x89, 0x32, 0x8, 0x6f, 0x84, 0x16, 0x96, 0x39, 0xc0, 0x5f, 0xfa, 0xec, 0xf7, 0x96, 0x16, 0xa4, 0x25, 0x3b, 0xff, 0xba, 0xb1, 0xf7, 0x2, 0x4b, 0x75, 0x3, 0xf, 0x4}}
+	return a, nil
+}
+
+var __000021_add_pollsUpSql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x6c\x8e\xc1\x6a\x02\x31\x14\x45\xf7\xf9\x8a\xbb\x54\xf0\x0f\x5c\xbd\x89\x4f\x1a\xfa\x4c\x24\xc6\x52\x57\x61\xb0\xa1\x0c\xcd\x34\x83\x89\x7e\x7f\xe9\x54\x28\xd2\xae\xcf\xb9\x97\x43\x12\xd8\x23\x50\x27\x8c\x6b\x4d\x97\x38\xa6\x5a\xfb\xf7\x54\x41\x9b\x0d\xb4\x93\xe3\xce\x62\x2a\x39\xa3\x13\xd7\xad\x95\xd2\x9e\x29\xf0\x7d\x61\xb6\xb0\x2e\x80\x5f\xcd\x21\x1c\x66\x2d\xde\x4a\x4b\x15\x0b\x05\xdc\x9f\xe2\xf0\x86\x17\xf2\xfa\x89\xfc\x2c\xdb\xa3\xc8\x4a\x01\xdf\xe2\xe5\x5f\x72\xce\xe5\xfc\x11\x6f\x7d\xbe\x26\x18\x1b\x1e\x58\x99\xda\x50\x3e\xeb\x5c\xf3\x00\xda\x30\xa6\xda\xfa\x71\xfa\x33\xd9\x7b\xb3\x23\x7f\xc2\x33\x9f\xb0\xf8\x8d\x5a\xfd\x14\x2c\xe1\x2c\xb4\xb3\x5b\x31\x3a\xc0\xf3\x5e\x48\xb3\x5a\xae\xd5\xd7\x00\x5d\x4f\x08\x8b\x1a\x01\x00\x00")
+
+func _000021_add_pollsUpSqlBytes() ([]byte, error) {
+	return bindataRead(
+		__000021_add_pollsUpSql,
+		"000021_add_polls.up.sql",
+	)
+}
+
+func _000021_add_pollsUpSql() (*asset, error) {
+	bytes, err := _000021_add_pollsUpSqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "000021_add_polls.up.sql", size: 282, mode: os.FileMode(0644), modTime: time.Unix(1792207877, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x19, 0x47, 0x63, 0xfc, 0xf6, 0xdb, 0x30, 0xa9, 0x68, 0x6c, 0x4c, 0xaf, 0xc5, 0x7, 0x4a, 0x29, 0xc7, 0x80, 0x84, 0xf4, 0x5c, 0x87, 0xc9, 0x82, 0xdb, 0x4c, 0xaa, 0xdf, 0xc9, 0x20, 0xa5, 0x86}}
+	return a, nil
+}
+
+var __000022_add_contact_profileDownSql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x03\x00\x00\x00\x00\x00\x00\x00\x00\x00")
+
+func _000022_add_contact_profileDownSqlBytes() ([]byte, error) {
+	return bindataRead(
+		__000022_add_contact_profileDownSql,
+		"000022_add_contact_profile.down.sql",
+	)
+}
+
+func _000022_add_contact_profileDownSql() (*asset, error) {
+	bytes, err := _000022_add_contact_profileDownSqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "000022_add_contact_profile.down.sql", size: 0, mode: os.FileMode(0644), modTime: time.Unix(1792208752, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0xe3, 0xb0, 0xc4, 0x42, 0x98, 0xfc, 0x1c, 0x14, 0x9a, 0xfb, 0xf4, 0xc8, 0x99, 0x6f, 0xb9, 0x24, 0x27, 0xae, 0x41, 0xe4, 0x64, 0x9b, 0x93, 0x4c, 0xa4, 0x95, 0x99, 0x1b, 0x78, 0x52, 0xb8, 0x55}}
+	return a, nil
+}
+
+var __000022_add_contact_profileUpSql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xa4\x8d\x3d\x0e\xc2\x20\x14\xc7\x77\x4f\xf1\xdf\x7a\x08\x27\x10\x9c\x9e\x90\x98\x47\xe2\xd6\x3c\x2b\x9a\x17\x29\x34\xd2\xc5\xdb\x7b\x03\x3b\x74\xff\x7d\x18\x62\x7f\x05\x1b\x4b\x1e\x53\xab\xab\x4c\x6b\x87\x71\x0e\xa7\x48\xe9\x12\xf0\xd0\xbe\x14\xf9\x8e\x55\xe6\x0c\xf6\x37\x46\x88\x8c\x90\x88\xe0\xfc\xd9\x24\x62\x0c\xc3\xf1\xb0\x95\xb9\x6b\xdb\x61\xeb\x2c\xaf\xdc\x61\x29\xda\x6d\x78\xf9\xb4\xa7\x96\x3c\x16\xad\xef\x3f\xcf\xdf\x00\xa8\x20\x29\xb2\xf9\x00\x00\x00")
+
+func _000022_add_contact_profileUpSqlBytes() ([]byte, error) {
+	return bindataRead(
+		__000022_add_contact_profileUpSql,
+		"000022_add_contact_profile.up.sql",
+	)
+}
+
+func _000022_add_contact_profileUpSql() (*asset, error) {
+	bytes, err := _000022_add_contact_profileUpSqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "000022_add_contact_profile.up.sql", size: 249, mode: os.FileMode(0644), modTime: time.Unix(1792208752, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x4e, 0x9, 0xe8, 0xf9, 0x3c, 0x1c, 0x10, 0x50, 0xdc, 0xb1, 0x6d, 0x1b, 0x1e, 0xff, 0x1d, 0xab, 0x6b, 0x5a, 0x9a, 0x38, 0x36, 0x65, 0x49, 0xa3, 0xb9, 0x8a, 0x9e, 0x5d, 0xb5, 0x15, 0x6c, 0x54}}
+	return a, nil
+}
+
+var __000023_add_pending_chat_notification_settingsDownSql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x00\x2f\x00\xd0\xff\x44\x52\x4f\x50\x20\x54\x41\x42\x4c\x45\x20\x70\x65\x6e\x64\x69\x6e\x67\x5f\x63\x68\x61\x74\x5f\x6e\x6f\x74\x69\x66\x69\x63\x61\x74\x69\x6f\x6e\x5f\x73\x65\x74\x74\x69\x6e\x67\x73\x3b\x0a\x03\x00\xce\xac\xc2\xe2\x2f\x00\x00\x00")
+
+func _000023_add_pending_chat_notification_settingsDownSqlBytes() ([]byte, error) {
+	return bindataRead(
+		__000023_add_pending_chat_notification_settingsDownSql,
+		"000023_add_pending_chat_notification_settings.down.sql",
+	)
+}
+
+func _000023_add_pending_chat_notification_settingsDownSql() (*asset, error) {
+	bytes, err := _000023_add_pending_chat_notification_settingsDownSqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "000023_add_pending_chat_notification_settings.down.sql", size: 47, mode: os.FileMode(0644), modTime: time.Unix(1792209729, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x85, 0x75, 0xb4, 0x5d, 0x9, 0x81, 0x81, 0x52, 0x13, 0x1, 0xc6, 0xa, 0x55, 0x2b, 0x45, 0x3b, 0xe1, 0x8b, 0x0, 0xb3, 0xcc, 0xa7, 0xe2, 0x83, 0xfc, 0x20, 0x4a, 0x5, 0x4d, 0x0, 0x53, 0x36}}
+	return a, nil
+}
+
+var __000023_add_pending_chat_notification_settingsUpSql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x74\xce\xbf\x4e\xc3\x30\x10\xc7\xf1\x3d\x4f\xf1\x1b\x41\x62\x60\x67\xba\x9a\xb3\xb0\x38\xec\xca\x71\x11\x9d\xac\x28\x31\xc5\xc2\x38\x48\x75\xfa\xfc\x28\x1d\x22\x75\x60\xfe\x7e\xee\x8f\xf2\x4c\x81\x11\x68\x27\x0c\xa3\x61\x5d\x00\x7f\x98\x3e\xf4\xf8\x4d\x75\xca\xf5\x14\xc7\xaf\xa1\xc5\x3a\xb7\xfc\x99\xc7\xa1\xe5\xb9\xc6\x73\x6a\x2d\xd7\xd3\x19\x77\x1d\x70\xcd\x79\xc2\x3b\x79\xf5\x42\x1e\x7b\x6f\xde\xc8\x1f\xf1\xca\x47\x38\x0b\xe5\xac\x16\xa3\x02\x3c\xef\x85\x14\x3f\xac\x23\x65\x1e\xbf\xe3\x65\x28\x4b\x82\xb1\xe1\x7a\xd4\x1e\x44\xd6\xf6\xb3\xb4\x34\x61\xe7\x9c\x30\xd9\xad\xe0\x99\x35\x1d\x24\x40\x93\xf4\xbc\xb9\xb8\xd4\x96\xcb\xcd\x8e\x4d\x3e\xae\xea\xe6\xed\x92\x2e\xe9\x3f\xdc\xdd\x3f\x75\x7f\x03\x00\x11\xf9\xe2\xd6\x0c\x01\x00\x00")
+
+func _000023_add_pending_chat_notification_settingsUpSqlBytes() ([]byte, error) {
+	return bindataRead(
+		__000023_add_pending_chat_notification_settingsUpSql,
+		"000023_add_pending_chat_notification_settings.up.sql",
+	)
+}
+
+func _000023_add_pending_chat_notification_settingsUpSql() (*asset, error) {
+	bytes, err := _000023_add_pending_chat_notification_settingsUpSqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "000023_add_pending_chat_notification_settings.up.sql", size: 268, mode: os.FileMode(0644), modTime: time.Unix(1792209729, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0xe6, 0x9, 0xac, 0x10, 0xa8, 0xca, 0x4b, 0x6b, 0xe0, 0x6, 0x77, 0x52, 0xd4, 0xfb, 0x6e, 0xb7, 0x52, 0xe2, 0x71, 0x4e, 0xad, 0x1b, 0x6, 0xdc, 0xc, 0xe7, 0xc2, 0xd8, 0xf7, 0xc, 0x8d, 0xcc}}
+	return a, nil
+}
+
+var __000024_add_scheduled_messages_attemptsDownSql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x03\x00\x00\x00\x00\x00\x00\x00\x00\x00")
+
+func _000024_add_scheduled_messages_attemptsDownSqlBytes() ([]byte, error) {
+	return bindataRead(
+		__000024_add_scheduled_messages_attemptsDownSql,
+		"000024_add_scheduled_messages_attempts.down.sql",
+	)
+}
+
+func _000024_add_scheduled_messages_attemptsDownSql() (*asset, error) {
+	bytes, err := _000024_add_scheduled_messages_attemptsDownSqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "000024_add_scheduled_messages_attempts.down.sql", size: 0, mode: os.FileMode(0644), modTime: time.Unix(1792210249, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0xe3, 0xb0, 0xc4, 0x42, 0x98, 0xfc, 0x1c, 0x14, 0x9a, 0xfb, 0xf4, 0xc8, 0x99, 0x6f, 0xb9, 0x24, 0x27, 0xae, 0x41, 0xe4, 0x64, 0x9b, 0x93, 0x4c, 0xa4, 0x95, 0x99, 0x1b, 0x78, 0x52, 0xb8, 0x55}}
+	return a, nil
+}
+
+var __000024_add_scheduled_messages_attemptsUpSql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xac\xcc\x3b\x0a\xc2\x40\x10\x06\xe0\x3e\xa7\xf8\x8f\x60\x9f\x6a\x62\x36\x20\x8c\xb3\xa0\xb3\x75\x58\xdc\xf1\x01\x09\x48\x66\x2c\xbc\xbd\x07\xb0\xb1\xf0\x02\x1f\xb1\xa6\x13\x94\x06\x4e\xf0\xcb\xdd\xda\x6b\xb1\x36\xaf\xe6\x5e\x6f\xe6\xa0\x71\xc4\x3e\x73\x39\x0a\x6a\x84\xad\xcf\x70\x1c\x44\x21\x59\x21\x85\x19\x63\x9a\xa8\xb0\x62\xd7\x77\xbf\x53\x9b\xc5\xf6\x9e\x6b\xfc\x81\xba\xd6\xc7\x62\x0d\x43\xce\x9c\x48\xbe\xb1\x89\xf8\x9c\xfa\xee\x33\x00\xdb\xd3\xd5\x19\xe7\x00\x00\x00")
+
+func _000024_add_scheduled_messages_attemptsUpSqlBytes() ([]byte, error) {
+	return bindataRead(
+		__000024_add_scheduled_messages_attemptsUpSql,
+		"000024_add_scheduled_messages_attempts.up.sql",
+	)
+}
+
+func _000024_add_scheduled_messages_attemptsUpSql() (*asset, error) {
+	bytes, err := _000024_add_scheduled_messages_attemptsUpSqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "000024_add_scheduled_messages_attempts.up.sql", size: 231, mode: os.FileMode(0644), modTime: time.Unix(1792210241, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x6b, 0xf7, 0x2d, 0x51, 0x41, 0x32, 0xaa, 0x6a, 0xb3, 0x74, 0x33, 0xfc, 0xbf, 0xfb, 0x29, 0x53, 0xcd, 0x12, 0x4d, 0x7b, 0xe8, 0xba, 0x44, 0x80, 0x65, 0x3e, 0x91, 0x7f, 0xbd, 0x5e, 0xc8, 0x7b}}
+	return a, nil
+}
+
+var __000025_add_edits_and_deletes_local_chat_idDownSql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x03\x00\x00\x00\x00\x00\x00\x00\x00\x00")
+
+func _000025_add_edits_and_deletes_local_chat_idDownSqlBytes() ([]byte, error) {
+	return bindataRead(
+		__000025_add_edits_and_deletes_local_chat_idDownSql,
+		"000025_add_edits_and_deletes_local_chat_id.down.sql",
+	)
+}
+
+func _000025_add_edits_and_deletes_local_chat_idDownSql() (*asset, error) {
+	bytes, err := _000025_add_edits_and_deletes_local_chat_idDownSqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "000025_add_edits_and_deletes_local_chat_id.down.sql", size: 0, mode: os.FileMode(0644), modTime: time.Unix(1792213848, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0xe3, 0xb0, 0xc4, 0x42, 0x98, 0xfc, 0x1c, 0x14, 0x9a, 0xfb, 0xf4, 0xc8, 0x99, 0x6f, 0xb9, 0x24, 0x27, 0xae, 0x41, 0xe4, 0x64, 0x9b, 0x93, 0x4c, 0xa4, 0x95, 0x99, 0x1b, 0x78, 0x52, 0xb8, 0x55}}
+	return a, nil
+}
+
+var __000025_add_edits_and_deletes_local_chat_idUpSql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x9c\xcc\x31\x0a\x42\x31\x0c\x06\xe0\xdd\x53\xfc\xdb\x3b\x84\x53\x7c\xad\x38\xc4\x3e\x28\xa9\x6b\x28\xaf\x41\x0b\x15\xc1\xd4\xfb\x7b\x02\x17\x2f\xf0\x11\x4b\xcc\x10\x3a\x71\xc4\xc7\xed\xad\x4f\x73\xaf\x77\x73\xb5\xd6\xa7\x83\x42\xc0\xba\x71\xb9\x26\x8c\xd7\x5e\x87\xee\x8f\x3a\xb5\x37\xdc\x28\xaf\x17\xca\x48\x9b\x20\x15\x66\x84\x78\xa6\xc2\x82\x65\x39\x1e\x7e\xab\xcd\x86\x4d\xfb\xd7\xfd\x0e\x00\x66\x95\xfb\xb5\xae\x00\x00\x00")
+
+func _000025_add_edits_and_deletes_local_chat_idUpSqlBytes() ([]byte, error) {
+	return bindataRead(
+		__000025_add_edits_and_deletes_local_chat_idUpSql,
+		"000025_add_edits_and_deletes_local_chat_id.up.sql",
+	)
+}
+
+func _000025_add_edits_and_deletes_local_chat_idUpSql() (*asset, error) {
+	bytes, err := _000025_add_edits_and_deletes_local_chat_idUpSqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "000025_add_edits_and_deletes_local_chat_id.up.sql", size: 174, mode: os.FileMode(0644), modTime: time.Unix(1792213848, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x79, 0x18, 0xe6, 0xc7, 0xd9, 0x20, 0x0, 0xc9, 0x6, 0x70, 0x80, 0x67, 0x50, 0x97, 0x2c, 0x33, 0x96, 0x67, 0x25, 0x1d, 0xe3, 0xfb, 0x46, 0x40, 0xde, 0x4d, 0x11, 0x4d, 0x9b, 0x7f, 0xc2, 0xc3}}
+	return a, nil
+}
+
+var __000026_add_message_chunks_received_atDownSql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x03\x00\x00\x00\x00\x00\x00\x00\x00\x00")
+
+func _000026_add_message_chunks_received_atDownSqlBytes() ([]byte, error) {
+	return bindataRead(
+		__000026_add_message_chunks_received_atDownSql,
+		"000026_add_message_chunks_received_at.down.sql",
+	)
+}
+
+func _000026_add_message_chunks_received_atDownSql() (*asset, error) {
+	bytes, err := _000026_add_message_chunks_received_atDownSqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "000026_add_message_chunks_received_at.down.sql", size: 0, mode: os.FileMode(0644), modTime: time.Unix(1792214180, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0xe3, 0xb0, 0xc4, 0x42, 0x98, 0xfc, 0x1c, 0x14, 0x9a, 0xfb, 0xf4, 0xc8, 0x99, 0x6f, 0xb9, 0x24, 0x27, 0xae, 0x41, 0xe4, 0x64, 0x9b, 0x93, 0x4c, 0xa4, 0x95, 0x99, 0x1b, 0x78, 0x52, 0xb8, 0x55}}
+	return a, nil
+}
+
+var __000026_add_message_chunks_received_atUpSql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x00\x4a\x00\xb5\xff\x41\x4c\x54\x45\x52\x20\x54\x41\x42\x4c\x45\x20\x6d\x65\x73\x73\x61\x67\x65\x5f\x63\x68\x75\x6e\x6b\x73\x20\x41\x44\x44\x20\x43\x4f\x4c\x55\x4d\x4e\x20\x72\x65\x63\x65\x69\x76\x65\x64\x5f\x61\x74\x20\x49\x4e\x54\x20\x4e\x4f\x54\x20\x4e\x55\x4c\x4c\x20\x44\x45\x46\x41\x55\x4c\x54\x20\x30\x3b\x0a\x03\x00\x26\x12\x77\xf6\x4a\x00\x00\x00")
+
+func _000026_add_message_chunks_received_atUpSqlBytes() ([]byte, error) {
+	return bindataRead(
+		__000026_add_message_chunks_received_atUpSql,
+		"000026_add_message_chunks_received_at.up.sql",
+	)
+}
+
+func _000026_add_message_chunks_received_atUpSql() (*asset, error) {
+	bytes, err := _000026_add_message_chunks_received_atUpSqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "000026_add_message_chunks_received_at.up.sql", size: 74, mode: os.FileMode(0644), modTime: time.Unix(1792214180, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x4, 0x6a, 0x2c, 0x6f, 0x2, 0x15, 0xfc, 0x5, 0xe1, 0x11, 0x47, 0x63, 0xeb, 0x38, 0x34, 0xcc, 0x17, 0x5d, 0x17, 0xa4, 0xa4, 0x42, 0x43, 0x43, 0x47, 0xf5, 0x24, 0x87, 0x2f, 0xee, 0x6b, 0x9f}}
+	return a, nil
+}
+
+var __000027_add_pin_messages_local_chat_id_keyDownSql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x7c\x52\xc1\x6a\xeb\x30\x10\xbc\xeb\x2b\xe6\x98\x80\x0e\xef\xee\x93\x22\xaf\x79\xa2\x8a\x14\xd6\x4a\x49\x4e\xc2\xb5\x4d\x6b\xea\x26\xa1\x6e\x4a\xfb\xf7\x25\x60\x83\x54\xda\xde\x96\x9d\x59\xcd\xec\x68\x35\x93\x0a\x84\xa0\x36\x96\x60\x2a\x38\x1f\x40\x07\x53\x87\x1a\x97\xe1\x14\x5f\xfa\x69\x6a\x1e\xfb\x29\x3e\x7c\x2e\x35\x56\x02\x98\xeb\x38\x74\xb8\x57\xac\xff\x2b\xc6\x8e\xcd\x56\xf1\x11\x77\x74\x84\x77\xd0\xde\x55\xd6\xe8\x00\xa6\x9d\x55\x9a\xa4\x00\x12\xf6\x4d\xc7\xed\xad\xbd\xb5\xdb\xf1\xdc\x3e\xc7\xf7\x66\xbc\xf6\x30\x2e\x64\xd8\x74\xbe\xbe\xb6\x3d\x02\x1d\xf2\x7e\xfb\xd4\xbc\xa5\xea\x29\x36\x9e\xdb\x66\x8c\x7f\x31\x2e\xc3\xe9\xd4\x77\x99\x18\x4a\xaa\xd4\xde\x06\xfc\x13\xeb\x42\x08\xe3\x6a\xe2\x70\x63\xf8\x5f\x83\xa8\xc9\x92\x0e\x49\x16\x12\x43\x27\xd3\x75\xe4\xec\x5f\x2e\x7e\x65\x6e\x4e\x2e\x4e\x2a\xf6\xdb\x4c\x07\x9e\x4b\x62\x6c\x8e\x59\x3a\xaa\xd6\x85\x10\x25\xfb\xdd\xfc\x63\xe9\x48\x21\x84\xb2\x81\xf8\x07\x28\x75\xcd\xe4\xd4\x96\xf0\x6d\xaf\x42\x88\xf9\x14\x8c\x2b\xe9\x80\xa1\xfb\x88\xd9\x0b\x79\xa8\xde\x65\xd3\xab\x0c\x5d\x17\xe2\x6b\x00\x9d\x54\x8f\x03\x57\x02\x00\x00")
+
+func _000027_add_pin_messages_local_chat_id_keyDownSqlBytes() ([]byte, error) {
+	return bindataRead(
+		__000027_add_pin_messages_local_chat_id_keyDownSql,
+		"000027_add_pin_messages_local_chat_id_key.down.sql",
+	)
+}
+
+func _000027_add_pin_messages_local_chat_id_keyDownSql() (*asset, error) {
+	bytes, err := _000027_add_pin_messages_local_chat_id_keyDownSqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "000027_add_pin_messages_local_chat_id_key.down.sql", size: 599, mode: os.FileMode(0644), modTime: time.Unix(1792214442, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0xd, 0x7b, 0x7e, 0x1c, 0xc9, 0xcd, 0xfd, 0x54, 0x44, 0x17, 0xd6, 0xf2, 0x2, 0x20, 0xf1, 0xc7, 0x54, 0x79, 0x91, 0xe3, 0x18, 0x3b, 0xff, 0x68, 0x8, 0x7c, 0xae, 0x60, 0xa9, 0x34, 0x75, 0xa6}}
+	return a, nil
+}
+
+var __000027_add_pin_messages_local_chat_id_keyUpSql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x7c\x92\xc1\x4f\x83\x30\x18\xc5\xef\xfd\x2b\xde\x71\x4b\x7a\xf0\xce\xa9\x76\x1f\xb1\xb1\x6b\x97\xd2\x19\x76\x6a\x10\x88\x12\x11\x16\x71\x46\xff\x7b\x83\x61\x4a\xc9\xe6\xf5\x7b\x7c\xdf\xef\xbd\x47\xa5\x23\xe1\x09\x5e\xdc\x6a\x82\x4a\x61\xac\x07\xe5\x2a\xf3\x19\x8e\x4d\x17\x5e\xeb\x61\x28\x9e\xea\x21\x3c\x7e\x85\xf2\xb9\x78\xc7\x8a\x01\xd3\x30\x34\x15\x1e\x84\x93\x77\xc2\xfd\xac\x99\xbd\xd6\x9c\x01\x57\xc6\x65\xdb\x97\x2f\xe1\xa3\x68\x4f\x35\x94\xf1\x91\x36\xf4\xa7\xb7\xb2\x86\xa7\x3c\x9e\x8f\xc8\x6b\x98\xb6\x2f\x8b\x36\xfc\xf7\xc5\xb1\xe9\xba\xba\x8a\x60\xd8\x50\x2a\xf6\xda\xe3\x66\x3c\xb1\x73\x6a\x2b\xdc\x01\xf7\x74\xc0\xea\x2f\x15\x8f\x6f\xaf\x61\x0d\xa4\x35\xa9\x56\xd2\xc3\xd1\x4e\x0b\x49\x6c\x9d\x30\xa6\x4c\x46\xce\x8f\x00\x7b\xb9\xad\x8c\x34\x49\x3f\x2b\x8c\x63\x3c\x3f\xab\x82\x4f\xd9\xf9\x39\xeb\x02\xce\xcf\x29\x52\x67\xb7\x11\x24\x61\x6c\xe3\xec\x6e\xfa\x75\x0b\x45\x68\x4f\xee\x82\xf4\xeb\xcc\x91\x11\x5b\xc2\xc2\x78\xc2\xd8\xf4\x20\x94\xd9\x50\x8e\xa6\xfa\x0c\xd1\x7a\xe4\x6d\xec\x65\xae\xae\x22\x75\x9d\xb0\xef\x01\x00\x8a\x3e\xb3\xc9\x5d\x02\x00\x00")
+
+func _000027_add_pin_messages_local_chat_id_keyUpSqlBytes() ([]byte, error) {
+	return bindataRead(
+		__000027_add_pin_messages_local_chat_id_keyUpSql,
+		"000027_add_pin_messages_local_chat_id_key.up.sql",
+	)
+}
+
+func _000027_add_pin_messages_local_chat_id_keyUpSql() (*asset, error) {
+	bytes, err := _000027_add_pin_messages_local_chat_id_keyUpSqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "000027_add_pin_messages_local_chat_id_key.up.sql", size: 605, mode: os.FileMode(0644), modTime: time.Unix(1792214442, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0xcf, 0x66, 0xb8, 0x97, 0xdc, 0x40, 0x3f, 0x2b, 0x37, 0xeb, 0x67, 0xce, 0x8, 0x9d, 0xae, 0x25, 0x3e, 0xed, 0x7c, 0xba, 0x2c, 0xd7, 0x53, 0xad, 0xfa, 0x7e, 0x4, 0xee, 0xde, 0x4d, 0x3f, 0x6a}}
+	return a, nil
+}
+
+var __000028_add_forwarded_from_verifiedDownSql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x03\x00\x00\x00\x00\x00\x00\x00\x00\x00")
+
+func _000028_add_forwarded_from_verifiedDownSqlBytes() ([]byte, error) {
+	return bindataRead(
+		__000028_add_forwarded_from_verifiedDownSql,
+		"000028_add_forwarded_from_verified.down.sql",
+	)
+}
+
+func _000028_add_forwarded_from_verifiedDownSql() (*asset, error) {
+	bytes, err := _000028_add_forwarded_from_verifiedDownSqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "000028_add_forwarded_from_verified.down.sql", size: 0, mode: os.FileMode(0644), modTime: time.Unix(1792214782, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0xe3, 0xb0, 0xc4, 0x42, 0x98, 0xfc, 0x1c, 0x14, 0x9a, 0xfb, 0xf4, 0xc8, 0x99, 0x6f, 0xb9, 0x24, 0x27, 0xae, 0x41, 0xe4, 0x64, 0x9b, 0x93, 0x4c, 0xa4, 0x95, 0x99, 0x1b, 0x78, 0x52, 0xb8, 0x55}}
+	return a, nil
+}
+
+var __000028_add_forwarded_from_verifiedUpSql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x00\x5d\x00\xa2\xff\x41\x4c\x54\x45\x52\x20\x54\x41\x42\x4c\x45\x20\x75\x73\x65\x72\x5f\x6d\x65\x73\x73\x61\x67\x65\x73\x20\x41\x44\x44\x20\x43\x4f\x4c\x55\x4d\x4e\x20\x66\x6f\x72\x77\x61\x72\x64\x65\x64\x5f\x66\x72\x6f\x6d\x5f\x76\x65\x72\x69\x66\x69\x65\x64\x20\x42\x4f\x4f\x4c\x45\x41\x4e\x20\x4e\x4f\x54\x20\x4e\x55\x4c\x4c\x20\x44\x45\x46\x41\x55\x4c\x54\x20\x46\x41\x4c\x53\x45\x3b\x0a\x03\x00\x1a\x71\xb3\x1f\x5d\x00\x00\x00")
+
+func _000028_add_forwarded_from_verifiedUpSqlBytes() ([]byte, error) {
+	return bindataRead(
+		__000028_add_forwarded_from_verifiedUpSql,
+		"000028_add_forwarded_from_verified.up.sql",
+	)
+}
+
+func _000028_add_forwarded_from_verifiedUpSql() (*asset, error) {
+	bytes, err := _000028_add_forwarded_from_verifiedUpSqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "000028_add_forwarded_from_verified.up.sql", size: 93, mode: os.FileMode(0644), modTime: time.Unix(1792214782, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x70, 0x64, 0xbe, 0x8b, 0xa8, 0xf3, 0xb6, 0x1e, 0x6c, 0xb5, 0xbc, 0x52, 0x87, 0x1a, 0x88, 0x84, 0x6, 0x63, 0x19, 0x1c, 0x34, 0xba, 0xd5, 0x46, 0x64, 0x2c, 0xa2, 0x5e, 0x8f, 0x35, 0x5e, 0x14}}
+	return a, nil
+}
+
+var __000029_add_user_messages_awaiting_postDownSql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x03\x00\x00\x00\x00\x00\x00\x00\x00\x00")
+
+func _000029_add_user_messages_awaiting_postDownSqlBytes() ([]byte, error) {
+	return bindataRead(
+		__000029_add_user_messages_awaiting_postDownSql,
+		"000029_add_user_messages_awaiting_post.down.sql",
+	)
+}
+
+func _000029_add_user_messages_awaiting_postDownSql() (*asset, error) {
+	bytes, err := _000029_add_user_messages_awaiting_postDownSqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "000029_add_user_messages_awaiting_post.down.sql", size: 0, mode: os.FileMode(0644), modTime: time.Unix(1792216502, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0xe3, 0xb0, 0xc4, 0x42, 0x98, 0xfc, 0x1c, 0x14, 0x9a, 0xfb, 0xf4, 0xc8, 0x99, 0x6f, 0xb9, 0x24, 0x27, 0xae, 0x41, 0xe4, 0x64, 0x9b, 0x93, 0x4c, 0xa4, 0x95, 0x99, 0x1b, 0x78, 0x52, 0xb8, 0x55}}
+	return a, nil
+}
+
+var __000029_add_user_messages_awaiting_postUpSql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x00\x53\x00\xac\xff\x41\x4c\x54\x45\x52\x20\x54\x41\x42\x4c\x45\x20\x75\x73\x65\x72\x5f\x6d\x65\x73\x73\x61\x67\x65\x73\x20\x41\x44\x44\x20\x43\x4f\x4c\x55\x4d\x4e\x20\x61\x77\x61\x69\x74\x69\x6e\x67\x5f\x70\x6f\x73\x74\x20\x42\x4f\x4f\x4c\x45\x41\x4e\x20\x4e\x4f\x54\x20\x4e\x55\x4c\x4c\x20\x44\x45\x46\x41\x55\x4c\x54\x20\x46\x41\x4c\x53\x45\x3b\x0a\x03\x00\x46\x00\x29\x84\x53\x00\x00\x00")
+
+func _000029_add_user_messages_awaiting_postUpSqlBytes() ([]byte, error) {
+	return bindataRead(
+		__000029_add_user_messages_awaiting_postUpSql,
+		"000029_add_user_messages_awaiting_post.up.sql",
+	)
+}
+
+func _000029_add_user_messages_awaiting_postUpSql() (*asset, error) {
+	bytes, err := _000029_add_user_messages_awaiting_postUpSqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "000029_add_user_messages_awaiting_post.up.sql", size: 83, mode: os.FileMode(0644), modTime: time.Unix(1792216502, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x86, 0xaa, 0x8a, 0xf6, 0xe4, 0x38, 0x65, 0xe6, 0x80, 0xa7, 0x94, 0x64, 0xa1, 0x7a, 0x5d, 0x10, 0x22, 0x79, 0x52, 0x18, 0xb6, 0xeb, 0x3a, 0x2f, 0xde, 0xcc, 0x4d, 0xe0, 0x31, 0xce, 0x2e, 0xbd}}
+	return a, nil
+}
+
 var _docGo = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x84\x8f\xbb\x6e\xc3\x30\x0c\x45\x77\x7f\xc5\x45\x96\x2c\xb5\xb4\x74\xea\xd6\xb1\x7b\x7f\x80\x91\x68\x89\x88\x1e\xae\x48\xe7\xf1\xf7\x85\xd3\x02\xcd\xd6\xf5\x00\xe7\xf0\xd2\x7b\x7c\x66\x51\x2c\x52\x18\xa2\x68\x1c\x58\x95\xc6\x1d\x27\x0e\xb4\x29\xe3\x90\xc4\xf2\x76\x72\xa1\x57\xaf\x46\xb6\xe9\x2c\xd5\x57\x49\x83\x8c\xfd\xe5\xf5\x30\x79\x8f\x40\xed\x68\xc8\xd4\x62\xe1\x47\x4b\xa1\x46\xc3\xa4\x25\x5c\xc5\x32\x08\xeb\xe0\x45\x6e\x0e\xef\x86\xc2\xa4\x06\xcb\x64\x47\x85\x65\x46\x20\xe5\x3d\xb3\xf4\x81\xd4\xe7\x93\xb4\x48\x46\x6e\x47\x1f\xcb\x13\xd9\x17\x06\x2a\x85\x23\x96\xd1\xeb\xc3\x55\xaa\x8c\x28\x83\x83\xf5\x71\x7f\x01\xa9\xb2\xa1\x51\x65\xdd\xfd\x4c\x17\x46\xeb\xbf\xe7\x41\x2d\xfe\xff\x11\xae\x7d\x9c\x15\xa4\xe0\xdb\xca\xc1\x38\xba\x69\x5a\x29\x9c\x29\x31\xf4\xab\x88\xf1\x34\x79\x9f\xfa\x5b\xe2\xc6\xbb\xf5\xbc\x71\x5e\xcf\x09\x3f\x35\xe9\x4d\x31\x77\x38\xe7\xff\x80\x4b\x1d\x6e\xfa\x0e\x00\x00\xff\xff\x9d\x60\x3d\x88\x79\x01\x00\x00")
 
 func docGoBytes() ([]byte, error) {
@@ -272,6 +1406,114 @@ var _bindata = map[string]func() (*asset, error){
 
 	"000002_add_last_ens_clock_value.up.sql": _000002_add_last_ens_clock_valueUpSql,
 
+	"000003_add_emoji_reactions.down.sql": _000003_add_emoji_reactionsDownSql,
+
+	"000003_add_emoji_reactions.up.sql": _000003_add_emoji_reactionsUpSql,
+
+	"000004_add_message_edits.down.sql": _000004_add_message_editsDownSql,
+
+	"000004_add_message_edits.up.sql": _000004_add_message_editsUpSql,
+
+	"000005_add_message_deletes.down.sql": _000005_add_message_deletesDownSql,
+
+	"000005_add_message_deletes.up.sql": _000005_add_message_deletesUpSql,
+
+	"000006_add_images.down.sql": _000006_add_imagesDownSql,
+
+	"000006_add_images.up.sql": _000006_add_imagesUpSql,
+
+	"000007_add_audio.down.sql": _000007_add_audioDownSql,
+
+	"000007_add_audio.up.sql": _000007_add_audioUpSql,
+
+	"000008_add_pin_messages.down.sql": _000008_add_pin_messagesDownSql,
+
+	"000008_add_pin_messages.up.sql": _000008_add_pin_messagesUpSql,
+
+	"000009_add_user_messages_fts.down.sql": _000009_add_user_messages_ftsDownSql,
+
+	"000009_add_user_messages_fts.up.sql": _000009_add_user_messages_ftsUpSql,
+
+	"000010_add_mentions.down.sql": _000010_add_mentionsDownSql,
+
+	"000010_add_mentions.up.sql": _000010_add_mentionsUpSql,
+
+	"000011_add_link_previews.down.sql": _000011_add_link_previewsDownSql,
+
+	"000011_add_link_previews.up.sql": _000011_add_link_previewsUpSql,
+
+	"000012_add_chat_notification_settings.down.sql": _000012_add_chat_notification_settingsDownSql,
+
+	"000012_add_chat_notification_settings.up.sql": _000012_add_chat_notification_settingsUpSql,
+
+	"000013_add_forwarded_from.down.sql": _000013_add_forwarded_fromDownSql,
+
+	"000013_add_forwarded_from.up.sql": _000013_add_forwarded_fromUpSql,
+
+	"000014_add_scheduled_messages.down.sql": _000014_add_scheduled_messagesDownSql,
+
+	"000014_add_scheduled_messages.up.sql": _000014_add_scheduled_messagesUpSql,
+
+	"000015_add_disappearing_messages.down.sql": _000015_add_disappearing_messagesDownSql,
+
+	"000015_add_disappearing_messages.up.sql": _000015_add_disappearing_messagesUpSql,
+
+	"000016_add_contact_requests.down.sql": _000016_add_contact_requestsDownSql,
+
+	"000016_add_contact_requests.up.sql": _000016_add_contact_requestsUpSql,
+
+	"000017_add_contact_local_nickname.down.sql": _000017_add_contact_local_nicknameDownSql,
+
+	"000017_add_contact_local_nickname.up.sql": _000017_add_contact_local_nicknameUpSql,
+
+	"000018_add_chat_description_image.down.sql": _000018_add_chat_description_imageDownSql,
+
+	"000018_add_chat_description_image.up.sql": _000018_add_chat_description_imageUpSql,
+
+	"000019_add_group_chat_invitations.down.sql": _000019_add_group_chat_invitationsDownSql,
+
+	"000019_add_group_chat_invitations.up.sql": _000019_add_group_chat_invitationsUpSql,
+
+	"000020_add_communities.down.sql": _000020_add_communitiesDownSql,
+
+	"000020_add_communities.up.sql": _000020_add_communitiesUpSql,
+
+	"000021_add_polls.down.sql": _000021_add_pollsDownSql,
+
+	"000021_add_polls.up.sql": _000021_add_pollsUpSql,
+
+	"000022_add_contact_profile.down.sql": _000022_add_contact_profileDownSql,
+
+	"000022_add_contact_profile.up.sql": _000022_add_contact_profileUpSql,
+
+	"000023_add_pending_chat_notification_settings.down.sql": _000023_add_pending_chat_notification_settingsDownSql,
+
+	"000023_add_pending_chat_notification_settings.up.sql": _000023_add_pending_chat_notification_settingsUpSql,
+
+	"000024_add_scheduled_messages_attempts.down.sql": _000024_add_scheduled_messages_attemptsDownSql,
+
+	"000024_add_scheduled_messages_attempts.up.sql": _000024_add_scheduled_messages_attemptsUpSql,
+
+	"000025_add_edits_and_deletes_local_chat_id.down.sql": _000025_add_edits_and_deletes_local_chat_idDownSql,
+
+	"000025_add_edits_and_deletes_local_chat_id.up.sql": _000025_add_edits_and_deletes_local_chat_idUpSql,
+
+	"000026_add_message_chunks_received_at.down.sql": _000026_add_message_chunks_received_atDownSql,
+
+	"000026_add_message_chunks_received_at.up.sql": _000026_add_message_chunks_received_atUpSql,
+
+	"000027_add_pin_messages_local_chat_id_key.down.sql": _000027_add_pin_messages_local_chat_id_keyDownSql,
+
+	"000027_add_pin_messages_local_chat_id_key.up.sql": _000027_add_pin_messages_local_chat_id_keyUpSql,
+
+	"000028_add_forwarded_from_verified.down.sql": _000028_add_forwarded_from_verifiedDownSql,
+
+	"000028_add_forwarded_from_verified.up.sql": _000028_add_forwarded_from_verifiedUpSql,
+
+	"000029_add_user_messages_awaiting_post.down.sql": _000029_add_user_messages_awaiting_postDownSql,
+
+	"000029_add_user_messages_awaiting_post.up.sql": _000029_add_user_messages_awaiting_postUpSql,
+
 	"doc.go": docGo,
 }
 
@@ -316,11 +1558,65 @@ type bintree struct {
 }
 
 var _bintree = &bintree{nil, map[string]*bintree{
-	"000001_init.down.db.sql":                  &bintree{_000001_initDownDbSql, map[string]*bintree{}},
-	"000001_init.up.db.sql":                    &bintree{_000001_initUpDbSql, map[string]*bintree{}},
-	"000002_add_last_ens_clock_value.down.sql": &bintree{_000002_add_last_ens_clock_valueDownSql, map[string]*bintree{}},
-	"000002_add_last_ens_clock_value.up.sql":   &bintree{_000002_add_last_ens_clock_valueUpSql, map[string]*bintree{}},
-	"doc.go":                                   &bintree{docGo, map[string]*bintree{}},
+	"000001_init.down.db.sql":                                &bintree{_000001_initDownDbSql, map[string]*bintree{}},
+	"000001_init.up.db.sql":                                  &bintree{_000001_initUpDbSql, map[string]*bintree{}},
+	"000002_add_last_ens_clock_value.down.sql":               &bintree{_000002_add_last_ens_clock_valueDownSql, map[string]*bintree{}},
+	"000002_add_last_ens_clock_value.up.sql":                 &bintree{_000002_add_last_ens_clock_valueUpSql, map[string]*bintree{}},
+	"000003_add_emoji_reactions.down.sql":                    &bintree{_000003_add_emoji_reactionsDownSql, map[string]*bintree{}},
+	"000003_add_emoji_reactions.up.sql":                      &bintree{_000003_add_emoji_reactionsUpSql, map[string]*bintree{}},
+	"000004_add_message_edits.down.sql":                      &bintree{_000004_add_message_editsDownSql, map[string]*bintree{}},
+	"000004_add_message_edits.up.sql":                        &bintree{_000004_add_message_editsUpSql, map[string]*bintree{}},
+	"000005_add_message_deletes.down.sql":                    &bintree{_000005_add_message_deletesDownSql, map[string]*bintree{}},
+	"000005_add_message_deletes.up.sql":                      &bintree{_000005_add_message_deletesUpSql, map[string]*bintree{}},
+	"000006_add_images.down.sql":                             &bintree{_000006_add_imagesDownSql, map[string]*bintree{}},
+	"000006_add_images.up.sql":                               &bintree{_000006_add_imagesUpSql, map[string]*bintree{}},
+	"000007_add_audio.down.sql":                              &bintree{_000007_add_audioDownSql, map[string]*bintree{}},
+	"000007_add_audio.up.sql":                                &bintree{_000007_add_audioUpSql, map[string]*bintree{}},
+	"000008_add_pin_messages.down.sql":                       &bintree{_000008_add_pin_messagesDownSql, map[string]*bintree{}},
+	"000008_add_pin_messages.up.sql":                         &bintree{_000008_add_pin_messagesUpSql, map[string]*bintree{}},
+	"000009_add_user_messages_fts.down.sql":                  &bintree{_000009_add_user_messages_ftsDownSql, map[string]*bintree{}},
+	"000009_add_user_messages_fts.up.sql":                    &bintree{_000009_add_user_messages_ftsUpSql, map[string]*bintree{}},
+	"000010_add_mentions.down.sql":                           &bintree{_000010_add_mentionsDownSql, map[string]*bintree{}},
+	"000010_add_mentions.up.sql":                             &bintree{_000010_add_mentionsUpSql, map[string]*bintree{}},
+	"000011_add_link_previews.down.sql":                      &bintree{_000011_add_link_previewsDownSql, map[string]*bintree{}},
+	"000011_add_link_previews.up.sql":                        &bintree{_000011_add_link_previewsUpSql, map[string]*bintree{}},
+	"000012_add_chat_notification_settings.down.sql":         &bintree{_000012_add_chat_notification_settingsDownSql, map[string]*bintree{}},
+	"000012_add_chat_notification_settings.up.sql":           &bintree{_000012_add_chat_notification_settingsUpSql, map[string]*bintree{}},
+	"000013_add_forwarded_from.down.sql":                     &bintree{_000013_add_forwarded_fromDownSql, map[string]*bintree{}},
+	"000013_add_forwarded_from.up.sql":                       &bintree{_000013_add_forwarded_fromUpSql, map[string]*bintree{}},
+	"000014_add_scheduled_messages.down.sql":                 &bintree{_000014_add_scheduled_messagesDownSql, map[string]*bintree{}},
+	"000014_add_scheduled_messages.up.sql":                   &bintree{_000014_add_scheduled_messagesUpSql, map[string]*bintree{}},
+	"000015_add_disappearing_messages.down.sql":              &bintree{_000015_add_disappearing_messagesDownSql, map[string]*bintree{}},
+	"000015_add_disappearing_messages.up.sql":                &bintree{_000015_add_disappearing_messagesUpSql, map[string]*bintree{}},
+	"000016_add_contact_requests.down.sql":                   &bintree{_000016_add_contact_requestsDownSql, map[string]*bintree{}},
+	"000016_add_contact_requests.up.sql":                     &bintree{_000016_add_contact_requestsUpSql, map[string]*bintree{}},
+	"000017_add_contact_local_nickname.down.sql":             &bintree{_000017_add_contact_local_nicknameDownSql, map[string]*bintree{}},
+	"000017_add_contact_local_nickname.up.sql":               &bintree{_000017_add_contact_local_nicknameUpSql, map[string]*bintree{}},
+	"000018_add_chat_description_image.down.sql":             &bintree{_000018_add_chat_description_imageDownSql, map[string]*bintree{}},
+	"000018_add_chat_description_image.up.sql":               &bintree{_000018_add_chat_description_imageUpSql, map[string]*bintree{}},
+	"000019_add_group_chat_invitations.down.sql":             &bintree{_000019_add_group_chat_invitationsDownSql, map[string]*bintree{}},
+	"000019_add_group_chat_invitations.up.sql":               &bintree{_000019_add_group_chat_invitationsUpSql, map[string]*bintree{}},
+	"000020_add_communities.down.sql":                        &bintree{_000020_add_communitiesDownSql, map[string]*bintree{}},
+	"000020_add_communities.up.sql":                          &bintree{_000020_add_communitiesUpSql, map[string]*bintree{}},
+	"000021_add_polls.down.sql":                              &bintree{_000021_add_pollsDownSql, map[string]*bintree{}},
+	"000021_add_polls.up.sql":                                &bintree{_000021_add_pollsUpSql, map[string]*bintree{}},
+	"000022_add_contact_profile.down.sql":                    &bintree{_000022_add_contact_profileDownSql, map[string]*bintree{}},
+	"000022_add_contact_profile.up.sql":                      &bintree{_000022_add_contact_profileUpSql, map[string]*bintree{}},
+	"000023_add_pending_chat_notification_settings.down.sql": &bintree{_000023_add_pending_chat_notification_settingsDownSql, map[string]*bintree{}},
+	"000023_add_pending_chat_notification_settings.up.sql":   &bintree{_000023_add_pending_chat_notification_settingsUpSql, map[string]*bintree{}},
+	"000024_add_scheduled_messages_attempts.down.sql":        &bintree{_000024_add_scheduled_messages_attemptsDownSql, map[string]*bintree{}},
+	"000024_add_scheduled_messages_attempts.up.sql":          &bintree{_000024_add_scheduled_messages_attemptsUpSql, map[string]*bintree{}},
+	"000025_add_edits_and_deletes_local_chat_id.down.sql":    &bintree{_000025_add_edits_and_deletes_local_chat_idDownSql, map[string]*bintree{}},
+	"000025_add_edits_and_deletes_local_chat_id.up.sql":      &bintree{_000025_add_edits_and_deletes_local_chat_idUpSql, map[string]*bintree{}},
+	"000026_add_message_chunks_received_at.down.sql":         &bintree{_000026_add_message_chunks_received_atDownSql, map[string]*bintree{}},
+	"000026_add_message_chunks_received_at.up.sql":           &bintree{_000026_add_message_chunks_received_atUpSql, map[string]*bintree{}},
+	"000027_add_pin_messages_local_chat_id_key.down.sql":     &bintree{_000027_add_pin_messages_local_chat_id_keyDownSql, map[string]*bintree{}},
+	"000027_add_pin_messages_local_chat_id_key.up.sql":       &bintree{_000027_add_pin_messages_local_chat_id_keyUpSql, map[string]*bintree{}},
+	"000028_add_forwarded_from_verified.down.sql":            &bintree{_000028_add_forwarded_from_verifiedDownSql, map[string]*bintree{}},
+	"000028_add_forwarded_from_verified.up.sql":              &bintree{_000028_add_forwarded_from_verifiedUpSql, map[string]*bintree{}},
+	"000029_add_user_messages_awaiting_post.down.sql":        &bintree{_000029_add_user_messages_awaiting_postDownSql, map[string]*bintree{}},
+	"000029_add_user_messages_awaiting_post.up.sql":          &bintree{_000029_add_user_messages_awaiting_postUpSql, map[string]*bintree{}},
+	"doc.go": &bintree{docGo, map[string]*bintree{}},
 }}
 
 // RestoreAsset restores an asset under the given directory.
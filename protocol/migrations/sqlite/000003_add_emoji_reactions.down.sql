@@ -0,0 +1 @@
+DROP TABLE emoji_reactions;
@@ -0,0 +1,13 @@
+CREATE TABLE IF NOT EXISTS emoji_reactions (
+  id VARCHAR PRIMARY KEY ON CONFLICT REPLACE,
+  clock_value INT NOT NULL,
+  source TEXT NOT NULL,
+  emoji_id INT NOT NULL,
+  message_id VARCHAR NOT NULL,
+  chat_id VARCHAR NOT NULL,
+  local_chat_id VARCHAR NOT NULL,
+  retracted INT DEFAULT 0
+);
+
+CREATE UNIQUE INDEX idx_emoji_reactions_message_id_source_emoji_id ON emoji_reactions(message_id, source, emoji_id);
+CREATE INDEX idx_emoji_reactions_local_chat_id ON emoji_reactions(local_chat_id);
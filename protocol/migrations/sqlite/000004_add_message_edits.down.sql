@@ -0,0 +1 @@
+DROP TABLE user_messages_edits;
@@ -0,0 +1,11 @@
+ALTER TABLE user_messages ADD COLUMN edited_at INT NOT NULL DEFAULT 0;
+
+CREATE TABLE IF NOT EXISTS user_messages_edits (
+  id VARCHAR PRIMARY KEY ON CONFLICT REPLACE,
+  clock_value INT NOT NULL,
+  source TEXT NOT NULL,
+  text VARCHAR NOT NULL,
+  message_id VARCHAR NOT NULL
+);
+
+CREATE INDEX idx_user_messages_edits_message_id_source ON user_messages_edits(message_id, source);
@@ -0,0 +1,11 @@
+ALTER TABLE user_messages ADD COLUMN deleted BOOLEAN NOT NULL DEFAULT FALSE;
+
+CREATE TABLE IF NOT EXISTS user_messages_deletes (
+  id VARCHAR PRIMARY KEY ON CONFLICT REPLACE,
+  clock_value INT NOT NULL,
+  source TEXT NOT NULL,
+  message_id VARCHAR NOT NULL
+);
+
+CREATE INDEX idx_user_messages_deletes_message_id_source ON user_messages_deletes(message_id, source);
+CREATE INDEX idx_user_messages_response_to ON user_messages(response_to);
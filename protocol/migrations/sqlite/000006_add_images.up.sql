@@ -0,0 +1,14 @@
+ALTER TABLE user_messages ADD COLUMN image_payload BLOB;
+ALTER TABLE user_messages ADD COLUMN image_format INT NOT NULL DEFAULT 0;
+ALTER TABLE user_messages ADD COLUMN image_width INT NOT NULL DEFAULT 0;
+ALTER TABLE user_messages ADD COLUMN image_height INT NOT NULL DEFAULT 0;
+
+CREATE TABLE IF NOT EXISTS message_chunks (
+  id VARCHAR NOT NULL,
+  source TEXT NOT NULL,
+  chunk_index INT NOT NULL,
+  total INT NOT NULL,
+  message_type INT NOT NULL,
+  payload BLOB NOT NULL,
+  PRIMARY KEY (id, source, chunk_index) ON CONFLICT REPLACE
+);
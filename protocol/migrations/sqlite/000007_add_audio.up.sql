@@ -0,0 +1,3 @@
+ALTER TABLE user_messages ADD COLUMN audio_payload BLOB;
+ALTER TABLE user_messages ADD COLUMN audio_type INT NOT NULL DEFAULT 0;
+ALTER TABLE user_messages ADD COLUMN audio_duration_ms INT NOT NULL DEFAULT 0;
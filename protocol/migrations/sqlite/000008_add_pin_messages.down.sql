@@ -0,0 +1 @@
+DROP TABLE pin_messages;
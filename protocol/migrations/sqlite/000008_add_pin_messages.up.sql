@@ -0,0 +1,11 @@
+CREATE TABLE IF NOT EXISTS pin_messages (
+  message_id VARCHAR PRIMARY KEY ON CONFLICT REPLACE,
+  id VARCHAR NOT NULL,
+  clock_value INT NOT NULL,
+  source TEXT NOT NULL,
+  chat_id VARCHAR NOT NULL,
+  local_chat_id VARCHAR NOT NULL,
+  pinned INT NOT NULL DEFAULT 0
+);
+
+CREATE INDEX idx_pin_messages_local_chat_id ON pin_messages(local_chat_id);
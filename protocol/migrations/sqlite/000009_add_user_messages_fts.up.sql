@@ -0,0 +1,22 @@
+CREATE VIRTUAL TABLE user_messages_fts USING fts4(text, tokenize=unicode61);
+
+INSERT INTO user_messages_fts(docid, text) SELECT rowid, text FROM user_messages;
+
+-- user_messages rows are replaced on conflict, which doesn't fire delete
+-- triggers, so the stale entry is removed before inserting
+CREATE TRIGGER user_messages_fts_before_insert BEFORE INSERT ON user_messages BEGIN
+  DELETE FROM user_messages_fts WHERE docid = (SELECT rowid FROM user_messages WHERE id = NEW.id);
+END;
+
+CREATE TRIGGER user_messages_fts_after_insert AFTER INSERT ON user_messages BEGIN
+  INSERT INTO user_messages_fts(docid, text) VALUES (NEW.rowid, NEW.text);
+END;
+
+CREATE TRIGGER user_messages_fts_after_update AFTER UPDATE OF text ON user_messages BEGIN
+  DELETE FROM user_messages_fts WHERE docid = OLD.rowid;
+  INSERT INTO user_messages_fts(docid, text) VALUES (NEW.rowid, NEW.text);
+END;
+
+CREATE TRIGGER user_messages_fts_after_delete AFTER DELETE ON user_messages BEGIN
+  DELETE FROM user_messages_fts WHERE docid = OLD.rowid;
+END;
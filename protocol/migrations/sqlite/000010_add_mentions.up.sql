@@ -0,0 +1,2 @@
+ALTER TABLE user_messages ADD COLUMN mentioned INT NOT NULL DEFAULT 0;
+ALTER TABLE chats ADD COLUMN unviewed_mentions_count INT NOT NULL DEFAULT 0;
@@ -0,0 +1,9 @@
+ALTER TABLE user_messages ADD COLUMN links BLOB;
+
+CREATE TABLE IF NOT EXISTS link_previews (
+  url VARCHAR PRIMARY KEY ON CONFLICT REPLACE,
+  title VARCHAR NOT NULL,
+  description VARCHAR NOT NULL,
+  thumbnail_payload BLOB,
+  fetched_at INT NOT NULL
+);
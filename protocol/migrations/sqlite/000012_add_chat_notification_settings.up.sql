@@ -0,0 +1,4 @@
+ALTER TABLE chats ADD COLUMN muted INT NOT NULL DEFAULT 0;
+ALTER TABLE chats ADD COLUMN muted_until INT NOT NULL DEFAULT 0;
+ALTER TABLE chats ADD COLUMN notification_level INT NOT NULL DEFAULT 0;
+ALTER TABLE chats ADD COLUMN notification_settings_clock INT NOT NULL DEFAULT 0;
@@ -0,0 +1,8 @@
+CREATE TABLE IF NOT EXISTS scheduled_messages (
+  id VARCHAR PRIMARY KEY ON CONFLICT REPLACE,
+  local_chat_id VARCHAR NOT NULL,
+  send_at INT NOT NULL,
+  message BLOB NOT NULL
+);
+
+CREATE INDEX idx_scheduled_messages_send_at ON scheduled_messages(send_at);
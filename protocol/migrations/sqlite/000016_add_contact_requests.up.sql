@@ -0,0 +1,4 @@
+ALTER TABLE contacts ADD COLUMN contact_request_state INT NOT NULL DEFAULT 0;
+ALTER TABLE contacts ADD COLUMN contact_request_clock INT NOT NULL DEFAULT 0;
+ALTER TABLE contacts ADD COLUMN contact_request_text TEXT NOT NULL DEFAULT '';
+ALTER TABLE user_messages ADD COLUMN hidden_from_non_contact BOOLEAN NOT NULL DEFAULT FALSE;
@@ -0,0 +1,2 @@
+ALTER TABLE contacts ADD COLUMN local_nickname TEXT NOT NULL DEFAULT '';
+ALTER TABLE contacts ADD COLUMN local_nickname_clock INT NOT NULL DEFAULT 0;
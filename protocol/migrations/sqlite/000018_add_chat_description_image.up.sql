@@ -0,0 +1,2 @@
+ALTER TABLE chats ADD COLUMN description TEXT NOT NULL DEFAULT '';
+ALTER TABLE chats ADD COLUMN image BLOB;
@@ -0,0 +1,2 @@
+DROP TABLE group_chat_invitations;
+DROP TABLE group_chat_join_requests;
@@ -0,0 +1,15 @@
+CREATE TABLE IF NOT EXISTS group_chat_invitations (
+  id VARCHAR PRIMARY KEY NOT NULL,
+  chat_id VARCHAR NOT NULL,
+  expires_at INT NOT NULL,
+  revoked BOOLEAN NOT NULL DEFAULT FALSE
+);
+
+CREATE TABLE IF NOT EXISTS group_chat_join_requests (
+  chat_id VARCHAR NOT NULL,
+  requester VARCHAR NOT NULL,
+  invitation_id VARCHAR NOT NULL,
+  clock_value INT NOT NULL,
+  state INT NOT NULL,
+  PRIMARY KEY (chat_id, requester) ON CONFLICT REPLACE
+);
@@ -0,0 +1,3 @@
+DROP TABLE communities;
+DROP TABLE community_keys;
+DROP TABLE community_requests_to_join;
@@ -0,0 +1,22 @@
+CREATE TABLE IF NOT EXISTS communities (
+  id VARCHAR PRIMARY KEY NOT NULL,
+  description BLOB NOT NULL,
+  joined BOOLEAN NOT NULL DEFAULT FALSE
+);
+
+CREATE TABLE IF NOT EXISTS community_keys (
+  community_id VARCHAR NOT NULL,
+  key_id INT NOT NULL,
+  key BLOB NOT NULL,
+  PRIMARY KEY (community_id, key_id) ON CONFLICT REPLACE
+);
+
+CREATE TABLE IF NOT EXISTS community_requests_to_join (
+  community_id VARCHAR NOT NULL,
+  requester VARCHAR NOT NULL,
+  clock_value INT NOT NULL,
+  state INT NOT NULL,
+  PRIMARY KEY (community_id, requester) ON CONFLICT REPLACE
+);
+
+ALTER TABLE chats ADD COLUMN community_id VARCHAR NOT NULL DEFAULT '';
@@ -0,0 +1,10 @@
+ALTER TABLE user_messages ADD COLUMN poll BLOB;
+
+CREATE TABLE IF NOT EXISTS poll_votes (
+  message_id VARCHAR NOT NULL,
+  voter VARCHAR NOT NULL,
+  clock_value INT NOT NULL,
+  options BLOB NOT NULL,
+  timestamp INT NOT NULL,
+  PRIMARY KEY (message_id, voter) ON CONFLICT REPLACE
+);
@@ -0,0 +1,4 @@
+ALTER TABLE contacts ADD COLUMN display_name TEXT NOT NULL DEFAULT '';
+ALTER TABLE contacts ADD COLUMN bio TEXT NOT NULL DEFAULT '';
+ALTER TABLE contacts ADD COLUMN images BLOB;
+ALTER TABLE contacts ADD COLUMN profile_link TEXT NOT NULL DEFAULT '';
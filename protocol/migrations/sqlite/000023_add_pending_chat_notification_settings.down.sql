@@ -0,0 +1 @@
+DROP TABLE pending_chat_notification_settings;
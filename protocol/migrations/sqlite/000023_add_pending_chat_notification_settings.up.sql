@@ -0,0 +1,7 @@
+CREATE TABLE IF NOT EXISTS pending_chat_notification_settings (
+  chat_id VARCHAR PRIMARY KEY ON CONFLICT REPLACE,
+  clock_value INT NOT NULL,
+  muted BOOLEAN NOT NULL DEFAULT FALSE,
+  muted_until INT NOT NULL DEFAULT 0,
+  notification_level INT NOT NULL DEFAULT 0
+);
@@ -0,0 +1,3 @@
+ALTER TABLE scheduled_messages ADD COLUMN attempts INT NOT NULL DEFAULT 0;
+ALTER TABLE scheduled_messages ADD COLUMN retry_at INT NOT NULL DEFAULT 0;
+ALTER TABLE scheduled_messages ADD COLUMN failed BOOLEAN NOT NULL DEFAULT FALSE;
@@ -0,0 +1,2 @@
+ALTER TABLE user_messages_edits ADD COLUMN local_chat_id VARCHAR NOT NULL DEFAULT '';
+ALTER TABLE user_messages_deletes ADD COLUMN local_chat_id VARCHAR NOT NULL DEFAULT '';
@@ -0,0 +1 @@
+ALTER TABLE message_chunks ADD COLUMN received_at INT NOT NULL DEFAULT 0;
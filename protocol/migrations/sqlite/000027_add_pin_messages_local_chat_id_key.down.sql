@@ -0,0 +1,17 @@
+CREATE TABLE IF NOT EXISTS pin_messages_by_message (
+  message_id VARCHAR PRIMARY KEY ON CONFLICT REPLACE,
+  id VARCHAR NOT NULL,
+  clock_value INT NOT NULL,
+  source TEXT NOT NULL,
+  chat_id VARCHAR NOT NULL,
+  local_chat_id VARCHAR NOT NULL,
+  pinned INT NOT NULL DEFAULT 0
+);
+
+INSERT INTO pin_messages_by_message SELECT message_id, id, clock_value, source, chat_id, local_chat_id, pinned FROM pin_messages ORDER BY clock_value ASC;
+
+DROP TABLE pin_messages;
+
+ALTER TABLE pin_messages_by_message RENAME TO pin_messages;
+
+CREATE INDEX idx_pin_messages_local_chat_id ON pin_messages(local_chat_id);
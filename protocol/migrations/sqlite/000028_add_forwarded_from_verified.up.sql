@@ -0,0 +1 @@
+ALTER TABLE user_messages ADD COLUMN forwarded_from_verified BOOLEAN NOT NULL DEFAULT FALSE;
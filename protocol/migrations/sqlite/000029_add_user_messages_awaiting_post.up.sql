@@ -0,0 +1 @@
+ALTER TABLE user_messages ADD COLUMN awaiting_post BOOLEAN NOT NULL DEFAULT FALSE;
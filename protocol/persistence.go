@@ -5,10 +5,13 @@ import (
 	"context"
 	"database/sql"
 	"encoding/gob"
+	"encoding/json"
 
+	"github.com/golang/protobuf/proto"
 	"github.com/pkg/errors"
 
 	"github.com/status-im/status-go/eth-node/crypto"
+	"github.com/status-im/status-go/protocol/protobuf"
 )
 
 var (
@@ -103,8 +106,8 @@ func (db sqlitePersistence) saveChat(tx *sql.Tx, chat Chat) error {
 	}
 
 	// Insert record
-	stmt, err := tx.Prepare(`INSERT INTO chats(id, name, color, active, type, timestamp,  deleted_at_clock_value, unviewed_message_count, last_clock_value, last_message, members, membership_updates)
-	    VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`)
+	stmt, err := tx.Prepare(`INSERT INTO chats(id, name, color, active, type, timestamp,  deleted_at_clock_value, unviewed_message_count, unviewed_mentions_count, last_clock_value, last_message, members, membership_updates, muted, muted_until, notification_level, notification_settings_clock, disappearing_messages_timer, disappearing_messages_clock, description, image, community_id)
+	    VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`)
 	if err != nil {
 		return err
 	}
@@ -119,10 +122,20 @@ func (db sqlitePersistence) saveChat(tx *sql.Tx, chat Chat) error {
 		chat.Timestamp,
 		chat.DeletedAtClockValue,
 		chat.UnviewedMessagesCount,
+		chat.UnviewedMentionsCount,
 		chat.LastClockValue,
 		chat.LastMessage,
 		encodedMembers.Bytes(),
 		encodedMembershipUpdates.Bytes(),
+		chat.Muted,
+		chat.MutedUntil,
+		chat.NotificationLevel,
+		chat.NotificationSettingsClock,
+		chat.DisappearingMessagesTimer,
+		chat.DisappearingMessagesClock,
+		chat.Description,
+		chat.Image,
+		chat.CommunityID,
 	)
 	if err != nil {
 		return err
@@ -166,10 +179,20 @@ func (db sqlitePersistence) chats(tx *sql.Tx) (chats []*Chat, err error) {
 			timestamp,
 			deleted_at_clock_value,
 			unviewed_message_count,
+			unviewed_mentions_count,
 			last_clock_value,
 			last_message,
 			members,
-			membership_updates
+			membership_updates,
+			muted,
+			muted_until,
+			notification_level,
+			notification_settings_clock,
+			disappearing_messages_timer,
+			disappearing_messages_clock,
+			description,
+			image,
+			community_id
 		FROM chats
 		ORDER BY chats.timestamp DESC
 	`)
@@ -193,10 +216,20 @@ func (db sqlitePersistence) chats(tx *sql.Tx) (chats []*Chat, err error) {
 			&chat.Timestamp,
 			&chat.DeletedAtClockValue,
 			&chat.UnviewedMessagesCount,
+			&chat.UnviewedMentionsCount,
 			&chat.LastClockValue,
 			&chat.LastMessage,
 			&encodedMembers,
 			&encodedMembershipUpdates,
+			&chat.Muted,
+			&chat.MutedUntil,
+			&chat.NotificationLevel,
+			&chat.NotificationSettingsClock,
+			&chat.DisappearingMessagesTimer,
+			&chat.DisappearingMessagesClock,
+			&chat.Description,
+			&chat.Image,
+			&chat.CommunityID,
 		)
 		if err != nil {
 			return
@@ -239,10 +272,20 @@ func (db sqlitePersistence) Chat(chatID string) (*Chat, error) {
 			timestamp,
 			deleted_at_clock_value,
 			unviewed_message_count,
+			unviewed_mentions_count,
 			last_clock_value,
 			last_message,
 			members,
-			membership_updates
+			membership_updates,
+			muted,
+			muted_until,
+			notification_level,
+			notification_settings_clock,
+			disappearing_messages_timer,
+			disappearing_messages_clock,
+			description,
+			image,
+			community_id
 		FROM chats
 		WHERE id = ?
 	`, chatID).Scan(&chat.ID,
@@ -253,10 +296,20 @@ func (db sqlitePersistence) Chat(chatID string) (*Chat, error) {
 		&chat.Timestamp,
 		&chat.DeletedAtClockValue,
 		&chat.UnviewedMessagesCount,
+		&chat.UnviewedMentionsCount,
 		&chat.LastClockValue,
 		&chat.LastMessage,
 		&encodedMembers,
 		&encodedMembershipUpdates,
+		&chat.Muted,
+		&chat.MutedUntil,
+		&chat.NotificationLevel,
+		&chat.NotificationSettingsClock,
+		&chat.DisappearingMessagesTimer,
+		&chat.DisappearingMessagesClock,
+		&chat.Description,
+		&chat.Image,
+		&chat.CommunityID,
 	)
 	switch err {
 	case sql.ErrNoRows:
@@ -297,7 +350,16 @@ func (db sqlitePersistence) Contacts() ([]*Contact, error) {
 			device_info,
 			ens_verified,
 			ens_verified_at,
-			tribute_to_talk
+			tribute_to_talk,
+			contact_request_state,
+			contact_request_clock,
+			contact_request_text,
+			local_nickname,
+			local_nickname_clock,
+			display_name,
+			bio,
+			images,
+			profile_link
 		FROM contacts
 	`)
 	if err != nil {
@@ -312,6 +374,7 @@ func (db sqlitePersistence) Contacts() ([]*Contact, error) {
 			contact           Contact
 			encodedDeviceInfo []byte
 			encodedSystemTags []byte
+			encodedImages     []byte
 		)
 		err := rows.Scan(
 			&contact.ID,
@@ -326,6 +389,15 @@ func (db sqlitePersistence) Contacts() ([]*Contact, error) {
 			&contact.ENSVerified,
 			&contact.ENSVerifiedAt,
 			&contact.TributeToTalk,
+			&contact.ContactRequestState,
+			&contact.ContactRequestClock,
+			&contact.ContactRequestText,
+			&contact.LocalNickname,
+			&contact.LocalNicknameClock,
+			&contact.DisplayName,
+			&contact.Bio,
+			&encodedImages,
+			&contact.ProfileLink,
 		)
 		if err != nil {
 			return nil, err
@@ -347,6 +419,14 @@ func (db sqlitePersistence) Contacts() ([]*Contact, error) {
 			}
 		}
 
+		if encodedImages != nil {
+			// Restore images
+			imagesDecoder := gob.NewDecoder(bytes.NewBuffer(encodedImages))
+			if err := imagesDecoder.Decode(&contact.Images); err != nil {
+				return nil, err
+			}
+		}
+
 		response = append(response, &contact)
 	}
 
@@ -478,6 +558,14 @@ func (db sqlitePersistence) SaveContact(contact *Contact, tx *sql.Tx) (err error
 		return
 	}
 
+	// Encode images
+	var encodedImages bytes.Buffer
+	imagesEncoder := gob.NewEncoder(&encodedImages)
+	err = imagesEncoder.Encode(contact.Images)
+	if err != nil {
+		return
+	}
+
 	// Insert record
 	stmt, err := tx.Prepare(`
 		INSERT INTO contacts(
@@ -492,8 +580,17 @@ func (db sqlitePersistence) SaveContact(contact *Contact, tx *sql.Tx) (err error
 			device_info,
 			ens_verified,
 			ens_verified_at,
-			tribute_to_talk
-		) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
+			tribute_to_talk,
+			contact_request_state,
+			contact_request_clock,
+			contact_request_text,
+			local_nickname,
+			local_nickname_clock,
+			display_name,
+			bio,
+			images,
+			profile_link
+		) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
 	`)
 	if err != nil {
 		return
@@ -513,6 +610,15 @@ func (db sqlitePersistence) SaveContact(contact *Contact, tx *sql.Tx) (err error
 		contact.ENSVerified,
 		contact.ENSVerifiedAt,
 		contact.TributeToTalk,
+		contact.ContactRequestState,
+		contact.ContactRequestClock,
+		contact.ContactRequestText,
+		contact.LocalNickname,
+		contact.LocalNicknameClock,
+		contact.DisplayName,
+		contact.Bio,
+		encodedImages.Bytes(),
+		contact.ProfileLink,
 	)
 	return
 }
@@ -602,3 +708,506 @@ func (db sqlitePersistence) TransactionsToValidate() ([]*TransactionToValidate,
 
 	return transactions, nil
 }
+
+// SaveMessageChunk stores a chunk of a message sent by source, receivedAt
+// being the time in milliseconds it has been received at
+func (db sqlitePersistence) SaveMessageChunk(chunk *protobuf.MessageChunk, source string, receivedAt uint64) error {
+	_, err := db.db.Exec(`INSERT INTO message_chunks(id, source, chunk_index, total, message_type, payload, received_at) VALUES (?, ?, ?, ?, ?, ?, ?)`,
+		chunk.Id,
+		source,
+		chunk.Index,
+		chunk.Total,
+		chunk.Type,
+		chunk.Payload,
+		receivedAt,
+	)
+	return err
+}
+
+// MessageChunks returns the chunks received so far of a message sent by
+// source, sorted by index
+func (db sqlitePersistence) MessageChunks(id, source string) ([]*protobuf.MessageChunk, error) {
+	rows, err := db.db.Query(`
+		SELECT
+			id,
+			chunk_index,
+			total,
+			message_type,
+			payload
+		FROM
+			message_chunks
+		WHERE
+			id = ? AND source = ?
+		ORDER BY chunk_index ASC
+	`, id, source)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var chunks []*protobuf.MessageChunk
+	for rows.Next() {
+		chunk := &protobuf.MessageChunk{}
+		err = rows.Scan(
+			&chunk.Id,
+			&chunk.Index,
+			&chunk.Total,
+			&chunk.Type,
+			&chunk.Payload,
+		)
+		if err != nil {
+			return nil, err
+		}
+		chunks = append(chunks, chunk)
+	}
+
+	return chunks, nil
+}
+
+func (db sqlitePersistence) DeleteMessageChunks(id, source string) error {
+	_, err := db.db.Exec(`DELETE FROM message_chunks WHERE id = ? AND source = ?`, id, source)
+	return err
+}
+
+// DeleteMessageChunksReceivedBefore deletes the chunks received before the
+// given time in milliseconds, the messages they belong to have not been
+// fully received
+func (db sqlitePersistence) DeleteMessageChunksReceivedBefore(timestamp uint64) error {
+	_, err := db.db.Exec(`DELETE FROM message_chunks WHERE received_at < ?`, timestamp)
+	return err
+}
+
+// SaveLinkPreview caches the preview of a link, fetchedAt being the time in
+// milliseconds it has been fetched at
+func (db sqlitePersistence) SaveLinkPreview(link *protobuf.UnfurledLink, fetchedAt uint64) error {
+	_, err := db.db.Exec(`INSERT INTO link_previews(url, title, description, thumbnail_payload, fetched_at) VALUES (?, ?, ?, ?, ?)`,
+		link.Url,
+		link.Title,
+		link.Description,
+		link.ThumbnailPayload,
+		fetchedAt,
+	)
+	return err
+}
+
+// LinkPreview returns the cached preview of a link along with the time it
+// has been fetched at
+func (db sqlitePersistence) LinkPreview(url string) (*protobuf.UnfurledLink, uint64, error) {
+	var fetchedAt uint64
+	link := &protobuf.UnfurledLink{}
+	err := db.db.QueryRow(`SELECT url, title, description, thumbnail_payload, fetched_at FROM link_previews WHERE url = ?`, url).Scan(
+		&link.Url,
+		&link.Title,
+		&link.Description,
+		&link.ThumbnailPayload,
+		&fetchedAt,
+	)
+	switch err {
+	case sql.ErrNoRows:
+		return nil, 0, errRecordNotFound
+	case nil:
+		return link, fetchedAt, nil
+	default:
+		return nil, 0, err
+	}
+}
+
+// SaveScheduledMessage stores a message to be sent later, replacing the
+// scheduled message with the same id if any
+func (db sqlitePersistence) SaveScheduledMessage(scheduled *ScheduledMessage) error {
+	encodedMessage, err := proto.Marshal(&scheduled.Message.ChatMessage)
+	if err != nil {
+		return err
+	}
+	_, err = db.db.Exec(`INSERT INTO scheduled_messages(id, local_chat_id, send_at, message, attempts, retry_at, failed) VALUES (?, ?, ?, ?, ?, ?, ?)`,
+		scheduled.ID,
+		scheduled.ChatID,
+		scheduled.SendAt,
+		encodedMessage,
+		scheduled.Attempts,
+		scheduled.retryAt,
+		scheduled.Failed,
+	)
+	return err
+}
+
+// ScheduledMessageByID returns the scheduled message with the given id
+func (db sqlitePersistence) ScheduledMessageByID(id string) (*ScheduledMessage, error) {
+	scheduled, err := db.scheduledMessages(`SELECT id, local_chat_id, send_at, message, attempts, retry_at, failed FROM scheduled_messages WHERE id = ?`, id)
+	if err != nil {
+		return nil, err
+	}
+	if len(scheduled) == 0 {
+		return nil, errRecordNotFound
+	}
+	return scheduled[0], nil
+}
+
+// ScheduledMessages returns all the scheduled messages, including the ones
+// that failed to be sent, the ones to be sent first coming first
+func (db sqlitePersistence) ScheduledMessages() ([]*ScheduledMessage, error) {
+	return db.scheduledMessages(`SELECT id, local_chat_id, send_at, message, attempts, retry_at, failed FROM scheduled_messages ORDER BY send_at`)
+}
+
+// DueScheduledMessages returns the scheduled messages to be sent at or
+// before now, leaving out the failed ones and the ones to be retried later
+func (db sqlitePersistence) DueScheduledMessages(now uint64) ([]*ScheduledMessage, error) {
+	return db.scheduledMessages(`SELECT id, local_chat_id, send_at, message, attempts, retry_at, failed FROM scheduled_messages WHERE send_at <= ? AND retry_at <= ? AND NOT failed ORDER BY send_at`, now, now)
+}
+
+func (db sqlitePersistence) scheduledMessages(query string, args ...interface{}) ([]*ScheduledMessage, error) {
+	rows, err := db.db.Query(query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var result []*ScheduledMessage
+	for rows.Next() {
+		var encodedMessage []byte
+		scheduled := &ScheduledMessage{Message: &Message{}}
+		if err := rows.Scan(&scheduled.ID, &scheduled.ChatID, &scheduled.SendAt, &encodedMessage, &scheduled.Attempts, &scheduled.retryAt, &scheduled.Failed); err != nil {
+			return nil, err
+		}
+		if err := proto.Unmarshal(encodedMessage, &scheduled.Message.ChatMessage); err != nil {
+			return nil, err
+		}
+		result = append(result, scheduled)
+	}
+	return result, rows.Err()
+}
+
+func (db sqlitePersistence) DeleteScheduledMessage(id string) error {
+	_, err := db.db.Exec(`DELETE FROM scheduled_messages WHERE id = ?`, id)
+	return err
+}
+
+func (db sqlitePersistence) SaveGroupChatInvitation(invitation *GroupChatInvitation) error {
+	_, err := db.db.Exec(`INSERT INTO group_chat_invitations(id, chat_id, expires_at, revoked) VALUES (?, ?, ?, ?)`,
+		invitation.ID,
+		invitation.ChatID,
+		invitation.ExpiresAt,
+		invitation.Revoked,
+	)
+	return err
+}
+
+// RevokeGroupChatInvitation marks an invitation we created as revoked
+func (db sqlitePersistence) RevokeGroupChatInvitation(id string) error {
+	result, err := db.db.Exec(`UPDATE group_chat_invitations SET revoked = 1 WHERE id = ?`, id)
+	if err != nil {
+		return err
+	}
+	count, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if count == 0 {
+		return errRecordNotFound
+	}
+	return nil
+}
+
+// SaveRevokedGroupChatInvitation marks an invitation revoked by another admin
+// of the chat as revoked, storing it if it was created by them
+func (db sqlitePersistence) SaveRevokedGroupChatInvitation(id, chatID string) error {
+	err := db.RevokeGroupChatInvitation(id)
+	if err != errRecordNotFound {
+		return err
+	}
+	return db.SaveGroupChatInvitation(&GroupChatInvitation{
+		ID:      id,
+		ChatID:  chatID,
+		Revoked: true,
+	})
+}
+
+// GroupChatInvitation returns an invitation we created, or one revoked by
+// another admin of its chat
+func (db sqlitePersistence) GroupChatInvitation(id string) (*GroupChatInvitation, error) {
+	invitation := &GroupChatInvitation{}
+	err := db.db.QueryRow(`SELECT id, chat_id, expires_at, revoked FROM group_chat_invitations WHERE id = ?`, id).Scan(
+		&invitation.ID,
+		&invitation.ChatID,
+		&invitation.ExpiresAt,
+		&invitation.Revoked,
+	)
+	if err == sql.ErrNoRows {
+		return nil, errRecordNotFound
+	}
+	return invitation, err
+}
+
+// GroupChatInvitationRevoked returns whether an invitation to join a chat has
+// been revoked, by us or by another admin of the chat. Invitations created on
+// another device are not stored, and can't be revoked
+func (db sqlitePersistence) GroupChatInvitationRevoked(id, chatID string) (bool, error) {
+	var revoked bool
+	err := db.db.QueryRow(`SELECT revoked FROM group_chat_invitations WHERE id = ? AND chat_id = ?`, id, chatID).Scan(&revoked)
+	if err == sql.ErrNoRows {
+		return false, nil
+	}
+	return revoked, err
+}
+
+// SaveGroupChatJoinRequest stores a join request, replacing the previous one
+// of its author for the same chat
+func (db sqlitePersistence) SaveGroupChatJoinRequest(request *GroupChatJoinRequest) error {
+	_, err := db.db.Exec(`INSERT INTO group_chat_join_requests(chat_id, requester, invitation_id, clock_value, state) VALUES (?, ?, ?, ?, ?)`,
+		request.ChatID,
+		request.From,
+		request.InvitationID,
+		request.Clock,
+		request.State,
+	)
+	return err
+}
+
+func (db sqlitePersistence) GroupChatJoinRequest(chatID string, from string) (*GroupChatJoinRequest, error) {
+	requests, err := db.groupChatJoinRequests(`SELECT chat_id, requester, invitation_id, clock_value, state FROM group_chat_join_requests WHERE chat_id = ? AND requester = ?`, chatID, from)
+	if err != nil {
+		return nil, err
+	}
+	if len(requests) == 0 {
+		return nil, errRecordNotFound
+	}
+	return requests[0], nil
+}
+
+// GroupChatJoinRequests returns the join requests of a chat in the given
+// state, the most recent first
+func (db sqlitePersistence) GroupChatJoinRequests(chatID string, state GroupChatJoinRequestState) ([]*GroupChatJoinRequest, error) {
+	return db.groupChatJoinRequests(`SELECT chat_id, requester, invitation_id, clock_value, state FROM group_chat_join_requests WHERE chat_id = ? AND state = ? ORDER BY clock_value DESC`, chatID, state)
+}
+
+func (db sqlitePersistence) groupChatJoinRequests(query string, args ...interface{}) ([]*GroupChatJoinRequest, error) {
+	rows, err := db.db.Query(query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var result []*GroupChatJoinRequest
+	for rows.Next() {
+		request := &GroupChatJoinRequest{}
+		if err := rows.Scan(&request.ChatID, &request.From, &request.InvitationID, &request.Clock, &request.State); err != nil {
+			return nil, err
+		}
+		result = append(result, request)
+	}
+	return result, rows.Err()
+}
+
+// SaveCommunity stores the signed description of a community, replacing the
+// previous one
+func (db sqlitePersistence) SaveCommunity(community *Community) error {
+	_, err := db.db.Exec(`INSERT OR REPLACE INTO communities(id, description, joined) VALUES (?, ?, ?)`,
+		community.Id,
+		community.SignedDescription,
+		community.Joined,
+	)
+	return err
+}
+
+func (db sqlitePersistence) Community(id string) (*Community, error) {
+	var (
+		signedDescription []byte
+		joined            bool
+	)
+	err := db.db.QueryRow(`SELECT description, joined FROM communities WHERE id = ?`, id).Scan(&signedDescription, &joined)
+	if err == sql.ErrNoRows {
+		return nil, errRecordNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	return decodeCommunity(signedDescription, joined)
+}
+
+func (db sqlitePersistence) Communities() ([]*Community, error) {
+	rows, err := db.db.Query(`SELECT description, joined FROM communities`)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var result []*Community
+	for rows.Next() {
+		var (
+			signedDescription []byte
+			joined            bool
+		)
+		if err := rows.Scan(&signedDescription, &joined); err != nil {
+			return nil, err
+		}
+		community, err := decodeCommunity(signedDescription, joined)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, community)
+	}
+	return result, rows.Err()
+}
+
+// SaveCommunityKey stores a key of a community. Past keys are kept while we
+// are a member, so that the messages encrypted with them can still be read
+func (db sqlitePersistence) SaveCommunityKey(communityID string, keyID uint32, key []byte) error {
+	_, err := db.db.Exec(`INSERT INTO community_keys(community_id, key_id, key) VALUES (?, ?, ?)`, communityID, keyID, key)
+	return err
+}
+
+// DeleteCommunityKeys deletes the keys of a community we are no longer a
+// member of
+func (db sqlitePersistence) DeleteCommunityKeys(communityID string) error {
+	_, err := db.db.Exec(`DELETE FROM community_keys WHERE community_id = ?`, communityID)
+	return err
+}
+
+func (db sqlitePersistence) CommunityKey(communityID string, keyID uint32) ([]byte, error) {
+	var key []byte
+	err := db.db.QueryRow(`SELECT key FROM community_keys WHERE community_id = ? AND key_id = ?`, communityID, keyID).Scan(&key)
+	if err == sql.ErrNoRows {
+		return nil, errRecordNotFound
+	}
+	return key, err
+}
+
+// SaveCommunityRequestToJoin stores a request to join, replacing the previous
+// one of its author for the same community
+func (db sqlitePersistence) SaveCommunityRequestToJoin(request *CommunityRequestToJoin) error {
+	_, err := db.db.Exec(`INSERT INTO community_requests_to_join(community_id, requester, clock_value, state) VALUES (?, ?, ?, ?)`,
+		request.CommunityID,
+		request.From,
+		request.Clock,
+		request.State,
+	)
+	return err
+}
+
+func (db sqlitePersistence) CommunityRequestToJoin(communityID string, from string) (*CommunityRequestToJoin, error) {
+	requests, err := db.communityRequestsToJoin(`SELECT community_id, requester, clock_value, state FROM community_requests_to_join WHERE community_id = ? AND requester = ?`, communityID, from)
+	if err != nil {
+		return nil, err
+	}
+	if len(requests) == 0 {
+		return nil, errRecordNotFound
+	}
+	return requests[0], nil
+}
+
+// CommunityRequestsToJoin returns the requests to join a community in the
+// given state, the most recent first
+func (db sqlitePersistence) CommunityRequestsToJoin(communityID string, state CommunityRequestToJoinState) ([]*CommunityRequestToJoin, error) {
+	return db.communityRequestsToJoin(`SELECT community_id, requester, clock_value, state FROM community_requests_to_join WHERE community_id = ? AND state = ? ORDER BY clock_value DESC`, communityID, state)
+}
+
+func (db sqlitePersistence) communityRequestsToJoin(query string, args ...interface{}) ([]*CommunityRequestToJoin, error) {
+	rows, err := db.db.Query(query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var result []*CommunityRequestToJoin
+	for rows.Next() {
+		request := &CommunityRequestToJoin{}
+		if err := rows.Scan(&request.CommunityID, &request.From, &request.Clock, &request.State); err != nil {
+			return nil, err
+		}
+		result = append(result, request)
+	}
+	return result, rows.Err()
+}
+
+// SavePollVote stores a vote, replacing the previous one of its author on the
+// same poll
+func (db sqlitePersistence) SavePollVote(vote *PollVote) error {
+	options, err := json.Marshal(vote.Options)
+	if err != nil {
+		return err
+	}
+
+	_, err = db.db.Exec(`INSERT INTO poll_votes(message_id, voter, clock_value, options, timestamp) VALUES (?, ?, ?, ?, ?)`,
+		vote.MessageId,
+		vote.From,
+		vote.Clock,
+		options,
+		vote.Timestamp,
+	)
+	return err
+}
+
+func (db sqlitePersistence) PollVote(messageID string, from string) (*PollVote, error) {
+	votes, err := db.pollVotes(`SELECT message_id, voter, clock_value, options, timestamp FROM poll_votes WHERE message_id = ? AND voter = ?`, messageID, from)
+	if err != nil {
+		return nil, err
+	}
+	if len(votes) == 0 {
+		return nil, errRecordNotFound
+	}
+	return votes[0], nil
+}
+
+func (db sqlitePersistence) PollVotes(messageID string) ([]*PollVote, error) {
+	return db.pollVotes(`SELECT message_id, voter, clock_value, options, timestamp FROM poll_votes WHERE message_id = ?`, messageID)
+}
+
+func (db sqlitePersistence) pollVotes(query string, args ...interface{}) ([]*PollVote, error) {
+	rows, err := db.db.Query(query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var result []*PollVote
+	for rows.Next() {
+		var options []byte
+		vote := &PollVote{}
+		if err := rows.Scan(&vote.MessageId, &vote.From, &vote.Clock, &options, &vote.Timestamp); err != nil {
+			return nil, err
+		}
+		if err := json.Unmarshal(options, &vote.Options); err != nil {
+			return nil, err
+		}
+		result = append(result, vote)
+	}
+	return result, rows.Err()
+}
+
+// SavePendingChatNotificationSettings keeps the notification settings synced
+// by a paired device for a chat we don't have yet
+func (db sqlitePersistence) SavePendingChatNotificationSettings(settings *protobuf.SyncChatNotificationSettings) error {
+	_, err := db.db.Exec(`INSERT INTO pending_chat_notification_settings(chat_id, clock_value, muted, muted_until, notification_level) VALUES (?, ?, ?, ?, ?)`,
+		settings.ChatId,
+		settings.Clock,
+		settings.Muted,
+		settings.MutedUntil,
+		settings.NotificationLevel,
+	)
+	return err
+}
+
+// PendingChatNotificationSettings returns the notification settings synced
+// for chats we don't have yet, by chat id
+func (db sqlitePersistence) PendingChatNotificationSettings() (map[string]*protobuf.SyncChatNotificationSettings, error) {
+	rows, err := db.db.Query(`SELECT chat_id, clock_value, muted, muted_until, notification_level FROM pending_chat_notification_settings`)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	result := make(map[string]*protobuf.SyncChatNotificationSettings)
+	for rows.Next() {
+		settings := &protobuf.SyncChatNotificationSettings{}
+		if err := rows.Scan(&settings.ChatId, &settings.Clock, &settings.Muted, &settings.MutedUntil, &settings.NotificationLevel); err != nil {
+			return nil, err
+		}
+		result[settings.ChatId] = settings
+	}
+	return result, rows.Err()
+}
+
+func (db sqlitePersistence) DeletePendingChatNotificationSettings(chatID string) error {
+	_, err := db.db.Exec(`DELETE FROM pending_chat_notification_settings WHERE chat_id = ?`, chatID)
+	return err
+}
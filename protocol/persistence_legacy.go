@@ -41,7 +41,22 @@ func (db sqlitePersistence) tableUserMessagesLegacyAllFields() string {
 		command_transaction_hash,
 		command_state,
 		command_signature,
-		response_to`
+		response_to,
+		edited_at,
+		deleted,
+		image_payload,
+		image_format,
+		image_width,
+		image_height,
+		audio_payload,
+		audio_type,
+		audio_duration_ms,
+		mentioned,
+		links,
+		forwarded_from,
+		forwarded_from_verified,
+		expires_at,
+		poll`
 }
 
 func (db sqlitePersistence) tableUserMessagesLegacyAllFieldsJoin() string {
@@ -70,8 +85,24 @@ func (db sqlitePersistence) tableUserMessagesLegacyAllFieldsJoin() string {
 		m1.command_state,
 		m1.command_signature,
 		m1.response_to,
+		m1.edited_at,
+		m1.deleted,
+		m1.image_payload,
+		m1.image_format,
+		m1.image_width,
+		m1.image_height,
+		m1.audio_payload,
+		m1.audio_type,
+		m1.audio_duration_ms,
+		m1.mentioned,
+		m1.links,
+		m1.forwarded_from,
+		m1.forwarded_from_verified,
+		m1.expires_at,
+		m1.poll,
 		m2.source,
 		m2.text,
+		m2.deleted,
 		c.alias,
 		c.identicon`
 }
@@ -87,10 +118,16 @@ type scanner interface {
 func (db sqlitePersistence) tableUserMessagesLegacyScanAllFields(row scanner, message *Message, others ...interface{}) error {
 	var quotedText sql.NullString
 	var quotedFrom sql.NullString
+	var quotedDeleted sql.NullBool
 	var alias sql.NullString
 	var identicon sql.NullString
+	var links []byte
+	var forwardedFrom []byte
+	var poll []byte
 
 	sticker := &protobuf.StickerMessage{}
+	image := &protobuf.ImageMessage{}
+	audio := &protobuf.AudioMessage{}
 	command := &CommandParameters{}
 
 	args := []interface{}{
@@ -119,8 +156,24 @@ func (db sqlitePersistence) tableUserMessagesLegacyScanAllFields(row scanner, me
 		&command.CommandState,
 		&command.Signature,
 		&message.ResponseTo,
+		&message.EditedAt,
+		&message.Deleted,
+		&image.Payload,
+		&image.Format,
+		&image.Width,
+		&image.Height,
+		&audio.Payload,
+		&audio.Type,
+		&audio.DurationMs,
+		&message.Mentioned,
+		&links,
+		&forwardedFrom,
+		&message.ForwardedFromVerified,
+		&message.ExpiresAt,
+		&poll,
 		&quotedFrom,
 		&quotedText,
+		&quotedDeleted,
 		&alias,
 		&identicon,
 	}
@@ -131,8 +184,9 @@ func (db sqlitePersistence) tableUserMessagesLegacyScanAllFields(row scanner, me
 
 	if quotedText.Valid {
 		message.QuotedMessage = &QuotedMessage{
-			From: quotedFrom.String,
-			Text: quotedText.String,
+			From:    quotedFrom.String,
+			Text:    quotedText.String,
+			Deleted: quotedDeleted.Bool,
 		}
 	}
 	message.Alias = alias.String
@@ -141,10 +195,39 @@ func (db sqlitePersistence) tableUserMessagesLegacyScanAllFields(row scanner, me
 		message.Payload = &protobuf.ChatMessage_Sticker{Sticker: sticker}
 	}
 
+	if message.ContentType == protobuf.ChatMessage_IMAGE && !message.Deleted {
+		message.Payload = &protobuf.ChatMessage_Image{Image: image}
+	}
+
+	if message.ContentType == protobuf.ChatMessage_AUDIO && !message.Deleted {
+		message.Payload = &protobuf.ChatMessage_Audio{Audio: audio}
+	}
+
 	if message.ContentType == protobuf.ChatMessage_TRANSACTION_COMMAND {
 		message.CommandParameters = command
 	}
 
+	if message.ContentType == protobuf.ChatMessage_POLL && len(poll) != 0 {
+		pollMessage := &protobuf.PollMessage{}
+		if err := json.Unmarshal(poll, pollMessage); err != nil {
+			return err
+		}
+		message.Payload = &protobuf.ChatMessage_Poll{Poll: pollMessage}
+	}
+
+	if len(links) != 0 {
+		if err := json.Unmarshal(links, &message.Links); err != nil {
+			return err
+		}
+	}
+
+	if len(forwardedFrom) != 0 {
+		message.ForwardedFrom = &protobuf.ForwardedFrom{}
+		if err := json.Unmarshal(forwardedFrom, message.ForwardedFrom); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
@@ -153,10 +236,42 @@ func (db sqlitePersistence) tableUserMessagesLegacyAllValues(message *Message) (
 	if sticker == nil {
 		sticker = &protobuf.StickerMessage{}
 	}
+	image := message.GetImage()
+	if image == nil {
+		image = &protobuf.ImageMessage{}
+	}
+	audio := message.GetAudio()
+	if audio == nil {
+		audio = &protobuf.AudioMessage{}
+	}
 	command := message.CommandParameters
 	if command == nil {
 		command = &CommandParameters{}
 	}
+	var links []byte
+	if len(message.Links) != 0 {
+		var err error
+		links, err = json.Marshal(message.Links)
+		if err != nil {
+			return nil, err
+		}
+	}
+	var forwardedFrom []byte
+	if message.ForwardedFrom != nil {
+		var err error
+		forwardedFrom, err = json.Marshal(message.ForwardedFrom)
+		if err != nil {
+			return nil, err
+		}
+	}
+	var poll []byte
+	if pollMessage := message.GetPoll(); pollMessage != nil {
+		var err error
+		poll, err = json.Marshal(pollMessage)
+		if err != nil {
+			return nil, err
+		}
+	}
 	return []interface{}{
 		message.ID,
 		message.WhisperTimestamp,
@@ -183,6 +298,21 @@ func (db sqlitePersistence) tableUserMessagesLegacyAllValues(message *Message) (
 		command.CommandState,
 		command.Signature,
 		message.ResponseTo,
+		message.EditedAt,
+		message.Deleted,
+		image.Payload,
+		image.Format,
+		image.Width,
+		image.Height,
+		audio.Payload,
+		audio.Type,
+		audio.DurationMs,
+		message.Mentioned,
+		links,
+		forwardedFrom,
+		message.ForwardedFromVerified,
+		message.ExpiresAt,
+		poll,
 	}, nil
 }
 
@@ -382,6 +512,80 @@ func (db sqlitePersistence) MessageByChatID(chatID string, currCursor string, li
 	return result, newCursor, nil
 }
 
+// TimelineMessages returns the status updates posted on the given timelines
+// in descending order, paginated the same way as MessageByChatID
+func (db sqlitePersistence) TimelineMessages(chatIDs []string, currCursor string, limit int) ([]*Message, string, error) {
+	if len(chatIDs) == 0 {
+		return nil, "", nil
+	}
+
+	cursorWhere := ""
+	if currCursor != "" {
+		cursorWhere = "AND cursor <= ?"
+	}
+	allFields := db.tableUserMessagesLegacyAllFieldsJoin()
+	args := make([]interface{}, 0, len(chatIDs)+3)
+	for _, chatID := range chatIDs {
+		args = append(args, chatID)
+	}
+	args = append(args, protobuf.ChatMessage_STATUS)
+	if currCursor != "" {
+		args = append(args, currCursor)
+	}
+	inVector := strings.Repeat("?, ", len(chatIDs)-1) + "?"
+	rows, err := db.db.Query(
+		fmt.Sprintf(`
+			SELECT
+				%s,
+				substr('0000000000000000000000000000000000000000000000000000000000000000' || m1.clock_value, -64, 64) || m1.id as cursor
+			FROM
+				user_messages m1
+			LEFT JOIN
+				user_messages m2
+			ON
+			m1.response_to = m2.id
+
+			LEFT JOIN
+			      contacts c
+			ON
+
+			m1.source = c.id
+			WHERE
+				m1.hide != 1 AND m1.local_chat_id IN (%s) AND m1.content_type = ? %s
+			ORDER BY cursor DESC
+			LIMIT ?
+		`, allFields, inVector, cursorWhere),
+		append(args, limit+1)..., // take one more to figure our whether a cursor should be returned
+	)
+	if err != nil {
+		return nil, "", err
+	}
+	defer rows.Close()
+
+	var (
+		result  []*Message
+		cursors []string
+	)
+	for rows.Next() {
+		var (
+			message Message
+			cursor  string
+		)
+		if err := db.tableUserMessagesLegacyScanAllFields(rows, &message, &cursor); err != nil {
+			return nil, "", err
+		}
+		result = append(result, &message)
+		cursors = append(cursors, cursor)
+	}
+
+	var newCursor string
+	if len(result) > limit {
+		newCursor = cursors[limit]
+		result = result[:limit]
+	}
+	return result, newCursor, nil
+}
+
 func (db sqlitePersistence) SaveMessagesLegacy(messages []*Message) (err error) {
 	tx, err := db.db.BeginTx(context.Background(), &sql.TxOptions{})
 	if err != nil {
@@ -429,6 +633,63 @@ func (db sqlitePersistence) HideMessage(id string) error {
 	return err
 }
 
+// HideMessagesFromNonContact hides messages received from someone who is not
+// a contact, until ShowMessagesFromContact is called for their chat
+func (db sqlitePersistence) HideMessagesFromNonContact(ids []string) error {
+	if len(ids) == 0 {
+		return nil
+	}
+
+	idsArgs := make([]interface{}, 0, len(ids))
+	for _, id := range ids {
+		idsArgs = append(idsArgs, id)
+	}
+
+	inVector := strings.Repeat("?, ", len(ids)-1) + "?"
+	query := "UPDATE user_messages SET hide = 1, hidden_from_non_contact = 1 WHERE id IN (" + inVector + ")" // nolint: gosec
+	_, err := db.db.Exec(query, idsArgs...)
+	return err
+}
+
+// ShowMessagesFromContact shows the messages of the chat hidden by
+// HideMessagesFromNonContact and returns how many of them there are
+func (db sqlitePersistence) ShowMessagesFromContact(localChatID string) (uint, error) {
+	result, err := db.db.Exec(`UPDATE user_messages SET hide = 0, hidden_from_non_contact = 0 WHERE local_chat_id = ? AND hidden_from_non_contact = 1`, localChatID)
+	if err != nil {
+		return 0, err
+	}
+	count, err := result.RowsAffected()
+	if err != nil {
+		return 0, err
+	}
+	return uint(count), nil
+}
+
+// HideMessagesAwaitingPost hides comments on a status update of a timeline
+// received before it, until ShowMessagesAwaitingPost is called for it
+func (db sqlitePersistence) HideMessagesAwaitingPost(ids []string) error {
+	if len(ids) == 0 {
+		return nil
+	}
+
+	idsArgs := make([]interface{}, 0, len(ids))
+	for _, id := range ids {
+		idsArgs = append(idsArgs, id)
+	}
+
+	inVector := strings.Repeat("?, ", len(ids)-1) + "?"
+	query := "UPDATE user_messages SET hide = 1, awaiting_post = 1 WHERE id IN (" + inVector + ")" // nolint: gosec
+	_, err := db.db.Exec(query, idsArgs...)
+	return err
+}
+
+// ShowMessagesAwaitingPost shows the comments of the chat on the status
+// update hidden by HideMessagesAwaitingPost
+func (db sqlitePersistence) ShowMessagesAwaitingPost(localChatID, postID string) error {
+	_, err := db.db.Exec(`UPDATE user_messages SET hide = 0, awaiting_post = 0 WHERE local_chat_id = ? AND response_to = ? AND awaiting_post = 1`, localChatID, postID)
+	return err
+}
+
 func (db sqlitePersistence) DeleteMessagesByChatID(id string) error {
 	_, err := db.db.Exec(`DELETE FROM user_messages WHERE local_chat_id = ?`, id)
 	return err
@@ -452,7 +713,7 @@ func (db sqlitePersistence) MarkAllRead(chatID string) error {
 	if err != nil {
 		return err
 	}
-	_, err = tx.Exec(`UPDATE chats SET unviewed_message_count = 0 WHERE id = ?`, chatID)
+	_, err = tx.Exec(`UPDATE chats SET unviewed_message_count = 0, unviewed_mentions_count = 0 WHERE id = ?`, chatID)
 	return err
 }
 
@@ -482,14 +743,18 @@ func (db sqlitePersistence) MarkMessagesSeen(chatID string, ids []string) error
 		return err
 	}
 
-	// Update denormalized count
+	// Update denormalized counts
 	_, err = tx.Exec(
 		`UPDATE chats
               	SET unviewed_message_count =
 		   (SELECT COUNT(1)
 		   FROM user_messages
-		   WHERE local_chat_id = ? AND seen = 0)
-		WHERE id = ?`, chatID, chatID)
+		   WHERE local_chat_id = ? AND seen = 0),
+		unviewed_mentions_count =
+		   (SELECT COUNT(1)
+		   FROM user_messages
+		   WHERE local_chat_id = ? AND seen = 0 AND mentioned = 1)
+		WHERE id = ?`, chatID, chatID, chatID)
 	return err
 }
 
@@ -545,7 +810,8 @@ func (db sqlitePersistence) BlockContact(contact *Contact) ([]*Chat, error) {
 	_, err = tx.Exec(`
 		UPDATE chats
 		SET
-			unviewed_message_count = (SELECT COUNT(1) FROM user_messages WHERE seen = 0 AND local_chat_id = chats.id)`)
+			unviewed_message_count = (SELECT COUNT(1) FROM user_messages WHERE seen = 0 AND local_chat_id = chats.id),
+			unviewed_mentions_count = (SELECT COUNT(1) FROM user_messages WHERE seen = 0 AND mentioned = 1 AND local_chat_id = chats.id)`)
 	if err != nil {
 		return nil, err
 	}
@@ -592,3 +858,781 @@ func (db sqlitePersistence) BlockContact(contact *Contact) ([]*Chat, error) {
 
 	return chats, err
 }
+
+// DeleteExpiredMessages deletes the messages that expired at or before now,
+// along with their raw messages and edits. It returns the ids of the deleted
+// messages and the chats they belonged to, with their denormalized fields
+// recalculated
+func (db sqlitePersistence) DeleteExpiredMessages(now uint64) (messageIDs []string, chats []*Chat, err error) {
+	tx, err := db.db.BeginTx(context.Background(), &sql.TxOptions{})
+	if err != nil {
+		return nil, nil, err
+	}
+	defer func() {
+		if err == nil {
+			err = tx.Commit()
+			return
+		}
+		// don't shadow original error
+		_ = tx.Rollback()
+	}()
+
+	rows, err := tx.Query(`SELECT id, local_chat_id FROM user_messages WHERE expires_at != 0 AND expires_at <= ?`, now)
+	if err != nil {
+		return nil, nil, err
+	}
+	var chatIDs []string
+	for rows.Next() {
+		var messageID, chatID string
+		if err = rows.Scan(&messageID, &chatID); err != nil {
+			rows.Close()
+			return nil, nil, err
+		}
+		messageIDs = append(messageIDs, messageID)
+		if !stringSliceContains(chatIDs, chatID) {
+			chatIDs = append(chatIDs, chatID)
+		}
+	}
+	rows.Close()
+	if err = rows.Err(); err != nil {
+		return nil, nil, err
+	}
+
+	if len(messageIDs) == 0 {
+		return nil, nil, nil
+	}
+
+	for _, query := range []string{
+		`DELETE FROM raw_messages WHERE id IN (SELECT id FROM user_messages WHERE expires_at != 0 AND expires_at <= ?)`,
+		`DELETE FROM user_messages_edits WHERE message_id IN (SELECT id FROM user_messages WHERE expires_at != 0 AND expires_at <= ?)`,
+		`DELETE FROM poll_votes WHERE message_id IN (SELECT id FROM user_messages WHERE expires_at != 0 AND expires_at <= ?)`,
+		`DELETE FROM emoji_reactions WHERE message_id IN (SELECT id FROM user_messages WHERE expires_at != 0 AND expires_at <= ?)`,
+		`DELETE FROM pin_messages WHERE message_id IN (SELECT id FROM user_messages WHERE expires_at != 0 AND expires_at <= ?)`,
+		`DELETE FROM user_messages WHERE expires_at != 0 AND expires_at <= ?`,
+	} {
+		if _, err = tx.Exec(query, now); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	for _, chatID := range chatIDs {
+		_, err = tx.Exec(`
+			UPDATE chats
+			SET
+				unviewed_message_count = (SELECT COUNT(1) FROM user_messages WHERE seen = 0 AND local_chat_id = chats.id),
+				unviewed_mentions_count = (SELECT COUNT(1) FROM user_messages WHERE seen = 0 AND mentioned = 1 AND local_chat_id = chats.id)
+			WHERE id = ?`, chatID)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		// Messages older than the deletion of the chat are not shown, they
+		// can't be its last message
+		var lastMessageID string
+		row := tx.QueryRow(`
+			SELECT m.id
+			FROM user_messages m
+			JOIN chats c ON m.local_chat_id = c.id
+			WHERE m.local_chat_id = ? AND m.clock_value > c.deleted_at_clock_value
+			ORDER BY m.clock_value DESC
+			LIMIT 1`, chatID)
+		switch err = row.Scan(&lastMessageID); err {
+		case nil:
+			var message *Message
+			message, err = db.messageByID(tx, lastMessageID)
+			if err != nil {
+				return nil, nil, err
+			}
+			var encodedMessage []byte
+			encodedMessage, err = json.Marshal(message)
+			if err != nil {
+				return nil, nil, err
+			}
+			_, err = tx.Exec(`UPDATE chats SET last_message = ? WHERE id = ?`, encodedMessage, chatID)
+		case sql.ErrNoRows:
+			_, err = tx.Exec(`UPDATE chats SET last_message = NULL WHERE id = ?`, chatID)
+		}
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+
+	allChats, err := db.chats(tx)
+	if err != nil {
+		return nil, nil, err
+	}
+	for _, chat := range allChats {
+		if stringSliceContains(chatIDs, chat.ID) {
+			chats = append(chats, chat)
+		}
+	}
+
+	return messageIDs, chats, nil
+}
+
+func (db sqlitePersistence) tableEmojiReactionsAllFields() string {
+	return `id,
+		clock_value,
+		source,
+		emoji_id,
+		message_id,
+		chat_id,
+		local_chat_id,
+		retracted`
+}
+
+func (db sqlitePersistence) tableEmojiReactionsScanAllFields(row scanner, emojiReaction *EmojiReaction) error {
+	return row.Scan(
+		&emojiReaction.ID,
+		&emojiReaction.Clock,
+		&emojiReaction.From,
+		&emojiReaction.Type,
+		&emojiReaction.MessageId,
+		&emojiReaction.ChatId,
+		&emojiReaction.LocalChatID,
+		&emojiReaction.Retracted,
+	)
+}
+
+func (db sqlitePersistence) SaveEmojiReaction(emojiReaction *EmojiReaction) error {
+	_, err := db.db.Exec(`INSERT OR REPLACE INTO emoji_reactions(`+db.tableEmojiReactionsAllFields()+`) VALUES (?, ?, ?, ?, ?, ?, ?, ?)`, // nolint: gosec
+		emojiReaction.ID,
+		emojiReaction.Clock,
+		emojiReaction.From,
+		emojiReaction.Type,
+		emojiReaction.MessageId,
+		emojiReaction.ChatId,
+		emojiReaction.LocalChatID,
+		emojiReaction.Retracted,
+	)
+	return err
+}
+
+func (db sqlitePersistence) SaveEmojiReactions(emojiReactions []*EmojiReaction) error {
+	for _, emojiReaction := range emojiReactions {
+		if err := db.SaveEmojiReaction(emojiReaction); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (db sqlitePersistence) EmojiReactionByID(id string) (*EmojiReaction, error) {
+	emojiReaction := &EmojiReaction{}
+	row := db.db.QueryRow(`SELECT `+db.tableEmojiReactionsAllFields()+` FROM emoji_reactions WHERE id = ?`, id) // nolint: gosec
+	err := db.tableEmojiReactionsScanAllFields(row, emojiReaction)
+	switch err {
+	case sql.ErrNoRows:
+		return nil, errRecordNotFound
+	case nil:
+		return emojiReaction, nil
+	default:
+		return nil, err
+	}
+}
+
+// emojiReactionBy returns the reaction of a given type sent by source to a
+// message, retracted or not.
+func (db sqlitePersistence) emojiReactionBy(messageID, source string, emojiID protobuf.EmojiReaction_Type) (*EmojiReaction, error) {
+	emojiReaction := &EmojiReaction{}
+	row := db.db.QueryRow(`SELECT `+db.tableEmojiReactionsAllFields()+` FROM emoji_reactions WHERE message_id = ? AND source = ? AND emoji_id = ?`, // nolint: gosec
+		messageID,
+		source,
+		emojiID,
+	)
+	err := db.tableEmojiReactionsScanAllFields(row, emojiReaction)
+	switch err {
+	case sql.ErrNoRows:
+		return nil, errRecordNotFound
+	case nil:
+		return emojiReaction, nil
+	default:
+		return nil, err
+	}
+}
+
+// EmojiReactionsByChatID returns the emoji reactions that have not been
+// retracted for the messages of the page identified by currCursor and limit,
+// as returned by MessageByChatID.
+// EmojiReactionsByMessageID returns the reactions to a message sent to a chat,
+// including the retracted ones
+func (db sqlitePersistence) EmojiReactionsByMessageID(messageID, localChatID string) ([]*EmojiReaction, error) {
+	rows, err := db.db.Query(`SELECT `+db.tableEmojiReactionsAllFields()+` FROM emoji_reactions WHERE message_id = ? AND local_chat_id = ?`, messageID, localChatID) // nolint: gosec
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var result []*EmojiReaction
+	for rows.Next() {
+		var emojiReaction EmojiReaction
+		if err := db.tableEmojiReactionsScanAllFields(rows, &emojiReaction); err != nil {
+			return nil, err
+		}
+		result = append(result, &emojiReaction)
+	}
+	return result, rows.Err()
+}
+
+// DeleteEmojiReactionsByMessageID deletes the reactions to a message sent to
+// a chat
+func (db sqlitePersistence) DeleteEmojiReactionsByMessageID(messageID, localChatID string) error {
+	_, err := db.db.Exec(`DELETE FROM emoji_reactions WHERE message_id = ? AND local_chat_id = ?`, messageID, localChatID)
+	return err
+}
+
+func (db sqlitePersistence) EmojiReactionsByChatID(chatID string, currCursor string, limit int) ([]*EmojiReaction, error) {
+	cursorWhere := ""
+	if currCursor != "" {
+		cursorWhere = "AND cursor <= ?"
+	}
+	args := []interface{}{chatID, chatID}
+	if currCursor != "" {
+		args = append(args, currCursor)
+	}
+	rows, err := db.db.Query(
+		fmt.Sprintf(`
+			SELECT
+				%s
+			FROM
+				emoji_reactions
+			WHERE
+				retracted = 0 AND local_chat_id = ? AND message_id IN (
+					SELECT id FROM (
+						SELECT
+							id,
+							substr('0000000000000000000000000000000000000000000000000000000000000000' || clock_value, -64, 64) || id as cursor
+						FROM
+							user_messages
+						WHERE
+							hide != 1 AND local_chat_id = ? %s
+						ORDER BY cursor DESC
+						LIMIT ?
+					)
+				)
+		`, db.tableEmojiReactionsAllFields(), cursorWhere),
+		append(args, limit)...,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var result []*EmojiReaction
+	for rows.Next() {
+		var emojiReaction EmojiReaction
+		if err := db.tableEmojiReactionsScanAllFields(rows, &emojiReaction); err != nil {
+			return nil, err
+		}
+		result = append(result, &emojiReaction)
+	}
+
+	return result, nil
+}
+
+func (db sqlitePersistence) tableUserMessagesEditsAllFields() string {
+	return `id,
+		clock_value,
+		source,
+		text,
+		message_id,
+		local_chat_id`
+}
+
+func (db sqlitePersistence) saveEdit(tx *sql.Tx, edit *EditMessage) error {
+	_, err := tx.Exec(`INSERT INTO user_messages_edits(`+db.tableUserMessagesEditsAllFields()+`) VALUES (?, ?, ?, ?, ?, ?)`, // nolint: gosec
+		edit.ID,
+		edit.Clock,
+		edit.From,
+		edit.Text,
+		edit.MessageId,
+		edit.LocalChatID,
+	)
+	return err
+}
+
+// SaveEdits stores edits in the edit history, without applying them to
+// the edited messages
+func (db sqlitePersistence) SaveEdits(edits []*EditMessage) (err error) {
+	tx, err := db.db.BeginTx(context.Background(), &sql.TxOptions{})
+	if err != nil {
+		return
+	}
+	defer func() {
+		if err == nil {
+			err = tx.Commit()
+			return
+		}
+		// don't shadow original error
+		_ = tx.Rollback()
+	}()
+
+	for _, edit := range edits {
+		err = db.saveEdit(tx, edit)
+		if err != nil {
+			return
+		}
+	}
+	return
+}
+
+// SaveEditedMessage stores the edits in the edit history and updates the
+// text of the edited message
+func (db sqlitePersistence) SaveEditedMessage(message *Message, edits []*EditMessage) (err error) {
+	tx, err := db.db.BeginTx(context.Background(), &sql.TxOptions{})
+	if err != nil {
+		return
+	}
+	defer func() {
+		if err == nil {
+			err = tx.Commit()
+			return
+		}
+		// don't shadow original error
+		_ = tx.Rollback()
+	}()
+
+	for _, edit := range edits {
+		err = db.saveEdit(tx, edit)
+		if err != nil {
+			return
+		}
+	}
+
+	_, err = tx.Exec(`UPDATE user_messages SET text = ?, parsed_text = ?, edited_at = ? WHERE id = ?`,
+		message.Text,
+		message.ParsedText,
+		message.EditedAt,
+		message.ID,
+	)
+	return
+}
+
+// latestEditBy returns the most recent edit of a message sent by source
+// in the given chat
+func (db sqlitePersistence) latestEditBy(messageID, source, localChatID string) (*EditMessage, error) {
+	edit := &EditMessage{}
+	err := db.db.QueryRow(`SELECT `+db.tableUserMessagesEditsAllFields()+` FROM user_messages_edits WHERE message_id = ? AND source = ? AND local_chat_id = ? ORDER BY clock_value DESC LIMIT 1`, // nolint: gosec
+		messageID,
+		source,
+		localChatID,
+	).Scan(
+		&edit.ID,
+		&edit.Clock,
+		&edit.From,
+		&edit.Text,
+		&edit.MessageId,
+		&edit.LocalChatID,
+	)
+	switch err {
+	case sql.ErrNoRows:
+		return nil, errRecordNotFound
+	case nil:
+		return edit, nil
+	default:
+		return nil, err
+	}
+}
+
+// MessageEditHistory returns all the versions of a message, starting
+// from the original one, in ascending clock order. Edits that have not been
+// sent by the author of the message are ignored
+func (db sqlitePersistence) MessageEditHistory(messageID string) ([]*EditMessage, error) {
+	rows, err := db.db.Query(`
+		SELECT
+			`+db.tableUserMessagesEditsAllFields()+`
+		FROM
+			user_messages_edits
+		WHERE
+			message_id = ? AND source = (SELECT source FROM user_messages WHERE id = ?)
+		ORDER BY clock_value ASC`, // nolint: gosec
+		messageID,
+		messageID,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var result []*EditMessage
+	for rows.Next() {
+		edit := &EditMessage{}
+		err := rows.Scan(
+			&edit.ID,
+			&edit.Clock,
+			&edit.From,
+			&edit.Text,
+			&edit.MessageId,
+			&edit.LocalChatID,
+		)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, edit)
+	}
+
+	return result, nil
+}
+
+// SaveDeletes stores deletions of messages that have not been received yet
+func (db sqlitePersistence) SaveDeletes(deletes []*DeleteMessage) (err error) {
+	tx, err := db.db.BeginTx(context.Background(), &sql.TxOptions{})
+	if err != nil {
+		return
+	}
+	defer func() {
+		if err == nil {
+			err = tx.Commit()
+			return
+		}
+		// don't shadow original error
+		_ = tx.Rollback()
+	}()
+
+	for _, d := range deletes {
+		_, err = tx.Exec(`INSERT INTO user_messages_deletes(id, clock_value, source, message_id, local_chat_id) VALUES (?, ?, ?, ?, ?)`,
+			d.ID,
+			d.Clock,
+			d.From,
+			d.MessageId,
+			d.LocalChatID,
+		)
+		if err != nil {
+			return
+		}
+	}
+	return
+}
+
+// deleteBy returns the deletion of a message sent by source in the given
+// chat, if any
+func (db sqlitePersistence) deleteBy(messageID, source, localChatID string) (*DeleteMessage, error) {
+	d := &DeleteMessage{}
+	err := db.db.QueryRow(`SELECT id, clock_value, source, message_id, local_chat_id FROM user_messages_deletes WHERE message_id = ? AND source = ? AND local_chat_id = ? ORDER BY clock_value ASC LIMIT 1`,
+		messageID,
+		source,
+		localChatID,
+	).Scan(
+		&d.ID,
+		&d.Clock,
+		&d.From,
+		&d.MessageId,
+		&d.LocalChatID,
+	)
+	switch err {
+	case sql.ErrNoRows:
+		return nil, errRecordNotFound
+	case nil:
+		return d, nil
+	default:
+		return nil, err
+	}
+}
+
+// SaveDeletedMessage replaces a message with its tombstone, dropping its
+// content and its edit history
+func (db sqlitePersistence) SaveDeletedMessage(message *Message) (err error) {
+	tx, err := db.db.BeginTx(context.Background(), &sql.TxOptions{})
+	if err != nil {
+		return
+	}
+	defer func() {
+		if err == nil {
+			err = tx.Commit()
+			return
+		}
+		// don't shadow original error
+		_ = tx.Rollback()
+	}()
+
+	_, err = tx.Exec(`UPDATE user_messages SET deleted = 1, text = '', parsed_text = NULL, sticker_pack = 0, sticker_hash = '', image_payload = NULL, audio_payload = NULL, links = NULL, forwarded_from = NULL, forwarded_from_verified = 0, poll = NULL WHERE id = ?`, message.ID)
+	if err != nil {
+		return
+	}
+
+	_, err = tx.Exec(`DELETE FROM user_messages_edits WHERE message_id = ?`, message.ID)
+	if err != nil {
+		return
+	}
+
+	_, err = tx.Exec(`DELETE FROM poll_votes WHERE message_id = ?`, message.ID)
+	return
+}
+
+// MessagesByResponseTo returns all the messages quoting the given message
+func (db sqlitePersistence) MessagesByResponseTo(messageID string) ([]*Message, error) {
+	allFields := db.tableUserMessagesLegacyAllFieldsJoin()
+	rows, err := db.db.Query(
+		fmt.Sprintf(`
+			SELECT
+				%s
+			FROM
+				user_messages m1
+			LEFT JOIN
+				user_messages m2
+			ON
+			m1.response_to = m2.id
+
+			LEFT JOIN
+			      contacts c
+			ON
+
+			m1.source = c.id
+			WHERE
+				m1.hide != 1 AND m1.response_to = ?
+		`, allFields),
+		messageID,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var result []*Message
+	for rows.Next() {
+		var message Message
+		if err := db.tableUserMessagesLegacyScanAllFields(rows, &message); err != nil {
+			return nil, err
+		}
+		result = append(result, &message)
+	}
+	return result, nil
+}
+
+func (db sqlitePersistence) tablePinMessagesAllFields() string {
+	return `message_id,
+		id,
+		clock_value,
+		source,
+		chat_id,
+		local_chat_id,
+		pinned`
+}
+
+// SavePinMessage stores the latest pin state of a message, replacing any
+// previous one
+func (db sqlitePersistence) SavePinMessage(pinMessage *PinMessage) error {
+	_, err := db.db.Exec(`INSERT OR REPLACE INTO pin_messages(`+db.tablePinMessagesAllFields()+`) VALUES (?, ?, ?, ?, ?, ?, ?)`, // nolint: gosec
+		pinMessage.MessageId,
+		pinMessage.ID,
+		pinMessage.Clock,
+		pinMessage.From,
+		pinMessage.ChatId,
+		pinMessage.LocalChatID,
+		pinMessage.Pinned,
+	)
+	return err
+}
+
+// pinMessageBy returns the latest pin or unpin of a message in a chat
+func (db sqlitePersistence) pinMessageBy(messageID, localChatID string) (*PinMessage, error) {
+	pinMessage := &PinMessage{}
+	row := db.db.QueryRow(`SELECT `+db.tablePinMessagesAllFields()+` FROM pin_messages WHERE message_id = ? AND local_chat_id = ?`, messageID, localChatID) // nolint: gosec
+	err := row.Scan(
+		&pinMessage.MessageId,
+		&pinMessage.ID,
+		&pinMessage.Clock,
+		&pinMessage.From,
+		&pinMessage.ChatId,
+		&pinMessage.LocalChatID,
+		&pinMessage.Pinned,
+	)
+	switch err {
+	case sql.ErrNoRows:
+		return nil, errRecordNotFound
+	case nil:
+		return pinMessage, nil
+	default:
+		return nil, err
+	}
+}
+
+// DeletePinMessagesOutsideChat deletes the pins of a message stored in
+// other chats than its own, they were received before the message
+func (db sqlitePersistence) DeletePinMessagesOutsideChat(messageID, localChatID string) error {
+	_, err := db.db.Exec(`DELETE FROM pin_messages WHERE message_id = ? AND local_chat_id != ?`, messageID, localChatID)
+	return err
+}
+
+// PinnedMessagesByChatID returns the messages currently pinned in a chat,
+// most recently pinned first. Pagination works the same as MessageByChatID,
+// the cursor being built from the clock value of the pin.
+func (db sqlitePersistence) PinnedMessagesByChatID(chatID string, currCursor string, limit int) ([]*PinnedMessage, string, error) {
+	cursorWhere := ""
+	if currCursor != "" {
+		cursorWhere = "AND cursor <= ?"
+	}
+	allFields := db.tableUserMessagesLegacyAllFieldsJoin()
+	args := []interface{}{chatID}
+	if currCursor != "" {
+		args = append(args, currCursor)
+	}
+	rows, err := db.db.Query(
+		fmt.Sprintf(`
+			SELECT
+				%s,
+				pm.clock_value,
+				pm.source,
+				substr('0000000000000000000000000000000000000000000000000000000000000000' || pm.clock_value, -64, 64) || m1.id as cursor
+			FROM
+				pin_messages pm
+			JOIN
+				user_messages m1
+			ON
+			pm.message_id = m1.id AND pm.local_chat_id = m1.local_chat_id
+
+			LEFT JOIN
+				user_messages m2
+			ON
+			m1.response_to = m2.id
+
+			LEFT JOIN
+			      contacts c
+			ON
+
+			m1.source = c.id
+			WHERE
+				pm.pinned = 1 AND m1.hide != 1 AND m1.deleted = 0 AND pm.local_chat_id = ? %s
+			ORDER BY cursor DESC
+			LIMIT ?
+		`, allFields, cursorWhere),
+		append(args, limit+1)..., // take one more to figure our whether a cursor should be returned
+	)
+	if err != nil {
+		return nil, "", err
+	}
+	defer rows.Close()
+
+	var (
+		result  []*PinnedMessage
+		cursors []string
+	)
+	for rows.Next() {
+		var (
+			message  Message
+			pinnedAt uint64
+			pinnedBy string
+			cursor   string
+		)
+		if err := db.tableUserMessagesLegacyScanAllFields(rows, &message, &pinnedAt, &pinnedBy, &cursor); err != nil {
+			return nil, "", err
+		}
+		result = append(result, &PinnedMessage{
+			Message:  &message,
+			PinnedAt: pinnedAt,
+			PinnedBy: pinnedBy,
+		})
+		cursors = append(cursors, cursor)
+	}
+
+	var newCursor string
+	if len(result) > limit {
+		newCursor = cursors[limit]
+		result = result[:limit]
+	}
+	return result, newCursor, nil
+}
+
+// SearchMessages returns the messages whose text matches all the words of
+// the query, optionally restricted to some chats and to a time range of
+// message timestamps, 0 meaning unbounded. Results are sorted by rank then
+// clock value, paginated the same way as MessageByChatID.
+func (db sqlitePersistence) SearchMessages(query string, chatIDs []string, from, to uint64, currCursor string, limit int) ([]*SearchResult, string, error) {
+	match, err := buildMatchExpression(query)
+	if err != nil {
+		return nil, "", err
+	}
+
+	var conditions []string
+	args := []interface{}{match}
+	if len(chatIDs) != 0 {
+		conditions = append(conditions, "AND m1.local_chat_id IN (?"+strings.Repeat(",?", len(chatIDs)-1)+")")
+		for _, chatID := range chatIDs {
+			args = append(args, chatID)
+		}
+	}
+	if from != 0 {
+		conditions = append(conditions, "AND m1.timestamp >= ?")
+		args = append(args, from)
+	}
+	if to != 0 {
+		conditions = append(conditions, "AND m1.timestamp <= ?")
+		args = append(args, to)
+	}
+	if currCursor != "" {
+		conditions = append(conditions, "AND cursor <= ?")
+		args = append(args, currCursor)
+	}
+
+	allFields := db.tableUserMessagesLegacyAllFieldsJoin()
+	// The rank is the number of matching terms, i.e. the number of groups
+	// of four integers returned by offsets(). The cursor is prefixed with it
+	// so that results are sorted by rank first.
+	rows, err := db.db.Query(
+		fmt.Sprintf(`
+			SELECT
+				%s,
+				offsets(user_messages_fts),
+				substr('0000000000' || ((length(offsets(user_messages_fts)) - length(replace(offsets(user_messages_fts), ' ', '')) + 1) / 4), -10, 10) ||
+				substr('0000000000000000000000000000000000000000000000000000000000000000' || m1.clock_value, -64, 64) || m1.id as cursor
+			FROM
+				user_messages_fts
+			JOIN
+				user_messages m1
+			ON
+			m1.rowid = user_messages_fts.docid
+
+			LEFT JOIN
+				user_messages m2
+			ON
+			m1.response_to = m2.id
+
+			LEFT JOIN
+			      contacts c
+			ON
+
+			m1.source = c.id
+			WHERE
+				user_messages_fts MATCH ? AND m1.hide != 1 AND m1.deleted = 0 %s
+			ORDER BY cursor DESC
+			LIMIT ?
+		`, allFields, strings.Join(conditions, " ")),
+		append(args, limit+1)..., // take one more to figure our whether a cursor should be returned
+	)
+	if err != nil {
+		return nil, "", err
+	}
+	defer rows.Close()
+
+	var (
+		result  []*SearchResult
+		cursors []string
+	)
+	for rows.Next() {
+		var (
+			message Message
+			offsets string
+			cursor  string
+		)
+		if err := db.tableUserMessagesLegacyScanAllFields(rows, &message, &offsets, &cursor); err != nil {
+			return nil, "", err
+		}
+		highlights, err := parseHighlights(offsets)
+		if err != nil {
+			return nil, "", err
+		}
+		result = append(result, &SearchResult{
+			Message:    &message,
+			Rank:       len(highlights),
+			Highlights: highlights,
+		})
+		cursors = append(cursors, cursor)
+	}
+
+	var newCursor string
+	if len(result) > limit {
+		newCursor = cursors[limit]
+		result = result[:limit]
+	}
+	return result, newCursor, nil
+}
@@ -317,6 +317,27 @@ func TestDeleteMessagesByChatID(t *testing.T) {
 
 }
 
+func TestDeleteMessageChunksReceivedBefore(t *testing.T) {
+	db, err := openTestDB()
+	require.NoError(t, err)
+	p := sqlitePersistence{db: db}
+
+	stale := &protobuf.MessageChunk{Id: "stale", Index: 0, Total: 2, Payload: []byte("a")}
+	recent := &protobuf.MessageChunk{Id: "recent", Index: 0, Total: 2, Payload: []byte("b")}
+	require.NoError(t, p.SaveMessageChunk(stale, "source", 10))
+	require.NoError(t, p.SaveMessageChunk(recent, "source", 20))
+
+	require.NoError(t, p.DeleteMessageChunksReceivedBefore(15))
+
+	chunks, err := p.MessageChunks("stale", "source")
+	require.NoError(t, err)
+	require.Len(t, chunks, 0)
+
+	chunks, err = p.MessageChunks("recent", "source")
+	require.NoError(t, err)
+	require.Len(t, chunks, 1)
+}
+
 func TestMarkMessageSeen(t *testing.T) {
 	chatID := "test-chat"
 	db, err := openTestDB()
@@ -356,6 +377,108 @@ func TestUpdateMessageOutgoingStatus(t *testing.T) {
 	require.Equal(t, "new-status", m.OutgoingStatus)
 }
 
+func TestSearchMessages(t *testing.T) {
+	db, err := openTestDB()
+	require.NoError(t, err)
+	p := sqlitePersistence{db: db}
+
+	newMessage := func(id, chatID, text string, clock uint64) *Message {
+		return &Message{
+			ID:          id,
+			LocalChatID: chatID,
+			ChatMessage: protobuf.ChatMessage{
+				Text:      text,
+				Clock:     clock,
+				Timestamp: clock,
+			},
+			From: "me",
+		}
+	}
+
+	err = p.SaveMessagesLegacy([]*Message{
+		newMessage("1", "chat-1", "the quick brown fox", 1),
+		newMessage("2", "chat-1", "fox says fox", 2),
+		newMessage("3", "chat-2", "a lazy Fox", 3),
+		newMessage("4", "chat-2", "nothing to see here", 4),
+	})
+	require.NoError(t, err)
+
+	results, cursor, err := p.SearchMessages("fox", nil, 0, 0, "", 10)
+	require.NoError(t, err)
+	require.Empty(t, cursor)
+	require.Len(t, results, 3)
+	// The message with the most matches comes first, then the most recent
+	require.Equal(t, "2", results[0].Message.ID)
+	require.Equal(t, 2, results[0].Rank)
+	require.Equal(t, []*Highlight{{Offset: 0, Length: 3}, {Offset: 9, Length: 3}}, results[0].Highlights)
+	require.Equal(t, "3", results[1].Message.ID)
+	require.Equal(t, []*Highlight{{Offset: 7, Length: 3}}, results[1].Highlights)
+	require.Equal(t, "1", results[2].Message.ID)
+
+	// Paginated
+	results, cursor, err = p.SearchMessages("fox", nil, 0, 0, "", 2)
+	require.NoError(t, err)
+	require.Len(t, results, 2)
+	require.NotEmpty(t, cursor)
+	results, cursor, err = p.SearchMessages("fox", nil, 0, 0, cursor, 2)
+	require.NoError(t, err)
+	require.Len(t, results, 1)
+	require.Empty(t, cursor)
+	require.Equal(t, "1", results[0].Message.ID)
+
+	// All the words need to match
+	results, _, err = p.SearchMessages("quick fox", nil, 0, 0, "", 10)
+	require.NoError(t, err)
+	require.Len(t, results, 1)
+	require.Equal(t, "1", results[0].Message.ID)
+
+	// Filtered by chat and time range
+	results, _, err = p.SearchMessages("fox", []string{"chat-2"}, 0, 0, "", 10)
+	require.NoError(t, err)
+	require.Len(t, results, 1)
+	require.Equal(t, "3", results[0].Message.ID)
+
+	results, _, err = p.SearchMessages("fox", nil, 2, 2, "", 10)
+	require.NoError(t, err)
+	require.Len(t, results, 1)
+	require.Equal(t, "2", results[0].Message.ID)
+
+	// Query operators are not interpreted
+	results, _, err = p.SearchMessages(`fox OR "nothing`, nil, 0, 0, "", 10)
+	require.NoError(t, err)
+	require.Len(t, results, 0)
+
+	_, _, err = p.SearchMessages(` " `, nil, 0, 0, "", 10)
+	require.Error(t, err)
+
+	// The index is kept in sync with edits, deletions and replaced messages
+	edited := newMessage("1", "chat-1", "the quick brown dog", 1)
+	edited.EditedAt = 5
+	require.NoError(t, p.SaveEditedMessage(edited, nil))
+
+	deleted, err := p.MessageByID("3")
+	require.NoError(t, err)
+	require.NoError(t, p.SaveDeletedMessage(deleted))
+
+	require.NoError(t, p.SaveMessagesLegacy([]*Message{newMessage("4", "chat-2", "a fox was here", 4)}))
+
+	results, _, err = p.SearchMessages("fox", nil, 0, 0, "", 10)
+	require.NoError(t, err)
+	require.Len(t, results, 2)
+	require.Equal(t, "2", results[0].Message.ID)
+	require.Equal(t, "4", results[1].Message.ID)
+
+	results, _, err = p.SearchMessages("dog", nil, 0, 0, "", 10)
+	require.NoError(t, err)
+	require.Len(t, results, 1)
+	require.Equal(t, "1", results[0].Message.ID)
+
+	require.NoError(t, p.DeleteMessage("4"))
+	results, _, err = p.SearchMessages("fox", nil, 0, 0, "", 10)
+	require.NoError(t, err)
+	require.Len(t, results, 1)
+}
+
 func openTestDB() (*sql.DB, error) {
 	dbPath, err := ioutil.TempFile("", "")
 	if err != nil {
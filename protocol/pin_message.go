@@ -0,0 +1,64 @@
+package protocol
+
+import (
+	"crypto/ecdsa"
+	"encoding/json"
+
+	"github.com/status-im/status-go/protocol/protobuf"
+)
+
+// PinMessage represents the pinning or unpinning of a message in a chat,
+// used for persistence, querying and signaling. Only the latest pin message
+// for each pinned message is kept
+type PinMessage struct {
+	protobuf.PinMessage
+
+	// ID is the ID of the message that carried the pin
+	ID string
+
+	// From is a public key of the author of the pin
+	From string
+
+	// LocalChatID is the chat id to be stored locally
+	LocalChatID string
+
+	// SigPubKey is the ecdsa encoded public key of the pin author
+	SigPubKey *ecdsa.PublicKey `json:"-"`
+}
+
+// GetSigPubKey returns an ecdsa encoded public key
+// this function is required to implement the ChatEntity interface
+func (p *PinMessage) GetSigPubKey() *ecdsa.PublicKey {
+	return p.SigPubKey
+}
+
+// MarshalJSON implements the json.Marshaler interface
+func (p *PinMessage) MarshalJSON() ([]byte, error) {
+	item := struct {
+		ID          string `json:"id"`
+		Clock       uint64 `json:"clock"`
+		ChatID      string `json:"chatId"`
+		LocalChatID string `json:"localChatId"`
+		MessageID   string `json:"messageId"`
+		From        string `json:"from"`
+		Pinned      bool   `json:"pinned"`
+	}{
+		ID:          p.ID,
+		Clock:       p.Clock,
+		ChatID:      p.ChatId,
+		LocalChatID: p.LocalChatID,
+		MessageID:   p.MessageId,
+		From:        p.From,
+		Pinned:      p.Pinned,
+	}
+
+	return json.Marshal(item)
+}
+
+// PinnedMessage is a message currently pinned in a chat, along with who
+// pinned it and when
+type PinnedMessage struct {
+	Message  *Message `json:"message"`
+	PinnedAt uint64   `json:"pinnedAt"`
+	PinnedBy string   `json:"pinnedBy"`
+}
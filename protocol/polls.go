@@ -0,0 +1,249 @@
+package protocol
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"errors"
+
+	"github.com/golang/protobuf/proto"
+
+	"github.com/status-im/status-go/protocol/protobuf"
+)
+
+// PollVote represents a vote on a poll in the application layer, used for
+// persistence and tallying. Votes are signed by their author like any other
+// message, only the latest vote of each voter counts
+type PollVote struct {
+	protobuf.PollVote
+
+	// From is a public key of the voter
+	From string
+
+	// Timestamp is the time in milliseconds the vote was sent at, votes sent
+	// after the poll closed are ignored
+	Timestamp uint64
+
+	// SigPubKey is the ecdsa encoded public key of the voter
+	SigPubKey *ecdsa.PublicKey `json:"-"`
+}
+
+// GetSigPubKey returns an ecdsa encoded public key
+// this function is required to implement the ChatEntity interface
+func (v *PollVote) GetSigPubKey() *ecdsa.PublicKey {
+	return v.SigPubKey
+}
+
+// PollResults is the tally of the votes on a poll
+type PollResults struct {
+	MessageID string `json:"messageId"`
+	// Votes is the number of votes for each option of the poll
+	Votes []uint `json:"votes"`
+	// Voters is the number of users who voted
+	Voters uint `json:"voters"`
+	// OurVote are the options we chose, if we voted
+	OurVote []uint32 `json:"ourVote,omitempty"`
+	// Closed indicates whether the poll doesn't accept votes anymore
+	Closed bool `json:"closed"`
+}
+
+// CreatePoll sends a poll to a chat. closesAt is the time in milliseconds
+// after which votes are ignored, 0 if the poll never closes
+func (m *Messenger) CreatePoll(ctx context.Context, chatID string, question string, options []string, multipleChoice bool, closesAt uint64) (*MessengerResponse, error) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	poll := &protobuf.PollMessage{
+		Question:       question,
+		Options:        options,
+		MultipleChoice: multipleChoice,
+		ClosesAt:       closesAt,
+	}
+	if err := ValidatePoll(poll); err != nil {
+		return nil, err
+	}
+
+	if closesAt != 0 && closesAt <= m.getTimesource().GetCurrentTime() {
+		return nil, errors.New("poll can't close in the past")
+	}
+
+	message := &Message{}
+	message.ChatId = chatID
+	// The question is sent as text as well, for clients that don't support
+	// polls yet
+	message.Text = question
+	message.ContentType = protobuf.ChatMessage_POLL
+	message.Payload = &protobuf.ChatMessage_Poll{Poll: poll}
+
+	return m.sendChatMessage(ctx, message)
+}
+
+// Vote votes on a poll, replacing our previous vote if any. In private group
+// chats only the members of the chat can vote
+func (m *Messenger) Vote(ctx context.Context, messageID string, options []uint32) (*MessengerResponse, error) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	message, poll, err := m.pollMessage(messageID)
+	if err != nil {
+		return nil, err
+	}
+
+	chat, ok := m.allChats[message.LocalChatID]
+	if !ok {
+		return nil, errors.New("Chat not found")
+	}
+
+	ourID := contactIDFromPublicKey(&m.identity.PublicKey)
+	if chat.PrivateGroupChat() && !chat.IsMember(ourID) {
+		return nil, errors.New("only members can vote")
+	}
+
+	now := m.getTimesource().GetCurrentTime()
+	if poll.ClosesAt != 0 && now > poll.ClosesAt {
+		return nil, errors.New("poll closed")
+	}
+
+	if err := validatePollVoteOptions(poll, options); err != nil {
+		return nil, err
+	}
+
+	clock, _ := chat.NextClockAndTimestamp(m.getTimesource())
+	vote := &PollVote{
+		PollVote: protobuf.PollVote{
+			Clock:       clock,
+			ChatId:      chat.ID,
+			MessageId:   messageID,
+			MessageType: chat.MessageType(),
+			Options:     options,
+		},
+		From:      ourID,
+		Timestamp: now,
+		SigPubKey: &m.identity.PublicKey,
+	}
+
+	encodedMessage, err := proto.Marshal(&vote.PollVote)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = m.dispatchMessage(ctx, &RawMessage{
+		LocalChatID:         chat.ID,
+		Payload:             encodedMessage,
+		MessageType:         protobuf.ApplicationMetadataMessage_POLL_VOTE,
+		ResendAutomatically: true,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	err = m.persistence.SavePollVote(vote)
+	if err != nil {
+		return nil, err
+	}
+
+	if chat.LastClockValue < clock {
+		chat.LastClockValue = clock
+	}
+	err = m.saveChat(chat)
+	if err != nil {
+		return nil, err
+	}
+
+	results, err := m.pollResults(message, poll)
+	if err != nil {
+		return nil, err
+	}
+
+	return &MessengerResponse{PollResults: []*PollResults{results}}, nil
+}
+
+// PollResults returns the tally of the votes on a poll
+func (m *Messenger) PollResults(messageID string) (*PollResults, error) {
+	message, poll, err := m.pollMessage(messageID)
+	if err != nil {
+		return nil, err
+	}
+
+	return m.pollResults(message, poll)
+}
+
+func (m *Messenger) pollMessage(messageID string) (*Message, *protobuf.PollMessage, error) {
+	message, err := m.persistence.MessageByID(messageID)
+	if err == errRecordNotFound {
+		return nil, nil, errors.New("poll not found")
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+
+	poll := message.GetPoll()
+	if message.ContentType != protobuf.ChatMessage_POLL || poll == nil || message.Deleted {
+		return nil, nil, errors.New("not a poll")
+	}
+	return message, poll, nil
+}
+
+func (m *Messenger) pollResults(message *Message, poll *protobuf.PollMessage) (*PollResults, error) {
+	votes, err := m.persistence.PollVotes(message.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	now := m.getTimesource().GetCurrentTime()
+	ourID := contactIDFromPublicKey(&m.identity.PublicKey)
+	return tallyPoll(message.ID, poll, votes, ourID, now), nil
+}
+
+// tallyPoll counts the votes on a poll. Votes were stored as received, the
+// ones that don't match the options of the poll or were sent after it closed
+// are ignored
+func tallyPoll(messageID string, poll *protobuf.PollMessage, votes []*PollVote, ourID string, now uint64) *PollResults {
+	results := &PollResults{
+		MessageID: messageID,
+		Votes:     make([]uint, len(poll.Options)),
+		Closed:    poll.ClosesAt != 0 && now > poll.ClosesAt,
+	}
+
+	for _, vote := range votes {
+		if validatePollVoteOptions(poll, vote.Options) != nil {
+			continue
+		}
+		if poll.ClosesAt != 0 && vote.Timestamp > poll.ClosesAt {
+			continue
+		}
+
+		results.Voters++
+		for _, option := range vote.Options {
+			results.Votes[option]++
+		}
+
+		if vote.From == ourID {
+			results.OurVote = vote.Options
+		}
+	}
+	return results
+}
+
+// validatePollVoteOptions checks that the options chosen exist and that only
+// one is chosen in single choice polls
+func validatePollVoteOptions(poll *protobuf.PollMessage, options []uint32) error {
+	if len(options) == 0 {
+		return errors.New("no option chosen")
+	}
+
+	if !poll.MultipleChoice && len(options) > 1 {
+		return errors.New("only one option can be chosen")
+	}
+
+	chosen := make(map[uint32]bool)
+	for _, option := range options {
+		if int(option) >= len(poll.Options) {
+			return errors.New("unknown option")
+		}
+		if chosen[option] {
+			return errors.New("option chosen twice")
+		}
+		chosen[option] = true
+	}
+	return nil
+}
@@ -0,0 +1,130 @@
+package protocol
+
+import (
+	"context"
+
+	"github.com/golang/protobuf/proto"
+
+	"github.com/status-im/status-go/protocol/protobuf"
+)
+
+// Profile is what we share about ourselves with our contacts, it's kept in
+// the contact with our own public key
+type Profile struct {
+	ENSName      string `json:"ensName,omitempty"`
+	ProfileImage string `json:"profileImage,omitempty"`
+	// DisplayName is the name we are shown with when we have no verified ENS
+	// name
+	DisplayName string `json:"displayName,omitempty"`
+	Bio         string `json:"bio,omitempty"`
+	// Images are the sizes of our avatar
+	Images      []ContactImage `json:"images,omitempty"`
+	ProfileLink string         `json:"profileLink,omitempty"`
+}
+
+// Profile returns our profile
+func (m *Messenger) Profile() Profile {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	return m.profile()
+}
+
+// SetProfile updates our profile and sends it to our contacts and paired
+// devices
+func (m *Messenger) SetProfile(ctx context.Context, profile Profile) error {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	return m.sendContactUpdates(ctx, profile)
+}
+
+func (m *Messenger) profile() Profile {
+	me, ok := m.allContacts[contactIDFromPublicKey(&m.identity.PublicKey)]
+	if !ok {
+		return Profile{}
+	}
+
+	return Profile{
+		ENSName:      me.Name,
+		ProfileImage: me.Photo,
+		DisplayName:  me.DisplayName,
+		Bio:          me.Bio,
+		Images:       me.Images,
+		ProfileLink:  me.ProfileLink,
+	}
+}
+
+func (m *Messenger) saveProfile(profile Profile) error {
+	me, ok := m.allContacts[contactIDFromPublicKey(&m.identity.PublicKey)]
+	if !ok {
+		var err error
+		me, err = buildContact(&m.identity.PublicKey)
+		if err != nil {
+			return err
+		}
+	}
+
+	if me.Name != profile.ENSName {
+		me.Name = profile.ENSName
+		me.ENSVerified = false
+	}
+	me.Photo = profile.ProfileImage
+	me.DisplayName = profile.DisplayName
+	me.Bio = profile.Bio
+	me.Images = profile.Images
+	me.ProfileLink = profile.ProfileLink
+
+	return m.saveContact(me)
+}
+
+// syncAccount syncs our profile with paired devices, along with the profile
+// image given
+func (m *Messenger) syncAccount(ctx context.Context, profileImage string) error {
+	if !m.hasPairedDevices() {
+		return nil
+	}
+	chatID := contactIDFromPublicKey(&m.identity.PublicKey)
+
+	chat, ok := m.allChats[chatID]
+	if !ok {
+		chat = OneToOneFromPublicKey(&m.identity.PublicKey, m.getTimesource())
+		// We don't want to show the chat to the user
+		chat.Active = false
+	}
+
+	m.allChats[chat.ID] = chat
+	clock, _ := chat.NextClockAndTimestamp(m.getTimesource())
+
+	profile := m.profile()
+	var lastUpdated uint64
+	if me, ok := m.allContacts[chatID]; ok {
+		lastUpdated = me.LastUpdated
+	}
+
+	syncMessage := &protobuf.SyncInstallationAccount{
+		Clock:        clock,
+		ProfileImage: profileImage,
+		LastUpdated:  lastUpdated,
+		DisplayName:  profile.DisplayName,
+		Bio:          profile.Bio,
+		Images:       protobufProfileImages(profile.Images),
+		ProfileLink:  profile.ProfileLink,
+	}
+	encodedMessage, err := proto.Marshal(syncMessage)
+	if err != nil {
+		return err
+	}
+
+	_, err = m.dispatchMessage(ctx, &RawMessage{
+		LocalChatID:         chatID,
+		Payload:             encodedMessage,
+		MessageType:         protobuf.ApplicationMetadataMessage_SYNC_INSTALLATION_ACCOUNT,
+		ResendAutomatically: true,
+	})
+	if err != nil {
+		return err
+	}
+
+	chat.LastClockValue = clock
+	return m.saveChat(chat)
+}
@@ -38,6 +38,28 @@ const (
 	ApplicationMetadataMessage_SYNC_INSTALLATION_CONTACT               ApplicationMetadataMessage_Type = 12
 	ApplicationMetadataMessage_SYNC_INSTALLATION_ACCOUNT               ApplicationMetadataMessage_Type = 13
 	ApplicationMetadataMessage_SYNC_INSTALLATION_PUBLIC_CHAT           ApplicationMetadataMessage_Type = 14
+	ApplicationMetadataMessage_EMOJI_REACTION                          ApplicationMetadataMessage_Type = 15
+	ApplicationMetadataMessage_EDIT_MESSAGE                            ApplicationMetadataMessage_Type = 16
+	ApplicationMetadataMessage_DELETE_MESSAGE                          ApplicationMetadataMessage_Type = 17
+	ApplicationMetadataMessage_MESSAGE_CHUNK                           ApplicationMetadataMessage_Type = 18
+	ApplicationMetadataMessage_PIN_MESSAGE                             ApplicationMetadataMessage_Type = 19
+	ApplicationMetadataMessage_SYNC_CHAT_NOTIFICATION_SETTINGS         ApplicationMetadataMessage_Type = 20
+	ApplicationMetadataMessage_READ_RECEIPT                            ApplicationMetadataMessage_Type = 21
+	ApplicationMetadataMessage_SYNC_READ_RECEIPTS_SETTING              ApplicationMetadataMessage_Type = 22
+	ApplicationMetadataMessage_TYPING_STATUS                           ApplicationMetadataMessage_Type = 23
+	ApplicationMetadataMessage_DISAPPEARING_MESSAGES_SETTING           ApplicationMetadataMessage_Type = 24
+	ApplicationMetadataMessage_CONTACT_REQUEST                         ApplicationMetadataMessage_Type = 25
+	ApplicationMetadataMessage_ACCEPT_CONTACT_REQUEST                  ApplicationMetadataMessage_Type = 26
+	ApplicationMetadataMessage_DECLINE_CONTACT_REQUEST                 ApplicationMetadataMessage_Type = 27
+	ApplicationMetadataMessage_RETRACT_CONTACT_REQUEST                 ApplicationMetadataMessage_Type = 28
+	ApplicationMetadataMessage_GROUP_CHAT_JOIN_REQUEST                 ApplicationMetadataMessage_Type = 29
+	ApplicationMetadataMessage_COMMUNITY_DESCRIPTION                   ApplicationMetadataMessage_Type = 30
+	ApplicationMetadataMessage_COMMUNITY_REQUEST_TO_JOIN               ApplicationMetadataMessage_Type = 31
+	ApplicationMetadataMessage_COMMUNITY_REQUEST_TO_LEAVE              ApplicationMetadataMessage_Type = 32
+	ApplicationMetadataMessage_COMMUNITY_KEY                           ApplicationMetadataMessage_Type = 33
+	ApplicationMetadataMessage_POLL_VOTE                               ApplicationMetadataMessage_Type = 34
+	ApplicationMetadataMessage_GROUP_CHAT_JOIN_REQUEST_REJECTED        ApplicationMetadataMessage_Type = 35
+	ApplicationMetadataMessage_GROUP_CHAT_INVITATION_REVOKED           ApplicationMetadataMessage_Type = 36
 )
 
 var ApplicationMetadataMessage_Type_name = map[int32]string{
@@ -56,6 +78,28 @@ var ApplicationMetadataMessage_Type_name = map[int32]string{
 	12: "SYNC_INSTALLATION_CONTACT",
 	13: "SYNC_INSTALLATION_ACCOUNT",
 	14: "SYNC_INSTALLATION_PUBLIC_CHAT",
+	15: "EMOJI_REACTION",
+	16: "EDIT_MESSAGE",
+	17: "DELETE_MESSAGE",
+	18: "MESSAGE_CHUNK",
+	19: "PIN_MESSAGE",
+	20: "SYNC_CHAT_NOTIFICATION_SETTINGS",
+	21: "READ_RECEIPT",
+	22: "SYNC_READ_RECEIPTS_SETTING",
+	23: "TYPING_STATUS",
+	24: "DISAPPEARING_MESSAGES_SETTING",
+	25: "CONTACT_REQUEST",
+	26: "ACCEPT_CONTACT_REQUEST",
+	27: "DECLINE_CONTACT_REQUEST",
+	28: "RETRACT_CONTACT_REQUEST",
+	29: "GROUP_CHAT_JOIN_REQUEST",
+	30: "COMMUNITY_DESCRIPTION",
+	31: "COMMUNITY_REQUEST_TO_JOIN",
+	32: "COMMUNITY_REQUEST_TO_LEAVE",
+	33: "COMMUNITY_KEY",
+	34: "POLL_VOTE",
+	35: "GROUP_CHAT_JOIN_REQUEST_REJECTED",
+	36: "GROUP_CHAT_INVITATION_REVOKED",
 }
 
 var ApplicationMetadataMessage_Type_value = map[string]int32{
@@ -74,6 +118,28 @@ var ApplicationMetadataMessage_Type_value = map[string]int32{
 	"SYNC_INSTALLATION_CONTACT":               12,
 	"SYNC_INSTALLATION_ACCOUNT":               13,
 	"SYNC_INSTALLATION_PUBLIC_CHAT":           14,
+	"EMOJI_REACTION":                          15,
+	"EDIT_MESSAGE":                            16,
+	"DELETE_MESSAGE":                          17,
+	"MESSAGE_CHUNK":                           18,
+	"PIN_MESSAGE":                             19,
+	"SYNC_CHAT_NOTIFICATION_SETTINGS":         20,
+	"READ_RECEIPT":                            21,
+	"SYNC_READ_RECEIPTS_SETTING":              22,
+	"TYPING_STATUS":                           23,
+	"DISAPPEARING_MESSAGES_SETTING":           24,
+	"CONTACT_REQUEST":                         25,
+	"ACCEPT_CONTACT_REQUEST":                  26,
+	"DECLINE_CONTACT_REQUEST":                 27,
+	"RETRACT_CONTACT_REQUEST":                 28,
+	"GROUP_CHAT_JOIN_REQUEST":                 29,
+	"COMMUNITY_DESCRIPTION":                   30,
+	"COMMUNITY_REQUEST_TO_JOIN":               31,
+	"COMMUNITY_REQUEST_TO_LEAVE":              32,
+	"COMMUNITY_KEY":                           33,
+	"POLL_VOTE":                               34,
+	"GROUP_CHAT_JOIN_REQUEST_REJECTED":        35,
+	"GROUP_CHAT_INVITATION_REVOKED":           36,
 }
 
 func (x ApplicationMetadataMessage_Type) String() string {
@@ -150,29 +216,44 @@ func init() {
 func init() { proto.RegisterFile("application_metadata_message.proto", fileDescriptor_ad09a6406fcf24c7) }
 
 var fileDescriptor_ad09a6406fcf24c7 = []byte{
-	// 377 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x91, 0xcf, 0x8e, 0xd3, 0x30,
-	0x10, 0xc6, 0xc9, 0x36, 0x6c, 0x77, 0x67, 0x4b, 0x65, 0x06, 0x10, 0xe1, 0xcf, 0x6a, 0x97, 0x22,
-	0xc1, 0x02, 0x52, 0x0e, 0x70, 0xe6, 0xe0, 0x75, 0x0c, 0x1b, 0x91, 0x38, 0xc1, 0x76, 0x84, 0x38,
-	0x59, 0x2e, 0x0d, 0x55, 0xa5, 0xb6, 0x89, 0xda, 0xf4, 0xd0, 0x07, 0xe3, 0x29, 0x78, 0x29, 0x94,
-	0xd0, 0xd2, 0x96, 0x82, 0x7a, 0xb2, 0xe6, 0xfb, 0x7e, 0x9f, 0x47, 0x33, 0x03, 0x3d, 0x5b, 0x96,
-	0xe3, 0xd1, 0x37, 0x5b, 0x8d, 0x8a, 0xa9, 0x99, 0xe4, 0x95, 0x1d, 0xd8, 0xca, 0x9a, 0x49, 0x3e,
-	0x9f, 0xdb, 0x61, 0xee, 0x97, 0xb3, 0xa2, 0x2a, 0xf0, 0xa4, 0x79, 0xfa, 0x8b, 0xef, 0xbd, 0x9f,
-	0x2e, 0x3c, 0xa6, 0x9b, 0x40, 0xbc, 0xe2, 0xe3, 0xdf, 0x38, 0x3e, 0x85, 0xd3, 0xf9, 0x68, 0x38,
-	0xb5, 0xd5, 0x62, 0x96, 0x7b, 0xce, 0xa5, 0x73, 0xd5, 0x91, 0x1b, 0x01, 0x3d, 0x68, 0x97, 0x76,
-	0x39, 0x2e, 0xec, 0xc0, 0x3b, 0x6a, 0xbc, 0x75, 0x89, 0xef, 0xc1, 0xad, 0x96, 0x65, 0xee, 0xb5,
-	0x2e, 0x9d, 0xab, 0xee, 0xdb, 0x57, 0xfe, 0xba, 0x9f, 0xff, 0xff, 0x5e, 0xbe, 0x5e, 0x96, 0xb9,
-	0x6c, 0x62, 0xbd, 0x1f, 0x2d, 0x70, 0xeb, 0x12, 0xcf, 0xa0, 0x9d, 0x89, 0x4f, 0x22, 0xf9, 0x22,
-	0xc8, 0x2d, 0x24, 0xd0, 0x61, 0x37, 0x54, 0x9b, 0x98, 0x2b, 0x45, 0x3f, 0x72, 0xe2, 0x20, 0x42,
-	0x97, 0x25, 0x42, 0x53, 0xa6, 0x4d, 0x96, 0x06, 0x54, 0x73, 0x72, 0x84, 0xe7, 0xf0, 0x28, 0xe6,
-	0xf1, 0x35, 0x97, 0xea, 0x26, 0x4c, 0x57, 0xf2, 0x9f, 0x48, 0x0b, 0x1f, 0xc0, 0xdd, 0x94, 0x86,
-	0xd2, 0x84, 0x42, 0x69, 0x1a, 0x45, 0x54, 0x87, 0x89, 0x20, 0x6e, 0x2d, 0xab, 0xaf, 0x82, 0xed,
-	0xca, 0xb7, 0xf1, 0x39, 0x5c, 0x48, 0xfe, 0x39, 0xe3, 0x4a, 0x1b, 0x1a, 0x04, 0x92, 0x2b, 0x65,
-	0x3e, 0x24, 0xd2, 0x68, 0x49, 0x85, 0xa2, 0xac, 0x81, 0x8e, 0xf1, 0x35, 0xbc, 0xa0, 0x8c, 0xf1,
-	0x54, 0x9b, 0x43, 0x6c, 0x1b, 0xdf, 0xc0, 0xcb, 0x80, 0xb3, 0x28, 0x14, 0xfc, 0x20, 0x7c, 0x82,
-	0x0f, 0xe1, 0xde, 0x1a, 0xda, 0x36, 0x4e, 0xf1, 0x3e, 0x10, 0xc5, 0x45, 0xb0, 0xa3, 0x02, 0x5e,
-	0xc0, 0x93, 0xbf, 0xff, 0xde, 0x06, 0xce, 0xea, 0xd5, 0xec, 0x0d, 0x69, 0x56, 0x0b, 0x24, 0x9d,
-	0x7f, 0xdb, 0x94, 0xb1, 0x24, 0x13, 0x9a, 0xdc, 0xc1, 0x67, 0x70, 0xbe, 0x6f, 0xa7, 0xd9, 0x75,
-	0x14, 0x32, 0x53, 0xdf, 0x85, 0x74, 0xfb, 0xc7, 0xcd, 0x9d, 0xdf, 0xfd, 0x0a, 0x00, 0x00, 0xff,
-	0xff, 0xb7, 0x6c, 0xd6, 0xba, 0x84, 0x02, 0x00, 0x00,
+	// 621 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x94, 0x4b, 0x4f, 0x23, 0x39,
+	0x10, 0xc7, 0x37, 0xc0, 0x12, 0x52, 0xbc, 0x8c, 0x79, 0x85, 0xf0, 0x0a, 0x01, 0xed, 0xb2, 0xbb,
+	0x52, 0x0e, 0xbb, 0xe7, 0x3d, 0x18, 0x77, 0x11, 0x4c, 0xba, 0xed, 0x1e, 0xdb, 0x9d, 0x51, 0x4e,
+	0x56, 0x33, 0x64, 0x10, 0x12, 0x90, 0x08, 0xc2, 0x81, 0xf3, 0x7c, 0xde, 0xf9, 0x0e, 0x23, 0x77,
+	0x3a, 0x0f, 0x02, 0x88, 0x53, 0xcb, 0xf5, 0xfb, 0x97, 0xab, 0x5c, 0xff, 0x52, 0x43, 0x2d, 0xed,
+	0xf5, 0xee, 0x6e, 0xbf, 0xa5, 0xfd, 0xdb, 0xee, 0x83, 0xbb, 0xef, 0xf4, 0xd3, 0xeb, 0xb4, 0x9f,
+	0xba, 0xfb, 0xce, 0xd3, 0x53, 0x7a, 0xd3, 0xa9, 0xf7, 0x1e, 0xbb, 0xfd, 0x2e, 0x5d, 0xc8, 0x3e,
+	0x57, 0xcf, 0xdf, 0x6b, 0x3f, 0x4a, 0x50, 0x61, 0xe3, 0x84, 0x28, 0xd7, 0x47, 0x03, 0x39, 0xdd,
+	0x83, 0xd2, 0xd3, 0xed, 0xcd, 0x43, 0xda, 0x7f, 0x7e, 0xec, 0x94, 0x0b, 0xd5, 0xc2, 0xe9, 0x92,
+	0x1e, 0x07, 0x68, 0x19, 0x8a, 0xbd, 0xf4, 0xe5, 0xae, 0x9b, 0x5e, 0x97, 0x67, 0x32, 0x36, 0x3c,
+	0xd2, 0xff, 0x61, 0xae, 0xff, 0xd2, 0xeb, 0x94, 0x67, 0xab, 0x85, 0xd3, 0x95, 0x7f, 0xff, 0xaa,
+	0x0f, 0xeb, 0xd5, 0x3f, 0xae, 0x55, 0xb7, 0x2f, 0xbd, 0x8e, 0xce, 0xd2, 0x6a, 0x3f, 0x8b, 0x30,
+	0xe7, 0x8f, 0x74, 0x11, 0x8a, 0x89, 0x6c, 0x4a, 0xf5, 0x55, 0x92, 0xdf, 0x28, 0x81, 0x25, 0x7e,
+	0xc1, 0xac, 0x8b, 0xd0, 0x18, 0xd6, 0x40, 0x52, 0xa0, 0x14, 0x56, 0xb8, 0x92, 0x96, 0x71, 0xeb,
+	0x92, 0x38, 0x60, 0x16, 0xc9, 0x0c, 0xdd, 0x87, 0x9d, 0x08, 0xa3, 0x33, 0xd4, 0xe6, 0x42, 0xc4,
+	0x79, 0x78, 0x94, 0x32, 0x4b, 0x37, 0x61, 0x2d, 0x66, 0x42, 0x3b, 0x21, 0x8d, 0x65, 0x61, 0xc8,
+	0xac, 0x50, 0x92, 0xcc, 0xf9, 0xb0, 0x69, 0x4b, 0xfe, 0x3a, 0xfc, 0x3b, 0x3d, 0x86, 0x43, 0x8d,
+	0x5f, 0x12, 0x34, 0xd6, 0xb1, 0x20, 0xd0, 0x68, 0x8c, 0x3b, 0x57, 0xda, 0x59, 0xcd, 0xa4, 0x61,
+	0x3c, 0x13, 0xcd, 0xd3, 0xbf, 0xe1, 0x0f, 0xc6, 0x39, 0xc6, 0xd6, 0x7d, 0xa6, 0x2d, 0xd2, 0x7f,
+	0xe0, 0xcf, 0x00, 0x79, 0x28, 0x24, 0x7e, 0x2a, 0x5e, 0xa0, 0xdb, 0xb0, 0x3e, 0x14, 0x4d, 0x82,
+	0x12, 0xdd, 0x00, 0x62, 0x50, 0x06, 0xaf, 0xa2, 0x40, 0x0f, 0x61, 0x77, 0xfa, 0xee, 0x49, 0xc1,
+	0xa2, 0x1f, 0xcd, 0x9b, 0x47, 0xba, 0x7c, 0x80, 0x64, 0xe9, 0x7d, 0xcc, 0x38, 0x57, 0x89, 0xb4,
+	0x64, 0x99, 0x1e, 0xc1, 0xfe, 0x5b, 0x1c, 0x27, 0x67, 0xa1, 0xe0, 0xce, 0xfb, 0x42, 0x56, 0xbc,
+	0x1f, 0x18, 0xa9, 0x4b, 0xe1, 0x34, 0xe6, 0x45, 0x57, 0xbd, 0x6b, 0x18, 0x88, 0xb1, 0x6b, 0xc4,
+	0xab, 0x02, 0x0c, 0x71, 0xc2, 0x96, 0x35, 0xba, 0x06, 0xcb, 0xf9, 0xc1, 0xf1, 0x8b, 0x44, 0x36,
+	0x09, 0xa5, 0xab, 0xb0, 0x18, 0x0b, 0x39, 0xd2, 0xac, 0x7b, 0x33, 0xb2, 0x06, 0xb2, 0x25, 0x90,
+	0xca, 0x8a, 0x73, 0xc1, 0x07, 0x5d, 0x18, 0xb4, 0x56, 0xc8, 0x86, 0x21, 0x1b, 0xbe, 0x9c, 0x46,
+	0x16, 0x38, 0x8d, 0x1c, 0x45, 0x6c, 0xc9, 0x26, 0x3d, 0x80, 0x4a, 0x96, 0x36, 0x19, 0x36, 0xc3,
+	0x14, 0xb2, 0xe5, 0x4b, 0xdb, 0x76, 0x2c, 0x64, 0xc3, 0x19, 0xcb, 0x6c, 0x62, 0xc8, 0xb6, 0x7f,
+	0x6a, 0x20, 0x0c, 0x8b, 0x63, 0x64, 0xda, 0x83, 0xbc, 0x87, 0x71, 0x56, 0x99, 0xae, 0xc3, 0xea,
+	0x70, 0xf5, 0xf2, 0x61, 0x93, 0x1d, 0x5a, 0x81, 0xad, 0x7c, 0x13, 0xa6, 0x59, 0x85, 0xee, 0xc2,
+	0xf6, 0xd0, 0x9d, 0x69, 0xb8, 0xeb, 0xa1, 0x46, 0xab, 0x7d, 0x70, 0x1a, 0xee, 0x79, 0xd8, 0xd0,
+	0x2a, 0x89, 0x07, 0x0f, 0xbf, 0x54, 0x42, 0x8e, 0xe0, 0x3e, 0xdd, 0x81, 0x4d, 0xae, 0xa2, 0x28,
+	0x91, 0xc2, 0xb6, 0x5d, 0x80, 0x86, 0x6b, 0x11, 0x67, 0x93, 0x3f, 0xf0, 0x7e, 0x8e, 0xd1, 0x68,
+	0x23, 0x54, 0x76, 0x03, 0x39, 0xf4, 0x73, 0x79, 0x17, 0x87, 0xc8, 0x5a, 0x48, 0xaa, 0x7e, 0x2e,
+	0x63, 0xde, 0xc4, 0x36, 0x39, 0xa2, 0xcb, 0x50, 0x8a, 0x55, 0x18, 0xba, 0x96, 0xb2, 0x48, 0x6a,
+	0xf4, 0x04, 0xaa, 0x1f, 0x34, 0xe6, 0x34, 0x5e, 0x22, 0xb7, 0x18, 0x90, 0x63, 0x3f, 0xcc, 0x09,
+	0x95, 0x90, 0x2d, 0x61, 0x07, 0xae, 0x69, 0x6c, 0xa9, 0x26, 0x06, 0xe4, 0xe4, 0x6a, 0x3e, 0xfb,
+	0x3f, 0xfc, 0xf7, 0x6b, 0x00, 0x39, 0xe3, 0x27, 0xfc, 0xbc, 0x04, 0x00, 0x00,
 }
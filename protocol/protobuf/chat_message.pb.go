@@ -20,6 +20,71 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.ProtoPackageIsVersion3 // please upgrade the proto package
 
+type ImageMessage_ImageFormat int32
+
+const (
+	ImageMessage_UNKNOWN_IMAGE_FORMAT ImageMessage_ImageFormat = 0
+	ImageMessage_PNG                  ImageMessage_ImageFormat = 1
+	ImageMessage_JPEG                 ImageMessage_ImageFormat = 2
+	ImageMessage_GIF                  ImageMessage_ImageFormat = 3
+	ImageMessage_WEBP                 ImageMessage_ImageFormat = 4
+)
+
+var ImageMessage_ImageFormat_name = map[int32]string{
+	0: "UNKNOWN_IMAGE_FORMAT",
+	1: "PNG",
+	2: "JPEG",
+	3: "GIF",
+	4: "WEBP",
+}
+
+var ImageMessage_ImageFormat_value = map[string]int32{
+	"UNKNOWN_IMAGE_FORMAT": 0,
+	"PNG":                  1,
+	"JPEG":                 2,
+	"GIF":                  3,
+	"WEBP":                 4,
+}
+
+func (x ImageMessage_ImageFormat) String() string {
+	return proto.EnumName(ImageMessage_ImageFormat_name, int32(x))
+}
+
+func (ImageMessage_ImageFormat) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_263952f55fd35689, []int{1, 0}
+}
+
+type AudioMessage_AudioType int32
+
+const (
+	AudioMessage_UNKNOWN_AUDIO_TYPE AudioMessage_AudioType = 0
+	AudioMessage_AAC                AudioMessage_AudioType = 1
+	AudioMessage_AMR                AudioMessage_AudioType = 2
+	AudioMessage_OPUS               AudioMessage_AudioType = 3
+)
+
+var AudioMessage_AudioType_name = map[int32]string{
+	0: "UNKNOWN_AUDIO_TYPE",
+	1: "AAC",
+	2: "AMR",
+	3: "OPUS",
+}
+
+var AudioMessage_AudioType_value = map[string]int32{
+	"UNKNOWN_AUDIO_TYPE": 0,
+	"AAC":                1,
+	"AMR":                2,
+	"OPUS":               3,
+}
+
+func (x AudioMessage_AudioType) String() string {
+	return proto.EnumName(AudioMessage_AudioType_name, int32(x))
+}
+
+func (AudioMessage_AudioType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_263952f55fd35689, []int{2, 0}
+}
+
 type ChatMessage_MessageType int32
 
 const (
@@ -29,6 +94,10 @@ const (
 	ChatMessage_PRIVATE_GROUP        ChatMessage_MessageType = 3
 	// Only local
 	ChatMessage_SYSTEM_MESSAGE_PRIVATE_GROUP ChatMessage_MessageType = 4
+	ChatMessage_COMMUNITY_CHAT               ChatMessage_MessageType = 5
+	// Status updates and the comments on them, posted on the timeline of
+	// their author
+	ChatMessage_TIMELINE ChatMessage_MessageType = 6
 )
 
 var ChatMessage_MessageType_name = map[int32]string{
@@ -37,6 +106,8 @@ var ChatMessage_MessageType_name = map[int32]string{
 	2: "PUBLIC_GROUP",
 	3: "PRIVATE_GROUP",
 	4: "SYSTEM_MESSAGE_PRIVATE_GROUP",
+	5: "COMMUNITY_CHAT",
+	6: "TIMELINE",
 }
 
 var ChatMessage_MessageType_value = map[string]int32{
@@ -45,6 +116,8 @@ var ChatMessage_MessageType_value = map[string]int32{
 	"PUBLIC_GROUP":                 2,
 	"PRIVATE_GROUP":                3,
 	"SYSTEM_MESSAGE_PRIVATE_GROUP": 4,
+	"COMMUNITY_CHAT":               5,
+	"TIMELINE":                     6,
 }
 
 func (x ChatMessage_MessageType) String() string {
@@ -52,7 +125,7 @@ func (x ChatMessage_MessageType) String() string {
 }
 
 func (ChatMessage_MessageType) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_263952f55fd35689, []int{1, 0}
+	return fileDescriptor_263952f55fd35689, []int{6, 0}
 }
 
 type ChatMessage_ContentType int32
@@ -66,6 +139,9 @@ const (
 	ChatMessage_TRANSACTION_COMMAND  ChatMessage_ContentType = 5
 	// Only local
 	ChatMessage_SYSTEM_MESSAGE_CONTENT_PRIVATE_GROUP ChatMessage_ContentType = 6
+	ChatMessage_IMAGE                                ChatMessage_ContentType = 7
+	ChatMessage_AUDIO                                ChatMessage_ContentType = 8
+	ChatMessage_POLL                                 ChatMessage_ContentType = 9
 )
 
 var ChatMessage_ContentType_name = map[int32]string{
@@ -76,6 +152,9 @@ var ChatMessage_ContentType_name = map[int32]string{
 	4: "EMOJI",
 	5: "TRANSACTION_COMMAND",
 	6: "SYSTEM_MESSAGE_CONTENT_PRIVATE_GROUP",
+	7: "IMAGE",
+	8: "AUDIO",
+	9: "POLL",
 }
 
 var ChatMessage_ContentType_value = map[string]int32{
@@ -86,6 +165,9 @@ var ChatMessage_ContentType_value = map[string]int32{
 	"EMOJI":                                4,
 	"TRANSACTION_COMMAND":                  5,
 	"SYSTEM_MESSAGE_CONTENT_PRIVATE_GROUP": 6,
+	"IMAGE":                                7,
+	"AUDIO":                                8,
+	"POLL":                                 9,
 }
 
 func (x ChatMessage_ContentType) String() string {
@@ -93,7 +175,7 @@ func (x ChatMessage_ContentType) String() string {
 }
 
 func (ChatMessage_ContentType) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_263952f55fd35689, []int{1, 1}
+	return fileDescriptor_263952f55fd35689, []int{6, 1}
 }
 
 type StickerMessage struct {
@@ -143,6 +225,337 @@ func (m *StickerMessage) GetPack() int32 {
 	return 0
 }
 
+type ImageMessage struct {
+	// The encoded image
+	Payload []byte `protobuf:"bytes,1,opt,name=payload,proto3" json:"payload,omitempty"`
+	// The format the image is encoded with
+	Format ImageMessage_ImageFormat `protobuf:"varint,2,opt,name=format,proto3,enum=protobuf.ImageMessage_ImageFormat" json:"format,omitempty"`
+	// Width of the image in pixels
+	Width uint32 `protobuf:"varint,3,opt,name=width,proto3" json:"width,omitempty"`
+	// Height of the image in pixels
+	Height               uint32   `protobuf:"varint,4,opt,name=height,proto3" json:"height,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ImageMessage) Reset()         { *m = ImageMessage{} }
+func (m *ImageMessage) String() string { return proto.CompactTextString(m) }
+func (*ImageMessage) ProtoMessage()    {}
+func (*ImageMessage) Descriptor() ([]byte, []int) {
+	return fileDescriptor_263952f55fd35689, []int{1}
+}
+
+func (m *ImageMessage) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ImageMessage.Unmarshal(m, b)
+}
+func (m *ImageMessage) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ImageMessage.Marshal(b, m, deterministic)
+}
+func (m *ImageMessage) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ImageMessage.Merge(m, src)
+}
+func (m *ImageMessage) XXX_Size() int {
+	return xxx_messageInfo_ImageMessage.Size(m)
+}
+func (m *ImageMessage) XXX_DiscardUnknown() {
+	xxx_messageInfo_ImageMessage.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ImageMessage proto.InternalMessageInfo
+
+func (m *ImageMessage) GetPayload() []byte {
+	if m != nil {
+		return m.Payload
+	}
+	return nil
+}
+
+func (m *ImageMessage) GetFormat() ImageMessage_ImageFormat {
+	if m != nil {
+		return m.Format
+	}
+	return ImageMessage_UNKNOWN_IMAGE_FORMAT
+}
+
+func (m *ImageMessage) GetWidth() uint32 {
+	if m != nil {
+		return m.Width
+	}
+	return 0
+}
+
+func (m *ImageMessage) GetHeight() uint32 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+type AudioMessage struct {
+	// The encoded audio
+	Payload []byte `protobuf:"bytes,1,opt,name=payload,proto3" json:"payload,omitempty"`
+	// The codec the audio is encoded with
+	Type AudioMessage_AudioType `protobuf:"varint,2,opt,name=type,proto3,enum=protobuf.AudioMessage_AudioType" json:"type,omitempty"`
+	// Duration of the audio in milliseconds
+	DurationMs           uint64   `protobuf:"varint,3,opt,name=duration_ms,json=durationMs,proto3" json:"duration_ms,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *AudioMessage) Reset()         { *m = AudioMessage{} }
+func (m *AudioMessage) String() string { return proto.CompactTextString(m) }
+func (*AudioMessage) ProtoMessage()    {}
+func (*AudioMessage) Descriptor() ([]byte, []int) {
+	return fileDescriptor_263952f55fd35689, []int{2}
+}
+
+func (m *AudioMessage) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AudioMessage.Unmarshal(m, b)
+}
+func (m *AudioMessage) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AudioMessage.Marshal(b, m, deterministic)
+}
+func (m *AudioMessage) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AudioMessage.Merge(m, src)
+}
+func (m *AudioMessage) XXX_Size() int {
+	return xxx_messageInfo_AudioMessage.Size(m)
+}
+func (m *AudioMessage) XXX_DiscardUnknown() {
+	xxx_messageInfo_AudioMessage.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AudioMessage proto.InternalMessageInfo
+
+func (m *AudioMessage) GetPayload() []byte {
+	if m != nil {
+		return m.Payload
+	}
+	return nil
+}
+
+func (m *AudioMessage) GetType() AudioMessage_AudioType {
+	if m != nil {
+		return m.Type
+	}
+	return AudioMessage_UNKNOWN_AUDIO_TYPE
+}
+
+func (m *AudioMessage) GetDurationMs() uint64 {
+	if m != nil {
+		return m.DurationMs
+	}
+	return 0
+}
+
+type PollMessage struct {
+	// The question asked
+	Question string `protobuf:"bytes,1,opt,name=question,proto3" json:"question,omitempty"`
+	// The options voters choose from, votes refer to them by index
+	Options []string `protobuf:"bytes,2,rep,name=options,proto3" json:"options,omitempty"`
+	// Whether voters can choose more than one option
+	MultipleChoice bool `protobuf:"varint,3,opt,name=multiple_choice,json=multipleChoice,proto3" json:"multiple_choice,omitempty"`
+	// Unix timestamp in milliseconds after which votes are ignored, 0 if the
+	// poll never closes
+	ClosesAt             uint64   `protobuf:"varint,4,opt,name=closes_at,json=closesAt,proto3" json:"closes_at,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PollMessage) Reset()         { *m = PollMessage{} }
+func (m *PollMessage) String() string { return proto.CompactTextString(m) }
+func (*PollMessage) ProtoMessage()    {}
+func (*PollMessage) Descriptor() ([]byte, []int) {
+	return fileDescriptor_263952f55fd35689, []int{3}
+}
+
+func (m *PollMessage) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PollMessage.Unmarshal(m, b)
+}
+func (m *PollMessage) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PollMessage.Marshal(b, m, deterministic)
+}
+func (m *PollMessage) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PollMessage.Merge(m, src)
+}
+func (m *PollMessage) XXX_Size() int {
+	return xxx_messageInfo_PollMessage.Size(m)
+}
+func (m *PollMessage) XXX_DiscardUnknown() {
+	xxx_messageInfo_PollMessage.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PollMessage proto.InternalMessageInfo
+
+func (m *PollMessage) GetQuestion() string {
+	if m != nil {
+		return m.Question
+	}
+	return ""
+}
+
+func (m *PollMessage) GetOptions() []string {
+	if m != nil {
+		return m.Options
+	}
+	return nil
+}
+
+func (m *PollMessage) GetMultipleChoice() bool {
+	if m != nil {
+		return m.MultipleChoice
+	}
+	return false
+}
+
+func (m *PollMessage) GetClosesAt() uint64 {
+	if m != nil {
+		return m.ClosesAt
+	}
+	return 0
+}
+
+type UnfurledLink struct {
+	// The url of the link
+	Url string `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+	// Title of the linked page
+	Title string `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	// Description of the linked page
+	Description string `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	// Thumbnail of the linked page, encoded as fetched
+	ThumbnailPayload     []byte   `protobuf:"bytes,4,opt,name=thumbnail_payload,json=thumbnailPayload,proto3" json:"thumbnail_payload,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *UnfurledLink) Reset()         { *m = UnfurledLink{} }
+func (m *UnfurledLink) String() string { return proto.CompactTextString(m) }
+func (*UnfurledLink) ProtoMessage()    {}
+func (*UnfurledLink) Descriptor() ([]byte, []int) {
+	return fileDescriptor_263952f55fd35689, []int{4}
+}
+
+func (m *UnfurledLink) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UnfurledLink.Unmarshal(m, b)
+}
+func (m *UnfurledLink) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UnfurledLink.Marshal(b, m, deterministic)
+}
+func (m *UnfurledLink) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UnfurledLink.Merge(m, src)
+}
+func (m *UnfurledLink) XXX_Size() int {
+	return xxx_messageInfo_UnfurledLink.Size(m)
+}
+func (m *UnfurledLink) XXX_DiscardUnknown() {
+	xxx_messageInfo_UnfurledLink.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UnfurledLink proto.InternalMessageInfo
+
+func (m *UnfurledLink) GetUrl() string {
+	if m != nil {
+		return m.Url
+	}
+	return ""
+}
+
+func (m *UnfurledLink) GetTitle() string {
+	if m != nil {
+		return m.Title
+	}
+	return ""
+}
+
+func (m *UnfurledLink) GetDescription() string {
+	if m != nil {
+		return m.Description
+	}
+	return ""
+}
+
+func (m *UnfurledLink) GetThumbnailPayload() []byte {
+	if m != nil {
+		return m.ThumbnailPayload
+	}
+	return nil
+}
+
+// ForwardedFrom is the attribution of a forwarded message. It's asserted by
+// the sender of the forwarded message, receivers only trust it if they know
+// the original message and it matches
+type ForwardedFrom struct {
+	// Id of the original message
+	MessageId string `protobuf:"bytes,1,opt,name=message_id,json=messageId,proto3" json:"message_id,omitempty"`
+	// Public key of the author of the original message
+	From string `protobuf:"bytes,2,opt,name=from,proto3" json:"from,omitempty"`
+	// Timestamp of the original message
+	Timestamp uint64 `protobuf:"varint,3,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	// Hash of the transaction, only set when forwarding the result of a
+	// transaction command
+	TransactionHash      string   `protobuf:"bytes,4,opt,name=transaction_hash,json=transactionHash,proto3" json:"transaction_hash,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ForwardedFrom) Reset()         { *m = ForwardedFrom{} }
+func (m *ForwardedFrom) String() string { return proto.CompactTextString(m) }
+func (*ForwardedFrom) ProtoMessage()    {}
+func (*ForwardedFrom) Descriptor() ([]byte, []int) {
+	return fileDescriptor_263952f55fd35689, []int{5}
+}
+
+func (m *ForwardedFrom) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ForwardedFrom.Unmarshal(m, b)
+}
+func (m *ForwardedFrom) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ForwardedFrom.Marshal(b, m, deterministic)
+}
+func (m *ForwardedFrom) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ForwardedFrom.Merge(m, src)
+}
+func (m *ForwardedFrom) XXX_Size() int {
+	return xxx_messageInfo_ForwardedFrom.Size(m)
+}
+func (m *ForwardedFrom) XXX_DiscardUnknown() {
+	xxx_messageInfo_ForwardedFrom.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ForwardedFrom proto.InternalMessageInfo
+
+func (m *ForwardedFrom) GetMessageId() string {
+	if m != nil {
+		return m.MessageId
+	}
+	return ""
+}
+
+func (m *ForwardedFrom) GetFrom() string {
+	if m != nil {
+		return m.From
+	}
+	return ""
+}
+
+func (m *ForwardedFrom) GetTimestamp() uint64 {
+	if m != nil {
+		return m.Timestamp
+	}
+	return 0
+}
+
+func (m *ForwardedFrom) GetTransactionHash() string {
+	if m != nil {
+		return m.TransactionHash
+	}
+	return ""
+}
+
 type ChatMessage struct {
 	// Lamport timestamp of the chat message
 	Clock uint64 `protobuf:"varint,1,opt,name=clock,proto3" json:"clock,omitempty"`
@@ -166,17 +579,27 @@ type ChatMessage struct {
 	ContentType ChatMessage_ContentType `protobuf:"varint,8,opt,name=content_type,json=contentType,proto3,enum=protobuf.ChatMessage_ContentType" json:"content_type,omitempty"`
 	// Types that are valid to be assigned to Payload:
 	//	*ChatMessage_Sticker
-	Payload              isChatMessage_Payload `protobuf_oneof:"payload"`
-	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
-	XXX_unrecognized     []byte                `json:"-"`
-	XXX_sizecache        int32                 `json:"-"`
+	//	*ChatMessage_Image
+	//	*ChatMessage_Audio
+	//	*ChatMessage_Poll
+	Payload isChatMessage_Payload `protobuf_oneof:"payload"`
+	// Previews of the links contained in the text, unfurled by the sender
+	Links []*UnfurledLink `protobuf:"bytes,12,rep,name=links,proto3" json:"links,omitempty"`
+	// Attribution of the original message, set when the message is forwarded
+	ForwardedFrom *ForwardedFrom `protobuf:"bytes,13,opt,name=forwarded_from,json=forwardedFrom,proto3" json:"forwarded_from,omitempty"`
+	// Unix timestamp in milliseconds after which the message is deleted by
+	// all the participants of the chat, 0 if it never is
+	ExpiresAt            uint64   `protobuf:"varint,14,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *ChatMessage) Reset()         { *m = ChatMessage{} }
 func (m *ChatMessage) String() string { return proto.CompactTextString(m) }
 func (*ChatMessage) ProtoMessage()    {}
 func (*ChatMessage) Descriptor() ([]byte, []int) {
-	return fileDescriptor_263952f55fd35689, []int{1}
+	return fileDescriptor_263952f55fd35689, []int{6}
 }
 
 func (m *ChatMessage) XXX_Unmarshal(b []byte) error {
@@ -261,8 +684,26 @@ type ChatMessage_Sticker struct {
 	Sticker *StickerMessage `protobuf:"bytes,9,opt,name=sticker,proto3,oneof"`
 }
 
+type ChatMessage_Image struct {
+	Image *ImageMessage `protobuf:"bytes,10,opt,name=image,proto3,oneof"`
+}
+
+type ChatMessage_Audio struct {
+	Audio *AudioMessage `protobuf:"bytes,11,opt,name=audio,proto3,oneof"`
+}
+
+type ChatMessage_Poll struct {
+	Poll *PollMessage `protobuf:"bytes,15,opt,name=poll,proto3,oneof"`
+}
+
 func (*ChatMessage_Sticker) isChatMessage_Payload() {}
 
+func (*ChatMessage_Image) isChatMessage_Payload() {}
+
+func (*ChatMessage_Audio) isChatMessage_Payload() {}
+
+func (*ChatMessage_Poll) isChatMessage_Payload() {}
+
 func (m *ChatMessage) GetPayload() isChatMessage_Payload {
 	if m != nil {
 		return m.Payload
@@ -277,52 +718,138 @@ func (m *ChatMessage) GetSticker() *StickerMessage {
 	return nil
 }
 
+func (m *ChatMessage) GetImage() *ImageMessage {
+	if x, ok := m.GetPayload().(*ChatMessage_Image); ok {
+		return x.Image
+	}
+	return nil
+}
+
+func (m *ChatMessage) GetAudio() *AudioMessage {
+	if x, ok := m.GetPayload().(*ChatMessage_Audio); ok {
+		return x.Audio
+	}
+	return nil
+}
+
+func (m *ChatMessage) GetPoll() *PollMessage {
+	if x, ok := m.GetPayload().(*ChatMessage_Poll); ok {
+		return x.Poll
+	}
+	return nil
+}
+
+func (m *ChatMessage) GetLinks() []*UnfurledLink {
+	if m != nil {
+		return m.Links
+	}
+	return nil
+}
+
+func (m *ChatMessage) GetForwardedFrom() *ForwardedFrom {
+	if m != nil {
+		return m.ForwardedFrom
+	}
+	return nil
+}
+
+func (m *ChatMessage) GetExpiresAt() uint64 {
+	if m != nil {
+		return m.ExpiresAt
+	}
+	return 0
+}
+
 // XXX_OneofWrappers is for the internal use of the proto package.
 func (*ChatMessage) XXX_OneofWrappers() []interface{} {
 	return []interface{}{
 		(*ChatMessage_Sticker)(nil),
+		(*ChatMessage_Image)(nil),
+		(*ChatMessage_Audio)(nil),
+		(*ChatMessage_Poll)(nil),
 	}
 }
 
 func init() {
+	proto.RegisterEnum("protobuf.ImageMessage_ImageFormat", ImageMessage_ImageFormat_name, ImageMessage_ImageFormat_value)
+	proto.RegisterEnum("protobuf.AudioMessage_AudioType", AudioMessage_AudioType_name, AudioMessage_AudioType_value)
 	proto.RegisterEnum("protobuf.ChatMessage_MessageType", ChatMessage_MessageType_name, ChatMessage_MessageType_value)
 	proto.RegisterEnum("protobuf.ChatMessage_ContentType", ChatMessage_ContentType_name, ChatMessage_ContentType_value)
 	proto.RegisterType((*StickerMessage)(nil), "protobuf.StickerMessage")
+	proto.RegisterType((*ImageMessage)(nil), "protobuf.ImageMessage")
+	proto.RegisterType((*AudioMessage)(nil), "protobuf.AudioMessage")
+	proto.RegisterType((*PollMessage)(nil), "protobuf.PollMessage")
+	proto.RegisterType((*UnfurledLink)(nil), "protobuf.UnfurledLink")
+	proto.RegisterType((*ForwardedFrom)(nil), "protobuf.ForwardedFrom")
 	proto.RegisterType((*ChatMessage)(nil), "protobuf.ChatMessage")
 }
 
 func init() { proto.RegisterFile("chat_message.proto", fileDescriptor_263952f55fd35689) }
 
 var fileDescriptor_263952f55fd35689 = []byte{
-	// 472 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x91, 0xcd, 0x6e, 0xd3, 0x40,
-	0x10, 0xc7, 0xeb, 0xc6, 0x8e, 0x93, 0x71, 0x89, 0xcc, 0x50, 0xa9, 0x46, 0xaa, 0x44, 0x88, 0x38,
-	0xe4, 0x94, 0x43, 0xe1, 0xc0, 0xd5, 0x75, 0x57, 0xc5, 0xb4, 0x5e, 0x47, 0xf6, 0x06, 0xe8, 0x69,
-	0xb5, 0x75, 0x16, 0x12, 0xa5, 0xfe, 0x50, 0xbc, 0x48, 0xe4, 0xc2, 0x73, 0x70, 0xe2, 0x59, 0x91,
-	0xd7, 0x09, 0x49, 0x38, 0x70, 0xda, 0xf9, 0xfc, 0xcd, 0x7f, 0x66, 0x01, 0xb3, 0x85, 0x50, 0x3c,
-	0x97, 0x75, 0x2d, 0xbe, 0xc9, 0x49, 0xb5, 0x2e, 0x55, 0x89, 0x3d, 0xfd, 0x3c, 0x7e, 0xff, 0x3a,
-	0x7a, 0x0f, 0x83, 0x54, 0x2d, 0xb3, 0x95, 0x5c, 0x47, 0x6d, 0x05, 0x22, 0x98, 0x0b, 0x51, 0x2f,
-	0x3c, 0x63, 0x68, 0x8c, 0xfb, 0x89, 0xb6, 0x9b, 0x58, 0x25, 0xb2, 0x95, 0x77, 0x3a, 0x34, 0xc6,
-	0x56, 0xa2, 0xed, 0xd1, 0x2f, 0x0b, 0x9c, 0x60, 0x21, 0xd4, 0xae, 0xef, 0x1c, 0xac, 0xec, 0xa9,
-	0xcc, 0x56, 0xba, 0xd1, 0x4c, 0x5a, 0x07, 0x2f, 0xa1, 0xaf, 0x96, 0xb9, 0xac, 0x95, 0xc8, 0x2b,
-	0xdd, 0x6e, 0x26, 0xfb, 0x40, 0xc3, 0x55, 0xf2, 0x87, 0xf2, 0x3a, 0xed, 0xac, 0xc6, 0xc6, 0x57,
-	0xe0, 0xac, 0x65, 0x5d, 0x95, 0x45, 0x2d, 0xb9, 0x2a, 0x3d, 0x53, 0xa7, 0x60, 0x17, 0x62, 0x25,
-	0xbe, 0x84, 0x9e, 0x2c, 0x6a, 0x5e, 0x88, 0x5c, 0x7a, 0x96, 0xce, 0xda, 0xb2, 0xa8, 0xa9, 0xc8,
-	0x25, 0x5e, 0x80, 0xad, 0xb7, 0x5d, 0xce, 0xbd, 0xae, 0xce, 0x74, 0x1b, 0x37, 0x9c, 0xe3, 0x0d,
-	0x9c, 0x6d, 0x2f, 0xc0, 0xd5, 0xa6, 0x92, 0x9e, 0x3d, 0x34, 0xc6, 0x83, 0xab, 0xd7, 0x93, 0xdd,
-	0x1d, 0x26, 0x07, 0x9b, 0x4c, 0xb6, 0x2f, 0xdb, 0x54, 0x32, 0x71, 0xf2, 0xbd, 0xd3, 0x50, 0xb2,
-	0xb2, 0x50, 0xb2, 0x50, 0x2d, 0xa5, 0xf7, 0x3f, 0x4a, 0xd0, 0x56, 0xb6, 0x94, 0x6c, 0xef, 0xe0,
-	0x3b, 0xb0, 0xeb, 0xf6, 0xe4, 0x5e, 0x7f, 0x68, 0x8c, 0x9d, 0x2b, 0x6f, 0x0f, 0x38, 0xfe, 0x8b,
-	0x0f, 0x27, 0xc9, 0xae, 0x74, 0xf4, 0x13, 0x9c, 0x03, 0x5d, 0xe8, 0xc1, 0xf9, 0x8c, 0xde, 0xd1,
-	0xf8, 0x33, 0xe5, 0x11, 0x49, 0x53, 0xff, 0x96, 0x70, 0xf6, 0x30, 0x25, 0xee, 0x09, 0x0e, 0x00,
-	0x62, 0x4a, 0x38, 0x8b, 0x79, 0x4c, 0x89, 0x6b, 0xa0, 0x0b, 0x67, 0xd3, 0xd9, 0xf5, 0x7d, 0x18,
-	0xf0, 0xdb, 0x24, 0x9e, 0x4d, 0xdd, 0x53, 0x7c, 0x0e, 0xcf, 0xa6, 0x49, 0xf8, 0xc9, 0x67, 0x64,
-	0x1b, 0xea, 0xe0, 0x10, 0x2e, 0xd3, 0x87, 0x94, 0x91, 0xe8, 0x2f, 0xed, 0xb8, 0xc2, 0x1c, 0xfd,
-	0x36, 0xc0, 0x39, 0x58, 0xe9, 0x50, 0x40, 0x10, 0x53, 0x46, 0x28, 0x3b, 0x10, 0xc0, 0xc8, 0x17,
-	0xc6, 0xa7, 0xf7, 0x7e, 0x48, 0x5d, 0x03, 0x1d, 0xb0, 0x53, 0x16, 0x06, 0x77, 0x24, 0x71, 0x4f,
-	0x11, 0xa0, 0x9b, 0x32, 0x9f, 0xcd, 0x52, 0xb7, 0x83, 0x7d, 0xb0, 0x48, 0x14, 0x7f, 0x0c, 0x5d,
-	0x13, 0x2f, 0xe0, 0x05, 0x4b, 0x7c, 0x9a, 0xfa, 0x01, 0x0b, 0xe3, 0x86, 0x18, 0x45, 0x3e, 0xbd,
-	0x71, 0x2d, 0x1c, 0xc3, 0x9b, 0x7f, 0x84, 0xed, 0xa6, 0x1d, 0x0b, 0xec, 0x5e, 0xf7, 0xc1, 0xae,
-	0xc4, 0xe6, 0xa9, 0x14, 0xf3, 0xc7, 0xae, 0xbe, 0xe7, 0xdb, 0x3f, 0x01, 0x00, 0x00, 0xff, 0xff,
-	0x0e, 0x1e, 0x7b, 0x43, 0xfb, 0x02, 0x00, 0x00,
+	// 1022 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x55, 0xcb, 0x6e, 0xe3, 0x54,
+	0x18, 0xae, 0x13, 0xe7, 0xe2, 0x3f, 0x69, 0xea, 0x39, 0x94, 0xd6, 0xc0, 0x20, 0x82, 0x85, 0x44,
+	0xd0, 0xa0, 0x2e, 0x86, 0x59, 0x20, 0x16, 0x20, 0x37, 0x75, 0x5b, 0xcf, 0xc4, 0x17, 0x9d, 0x38,
+	0x0c, 0x5d, 0x59, 0xae, 0x7d, 0xd2, 0x58, 0xf5, 0x0d, 0xfb, 0x44, 0x33, 0x7d, 0x00, 0x16, 0x88,
+	0x97, 0xe0, 0x49, 0x10, 0xaf, 0xc2, 0x43, 0xb0, 0x47, 0xe7, 0xd8, 0x6e, 0x9c, 0x0a, 0xc1, 0xca,
+	0xff, 0xff, 0xf9, 0xfb, 0x6f, 0xfe, 0x2f, 0x06, 0x14, 0x6c, 0x7c, 0xea, 0x25, 0xa4, 0x2c, 0xfd,
+	0x3b, 0x72, 0x96, 0x17, 0x19, 0xcd, 0xd0, 0x90, 0x3f, 0x6e, 0xb7, 0x6b, 0xf5, 0x5b, 0x98, 0x2c,
+	0x69, 0x14, 0xdc, 0x93, 0xc2, 0xac, 0x18, 0x08, 0x81, 0xb8, 0xf1, 0xcb, 0x8d, 0x22, 0x4c, 0x85,
+	0x99, 0x84, 0xb9, 0xcc, 0xb0, 0xdc, 0x0f, 0xee, 0x95, 0xce, 0x54, 0x98, 0xf5, 0x30, 0x97, 0xd5,
+	0xbf, 0x04, 0x18, 0x1b, 0x89, 0x7f, 0x47, 0x1a, 0x43, 0x05, 0x06, 0xb9, 0xff, 0x10, 0x67, 0x7e,
+	0xc8, 0x6d, 0xc7, 0xb8, 0x51, 0xd1, 0x77, 0xd0, 0x5f, 0x67, 0x45, 0xe2, 0x53, 0xee, 0x60, 0xf2,
+	0x52, 0x3d, 0x6b, 0xe2, 0x9f, 0xb5, 0x3d, 0x54, 0xca, 0x25, 0x67, 0xe2, 0xda, 0x02, 0x1d, 0x43,
+	0xef, 0x5d, 0x14, 0xd2, 0x8d, 0xd2, 0x9d, 0x0a, 0xb3, 0x43, 0x5c, 0x29, 0xe8, 0x04, 0xfa, 0x1b,
+	0x12, 0xdd, 0x6d, 0xa8, 0x22, 0x72, 0xb8, 0xd6, 0x54, 0x13, 0x46, 0x2d, 0x27, 0x48, 0x81, 0xe3,
+	0x95, 0xf5, 0xc6, 0xb2, 0xdf, 0x5a, 0x9e, 0x61, 0x6a, 0x57, 0xba, 0x77, 0x69, 0x63, 0x53, 0x73,
+	0xe5, 0x03, 0x34, 0x80, 0xae, 0x63, 0x5d, 0xc9, 0x02, 0x1a, 0x82, 0xf8, 0xda, 0xd1, 0xaf, 0xe4,
+	0x0e, 0x83, 0xae, 0x8c, 0x4b, 0xb9, 0xcb, 0xa0, 0xb7, 0xfa, 0xb9, 0x23, 0x8b, 0xea, 0x9f, 0x02,
+	0x8c, 0xb5, 0x6d, 0x18, 0x65, 0xff, 0x5f, 0xe3, 0x2b, 0x10, 0xe9, 0x43, 0x4e, 0xea, 0x0a, 0xa7,
+	0xbb, 0x0a, 0xdb, 0xf6, 0x95, 0xe2, 0x3e, 0xe4, 0x04, 0x73, 0x36, 0xfa, 0x0c, 0x46, 0xe1, 0xb6,
+	0xf0, 0x69, 0x94, 0xa5, 0x5e, 0x52, 0xf2, 0x1a, 0x45, 0x0c, 0x0d, 0x64, 0x96, 0xea, 0x0f, 0x20,
+	0x3d, 0xda, 0xa0, 0x13, 0x40, 0x4d, 0x39, 0xda, 0xea, 0xc2, 0xb0, 0x3d, 0xf7, 0xc6, 0xd1, 0xab,
+	0x62, 0x34, 0x6d, 0x2e, 0x0b, 0x5c, 0x30, 0xb1, 0xdc, 0x61, 0x25, 0xd8, 0xce, 0x6a, 0x29, 0x77,
+	0xd5, 0x5f, 0x05, 0x18, 0x39, 0x59, 0x1c, 0x37, 0x15, 0x7c, 0x0c, 0xc3, 0x9f, 0xb7, 0xa4, 0x64,
+	0xee, 0xeb, 0x16, 0x3f, 0xea, 0xac, 0xba, 0x2c, 0x67, 0x52, 0xa9, 0x74, 0xa6, 0xdd, 0x99, 0x84,
+	0x1b, 0x15, 0x7d, 0x09, 0x47, 0xc9, 0x36, 0xa6, 0x51, 0x1e, 0x13, 0x2f, 0xd8, 0x64, 0x51, 0x40,
+	0x78, 0xae, 0x43, 0x3c, 0x69, 0xe0, 0x39, 0x47, 0xd1, 0x27, 0x20, 0x05, 0x71, 0x56, 0x92, 0xd2,
+	0xf3, 0xab, 0xde, 0x88, 0x78, 0x58, 0x01, 0x1a, 0x55, 0x7f, 0x11, 0x60, 0xbc, 0x4a, 0xd7, 0xdb,
+	0x22, 0x26, 0xe1, 0x22, 0x4a, 0xef, 0x91, 0x0c, 0xdd, 0x6d, 0x11, 0xd7, 0x79, 0x30, 0x91, 0xb5,
+	0x9b, 0x46, 0x34, 0xae, 0xbe, 0xa3, 0x84, 0x2b, 0x05, 0x4d, 0x61, 0x14, 0x92, 0x32, 0x28, 0x22,
+	0x9e, 0x0e, 0x0f, 0x2d, 0xe1, 0x36, 0x84, 0x5e, 0xc0, 0x33, 0xba, 0xd9, 0x26, 0xb7, 0xa9, 0x1f,
+	0xc5, 0x5e, 0xd3, 0x22, 0x91, 0xb7, 0x48, 0x7e, 0x7c, 0xe1, 0x54, 0xb8, 0xfa, 0x9b, 0x00, 0x87,
+	0x97, 0x59, 0xf1, 0xce, 0x2f, 0x42, 0x12, 0x5e, 0x16, 0x59, 0x82, 0x3e, 0x05, 0xa8, 0x37, 0xc4,
+	0x8b, 0xc2, 0x3a, 0x1f, 0xa9, 0x46, 0x8c, 0x90, 0xcd, 0xff, 0xba, 0xc8, 0x92, 0x3a, 0x29, 0x2e,
+	0xa3, 0xe7, 0x20, 0xd1, 0x28, 0x21, 0x25, 0xf5, 0x93, 0xbc, 0x6e, 0xdc, 0x0e, 0x40, 0x5f, 0x81,
+	0x4c, 0x0b, 0x3f, 0x2d, 0xfd, 0x80, 0xf7, 0x96, 0x6f, 0x94, 0xc8, 0xad, 0x8f, 0x5a, 0xf8, 0xb5,
+	0x5f, 0x6e, 0xd4, 0xbf, 0x07, 0x30, 0x9a, 0x6f, 0x7c, 0xda, 0x74, 0xe8, 0x18, 0x7a, 0x41, 0x9c,
+	0x05, 0xf7, 0x3c, 0x0d, 0x11, 0x57, 0xca, 0x7e, 0xb8, 0xce, 0xd3, 0x70, 0x08, 0x44, 0x4a, 0xde,
+	0xd3, 0xfa, 0xcb, 0x70, 0x99, 0xcd, 0x56, 0x41, 0xca, 0x3c, 0x4b, 0x4b, 0xe2, 0xd1, 0xac, 0x8e,
+	0x0e, 0x0d, 0xe4, 0x66, 0xe8, 0x23, 0x18, 0x92, 0xb4, 0xf4, 0x52, 0x3f, 0x21, 0x4a, 0x8f, 0xbf,
+	0x1d, 0x90, 0xb4, 0xb4, 0xfc, 0x84, 0xa0, 0x53, 0x18, 0xf0, 0xb3, 0x11, 0x85, 0x4a, 0x9f, 0xbf,
+	0xe9, 0x33, 0xd5, 0x08, 0xd1, 0x05, 0x8c, 0x9b, 0x0f, 0xc5, 0xc7, 0x7d, 0xc0, 0xc7, 0xfd, 0xf3,
+	0xdd, 0xb8, 0xb7, 0x2a, 0x39, 0xab, 0x9f, 0x7c, 0xde, 0x47, 0xc9, 0x4e, 0x61, 0x5e, 0x82, 0x2c,
+	0xa5, 0x24, 0xa5, 0x95, 0x97, 0xe1, 0x7f, 0x79, 0x99, 0x57, 0xcc, 0xca, 0x4b, 0xb0, 0x53, 0xd0,
+	0x2b, 0x18, 0x94, 0xd5, 0xed, 0x52, 0xa4, 0xa9, 0x30, 0x1b, 0xbd, 0x54, 0x76, 0x0e, 0xf6, 0x8f,
+	0xda, 0xf5, 0x01, 0x6e, 0xa8, 0xe8, 0x0c, 0x7a, 0x11, 0x3b, 0x11, 0x0a, 0x70, 0x9b, 0x93, 0x7f,
+	0xbf, 0x45, 0xd7, 0x07, 0xb8, 0xa2, 0x31, 0xbe, 0xcf, 0x36, 0x50, 0x19, 0x3d, 0xe5, 0xb7, 0x37,
+	0x9b, 0xf1, 0x39, 0x0d, 0xbd, 0x00, 0x31, 0xcf, 0xe2, 0x58, 0x39, 0xe2, 0xf4, 0x0f, 0x77, 0xf4,
+	0xd6, 0x16, 0x5e, 0x1f, 0x60, 0x4e, 0x42, 0x5f, 0x43, 0x2f, 0x8e, 0xd2, 0xfb, 0x52, 0x19, 0x4f,
+	0xbb, 0xfb, 0xce, 0xdb, 0x7b, 0x82, 0x2b, 0x12, 0xfa, 0x1e, 0x26, 0xeb, 0x66, 0x6c, 0x3d, 0x3e,
+	0x90, 0x87, 0x3c, 0xc8, 0xe9, 0xce, 0x6c, 0x6f, 0xac, 0xf1, 0xe1, 0xfa, 0xe9, 0x94, 0x93, 0xf7,
+	0x79, 0x54, 0x54, 0xdb, 0x39, 0xa9, 0x86, 0xa8, 0x46, 0x34, 0xaa, 0xfe, 0x2e, 0xc0, 0xa8, 0xd5,
+	0xb2, 0xf6, 0xf5, 0x34, 0xf5, 0xe5, 0x92, 0xdd, 0xcf, 0xfa, 0xe0, 0x4c, 0x00, 0x6c, 0x4b, 0xf7,
+	0x5c, 0xdb, 0xb3, 0x2d, 0x5d, 0x16, 0x90, 0x0c, 0x63, 0x67, 0x75, 0xbe, 0x30, 0xe6, 0xde, 0x15,
+	0xb6, 0x57, 0x8e, 0xdc, 0x41, 0xcf, 0xe0, 0xd0, 0xc1, 0xc6, 0x8f, 0x9a, 0xab, 0xd7, 0x50, 0x17,
+	0x4d, 0xe1, 0xf9, 0xf2, 0x66, 0xe9, 0xea, 0xe6, 0xa3, 0xb7, 0x7d, 0x86, 0x88, 0x10, 0x4c, 0xe6,
+	0xb6, 0x69, 0xae, 0x2c, 0xc3, 0xbd, 0xf1, 0xe6, 0xd7, 0x9a, 0x2b, 0xf7, 0xd0, 0x18, 0x86, 0xae,
+	0x61, 0xea, 0x0b, 0xc3, 0xd2, 0xe5, 0xbe, 0xfa, 0x87, 0x00, 0xa3, 0xd6, 0x3c, 0xb4, 0x53, 0x9c,
+	0xdb, 0x96, 0xab, 0x5b, 0x6e, 0x2b, 0x45, 0x57, 0xff, 0xc9, 0xf5, 0x9c, 0x85, 0x66, 0x58, 0xb2,
+	0x80, 0x46, 0x30, 0x58, 0xba, 0xc6, 0xfc, 0x8d, 0xce, 0xce, 0x23, 0x40, 0x7f, 0xe9, 0x6a, 0x2e,
+	0x3b, 0x90, 0x48, 0x82, 0x9e, 0x6e, 0xda, 0xaf, 0x0d, 0x59, 0x44, 0xa7, 0xf0, 0x81, 0x8b, 0x35,
+	0x6b, 0xa9, 0xcd, 0x5d, 0xc3, 0x66, 0x1e, 0x4d, 0x53, 0xb3, 0x2e, 0xe4, 0x1e, 0x9a, 0xc1, 0x17,
+	0x4f, 0x52, 0x6f, 0xa2, 0xed, 0x97, 0xd0, 0x67, 0xde, 0xf8, 0x9f, 0x46, 0x1e, 0x30, 0x91, 0x5f,
+	0x69, 0x79, 0xc8, 0xce, 0xb1, 0x63, 0x2f, 0x16, 0xb2, 0x74, 0x2e, 0x3d, 0xfe, 0x40, 0x6e, 0xfb,
+	0xbc, 0x69, 0xdf, 0xfc, 0x33, 0x00, 0x6c, 0x55, 0x37, 0xb9, 0xa1, 0x07, 0x00, 0x00,
 }
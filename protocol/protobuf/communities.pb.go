@@ -0,0 +1,530 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: communities.proto
+
+package protobuf
+
+import (
+	fmt "fmt"
+	proto "github.com/golang/protobuf/proto"
+	math "math"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.ProtoPackageIsVersion3 // please upgrade the proto package
+
+type CommunityDescription_Access int32
+
+const (
+	CommunityDescription_UNKNOWN_ACCESS CommunityDescription_Access = 0
+	// Anyone can join the community
+	CommunityDescription_OPEN CommunityDescription_Access = 1
+	// Requests to join the community are approved by the owner
+	CommunityDescription_REQUEST_TO_JOIN CommunityDescription_Access = 2
+)
+
+var CommunityDescription_Access_name = map[int32]string{
+	0: "UNKNOWN_ACCESS",
+	1: "OPEN",
+	2: "REQUEST_TO_JOIN",
+}
+
+var CommunityDescription_Access_value = map[string]int32{
+	"UNKNOWN_ACCESS":  0,
+	"OPEN":            1,
+	"REQUEST_TO_JOIN": 2,
+}
+
+func (x CommunityDescription_Access) String() string {
+	return proto.EnumName(CommunityDescription_Access_name, int32(x))
+}
+
+func (CommunityDescription_Access) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_f937943d74c1cd8b, []int{1, 0}
+}
+
+type CommunityChannel struct {
+	// Id of the channel, unique within its community
+	Id                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name                 string   `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Description          string   `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CommunityChannel) Reset()         { *m = CommunityChannel{} }
+func (m *CommunityChannel) String() string { return proto.CompactTextString(m) }
+func (*CommunityChannel) ProtoMessage()    {}
+func (*CommunityChannel) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f937943d74c1cd8b, []int{0}
+}
+
+func (m *CommunityChannel) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CommunityChannel.Unmarshal(m, b)
+}
+func (m *CommunityChannel) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CommunityChannel.Marshal(b, m, deterministic)
+}
+func (m *CommunityChannel) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CommunityChannel.Merge(m, src)
+}
+func (m *CommunityChannel) XXX_Size() int {
+	return xxx_messageInfo_CommunityChannel.Size(m)
+}
+func (m *CommunityChannel) XXX_DiscardUnknown() {
+	xxx_messageInfo_CommunityChannel.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CommunityChannel proto.InternalMessageInfo
+
+func (m *CommunityChannel) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *CommunityChannel) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *CommunityChannel) GetDescription() string {
+	if m != nil {
+		return m.Description
+	}
+	return ""
+}
+
+type CommunityDescription struct {
+	// Id of the community, a random uuid appended with the hex encoded public
+	// key of its owner
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Lamport timestamp of the description, the most recent one applies
+	Clock       uint64 `protobuf:"varint,2,opt,name=clock,proto3" json:"clock,omitempty"`
+	Name        string `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Description string `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	// Hex encoded public key of the owner, who signs the description
+	Owner  string                      `protobuf:"bytes,5,opt,name=owner,proto3" json:"owner,omitempty"`
+	Access CommunityDescription_Access `protobuf:"varint,6,opt,name=access,proto3,enum=protobuf.CommunityDescription_Access" json:"access,omitempty"`
+	// Hex encoded public keys of the members
+	Members  []string            `protobuf:"bytes,7,rep,name=members,proto3" json:"members,omitempty"`
+	Channels []*CommunityChannel `protobuf:"bytes,8,rep,name=channels,proto3" json:"channels,omitempty"`
+	// Id of the key the messages of the channels are encrypted with
+	KeyId                uint32   `protobuf:"varint,9,opt,name=key_id,json=keyId,proto3" json:"key_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CommunityDescription) Reset()         { *m = CommunityDescription{} }
+func (m *CommunityDescription) String() string { return proto.CompactTextString(m) }
+func (*CommunityDescription) ProtoMessage()    {}
+func (*CommunityDescription) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f937943d74c1cd8b, []int{1}
+}
+
+func (m *CommunityDescription) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CommunityDescription.Unmarshal(m, b)
+}
+func (m *CommunityDescription) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CommunityDescription.Marshal(b, m, deterministic)
+}
+func (m *CommunityDescription) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CommunityDescription.Merge(m, src)
+}
+func (m *CommunityDescription) XXX_Size() int {
+	return xxx_messageInfo_CommunityDescription.Size(m)
+}
+func (m *CommunityDescription) XXX_DiscardUnknown() {
+	xxx_messageInfo_CommunityDescription.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CommunityDescription proto.InternalMessageInfo
+
+func (m *CommunityDescription) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *CommunityDescription) GetClock() uint64 {
+	if m != nil {
+		return m.Clock
+	}
+	return 0
+}
+
+func (m *CommunityDescription) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *CommunityDescription) GetDescription() string {
+	if m != nil {
+		return m.Description
+	}
+	return ""
+}
+
+func (m *CommunityDescription) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+func (m *CommunityDescription) GetAccess() CommunityDescription_Access {
+	if m != nil {
+		return m.Access
+	}
+	return CommunityDescription_UNKNOWN_ACCESS
+}
+
+func (m *CommunityDescription) GetMembers() []string {
+	if m != nil {
+		return m.Members
+	}
+	return nil
+}
+
+func (m *CommunityDescription) GetChannels() []*CommunityChannel {
+	if m != nil {
+		return m.Channels
+	}
+	return nil
+}
+
+func (m *CommunityDescription) GetKeyId() uint32 {
+	if m != nil {
+		return m.KeyId
+	}
+	return 0
+}
+
+type SignedCommunityDescription struct {
+	// Encoded CommunityDescription
+	Payload []byte `protobuf:"bytes,1,opt,name=payload,proto3" json:"payload,omitempty"`
+	// Signature of the payload by the owner of the community
+	Signature            []byte   `protobuf:"bytes,2,opt,name=signature,proto3" json:"signature,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SignedCommunityDescription) Reset()         { *m = SignedCommunityDescription{} }
+func (m *SignedCommunityDescription) String() string { return proto.CompactTextString(m) }
+func (*SignedCommunityDescription) ProtoMessage()    {}
+func (*SignedCommunityDescription) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f937943d74c1cd8b, []int{2}
+}
+
+func (m *SignedCommunityDescription) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SignedCommunityDescription.Unmarshal(m, b)
+}
+func (m *SignedCommunityDescription) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SignedCommunityDescription.Marshal(b, m, deterministic)
+}
+func (m *SignedCommunityDescription) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SignedCommunityDescription.Merge(m, src)
+}
+func (m *SignedCommunityDescription) XXX_Size() int {
+	return xxx_messageInfo_SignedCommunityDescription.Size(m)
+}
+func (m *SignedCommunityDescription) XXX_DiscardUnknown() {
+	xxx_messageInfo_SignedCommunityDescription.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SignedCommunityDescription proto.InternalMessageInfo
+
+func (m *SignedCommunityDescription) GetPayload() []byte {
+	if m != nil {
+		return m.Payload
+	}
+	return nil
+}
+
+func (m *SignedCommunityDescription) GetSignature() []byte {
+	if m != nil {
+		return m.Signature
+	}
+	return nil
+}
+
+type CommunityRequestToJoin struct {
+	Clock                uint64   `protobuf:"varint,1,opt,name=clock,proto3" json:"clock,omitempty"`
+	CommunityId          string   `protobuf:"bytes,2,opt,name=community_id,json=communityId,proto3" json:"community_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CommunityRequestToJoin) Reset()         { *m = CommunityRequestToJoin{} }
+func (m *CommunityRequestToJoin) String() string { return proto.CompactTextString(m) }
+func (*CommunityRequestToJoin) ProtoMessage()    {}
+func (*CommunityRequestToJoin) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f937943d74c1cd8b, []int{3}
+}
+
+func (m *CommunityRequestToJoin) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CommunityRequestToJoin.Unmarshal(m, b)
+}
+func (m *CommunityRequestToJoin) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CommunityRequestToJoin.Marshal(b, m, deterministic)
+}
+func (m *CommunityRequestToJoin) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CommunityRequestToJoin.Merge(m, src)
+}
+func (m *CommunityRequestToJoin) XXX_Size() int {
+	return xxx_messageInfo_CommunityRequestToJoin.Size(m)
+}
+func (m *CommunityRequestToJoin) XXX_DiscardUnknown() {
+	xxx_messageInfo_CommunityRequestToJoin.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CommunityRequestToJoin proto.InternalMessageInfo
+
+func (m *CommunityRequestToJoin) GetClock() uint64 {
+	if m != nil {
+		return m.Clock
+	}
+	return 0
+}
+
+func (m *CommunityRequestToJoin) GetCommunityId() string {
+	if m != nil {
+		return m.CommunityId
+	}
+	return ""
+}
+
+type CommunityRequestToLeave struct {
+	Clock                uint64   `protobuf:"varint,1,opt,name=clock,proto3" json:"clock,omitempty"`
+	CommunityId          string   `protobuf:"bytes,2,opt,name=community_id,json=communityId,proto3" json:"community_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CommunityRequestToLeave) Reset()         { *m = CommunityRequestToLeave{} }
+func (m *CommunityRequestToLeave) String() string { return proto.CompactTextString(m) }
+func (*CommunityRequestToLeave) ProtoMessage()    {}
+func (*CommunityRequestToLeave) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f937943d74c1cd8b, []int{4}
+}
+
+func (m *CommunityRequestToLeave) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CommunityRequestToLeave.Unmarshal(m, b)
+}
+func (m *CommunityRequestToLeave) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CommunityRequestToLeave.Marshal(b, m, deterministic)
+}
+func (m *CommunityRequestToLeave) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CommunityRequestToLeave.Merge(m, src)
+}
+func (m *CommunityRequestToLeave) XXX_Size() int {
+	return xxx_messageInfo_CommunityRequestToLeave.Size(m)
+}
+func (m *CommunityRequestToLeave) XXX_DiscardUnknown() {
+	xxx_messageInfo_CommunityRequestToLeave.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CommunityRequestToLeave proto.InternalMessageInfo
+
+func (m *CommunityRequestToLeave) GetClock() uint64 {
+	if m != nil {
+		return m.Clock
+	}
+	return 0
+}
+
+func (m *CommunityRequestToLeave) GetCommunityId() string {
+	if m != nil {
+		return m.CommunityId
+	}
+	return ""
+}
+
+// CommunityKey is sent by the owner of a community to its members when they
+// join and whenever the key is rotated
+type CommunityKey struct {
+	Clock uint64 `protobuf:"varint,1,opt,name=clock,proto3" json:"clock,omitempty"`
+	KeyId uint32 `protobuf:"varint,2,opt,name=key_id,json=keyId,proto3" json:"key_id,omitempty"`
+	Key   []byte `protobuf:"bytes,3,opt,name=key,proto3" json:"key,omitempty"`
+	// The latest description of the community
+	Description          *SignedCommunityDescription `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                    `json:"-"`
+	XXX_unrecognized     []byte                      `json:"-"`
+	XXX_sizecache        int32                       `json:"-"`
+}
+
+func (m *CommunityKey) Reset()         { *m = CommunityKey{} }
+func (m *CommunityKey) String() string { return proto.CompactTextString(m) }
+func (*CommunityKey) ProtoMessage()    {}
+func (*CommunityKey) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f937943d74c1cd8b, []int{5}
+}
+
+func (m *CommunityKey) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CommunityKey.Unmarshal(m, b)
+}
+func (m *CommunityKey) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CommunityKey.Marshal(b, m, deterministic)
+}
+func (m *CommunityKey) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CommunityKey.Merge(m, src)
+}
+func (m *CommunityKey) XXX_Size() int {
+	return xxx_messageInfo_CommunityKey.Size(m)
+}
+func (m *CommunityKey) XXX_DiscardUnknown() {
+	xxx_messageInfo_CommunityKey.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CommunityKey proto.InternalMessageInfo
+
+func (m *CommunityKey) GetClock() uint64 {
+	if m != nil {
+		return m.Clock
+	}
+	return 0
+}
+
+func (m *CommunityKey) GetKeyId() uint32 {
+	if m != nil {
+		return m.KeyId
+	}
+	return 0
+}
+
+func (m *CommunityKey) GetKey() []byte {
+	if m != nil {
+		return m.Key
+	}
+	return nil
+}
+
+func (m *CommunityKey) GetDescription() *SignedCommunityDescription {
+	if m != nil {
+		return m.Description
+	}
+	return nil
+}
+
+// CommunityEncryptedMessage is the payload of the messages sent to the
+// channels of a community
+type CommunityEncryptedMessage struct {
+	CommunityId string `protobuf:"bytes,1,opt,name=community_id,json=communityId,proto3" json:"community_id,omitempty"`
+	KeyId       uint32 `protobuf:"varint,2,opt,name=key_id,json=keyId,proto3" json:"key_id,omitempty"`
+	// The message encrypted with the key
+	Payload              []byte   `protobuf:"bytes,3,opt,name=payload,proto3" json:"payload,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CommunityEncryptedMessage) Reset()         { *m = CommunityEncryptedMessage{} }
+func (m *CommunityEncryptedMessage) String() string { return proto.CompactTextString(m) }
+func (*CommunityEncryptedMessage) ProtoMessage()    {}
+func (*CommunityEncryptedMessage) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f937943d74c1cd8b, []int{6}
+}
+
+func (m *CommunityEncryptedMessage) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CommunityEncryptedMessage.Unmarshal(m, b)
+}
+func (m *CommunityEncryptedMessage) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CommunityEncryptedMessage.Marshal(b, m, deterministic)
+}
+func (m *CommunityEncryptedMessage) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CommunityEncryptedMessage.Merge(m, src)
+}
+func (m *CommunityEncryptedMessage) XXX_Size() int {
+	return xxx_messageInfo_CommunityEncryptedMessage.Size(m)
+}
+func (m *CommunityEncryptedMessage) XXX_DiscardUnknown() {
+	xxx_messageInfo_CommunityEncryptedMessage.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CommunityEncryptedMessage proto.InternalMessageInfo
+
+func (m *CommunityEncryptedMessage) GetCommunityId() string {
+	if m != nil {
+		return m.CommunityId
+	}
+	return ""
+}
+
+func (m *CommunityEncryptedMessage) GetKeyId() uint32 {
+	if m != nil {
+		return m.KeyId
+	}
+	return 0
+}
+
+func (m *CommunityEncryptedMessage) GetPayload() []byte {
+	if m != nil {
+		return m.Payload
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterEnum("protobuf.CommunityDescription_Access", CommunityDescription_Access_name, CommunityDescription_Access_value)
+	proto.RegisterType((*CommunityChannel)(nil), "protobuf.CommunityChannel")
+	proto.RegisterType((*CommunityDescription)(nil), "protobuf.CommunityDescription")
+	proto.RegisterType((*SignedCommunityDescription)(nil), "protobuf.SignedCommunityDescription")
+	proto.RegisterType((*CommunityRequestToJoin)(nil), "protobuf.CommunityRequestToJoin")
+	proto.RegisterType((*CommunityRequestToLeave)(nil), "protobuf.CommunityRequestToLeave")
+	proto.RegisterType((*CommunityKey)(nil), "protobuf.CommunityKey")
+	proto.RegisterType((*CommunityEncryptedMessage)(nil), "protobuf.CommunityEncryptedMessage")
+}
+
+func init() { proto.RegisterFile("communities.proto", fileDescriptor_f937943d74c1cd8b) }
+
+var fileDescriptor_f937943d74c1cd8b = []byte{
+	// 470 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x53, 0xcd, 0x6e, 0xd3, 0x40,
+	0x10, 0xc6, 0x76, 0x7e, 0x27, 0x21, 0x84, 0xa5, 0xc0, 0x52, 0x71, 0x30, 0x16, 0x48, 0x3e, 0xe5,
+	0x10, 0x24, 0x2e, 0x88, 0x43, 0x15, 0x8c, 0x94, 0x16, 0x1c, 0xba, 0x49, 0x05, 0xb7, 0xc8, 0xb1,
+	0x87, 0xb0, 0x4a, 0xbc, 0x1b, 0xbc, 0x0e, 0xc8, 0x0f, 0xc2, 0x63, 0xf2, 0x0e, 0xa8, 0xeb, 0xc4,
+	0x4e, 0xe5, 0xe4, 0xd2, 0x93, 0xe7, 0xcf, 0xdf, 0xcc, 0x7c, 0xdf, 0x2c, 0x3c, 0x0e, 0x65, 0x1c,
+	0x6f, 0x05, 0x4f, 0x39, 0xaa, 0xc1, 0x26, 0x91, 0xa9, 0x24, 0x2d, 0xfd, 0x59, 0x6c, 0x7f, 0x38,
+	0xdf, 0xa1, 0x3f, 0xda, 0xa5, 0xb3, 0xd1, 0xcf, 0x40, 0x08, 0x5c, 0x93, 0x1e, 0x98, 0x3c, 0xa2,
+	0x86, 0x6d, 0xb8, 0x6d, 0x66, 0xf2, 0x88, 0x10, 0xa8, 0x89, 0x20, 0x46, 0x6a, 0xea, 0x88, 0xb6,
+	0x89, 0x0d, 0x9d, 0x08, 0x55, 0x98, 0xf0, 0x4d, 0xca, 0xa5, 0xa0, 0x96, 0x4e, 0x1d, 0x86, 0x9c,
+	0x7f, 0x26, 0x9c, 0x15, 0xd0, 0x1f, 0xcb, 0x44, 0x05, 0xfe, 0x0c, 0xea, 0xe1, 0x5a, 0x86, 0x2b,
+	0x8d, 0x5f, 0x63, 0xb9, 0x53, 0x34, 0xb5, 0x4e, 0x37, 0xad, 0x55, 0x9a, 0xde, 0x62, 0xc9, 0x3f,
+	0x02, 0x13, 0x5a, 0xd7, 0xb9, 0xdc, 0x21, 0x1f, 0xa0, 0x11, 0x84, 0x21, 0x2a, 0x45, 0x1b, 0xb6,
+	0xe1, 0xf6, 0x86, 0x6f, 0x06, 0xfb, 0xfd, 0x07, 0xc7, 0x26, 0x1c, 0x5c, 0xe8, 0x62, 0xb6, 0xfb,
+	0x89, 0x50, 0x68, 0xc6, 0x18, 0x2f, 0x30, 0x51, 0xb4, 0x69, 0x5b, 0x6e, 0x9b, 0xed, 0x5d, 0xf2,
+	0x0e, 0x5a, 0x61, 0x4e, 0x9a, 0xa2, 0x2d, 0xdb, 0x72, 0x3b, 0xc3, 0xf3, 0x23, 0xd0, 0x3b, 0x5e,
+	0x59, 0x51, 0x4b, 0x9e, 0x42, 0x63, 0x85, 0xd9, 0x9c, 0x47, 0xb4, 0x6d, 0x1b, 0xee, 0x43, 0x56,
+	0x5f, 0x61, 0x36, 0x8e, 0x9c, 0xf7, 0xd0, 0xc8, 0x5b, 0x13, 0x02, 0xbd, 0x1b, 0xff, 0xca, 0x9f,
+	0x7c, 0xf3, 0xe7, 0x17, 0xa3, 0x91, 0x37, 0x9d, 0xf6, 0x1f, 0x90, 0x16, 0xd4, 0x26, 0x5f, 0x3d,
+	0xbf, 0x6f, 0x90, 0x27, 0xf0, 0x88, 0x79, 0xd7, 0x37, 0xde, 0x74, 0x36, 0x9f, 0x4d, 0xe6, 0x97,
+	0x93, 0xb1, 0xdf, 0x37, 0x9d, 0x19, 0x9c, 0x4f, 0xf9, 0x52, 0x60, 0x74, 0x94, 0x74, 0x0a, 0xcd,
+	0x4d, 0x90, 0xad, 0x65, 0x90, 0x33, 0xdf, 0x65, 0x7b, 0x97, 0xbc, 0x84, 0xb6, 0xe2, 0x4b, 0x11,
+	0xa4, 0xdb, 0x24, 0x97, 0xb8, 0xcb, 0xca, 0x80, 0x73, 0x0d, 0xcf, 0x0a, 0x3c, 0x86, 0xbf, 0xb6,
+	0xa8, 0xd2, 0x99, 0xbc, 0x94, 0x5c, 0x94, 0xb2, 0x19, 0x87, 0xb2, 0xbd, 0x82, 0xee, 0xfe, 0xdc,
+	0xf4, 0x7e, 0xf9, 0xcd, 0x74, 0x8a, 0xd8, 0x38, 0x72, 0x18, 0x3c, 0xaf, 0x42, 0x7e, 0xc6, 0xe0,
+	0x37, 0xde, 0x1f, 0xf3, 0xaf, 0x01, 0xdd, 0x02, 0xf4, 0x0a, 0xb3, 0x13, 0x48, 0x25, 0xef, 0xe6,
+	0x01, 0xef, 0xa4, 0x0f, 0xd6, 0x0a, 0x33, 0x7d, 0x6a, 0x5d, 0x76, 0x6b, 0x92, 0x4f, 0xd5, 0x4b,
+	0xeb, 0x0c, 0x5f, 0x97, 0xda, 0x9e, 0x66, 0xfa, 0xee, 0x23, 0x90, 0xf0, 0xa2, 0x28, 0xf2, 0x44,
+	0x98, 0x64, 0x9b, 0x14, 0xa3, 0x2f, 0xa8, 0x54, 0xb0, 0xc4, 0xca, 0x5e, 0x46, 0x65, 0xaf, 0x53,
+	0x03, 0x1f, 0xa8, 0x69, 0xdd, 0x51, 0x73, 0xd1, 0xd0, 0x23, 0xbe, 0xfd, 0x3f, 0x00, 0xdb, 0x5a,
+	0x9e, 0x1a, 0xf5, 0x03, 0x00, 0x00,
+}
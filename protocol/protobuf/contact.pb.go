@@ -21,12 +21,18 @@ var _ = math.Inf
 const _ = proto.ProtoPackageIsVersion3 // please upgrade the proto package
 
 type ContactUpdate struct {
-	Clock                uint64   `protobuf:"varint,1,opt,name=clock,proto3" json:"clock,omitempty"`
-	EnsName              string   `protobuf:"bytes,2,opt,name=ens_name,json=ensName,proto3" json:"ens_name,omitempty"`
-	ProfileImage         string   `protobuf:"bytes,3,opt,name=profile_image,json=profileImage,proto3" json:"profile_image,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	Clock        uint64 `protobuf:"varint,1,opt,name=clock,proto3" json:"clock,omitempty"`
+	EnsName      string `protobuf:"bytes,2,opt,name=ens_name,json=ensName,proto3" json:"ens_name,omitempty"`
+	ProfileImage string `protobuf:"bytes,3,opt,name=profile_image,json=profileImage,proto3" json:"profile_image,omitempty"`
+	// Name chosen by the user, shown when they have no verified ENS name
+	DisplayName string `protobuf:"bytes,4,opt,name=display_name,json=displayName,proto3" json:"display_name,omitempty"`
+	Bio         string `protobuf:"bytes,5,opt,name=bio,proto3" json:"bio,omitempty"`
+	// The avatar of the user, in several sizes
+	Images               []*ProfileImage `protobuf:"bytes,6,rep,name=images,proto3" json:"images,omitempty"`
+	ProfileLink          string          `protobuf:"bytes,7,opt,name=profile_link,json=profileLink,proto3" json:"profile_link,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
 }
 
 func (m *ContactUpdate) Reset()         { *m = ContactUpdate{} }
@@ -75,21 +81,298 @@ func (m *ContactUpdate) GetProfileImage() string {
 	return ""
 }
 
+func (m *ContactUpdate) GetDisplayName() string {
+	if m != nil {
+		return m.DisplayName
+	}
+	return ""
+}
+
+func (m *ContactUpdate) GetBio() string {
+	if m != nil {
+		return m.Bio
+	}
+	return ""
+}
+
+func (m *ContactUpdate) GetImages() []*ProfileImage {
+	if m != nil {
+		return m.Images
+	}
+	return nil
+}
+
+func (m *ContactUpdate) GetProfileLink() string {
+	if m != nil {
+		return m.ProfileLink
+	}
+	return ""
+}
+
+type ProfileImage struct {
+	// Size of the image, e.g. thumbnail or large
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Base64 encoded image
+	Payload              string   `protobuf:"bytes,2,opt,name=payload,proto3" json:"payload,omitempty"`
+	Width                uint32   `protobuf:"varint,3,opt,name=width,proto3" json:"width,omitempty"`
+	Height               uint32   `protobuf:"varint,4,opt,name=height,proto3" json:"height,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ProfileImage) Reset()         { *m = ProfileImage{} }
+func (m *ProfileImage) String() string { return proto.CompactTextString(m) }
+func (*ProfileImage) ProtoMessage()    {}
+func (*ProfileImage) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a5036fff2565fb15, []int{1}
+}
+
+func (m *ProfileImage) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ProfileImage.Unmarshal(m, b)
+}
+func (m *ProfileImage) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ProfileImage.Marshal(b, m, deterministic)
+}
+func (m *ProfileImage) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProfileImage.Merge(m, src)
+}
+func (m *ProfileImage) XXX_Size() int {
+	return xxx_messageInfo_ProfileImage.Size(m)
+}
+func (m *ProfileImage) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProfileImage.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ProfileImage proto.InternalMessageInfo
+
+func (m *ProfileImage) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *ProfileImage) GetPayload() string {
+	if m != nil {
+		return m.Payload
+	}
+	return ""
+}
+
+func (m *ProfileImage) GetWidth() uint32 {
+	if m != nil {
+		return m.Width
+	}
+	return 0
+}
+
+func (m *ProfileImage) GetHeight() uint32 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+type ContactRequest struct {
+	// Lamport timestamp of the request, the most recent of the requests,
+	// acceptances, declines and retractions exchanged with a contact is the
+	// one that applies
+	Clock uint64 `protobuf:"varint,1,opt,name=clock,proto3" json:"clock,omitempty"`
+	// Introduction text shown to the recipient of the request
+	Text                 string   `protobuf:"bytes,2,opt,name=text,proto3" json:"text,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ContactRequest) Reset()         { *m = ContactRequest{} }
+func (m *ContactRequest) String() string { return proto.CompactTextString(m) }
+func (*ContactRequest) ProtoMessage()    {}
+func (*ContactRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a5036fff2565fb15, []int{2}
+}
+
+func (m *ContactRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ContactRequest.Unmarshal(m, b)
+}
+func (m *ContactRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ContactRequest.Marshal(b, m, deterministic)
+}
+func (m *ContactRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ContactRequest.Merge(m, src)
+}
+func (m *ContactRequest) XXX_Size() int {
+	return xxx_messageInfo_ContactRequest.Size(m)
+}
+func (m *ContactRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ContactRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ContactRequest proto.InternalMessageInfo
+
+func (m *ContactRequest) GetClock() uint64 {
+	if m != nil {
+		return m.Clock
+	}
+	return 0
+}
+
+func (m *ContactRequest) GetText() string {
+	if m != nil {
+		return m.Text
+	}
+	return ""
+}
+
+type AcceptContactRequest struct {
+	Clock                uint64   `protobuf:"varint,1,opt,name=clock,proto3" json:"clock,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *AcceptContactRequest) Reset()         { *m = AcceptContactRequest{} }
+func (m *AcceptContactRequest) String() string { return proto.CompactTextString(m) }
+func (*AcceptContactRequest) ProtoMessage()    {}
+func (*AcceptContactRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a5036fff2565fb15, []int{3}
+}
+
+func (m *AcceptContactRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AcceptContactRequest.Unmarshal(m, b)
+}
+func (m *AcceptContactRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AcceptContactRequest.Marshal(b, m, deterministic)
+}
+func (m *AcceptContactRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AcceptContactRequest.Merge(m, src)
+}
+func (m *AcceptContactRequest) XXX_Size() int {
+	return xxx_messageInfo_AcceptContactRequest.Size(m)
+}
+func (m *AcceptContactRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_AcceptContactRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AcceptContactRequest proto.InternalMessageInfo
+
+func (m *AcceptContactRequest) GetClock() uint64 {
+	if m != nil {
+		return m.Clock
+	}
+	return 0
+}
+
+type DeclineContactRequest struct {
+	Clock                uint64   `protobuf:"varint,1,opt,name=clock,proto3" json:"clock,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DeclineContactRequest) Reset()         { *m = DeclineContactRequest{} }
+func (m *DeclineContactRequest) String() string { return proto.CompactTextString(m) }
+func (*DeclineContactRequest) ProtoMessage()    {}
+func (*DeclineContactRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a5036fff2565fb15, []int{4}
+}
+
+func (m *DeclineContactRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DeclineContactRequest.Unmarshal(m, b)
+}
+func (m *DeclineContactRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DeclineContactRequest.Marshal(b, m, deterministic)
+}
+func (m *DeclineContactRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeclineContactRequest.Merge(m, src)
+}
+func (m *DeclineContactRequest) XXX_Size() int {
+	return xxx_messageInfo_DeclineContactRequest.Size(m)
+}
+func (m *DeclineContactRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeclineContactRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeclineContactRequest proto.InternalMessageInfo
+
+func (m *DeclineContactRequest) GetClock() uint64 {
+	if m != nil {
+		return m.Clock
+	}
+	return 0
+}
+
+type RetractContactRequest struct {
+	Clock                uint64   `protobuf:"varint,1,opt,name=clock,proto3" json:"clock,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RetractContactRequest) Reset()         { *m = RetractContactRequest{} }
+func (m *RetractContactRequest) String() string { return proto.CompactTextString(m) }
+func (*RetractContactRequest) ProtoMessage()    {}
+func (*RetractContactRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a5036fff2565fb15, []int{5}
+}
+
+func (m *RetractContactRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RetractContactRequest.Unmarshal(m, b)
+}
+func (m *RetractContactRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RetractContactRequest.Marshal(b, m, deterministic)
+}
+func (m *RetractContactRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RetractContactRequest.Merge(m, src)
+}
+func (m *RetractContactRequest) XXX_Size() int {
+	return xxx_messageInfo_RetractContactRequest.Size(m)
+}
+func (m *RetractContactRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RetractContactRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RetractContactRequest proto.InternalMessageInfo
+
+func (m *RetractContactRequest) GetClock() uint64 {
+	if m != nil {
+		return m.Clock
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*ContactUpdate)(nil), "protobuf.ContactUpdate")
+	proto.RegisterType((*ProfileImage)(nil), "protobuf.ProfileImage")
+	proto.RegisterType((*ContactRequest)(nil), "protobuf.ContactRequest")
+	proto.RegisterType((*AcceptContactRequest)(nil), "protobuf.AcceptContactRequest")
+	proto.RegisterType((*DeclineContactRequest)(nil), "protobuf.DeclineContactRequest")
+	proto.RegisterType((*RetractContactRequest)(nil), "protobuf.RetractContactRequest")
 }
 
 func init() { proto.RegisterFile("contact.proto", fileDescriptor_a5036fff2565fb15) }
 
 var fileDescriptor_a5036fff2565fb15 = []byte{
-	// 135 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0xe2, 0x4d, 0xce, 0xcf, 0x2b,
-	0x49, 0x4c, 0x2e, 0xd1, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0xe2, 0x00, 0x53, 0x49, 0xa5, 0x69,
-	0x4a, 0xa9, 0x5c, 0xbc, 0xce, 0x10, 0xa9, 0xd0, 0x82, 0x94, 0xc4, 0x92, 0x54, 0x21, 0x11, 0x2e,
-	0xd6, 0xe4, 0x9c, 0xfc, 0xe4, 0x6c, 0x09, 0x46, 0x05, 0x46, 0x0d, 0x96, 0x20, 0x08, 0x47, 0x48,
-	0x92, 0x8b, 0x23, 0x35, 0xaf, 0x38, 0x3e, 0x2f, 0x31, 0x37, 0x55, 0x82, 0x49, 0x81, 0x51, 0x83,
-	0x33, 0x88, 0x3d, 0x35, 0xaf, 0xd8, 0x2f, 0x31, 0x37, 0x55, 0x48, 0x99, 0x8b, 0xb7, 0xa0, 0x28,
-	0x3f, 0x2d, 0x33, 0x27, 0x35, 0x3e, 0x33, 0x37, 0x31, 0x3d, 0x55, 0x82, 0x19, 0x2c, 0xcf, 0x03,
-	0x15, 0xf4, 0x04, 0x89, 0x25, 0xb1, 0x81, 0x2d, 0x34, 0x06, 0x04, 0x00, 0x00, 0xff, 0xff, 0x74,
-	0xf9, 0x5c, 0xff, 0x88, 0x00, 0x00, 0x00,
+	// 311 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x50, 0xc1, 0x4e, 0x02, 0x31,
+	0x10, 0xcd, 0xca, 0xb2, 0xe0, 0xc0, 0x1a, 0xd3, 0x20, 0xa9, 0x37, 0x5c, 0x2f, 0x1c, 0x74, 0x0f,
+	0x7a, 0xf3, 0x66, 0xf4, 0x62, 0x62, 0x8c, 0x69, 0xe2, 0x99, 0x94, 0xee, 0x00, 0x95, 0xa5, 0xad,
+	0x6c, 0x89, 0xf2, 0xd1, 0xfe, 0x83, 0xd9, 0xd9, 0x92, 0x70, 0x31, 0xd9, 0x53, 0xe7, 0xbd, 0x79,
+	0x7d, 0x33, 0xf3, 0x20, 0x55, 0xd6, 0x78, 0xa9, 0x7c, 0xee, 0xb6, 0xd6, 0x5b, 0xd6, 0xa7, 0x67,
+	0xbe, 0x5b, 0x64, 0xbf, 0x11, 0xa4, 0x4f, 0x4d, 0xef, 0xc3, 0x15, 0xd2, 0x23, 0x1b, 0x41, 0x57,
+	0x95, 0x56, 0xad, 0x79, 0x34, 0x89, 0xa6, 0xb1, 0x68, 0x00, 0xbb, 0x84, 0x3e, 0x9a, 0x6a, 0x66,
+	0xe4, 0x06, 0xf9, 0xc9, 0x24, 0x9a, 0x9e, 0x8a, 0x1e, 0x9a, 0xea, 0x4d, 0x6e, 0x90, 0x5d, 0x43,
+	0xea, 0xb6, 0x76, 0xa1, 0x4b, 0x9c, 0xe9, 0x8d, 0x5c, 0x22, 0xef, 0x50, 0x7f, 0x18, 0xc8, 0x97,
+	0x9a, 0x63, 0x57, 0x30, 0x2c, 0x74, 0xe5, 0x4a, 0xb9, 0x6f, 0x3c, 0x62, 0xd2, 0x0c, 0x02, 0x47,
+	0x3e, 0xe7, 0xd0, 0x99, 0x6b, 0xcb, 0xbb, 0xd4, 0xa9, 0x4b, 0x96, 0x43, 0x42, 0x8e, 0x15, 0x4f,
+	0x26, 0x9d, 0xe9, 0xe0, 0x6e, 0x9c, 0x1f, 0xf6, 0xce, 0xdf, 0x8f, 0xcc, 0x45, 0x50, 0xd5, 0x43,
+	0x0e, 0x9b, 0x94, 0xda, 0xac, 0x79, 0xaf, 0x19, 0x12, 0xb8, 0x57, 0x6d, 0xd6, 0xd9, 0x27, 0x0c,
+	0x8f, 0xbf, 0x32, 0x06, 0x31, 0xed, 0x13, 0x91, 0x94, 0x6a, 0xc6, 0xa1, 0xe7, 0xe4, 0xbe, 0xb4,
+	0xb2, 0x38, 0x9c, 0x1a, 0x60, 0x9d, 0xcd, 0xb7, 0x2e, 0xfc, 0x8a, 0x4e, 0x4c, 0x45, 0x03, 0xd8,
+	0x18, 0x92, 0x15, 0xea, 0xe5, 0xca, 0xd3, 0x55, 0xa9, 0x08, 0x28, 0x7b, 0x80, 0xb3, 0x10, 0xad,
+	0xc0, 0xaf, 0x1d, 0x56, 0xfe, 0x9f, 0x6c, 0x19, 0xc4, 0x1e, 0x7f, 0x7c, 0x18, 0x46, 0x75, 0x76,
+	0x03, 0xa3, 0x47, 0xa5, 0xd0, 0xf9, 0x36, 0x0e, 0xd9, 0x2d, 0x5c, 0x3c, 0xa3, 0x2a, 0xb5, 0xc1,
+	0xb6, 0x72, 0x81, 0x7e, 0x2b, 0x55, 0x2b, 0xf7, 0x79, 0x42, 0xa9, 0xdf, 0xff, 0x0d, 0x00, 0x09,
+	0xdf, 0xbb, 0x92, 0x45, 0x02, 0x00, 0x00,
 }
@@ -0,0 +1,333 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: group_chat_invitation.proto
+
+package protobuf
+
+import (
+	fmt "fmt"
+	proto "github.com/golang/protobuf/proto"
+	math "math"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.ProtoPackageIsVersion3 // please upgrade the proto package
+
+type GroupChatInvitation struct {
+	// Id of the invitation, used to revoke it
+	Id     string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	ChatId string `protobuf:"bytes,2,opt,name=chat_id,json=chatId,proto3" json:"chat_id,omitempty"`
+	// Timestamp in milliseconds after which the invitation can't be redeemed
+	ExpiresAt uint64 `protobuf:"varint,3,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	// Public keys of the admins of the chat when the invitation was created,
+	// join requests are sent to all of them
+	Admins               []string `protobuf:"bytes,4,rep,name=admins,proto3" json:"admins,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GroupChatInvitation) Reset()         { *m = GroupChatInvitation{} }
+func (m *GroupChatInvitation) String() string { return proto.CompactTextString(m) }
+func (*GroupChatInvitation) ProtoMessage()    {}
+func (*GroupChatInvitation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a6a73333de6a8ebe, []int{0}
+}
+
+func (m *GroupChatInvitation) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GroupChatInvitation.Unmarshal(m, b)
+}
+func (m *GroupChatInvitation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GroupChatInvitation.Marshal(b, m, deterministic)
+}
+func (m *GroupChatInvitation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GroupChatInvitation.Merge(m, src)
+}
+func (m *GroupChatInvitation) XXX_Size() int {
+	return xxx_messageInfo_GroupChatInvitation.Size(m)
+}
+func (m *GroupChatInvitation) XXX_DiscardUnknown() {
+	xxx_messageInfo_GroupChatInvitation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GroupChatInvitation proto.InternalMessageInfo
+
+func (m *GroupChatInvitation) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *GroupChatInvitation) GetChatId() string {
+	if m != nil {
+		return m.ChatId
+	}
+	return ""
+}
+
+func (m *GroupChatInvitation) GetExpiresAt() uint64 {
+	if m != nil {
+		return m.ExpiresAt
+	}
+	return 0
+}
+
+func (m *GroupChatInvitation) GetAdmins() []string {
+	if m != nil {
+		return m.Admins
+	}
+	return nil
+}
+
+type SignedGroupChatInvitation struct {
+	// Encoded GroupChatInvitation
+	Payload []byte `protobuf:"bytes,1,opt,name=payload,proto3" json:"payload,omitempty"`
+	// Signature of the payload by the admin who created the invitation
+	Signature            []byte   `protobuf:"bytes,2,opt,name=signature,proto3" json:"signature,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SignedGroupChatInvitation) Reset()         { *m = SignedGroupChatInvitation{} }
+func (m *SignedGroupChatInvitation) String() string { return proto.CompactTextString(m) }
+func (*SignedGroupChatInvitation) ProtoMessage()    {}
+func (*SignedGroupChatInvitation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a6a73333de6a8ebe, []int{1}
+}
+
+func (m *SignedGroupChatInvitation) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SignedGroupChatInvitation.Unmarshal(m, b)
+}
+func (m *SignedGroupChatInvitation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SignedGroupChatInvitation.Marshal(b, m, deterministic)
+}
+func (m *SignedGroupChatInvitation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SignedGroupChatInvitation.Merge(m, src)
+}
+func (m *SignedGroupChatInvitation) XXX_Size() int {
+	return xxx_messageInfo_SignedGroupChatInvitation.Size(m)
+}
+func (m *SignedGroupChatInvitation) XXX_DiscardUnknown() {
+	xxx_messageInfo_SignedGroupChatInvitation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SignedGroupChatInvitation proto.InternalMessageInfo
+
+func (m *SignedGroupChatInvitation) GetPayload() []byte {
+	if m != nil {
+		return m.Payload
+	}
+	return nil
+}
+
+func (m *SignedGroupChatInvitation) GetSignature() []byte {
+	if m != nil {
+		return m.Signature
+	}
+	return nil
+}
+
+type GroupChatJoinRequest struct {
+	Clock                uint64                     `protobuf:"varint,1,opt,name=clock,proto3" json:"clock,omitempty"`
+	Invitation           *SignedGroupChatInvitation `protobuf:"bytes,2,opt,name=invitation,proto3" json:"invitation,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                   `json:"-"`
+	XXX_unrecognized     []byte                     `json:"-"`
+	XXX_sizecache        int32                      `json:"-"`
+}
+
+func (m *GroupChatJoinRequest) Reset()         { *m = GroupChatJoinRequest{} }
+func (m *GroupChatJoinRequest) String() string { return proto.CompactTextString(m) }
+func (*GroupChatJoinRequest) ProtoMessage()    {}
+func (*GroupChatJoinRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a6a73333de6a8ebe, []int{2}
+}
+
+func (m *GroupChatJoinRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GroupChatJoinRequest.Unmarshal(m, b)
+}
+func (m *GroupChatJoinRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GroupChatJoinRequest.Marshal(b, m, deterministic)
+}
+func (m *GroupChatJoinRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GroupChatJoinRequest.Merge(m, src)
+}
+func (m *GroupChatJoinRequest) XXX_Size() int {
+	return xxx_messageInfo_GroupChatJoinRequest.Size(m)
+}
+func (m *GroupChatJoinRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GroupChatJoinRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GroupChatJoinRequest proto.InternalMessageInfo
+
+func (m *GroupChatJoinRequest) GetClock() uint64 {
+	if m != nil {
+		return m.Clock
+	}
+	return 0
+}
+
+func (m *GroupChatJoinRequest) GetInvitation() *SignedGroupChatInvitation {
+	if m != nil {
+		return m.Invitation
+	}
+	return nil
+}
+
+// GroupChatJoinRequestRejected tells the other admins of a group chat that a
+// join request has been rejected, so that they don't approve it
+type GroupChatJoinRequestRejected struct {
+	Clock  uint64 `protobuf:"varint,1,opt,name=clock,proto3" json:"clock,omitempty"`
+	ChatId string `protobuf:"bytes,2,opt,name=chat_id,json=chatId,proto3" json:"chat_id,omitempty"`
+	// Public key of the author of the join request
+	Requester            string   `protobuf:"bytes,3,opt,name=requester,proto3" json:"requester,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GroupChatJoinRequestRejected) Reset()         { *m = GroupChatJoinRequestRejected{} }
+func (m *GroupChatJoinRequestRejected) String() string { return proto.CompactTextString(m) }
+func (*GroupChatJoinRequestRejected) ProtoMessage()    {}
+func (*GroupChatJoinRequestRejected) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a6a73333de6a8ebe, []int{3}
+}
+
+func (m *GroupChatJoinRequestRejected) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GroupChatJoinRequestRejected.Unmarshal(m, b)
+}
+func (m *GroupChatJoinRequestRejected) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GroupChatJoinRequestRejected.Marshal(b, m, deterministic)
+}
+func (m *GroupChatJoinRequestRejected) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GroupChatJoinRequestRejected.Merge(m, src)
+}
+func (m *GroupChatJoinRequestRejected) XXX_Size() int {
+	return xxx_messageInfo_GroupChatJoinRequestRejected.Size(m)
+}
+func (m *GroupChatJoinRequestRejected) XXX_DiscardUnknown() {
+	xxx_messageInfo_GroupChatJoinRequestRejected.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GroupChatJoinRequestRejected proto.InternalMessageInfo
+
+func (m *GroupChatJoinRequestRejected) GetClock() uint64 {
+	if m != nil {
+		return m.Clock
+	}
+	return 0
+}
+
+func (m *GroupChatJoinRequestRejected) GetChatId() string {
+	if m != nil {
+		return m.ChatId
+	}
+	return ""
+}
+
+func (m *GroupChatJoinRequestRejected) GetRequester() string {
+	if m != nil {
+		return m.Requester
+	}
+	return ""
+}
+
+// GroupChatInvitationRevoked tells the other admins of a group chat that an
+// invitation has been revoked, so that they ignore the join requests sent
+// with it
+type GroupChatInvitationRevoked struct {
+	Clock                uint64   `protobuf:"varint,1,opt,name=clock,proto3" json:"clock,omitempty"`
+	ChatId               string   `protobuf:"bytes,2,opt,name=chat_id,json=chatId,proto3" json:"chat_id,omitempty"`
+	InvitationId         string   `protobuf:"bytes,3,opt,name=invitation_id,json=invitationId,proto3" json:"invitation_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GroupChatInvitationRevoked) Reset()         { *m = GroupChatInvitationRevoked{} }
+func (m *GroupChatInvitationRevoked) String() string { return proto.CompactTextString(m) }
+func (*GroupChatInvitationRevoked) ProtoMessage()    {}
+func (*GroupChatInvitationRevoked) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a6a73333de6a8ebe, []int{4}
+}
+
+func (m *GroupChatInvitationRevoked) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GroupChatInvitationRevoked.Unmarshal(m, b)
+}
+func (m *GroupChatInvitationRevoked) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GroupChatInvitationRevoked.Marshal(b, m, deterministic)
+}
+func (m *GroupChatInvitationRevoked) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GroupChatInvitationRevoked.Merge(m, src)
+}
+func (m *GroupChatInvitationRevoked) XXX_Size() int {
+	return xxx_messageInfo_GroupChatInvitationRevoked.Size(m)
+}
+func (m *GroupChatInvitationRevoked) XXX_DiscardUnknown() {
+	xxx_messageInfo_GroupChatInvitationRevoked.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GroupChatInvitationRevoked proto.InternalMessageInfo
+
+func (m *GroupChatInvitationRevoked) GetClock() uint64 {
+	if m != nil {
+		return m.Clock
+	}
+	return 0
+}
+
+func (m *GroupChatInvitationRevoked) GetChatId() string {
+	if m != nil {
+		return m.ChatId
+	}
+	return ""
+}
+
+func (m *GroupChatInvitationRevoked) GetInvitationId() string {
+	if m != nil {
+		return m.InvitationId
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterType((*GroupChatInvitation)(nil), "protobuf.GroupChatInvitation")
+	proto.RegisterType((*SignedGroupChatInvitation)(nil), "protobuf.SignedGroupChatInvitation")
+	proto.RegisterType((*GroupChatJoinRequest)(nil), "protobuf.GroupChatJoinRequest")
+	proto.RegisterType((*GroupChatJoinRequestRejected)(nil), "protobuf.GroupChatJoinRequestRejected")
+	proto.RegisterType((*GroupChatInvitationRevoked)(nil), "protobuf.GroupChatInvitationRevoked")
+}
+
+func init() { proto.RegisterFile("group_chat_invitation.proto", fileDescriptor_a6a73333de6a8ebe) }
+
+var fileDescriptor_a6a73333de6a8ebe = []byte{
+	// 293 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x50, 0xc1, 0x52, 0x83, 0x30,
+	0x14, 0x9c, 0x52, 0xa4, 0xf2, 0x44, 0x0f, 0xb1, 0xa3, 0xa8, 0x38, 0xc3, 0xd0, 0x4b, 0x4f, 0x1c,
+	0xf4, 0x0b, 0x9c, 0x1e, 0x1c, 0x3c, 0xa6, 0x1f, 0xc0, 0xa4, 0x24, 0xd2, 0x48, 0x4d, 0x68, 0x08,
+	0x1d, 0xfd, 0x7b, 0x87, 0xc4, 0x16, 0x0f, 0x70, 0xf0, 0x94, 0xd9, 0xcd, 0x7b, 0xbb, 0xfb, 0x16,
+	0x1e, 0x4a, 0x25, 0xdb, 0x3a, 0x2f, 0xb6, 0x44, 0xe7, 0x5c, 0x1c, 0xb8, 0x26, 0x9a, 0x4b, 0x91,
+	0xd6, 0x4a, 0x6a, 0x89, 0xce, 0xcd, 0xb3, 0x69, 0xdf, 0x93, 0x16, 0xae, 0x5f, 0xbb, 0xc1, 0xd5,
+	0x96, 0xe8, 0xec, 0x34, 0x86, 0xae, 0xc0, 0xe1, 0x34, 0x9c, 0xc4, 0x93, 0xa5, 0x8f, 0x1d, 0x4e,
+	0xd1, 0x2d, 0xcc, 0xac, 0x12, 0x0d, 0x1d, 0x43, 0x7a, 0x1d, 0xcc, 0x28, 0x7a, 0x04, 0x60, 0x5f,
+	0x35, 0x57, 0xac, 0xc9, 0x89, 0x0e, 0xa7, 0xf1, 0x64, 0xe9, 0x62, 0xff, 0x97, 0x79, 0xd1, 0xe8,
+	0x06, 0x3c, 0x42, 0x3f, 0xb9, 0x68, 0x42, 0x37, 0x9e, 0x76, 0x6b, 0x16, 0x25, 0x6b, 0xb8, 0x5b,
+	0xf3, 0x52, 0x30, 0x3a, 0x64, 0x1e, 0xc2, 0xac, 0x26, 0xdf, 0x3b, 0x49, 0x6c, 0x82, 0x00, 0x1f,
+	0x21, 0x8a, 0xc0, 0x6f, 0x78, 0x29, 0x88, 0x6e, 0x15, 0x33, 0x41, 0x02, 0xdc, 0x13, 0xc9, 0x1e,
+	0xe6, 0x27, 0xb9, 0x37, 0xc9, 0x05, 0x66, 0xfb, 0x96, 0x35, 0x1a, 0xcd, 0xe1, 0xac, 0xd8, 0xc9,
+	0xa2, 0x32, 0x6a, 0x2e, 0xb6, 0x00, 0xad, 0x00, 0xfa, 0x5e, 0x8c, 0xd8, 0xc5, 0xd3, 0x22, 0x3d,
+	0x16, 0x93, 0x8e, 0xc6, 0xc3, 0x7f, 0xd6, 0x92, 0x0a, 0xa2, 0x21, 0x4b, 0xcc, 0x3e, 0x58, 0xa1,
+	0x19, 0x1d, 0xb1, 0x1e, 0x6d, 0x33, 0x02, 0x5f, 0x59, 0x05, 0xa6, 0x4c, 0x99, 0x3e, 0xee, 0x89,
+	0xa4, 0x86, 0xfb, 0xa1, 0x3c, 0xec, 0x20, 0xab, 0xff, 0x5b, 0x2d, 0xe0, 0xb2, 0xbf, 0xa3, 0xfb,
+	0xb6, 0x76, 0x41, 0x4f, 0x66, 0x74, 0xe3, 0x99, 0x3a, 0x9e, 0x7f, 0x06, 0x00, 0x8b, 0xeb, 0xf8,
+	0x1b, 0x4d, 0x02, 0x00, 0x00,
+}
@@ -23,36 +23,45 @@ const _ = proto.ProtoPackageIsVersion3 // please upgrade the proto package
 type MembershipUpdateEvent_EventType int32
 
 const (
-	MembershipUpdateEvent_UNKNOWN        MembershipUpdateEvent_EventType = 0
-	MembershipUpdateEvent_CHAT_CREATED   MembershipUpdateEvent_EventType = 1
-	MembershipUpdateEvent_NAME_CHANGED   MembershipUpdateEvent_EventType = 2
-	MembershipUpdateEvent_MEMBERS_ADDED  MembershipUpdateEvent_EventType = 3
-	MembershipUpdateEvent_MEMBER_JOINED  MembershipUpdateEvent_EventType = 4
-	MembershipUpdateEvent_MEMBER_REMOVED MembershipUpdateEvent_EventType = 5
-	MembershipUpdateEvent_ADMINS_ADDED   MembershipUpdateEvent_EventType = 6
-	MembershipUpdateEvent_ADMIN_REMOVED  MembershipUpdateEvent_EventType = 7
+	MembershipUpdateEvent_UNKNOWN               MembershipUpdateEvent_EventType = 0
+	MembershipUpdateEvent_CHAT_CREATED          MembershipUpdateEvent_EventType = 1
+	MembershipUpdateEvent_NAME_CHANGED          MembershipUpdateEvent_EventType = 2
+	MembershipUpdateEvent_MEMBERS_ADDED         MembershipUpdateEvent_EventType = 3
+	MembershipUpdateEvent_MEMBER_JOINED         MembershipUpdateEvent_EventType = 4
+	MembershipUpdateEvent_MEMBER_REMOVED        MembershipUpdateEvent_EventType = 5
+	MembershipUpdateEvent_ADMINS_ADDED          MembershipUpdateEvent_EventType = 6
+	MembershipUpdateEvent_ADMIN_REMOVED         MembershipUpdateEvent_EventType = 7
+	MembershipUpdateEvent_DESCRIPTION_CHANGED   MembershipUpdateEvent_EventType = 8
+	MembershipUpdateEvent_IMAGE_CHANGED         MembershipUpdateEvent_EventType = 9
+	MembershipUpdateEvent_OWNERSHIP_TRANSFERRED MembershipUpdateEvent_EventType = 10
 )
 
 var MembershipUpdateEvent_EventType_name = map[int32]string{
-	0: "UNKNOWN",
-	1: "CHAT_CREATED",
-	2: "NAME_CHANGED",
-	3: "MEMBERS_ADDED",
-	4: "MEMBER_JOINED",
-	5: "MEMBER_REMOVED",
-	6: "ADMINS_ADDED",
-	7: "ADMIN_REMOVED",
+	0:  "UNKNOWN",
+	1:  "CHAT_CREATED",
+	2:  "NAME_CHANGED",
+	3:  "MEMBERS_ADDED",
+	4:  "MEMBER_JOINED",
+	5:  "MEMBER_REMOVED",
+	6:  "ADMINS_ADDED",
+	7:  "ADMIN_REMOVED",
+	8:  "DESCRIPTION_CHANGED",
+	9:  "IMAGE_CHANGED",
+	10: "OWNERSHIP_TRANSFERRED",
 }
 
 var MembershipUpdateEvent_EventType_value = map[string]int32{
-	"UNKNOWN":        0,
-	"CHAT_CREATED":   1,
-	"NAME_CHANGED":   2,
-	"MEMBERS_ADDED":  3,
-	"MEMBER_JOINED":  4,
-	"MEMBER_REMOVED": 5,
-	"ADMINS_ADDED":   6,
-	"ADMIN_REMOVED":  7,
+	"UNKNOWN":               0,
+	"CHAT_CREATED":          1,
+	"NAME_CHANGED":          2,
+	"MEMBERS_ADDED":         3,
+	"MEMBER_JOINED":         4,
+	"MEMBER_REMOVED":        5,
+	"ADMINS_ADDED":          6,
+	"ADMIN_REMOVED":         7,
+	"DESCRIPTION_CHANGED":   8,
+	"IMAGE_CHANGED":         9,
+	"OWNERSHIP_TRANSFERRED": 10,
 }
 
 func (x MembershipUpdateEvent_EventType) String() string {
@@ -71,10 +80,15 @@ type MembershipUpdateEvent struct {
 	// Name of the chat for the CHAT_CREATED/NAME_CHANGED event types
 	Name string `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
 	// The type of the event
-	Type                 MembershipUpdateEvent_EventType `protobuf:"varint,4,opt,name=type,proto3,enum=protobuf.MembershipUpdateEvent_EventType" json:"type,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}                        `json:"-"`
-	XXX_unrecognized     []byte                          `json:"-"`
-	XXX_sizecache        int32                           `json:"-"`
+	Type MembershipUpdateEvent_EventType `protobuf:"varint,4,opt,name=type,proto3,enum=protobuf.MembershipUpdateEvent_EventType" json:"type,omitempty"`
+	// Description of the chat for the DESCRIPTION_CHANGED event type
+	Description string `protobuf:"bytes,5,opt,name=description,proto3" json:"description,omitempty"`
+	// Encoded image of the chat for the IMAGE_CHANGED event type, empty if
+	// the image is removed
+	Image                []byte   `protobuf:"bytes,6,opt,name=image,proto3" json:"image,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *MembershipUpdateEvent) Reset()         { *m = MembershipUpdateEvent{} }
@@ -130,6 +144,20 @@ func (m *MembershipUpdateEvent) GetType() MembershipUpdateEvent_EventType {
 	return MembershipUpdateEvent_UNKNOWN
 }
 
+func (m *MembershipUpdateEvent) GetDescription() string {
+	if m != nil {
+		return m.Description
+	}
+	return ""
+}
+
+func (m *MembershipUpdateEvent) GetImage() []byte {
+	if m != nil {
+		return m.Image
+	}
+	return nil
+}
+
 // MembershipUpdateMessage is a message used to propagate information
 // about group membership changes.
 // For more information, see https://github.com/status-im/specs/blob/master/status-group-chats-spec.md.
@@ -201,27 +229,31 @@ func init() {
 func init() { proto.RegisterFile("membership_update_message.proto", fileDescriptor_8d37dd0dc857a6be) }
 
 var fileDescriptor_8d37dd0dc857a6be = []byte{
-	// 340 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x50, 0x41, 0x4f, 0xf2, 0x40,
-	0x10, 0xfd, 0x0a, 0xa5, 0xfd, 0x3a, 0x20, 0xa9, 0x13, 0x91, 0xc6, 0x8b, 0x0d, 0xa7, 0x7a, 0xa9,
-	0x09, 0x9e, 0x3d, 0xd4, 0xee, 0x46, 0xd0, 0xec, 0x92, 0xac, 0xa0, 0xc7, 0xa6, 0xc0, 0x2a, 0x44,
-	0x0b, 0x0d, 0x14, 0x13, 0xe2, 0x4f, 0xf1, 0x8f, 0xf8, 0xf3, 0x4c, 0x97, 0x16, 0xa2, 0xf1, 0xb2,
-	0xbb, 0xef, 0xcd, 0xbc, 0x37, 0x3b, 0x0f, 0xce, 0x13, 0x99, 0x8c, 0xe5, 0x6a, 0x3d, 0x9b, 0xa7,
-	0xd1, 0x26, 0x9d, 0xc6, 0x99, 0x8c, 0x12, 0xb9, 0x5e, 0xc7, 0x2f, 0xd2, 0x4f, 0x57, 0xcb, 0x6c,
-	0x89, 0xff, 0xd5, 0x35, 0xde, 0x3c, 0x9f, 0xe1, 0x64, 0x16, 0x67, 0x3f, 0xab, 0x9d, 0xaf, 0x0a,
-	0xb4, 0xd8, 0xde, 0x61, 0xa4, 0x0c, 0xe8, 0xbb, 0x5c, 0x64, 0x78, 0x02, 0xb5, 0xc9, 0xdb, 0x72,
-	0xf2, 0xea, 0x68, 0xae, 0xe6, 0xe9, 0x62, 0x07, 0xd0, 0x01, 0xb3, 0x18, 0xe8, 0x54, 0xdc, 0xaa,
-	0x67, 0x89, 0x12, 0x22, 0x82, 0xbe, 0x88, 0x13, 0xe9, 0x54, 0x5d, 0xcd, 0xb3, 0x84, 0x7a, 0xe3,
-	0x35, 0xe8, 0xd9, 0x36, 0x95, 0x8e, 0xee, 0x6a, 0x5e, 0xb3, 0x7b, 0xe1, 0x97, 0x5f, 0xf1, 0xff,
-	0x1c, 0xe9, 0xab, 0x73, 0xb8, 0x4d, 0xa5, 0x50, 0xb2, 0xce, 0xa7, 0x06, 0xd6, 0x9e, 0xc3, 0x3a,
-	0x98, 0x23, 0x7e, 0xcf, 0x07, 0x4f, 0xdc, 0xfe, 0x87, 0x36, 0x34, 0xc2, 0x5e, 0x30, 0x8c, 0x42,
-	0x41, 0x83, 0x21, 0x25, 0xb6, 0x96, 0x33, 0x3c, 0x60, 0x34, 0x0a, 0x7b, 0x01, 0xbf, 0xa5, 0xc4,
-	0xae, 0xe0, 0x31, 0x1c, 0x31, 0xca, 0x6e, 0xa8, 0x78, 0x88, 0x02, 0x42, 0x28, 0xb1, 0xab, 0x07,
-	0x2a, 0xba, 0x1b, 0xf4, 0x39, 0x25, 0xb6, 0x8e, 0x08, 0xcd, 0x82, 0x12, 0x94, 0x0d, 0x1e, 0x29,
-	0xb1, 0x6b, 0xb9, 0x57, 0x40, 0x58, 0x9f, 0x97, 0x42, 0x23, 0x17, 0x2a, 0x66, 0xdf, 0x64, 0x76,
-	0x3e, 0xa0, 0xfd, 0x7b, 0x0d, 0xb6, 0xcb, 0x16, 0xdb, 0x60, 0xaa, 0xac, 0xe7, 0x53, 0x95, 0x9e,
-	0x25, 0x8c, 0x1c, 0xf6, 0xa7, 0x78, 0x0a, 0x86, 0xcc, 0x17, 0xda, 0xa5, 0xd7, 0x10, 0x05, 0xc2,
-	0xcb, 0x3c, 0x56, 0xa5, 0x55, 0xf9, 0xd5, 0xbb, 0xad, 0x43, 0x56, 0xe1, 0x2c, 0xce, 0x0a, 0x63,
-	0x51, 0x76, 0x8d, 0x0d, 0x55, 0xbe, 0xfa, 0x0e, 0x00, 0x00, 0xff, 0xff, 0x7f, 0xb8, 0xe8, 0x9e,
-	0xff, 0x01, 0x00, 0x00,
+	// 413 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x51, 0xc1, 0x6e, 0xd3, 0x40,
+	0x10, 0xc5, 0x8d, 0xe3, 0xd4, 0x93, 0x50, 0x99, 0x81, 0x10, 0xc3, 0x05, 0x2b, 0x27, 0x73, 0x09,
+	0x52, 0x39, 0x73, 0x30, 0xde, 0xa5, 0x31, 0x68, 0xd7, 0xd5, 0xc4, 0xa5, 0x47, 0xcb, 0x49, 0x96,
+	0xc6, 0x02, 0x27, 0x56, 0xe2, 0x22, 0x55, 0x7c, 0x18, 0xff, 0xc3, 0x97, 0xa0, 0xdd, 0x24, 0x0e,
+	0x20, 0x2e, 0xf6, 0xbe, 0x37, 0xf3, 0xde, 0x8c, 0xe6, 0xc1, 0xab, 0x4a, 0x55, 0x73, 0xb5, 0xdd,
+	0xad, 0xca, 0x3a, 0xbf, 0xaf, 0x97, 0x45, 0xa3, 0xf2, 0x4a, 0xed, 0x76, 0xc5, 0x9d, 0x9a, 0xd4,
+	0xdb, 0x4d, 0xb3, 0xc1, 0x73, 0xf3, 0x9b, 0xdf, 0x7f, 0x79, 0x89, 0x8b, 0x55, 0xd1, 0xfc, 0x5d,
+	0x1d, 0xff, 0xec, 0xc0, 0x50, 0xb4, 0x0e, 0x37, 0xc6, 0x80, 0x7f, 0x57, 0xeb, 0x06, 0x9f, 0x41,
+	0x77, 0xf1, 0x6d, 0xb3, 0xf8, 0xea, 0x5b, 0x81, 0x15, 0xda, 0xb4, 0x07, 0xe8, 0x43, 0xef, 0x30,
+	0xd0, 0x3f, 0x0b, 0x3a, 0xa1, 0x4b, 0x47, 0x88, 0x08, 0xf6, 0xba, 0xa8, 0x94, 0xdf, 0x09, 0xac,
+	0xd0, 0x25, 0xf3, 0xc6, 0x77, 0x60, 0x37, 0x0f, 0xb5, 0xf2, 0xed, 0xc0, 0x0a, 0x2f, 0x2e, 0x5f,
+	0x4f, 0x8e, 0xab, 0x4c, 0xfe, 0x3b, 0x72, 0x62, 0xbe, 0xd9, 0x43, 0xad, 0xc8, 0xc8, 0x30, 0x80,
+	0xfe, 0x52, 0xed, 0x16, 0xdb, 0xb2, 0x6e, 0xca, 0xcd, 0xda, 0xef, 0x1a, 0xe7, 0x3f, 0x29, 0xbd,
+	0x64, 0x59, 0x15, 0x77, 0xca, 0x77, 0x02, 0x2b, 0x1c, 0xd0, 0x1e, 0x8c, 0x7f, 0x59, 0xe0, 0xb6,
+	0x5e, 0xd8, 0x87, 0xde, 0x8d, 0xfc, 0x24, 0xd3, 0x5b, 0xe9, 0x3d, 0x42, 0x0f, 0x06, 0xf1, 0x34,
+	0xca, 0xf2, 0x98, 0x78, 0x94, 0x71, 0xe6, 0x59, 0x9a, 0x91, 0x91, 0xe0, 0x79, 0x3c, 0x8d, 0xe4,
+	0x15, 0x67, 0xde, 0x19, 0x3e, 0x81, 0xc7, 0x82, 0x8b, 0xf7, 0x9c, 0x66, 0x79, 0xc4, 0x18, 0x67,
+	0x5e, 0xe7, 0x44, 0xe5, 0x1f, 0xd3, 0x44, 0x72, 0xe6, 0xd9, 0x88, 0x70, 0x71, 0xa0, 0x88, 0x8b,
+	0xf4, 0x33, 0x67, 0x5e, 0x57, 0x7b, 0x45, 0x4c, 0x24, 0xf2, 0x28, 0x74, 0xb4, 0xd0, 0x30, 0x6d,
+	0x53, 0x0f, 0x47, 0xf0, 0x94, 0xf1, 0x59, 0x4c, 0xc9, 0x75, 0x96, 0xa4, 0xb2, 0x9d, 0x7b, 0xae,
+	0x7b, 0x13, 0x11, 0x5d, 0x9d, 0x56, 0x71, 0xf1, 0x05, 0x0c, 0xd3, 0x5b, 0xc9, 0x69, 0x36, 0x4d,
+	0xae, 0xf3, 0x8c, 0x22, 0x39, 0xfb, 0xc0, 0x89, 0x38, 0xf3, 0x60, 0xfc, 0x03, 0x46, 0xff, 0x5e,
+	0x51, 0xec, 0xa3, 0xc5, 0x11, 0xf4, 0x4c, 0xd4, 0xe5, 0xd2, 0x84, 0xe7, 0x92, 0xa3, 0x61, 0xb2,
+	0xc4, 0xe7, 0xe0, 0x28, 0x7d, 0x97, 0x7d, 0x78, 0x03, 0x3a, 0x20, 0x7c, 0xa3, 0x53, 0x35, 0x5a,
+	0x13, 0x5f, 0xff, 0x72, 0x78, 0x8a, 0x2a, 0x5e, 0x15, 0xcd, 0xc1, 0x98, 0x8e, 0x5d, 0x73, 0xc7,
+	0x94, 0xdf, 0xfe, 0x1e, 0x00, 0xf1, 0xba, 0x2b, 0x47, 0x7e, 0x02, 0x00, 0x00,
 }
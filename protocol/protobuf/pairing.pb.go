@@ -20,6 +20,68 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.ProtoPackageIsVersion3 // please upgrade the proto package
 
+type SyncInstallationContact_ContactRequestState int32
+
+const (
+	SyncInstallationContact_NONE     SyncInstallationContact_ContactRequestState = 0
+	SyncInstallationContact_SENT     SyncInstallationContact_ContactRequestState = 1
+	SyncInstallationContact_RECEIVED SyncInstallationContact_ContactRequestState = 2
+	SyncInstallationContact_ACCEPTED SyncInstallationContact_ContactRequestState = 3
+	SyncInstallationContact_DECLINED SyncInstallationContact_ContactRequestState = 4
+)
+
+var SyncInstallationContact_ContactRequestState_name = map[int32]string{
+	0: "NONE",
+	1: "SENT",
+	2: "RECEIVED",
+	3: "ACCEPTED",
+	4: "DECLINED",
+}
+
+var SyncInstallationContact_ContactRequestState_value = map[string]int32{
+	"NONE":     0,
+	"SENT":     1,
+	"RECEIVED": 2,
+	"ACCEPTED": 3,
+	"DECLINED": 4,
+}
+
+func (x SyncInstallationContact_ContactRequestState) String() string {
+	return proto.EnumName(SyncInstallationContact_ContactRequestState_name, int32(x))
+}
+
+func (SyncInstallationContact_ContactRequestState) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_d61ab7221f0b5518, []int{1, 0}
+}
+
+type SyncChatNotificationSettings_NotificationLevel int32
+
+const (
+	SyncChatNotificationSettings_ALL      SyncChatNotificationSettings_NotificationLevel = 0
+	SyncChatNotificationSettings_MENTIONS SyncChatNotificationSettings_NotificationLevel = 1
+	SyncChatNotificationSettings_NONE     SyncChatNotificationSettings_NotificationLevel = 2
+)
+
+var SyncChatNotificationSettings_NotificationLevel_name = map[int32]string{
+	0: "ALL",
+	1: "MENTIONS",
+	2: "NONE",
+}
+
+var SyncChatNotificationSettings_NotificationLevel_value = map[string]int32{
+	"ALL":      0,
+	"MENTIONS": 1,
+	"NONE":     2,
+}
+
+func (x SyncChatNotificationSettings_NotificationLevel) String() string {
+	return proto.EnumName(SyncChatNotificationSettings_NotificationLevel_name, int32(x))
+}
+
+func (SyncChatNotificationSettings_NotificationLevel) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_d61ab7221f0b5518, []int{4, 0}
+}
+
 type PairInstallation struct {
 	Clock                uint64   `protobuf:"varint,1,opt,name=clock,proto3" json:"clock,omitempty"`
 	InstallationId       string   `protobuf:"bytes,2,opt,name=installation_id,json=installationId,proto3" json:"installation_id,omitempty"`
@@ -84,12 +146,32 @@ func (m *PairInstallation) GetName() string {
 }
 
 type SyncInstallationContact struct {
-	Clock                uint64   `protobuf:"varint,1,opt,name=clock,proto3" json:"clock,omitempty"`
-	Id                   string   `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
-	ProfileImage         string   `protobuf:"bytes,3,opt,name=profile_image,json=profileImage,proto3" json:"profile_image,omitempty"`
-	EnsName              string   `protobuf:"bytes,4,opt,name=ens_name,json=ensName,proto3" json:"ens_name,omitempty"`
-	LastUpdated          uint64   `protobuf:"varint,5,opt,name=last_updated,json=lastUpdated,proto3" json:"last_updated,omitempty"`
-	SystemTags           []string `protobuf:"bytes,6,rep,name=system_tags,json=systemTags,proto3" json:"system_tags,omitempty"`
+	Clock        uint64   `protobuf:"varint,1,opt,name=clock,proto3" json:"clock,omitempty"`
+	Id           string   `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	ProfileImage string   `protobuf:"bytes,3,opt,name=profile_image,json=profileImage,proto3" json:"profile_image,omitempty"`
+	EnsName      string   `protobuf:"bytes,4,opt,name=ens_name,json=ensName,proto3" json:"ens_name,omitempty"`
+	LastUpdated  uint64   `protobuf:"varint,5,opt,name=last_updated,json=lastUpdated,proto3" json:"last_updated,omitempty"`
+	SystemTags   []string `protobuf:"bytes,6,rep,name=system_tags,json=systemTags,proto3" json:"system_tags,omitempty"`
+	// State of the contact request exchanged with the contact, applied when
+	// contact_request_clock is greater than ours
+	ContactRequestState SyncInstallationContact_ContactRequestState `protobuf:"varint,7,opt,name=contact_request_state,json=contactRequestState,proto3,enum=protobuf.SyncInstallationContact_ContactRequestState" json:"contact_request_state,omitempty"`
+	ContactRequestClock uint64                                      `protobuf:"varint,8,opt,name=contact_request_clock,json=contactRequestClock,proto3" json:"contact_request_clock,omitempty"`
+	ContactRequestText  string                                      `protobuf:"bytes,9,opt,name=contact_request_text,json=contactRequestText,proto3" json:"contact_request_text,omitempty"`
+	// local_nickname is the nickname we gave to the contact, it's never shared
+	// with the contact
+	LocalNickname      string `protobuf:"bytes,10,opt,name=local_nickname,json=localNickname,proto3" json:"local_nickname,omitempty"`
+	LocalNicknameClock uint64 `protobuf:"varint,11,opt,name=local_nickname_clock,json=localNicknameClock,proto3" json:"local_nickname_clock,omitempty"`
+	// Profile of the contact, applied along with ens_name and profile_image
+	// when last_updated is greater than ours
+	DisplayName string          `protobuf:"bytes,12,opt,name=display_name,json=displayName,proto3" json:"display_name,omitempty"`
+	Bio         string          `protobuf:"bytes,13,opt,name=bio,proto3" json:"bio,omitempty"`
+	Images      []*ProfileImage `protobuf:"bytes,14,rep,name=images,proto3" json:"images,omitempty"`
+	ProfileLink string          `protobuf:"bytes,15,opt,name=profile_link,json=profileLink,proto3" json:"profile_link,omitempty"`
+	// not_added tells that we didn't add the contact, it's only applied when
+	// no contact request was exchanged with the contact. Contacts not added are
+	// synced along with their local nickname, older devices only sync added
+	// contacts and leave it unset
+	NotAdded             bool     `protobuf:"varint,16,opt,name=not_added,json=notAdded,proto3" json:"not_added,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -162,13 +244,87 @@ func (m *SyncInstallationContact) GetSystemTags() []string {
 	return nil
 }
 
+func (m *SyncInstallationContact) GetContactRequestState() SyncInstallationContact_ContactRequestState {
+	if m != nil {
+		return m.ContactRequestState
+	}
+	return SyncInstallationContact_NONE
+}
+
+func (m *SyncInstallationContact) GetContactRequestClock() uint64 {
+	if m != nil {
+		return m.ContactRequestClock
+	}
+	return 0
+}
+
+func (m *SyncInstallationContact) GetContactRequestText() string {
+	if m != nil {
+		return m.ContactRequestText
+	}
+	return ""
+}
+
+func (m *SyncInstallationContact) GetLocalNickname() string {
+	if m != nil {
+		return m.LocalNickname
+	}
+	return ""
+}
+
+func (m *SyncInstallationContact) GetLocalNicknameClock() uint64 {
+	if m != nil {
+		return m.LocalNicknameClock
+	}
+	return 0
+}
+
+func (m *SyncInstallationContact) GetDisplayName() string {
+	if m != nil {
+		return m.DisplayName
+	}
+	return ""
+}
+
+func (m *SyncInstallationContact) GetBio() string {
+	if m != nil {
+		return m.Bio
+	}
+	return ""
+}
+
+func (m *SyncInstallationContact) GetImages() []*ProfileImage {
+	if m != nil {
+		return m.Images
+	}
+	return nil
+}
+
+func (m *SyncInstallationContact) GetProfileLink() string {
+	if m != nil {
+		return m.ProfileLink
+	}
+	return ""
+}
+
+func (m *SyncInstallationContact) GetNotAdded() bool {
+	if m != nil {
+		return m.NotAdded
+	}
+	return false
+}
+
 type SyncInstallationAccount struct {
-	Clock                uint64   `protobuf:"varint,1,opt,name=clock,proto3" json:"clock,omitempty"`
-	ProfileImage         string   `protobuf:"bytes,2,opt,name=profile_image,json=profileImage,proto3" json:"profile_image,omitempty"`
-	LastUpdated          uint64   `protobuf:"varint,3,opt,name=last_updated,json=lastUpdated,proto3" json:"last_updated,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	Clock                uint64          `protobuf:"varint,1,opt,name=clock,proto3" json:"clock,omitempty"`
+	ProfileImage         string          `protobuf:"bytes,2,opt,name=profile_image,json=profileImage,proto3" json:"profile_image,omitempty"`
+	LastUpdated          uint64          `protobuf:"varint,3,opt,name=last_updated,json=lastUpdated,proto3" json:"last_updated,omitempty"`
+	DisplayName          string          `protobuf:"bytes,4,opt,name=display_name,json=displayName,proto3" json:"display_name,omitempty"`
+	Bio                  string          `protobuf:"bytes,5,opt,name=bio,proto3" json:"bio,omitempty"`
+	Images               []*ProfileImage `protobuf:"bytes,6,rep,name=images,proto3" json:"images,omitempty"`
+	ProfileLink          string          `protobuf:"bytes,7,opt,name=profile_link,json=profileLink,proto3" json:"profile_link,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
 }
 
 func (m *SyncInstallationAccount) Reset()         { *m = SyncInstallationAccount{} }
@@ -217,6 +373,34 @@ func (m *SyncInstallationAccount) GetLastUpdated() uint64 {
 	return 0
 }
 
+func (m *SyncInstallationAccount) GetDisplayName() string {
+	if m != nil {
+		return m.DisplayName
+	}
+	return ""
+}
+
+func (m *SyncInstallationAccount) GetBio() string {
+	if m != nil {
+		return m.Bio
+	}
+	return ""
+}
+
+func (m *SyncInstallationAccount) GetImages() []*ProfileImage {
+	if m != nil {
+		return m.Images
+	}
+	return nil
+}
+
+func (m *SyncInstallationAccount) GetProfileLink() string {
+	if m != nil {
+		return m.ProfileLink
+	}
+	return ""
+}
+
 type SyncInstallationPublicChat struct {
 	Clock                uint64   `protobuf:"varint,1,opt,name=clock,proto3" json:"clock,omitempty"`
 	Id                   string   `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
@@ -264,6 +448,128 @@ func (m *SyncInstallationPublicChat) GetId() string {
 	return ""
 }
 
+type SyncChatNotificationSettings struct {
+	Clock uint64 `protobuf:"varint,1,opt,name=clock,proto3" json:"clock,omitempty"`
+	// chat_id is the id of the chat the settings apply to
+	ChatId string `protobuf:"bytes,2,opt,name=chat_id,json=chatId,proto3" json:"chat_id,omitempty"`
+	Muted  bool   `protobuf:"varint,3,opt,name=muted,proto3" json:"muted,omitempty"`
+	// muted_until is the timestamp in ms until which the chat is muted, 0 if
+	// it's muted until it's unmuted
+	MutedUntil           uint64                                         `protobuf:"varint,4,opt,name=muted_until,json=mutedUntil,proto3" json:"muted_until,omitempty"`
+	NotificationLevel    SyncChatNotificationSettings_NotificationLevel `protobuf:"varint,5,opt,name=notification_level,json=notificationLevel,proto3,enum=protobuf.SyncChatNotificationSettings_NotificationLevel" json:"notification_level,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                                       `json:"-"`
+	XXX_unrecognized     []byte                                         `json:"-"`
+	XXX_sizecache        int32                                          `json:"-"`
+}
+
+func (m *SyncChatNotificationSettings) Reset()         { *m = SyncChatNotificationSettings{} }
+func (m *SyncChatNotificationSettings) String() string { return proto.CompactTextString(m) }
+func (*SyncChatNotificationSettings) ProtoMessage()    {}
+func (*SyncChatNotificationSettings) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d61ab7221f0b5518, []int{4}
+}
+
+func (m *SyncChatNotificationSettings) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SyncChatNotificationSettings.Unmarshal(m, b)
+}
+func (m *SyncChatNotificationSettings) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SyncChatNotificationSettings.Marshal(b, m, deterministic)
+}
+func (m *SyncChatNotificationSettings) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SyncChatNotificationSettings.Merge(m, src)
+}
+func (m *SyncChatNotificationSettings) XXX_Size() int {
+	return xxx_messageInfo_SyncChatNotificationSettings.Size(m)
+}
+func (m *SyncChatNotificationSettings) XXX_DiscardUnknown() {
+	xxx_messageInfo_SyncChatNotificationSettings.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SyncChatNotificationSettings proto.InternalMessageInfo
+
+func (m *SyncChatNotificationSettings) GetClock() uint64 {
+	if m != nil {
+		return m.Clock
+	}
+	return 0
+}
+
+func (m *SyncChatNotificationSettings) GetChatId() string {
+	if m != nil {
+		return m.ChatId
+	}
+	return ""
+}
+
+func (m *SyncChatNotificationSettings) GetMuted() bool {
+	if m != nil {
+		return m.Muted
+	}
+	return false
+}
+
+func (m *SyncChatNotificationSettings) GetMutedUntil() uint64 {
+	if m != nil {
+		return m.MutedUntil
+	}
+	return 0
+}
+
+func (m *SyncChatNotificationSettings) GetNotificationLevel() SyncChatNotificationSettings_NotificationLevel {
+	if m != nil {
+		return m.NotificationLevel
+	}
+	return SyncChatNotificationSettings_ALL
+}
+
+type SyncReadReceiptsSetting struct {
+	Clock uint64 `protobuf:"varint,1,opt,name=clock,proto3" json:"clock,omitempty"`
+	// enabled indicates whether read receipts are sent to contacts
+	Enabled              bool     `protobuf:"varint,2,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SyncReadReceiptsSetting) Reset()         { *m = SyncReadReceiptsSetting{} }
+func (m *SyncReadReceiptsSetting) String() string { return proto.CompactTextString(m) }
+func (*SyncReadReceiptsSetting) ProtoMessage()    {}
+func (*SyncReadReceiptsSetting) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d61ab7221f0b5518, []int{5}
+}
+
+func (m *SyncReadReceiptsSetting) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SyncReadReceiptsSetting.Unmarshal(m, b)
+}
+func (m *SyncReadReceiptsSetting) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SyncReadReceiptsSetting.Marshal(b, m, deterministic)
+}
+func (m *SyncReadReceiptsSetting) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SyncReadReceiptsSetting.Merge(m, src)
+}
+func (m *SyncReadReceiptsSetting) XXX_Size() int {
+	return xxx_messageInfo_SyncReadReceiptsSetting.Size(m)
+}
+func (m *SyncReadReceiptsSetting) XXX_DiscardUnknown() {
+	xxx_messageInfo_SyncReadReceiptsSetting.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SyncReadReceiptsSetting proto.InternalMessageInfo
+
+func (m *SyncReadReceiptsSetting) GetClock() uint64 {
+	if m != nil {
+		return m.Clock
+	}
+	return 0
+}
+
+func (m *SyncReadReceiptsSetting) GetEnabled() bool {
+	if m != nil {
+		return m.Enabled
+	}
+	return false
+}
+
 type SyncInstallation struct {
 	Contacts             []*SyncInstallationContact    `protobuf:"bytes,1,rep,name=contacts,proto3" json:"contacts,omitempty"`
 	PublicChats          []*SyncInstallationPublicChat `protobuf:"bytes,2,rep,name=public_chats,json=publicChats,proto3" json:"public_chats,omitempty"`
@@ -277,7 +583,7 @@ func (m *SyncInstallation) Reset()         { *m = SyncInstallation{} }
 func (m *SyncInstallation) String() string { return proto.CompactTextString(m) }
 func (*SyncInstallation) ProtoMessage()    {}
 func (*SyncInstallation) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d61ab7221f0b5518, []int{4}
+	return fileDescriptor_d61ab7221f0b5518, []int{6}
 }
 
 func (m *SyncInstallation) XXX_Unmarshal(b []byte) error {
@@ -320,39 +626,69 @@ func (m *SyncInstallation) GetAccount() *SyncInstallationAccount {
 }
 
 func init() {
+	proto.RegisterEnum("protobuf.SyncInstallationContact_ContactRequestState", SyncInstallationContact_ContactRequestState_name, SyncInstallationContact_ContactRequestState_value)
+	proto.RegisterEnum("protobuf.SyncChatNotificationSettings_NotificationLevel", SyncChatNotificationSettings_NotificationLevel_name, SyncChatNotificationSettings_NotificationLevel_value)
 	proto.RegisterType((*PairInstallation)(nil), "protobuf.PairInstallation")
 	proto.RegisterType((*SyncInstallationContact)(nil), "protobuf.SyncInstallationContact")
 	proto.RegisterType((*SyncInstallationAccount)(nil), "protobuf.SyncInstallationAccount")
 	proto.RegisterType((*SyncInstallationPublicChat)(nil), "protobuf.SyncInstallationPublicChat")
+	proto.RegisterType((*SyncChatNotificationSettings)(nil), "protobuf.SyncChatNotificationSettings")
+	proto.RegisterType((*SyncReadReceiptsSetting)(nil), "protobuf.SyncReadReceiptsSetting")
 	proto.RegisterType((*SyncInstallation)(nil), "protobuf.SyncInstallation")
 }
 
 func init() { proto.RegisterFile("pairing.proto", fileDescriptor_d61ab7221f0b5518) }
 
 var fileDescriptor_d61ab7221f0b5518 = []byte{
-	// 378 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x92, 0xcf, 0x6e, 0x9b, 0x40,
-	0x10, 0xc6, 0x05, 0xf8, 0x5f, 0x07, 0xdb, 0xb5, 0x56, 0x95, 0xba, 0xed, 0xa5, 0x98, 0x56, 0xaa,
-	0x4f, 0x3e, 0xb4, 0xc7, 0xaa, 0x87, 0xc4, 0x87, 0xc8, 0x97, 0xc8, 0x22, 0xce, 0x19, 0xad, 0x97,
-	0x35, 0x5e, 0x05, 0x96, 0x15, 0xbb, 0x24, 0xe2, 0x05, 0xf2, 0x62, 0x79, 0x88, 0xbc, 0x4e, 0xc4,
-	0x62, 0x3b, 0xc8, 0x0e, 0x51, 0x4e, 0x2c, 0x1f, 0xb3, 0xf3, 0xfd, 0xbe, 0x19, 0x60, 0x24, 0x09,
-	0xcf, 0xb9, 0x88, 0xe7, 0x32, 0xcf, 0x74, 0x86, 0x06, 0xe6, 0xb1, 0x29, 0xb6, 0xfe, 0xa3, 0x05,
-	0x93, 0x15, 0xe1, 0xf9, 0x52, 0x28, 0x4d, 0x92, 0x84, 0x68, 0x9e, 0x09, 0xf4, 0x05, 0xba, 0x34,
-	0xc9, 0xe8, 0x1d, 0xb6, 0x3c, 0x6b, 0xd6, 0x09, 0xea, 0x17, 0xf4, 0x1b, 0x3e, 0xf3, 0x46, 0x55,
-	0xc8, 0x23, 0x6c, 0x7b, 0xd6, 0xec, 0x53, 0x30, 0x6e, 0xca, 0xcb, 0x08, 0xfd, 0x00, 0x37, 0x62,
-	0xf7, 0x9c, 0xb2, 0x50, 0x97, 0x92, 0x61, 0xc7, 0x14, 0x41, 0x2d, 0xad, 0x4b, 0xc9, 0x10, 0x82,
-	0x8e, 0x20, 0x29, 0xc3, 0x1d, 0xf3, 0xc5, 0x9c, 0xfd, 0x27, 0x0b, 0xbe, 0xde, 0x94, 0x82, 0x36,
-	0x41, 0x16, 0x99, 0xd0, 0x84, 0xea, 0x16, 0x9e, 0x31, 0xd8, 0x47, 0x04, 0x9b, 0x47, 0xe8, 0x27,
-	0x8c, 0x64, 0x9e, 0x6d, 0x79, 0xc2, 0x42, 0x9e, 0x92, 0xf8, 0x60, 0x3c, 0xdc, 0x8b, 0xcb, 0x4a,
-	0x43, 0xdf, 0x60, 0xc0, 0x84, 0x0a, 0x1b, 0xf6, 0x7d, 0x26, 0xd4, 0x35, 0x49, 0x19, 0x9a, 0xc2,
-	0x30, 0x21, 0x4a, 0x87, 0x85, 0x8c, 0x88, 0x66, 0x11, 0xee, 0x1a, 0x33, 0xb7, 0xd2, 0x6e, 0x6b,
-	0xa9, 0x4a, 0xa6, 0x4a, 0xa5, 0x59, 0x1a, 0x6a, 0x12, 0x2b, 0xdc, 0xf3, 0x9c, 0x2a, 0x59, 0x2d,
-	0xad, 0x49, 0xac, 0xfc, 0x87, 0xf3, 0x10, 0x17, 0x94, 0x66, 0x85, 0x68, 0x0b, 0x71, 0x06, 0x6d,
-	0xbf, 0x01, 0x7d, 0x4a, 0xe6, 0x9c, 0x91, 0xf9, 0x97, 0xf0, 0xfd, 0xd4, 0x78, 0x55, 0x6c, 0x12,
-	0x4e, 0x17, 0x3b, 0xf2, 0xc1, 0x01, 0xfa, 0xcf, 0x16, 0x4c, 0x4e, 0x9b, 0xa0, 0xff, 0x30, 0xa0,
-	0xf5, 0x1a, 0x14, 0xb6, 0x3c, 0x67, 0xe6, 0xfe, 0x99, 0xce, 0x0f, 0x7f, 0xcf, 0xbc, 0x65, 0x61,
-	0xc1, 0xf1, 0x0a, 0xba, 0x82, 0xa1, 0x34, 0x1c, 0x21, 0xdd, 0x11, 0xad, 0xb0, 0x6d, 0x5a, 0xfc,
-	0x6a, 0x6f, 0xf1, 0x4a, 0x1d, 0xb8, 0xf2, 0x78, 0x56, 0xe8, 0x1f, 0xf4, 0x49, 0x3d, 0x49, 0x13,
-	0xff, 0x5d, 0x8c, 0xfd, 0xc8, 0x83, 0xc3, 0x8d, 0x4d, 0xcf, 0x94, 0xfe, 0x7d, 0x09, 0x00, 0x00,
-	0xff, 0xff, 0xd7, 0x5f, 0x3a, 0x87, 0x07, 0x03, 0x00, 0x00,
+	// 785 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x54, 0xdb, 0x8e, 0xeb, 0x34,
+	0x14, 0x3d, 0x49, 0x6f, 0x99, 0xdd, 0xcb, 0xc9, 0xf1, 0x39, 0x70, 0xc2, 0x80, 0x44, 0x27, 0x80,
+	0xe8, 0x53, 0x85, 0x0a, 0x48, 0x48, 0x88, 0x87, 0xd2, 0x46, 0x28, 0x52, 0xc9, 0x54, 0x6e, 0x87,
+	0xd7, 0xc8, 0x4d, 0x3c, 0x1d, 0xab, 0xa9, 0x13, 0x6a, 0x77, 0x34, 0xfd, 0x01, 0x7e, 0x84, 0x8f,
+	0xe2, 0x13, 0xf8, 0x0c, 0x90, 0x9d, 0xb4, 0xd3, 0xcb, 0x04, 0x10, 0x4f, 0xf1, 0x5e, 0xd9, 0x37,
+	0xaf, 0xbd, 0xb6, 0xa1, 0x9d, 0x11, 0xb6, 0x61, 0x7c, 0xd9, 0xcf, 0x36, 0xa9, 0x4c, 0x91, 0xa5,
+	0x3f, 0x8b, 0xed, 0xfd, 0x75, 0x3b, 0x4a, 0xb9, 0x24, 0x91, 0xcc, 0x7f, 0xb8, 0xbf, 0x19, 0x60,
+	0x4f, 0x09, 0xdb, 0xf8, 0x5c, 0x48, 0x92, 0x24, 0x44, 0xb2, 0x94, 0xa3, 0x77, 0x50, 0x8b, 0x92,
+	0x34, 0x5a, 0x39, 0x46, 0xd7, 0xe8, 0x55, 0x71, 0x6e, 0xa0, 0x2f, 0xe1, 0x35, 0x3b, 0xf2, 0x0a,
+	0x59, 0xec, 0x98, 0x5d, 0xa3, 0x77, 0x85, 0x3b, 0xc7, 0xb0, 0x1f, 0xa3, 0x4f, 0xa1, 0x19, 0xd3,
+	0x47, 0x16, 0xd1, 0x50, 0xee, 0x32, 0xea, 0x54, 0xb4, 0x13, 0xe4, 0xd0, 0x7c, 0x97, 0x51, 0x84,
+	0xa0, 0xca, 0xc9, 0x9a, 0x3a, 0x55, 0xfd, 0x47, 0x9f, 0xdd, 0x3f, 0x6b, 0xf0, 0x7e, 0xb6, 0xe3,
+	0xd1, 0x71, 0x23, 0xa3, 0xbc, 0xd5, 0x92, 0x7e, 0x3a, 0x60, 0x1e, 0x5a, 0x30, 0x59, 0x8c, 0x3e,
+	0x83, 0x76, 0xb6, 0x49, 0xef, 0x59, 0x42, 0x43, 0xb6, 0x26, 0xcb, 0x7d, 0xe1, 0x56, 0x01, 0xfa,
+	0x0a, 0x43, 0x1f, 0x81, 0x45, 0xb9, 0x08, 0x8f, 0xca, 0x37, 0x28, 0x17, 0x01, 0x59, 0x53, 0x74,
+	0x03, 0xad, 0x84, 0x08, 0x19, 0x6e, 0xb3, 0x98, 0x48, 0x1a, 0x3b, 0x35, 0x5d, 0xac, 0xa9, 0xb0,
+	0xbb, 0x1c, 0x52, 0x37, 0x13, 0x3b, 0x21, 0xe9, 0x3a, 0x94, 0x64, 0x29, 0x9c, 0x7a, 0xb7, 0xa2,
+	0x6e, 0x96, 0x43, 0x73, 0xb2, 0x14, 0x88, 0xc1, 0x07, 0x05, 0xbf, 0xe1, 0x86, 0xfe, 0xba, 0xa5,
+	0x42, 0x86, 0x42, 0x12, 0x49, 0x9d, 0x46, 0xd7, 0xe8, 0x75, 0x06, 0xdf, 0xf6, 0xf7, 0x73, 0xe8,
+	0x97, 0xdc, 0xb5, 0x5f, 0x7c, 0x71, 0x1e, 0x3d, 0x53, 0xc1, 0xf8, 0x6d, 0x74, 0x09, 0xa2, 0xc1,
+	0x65, 0xa9, 0x9c, 0x24, 0x4b, 0xf7, 0x7d, 0x16, 0x33, 0xd2, 0x94, 0x7d, 0x05, 0xef, 0xce, 0x63,
+	0x24, 0x7d, 0x92, 0xce, 0x95, 0x66, 0x02, 0x9d, 0x86, 0xcc, 0xe9, 0x93, 0x44, 0x5f, 0x40, 0x27,
+	0x49, 0x23, 0x92, 0x84, 0x9c, 0x45, 0x2b, 0xcd, 0x1a, 0x68, 0xdf, 0xb6, 0x46, 0x83, 0x02, 0x54,
+	0x89, 0x4f, 0xdd, 0x8a, 0x5e, 0x9a, 0xba, 0x17, 0x74, 0xe2, 0x9c, 0xb7, 0x72, 0x03, 0xad, 0x98,
+	0x89, 0x2c, 0x21, 0xbb, 0x7c, 0x18, 0x2d, 0x9d, 0xb6, 0x59, 0x60, 0x7a, 0x20, 0x36, 0x54, 0x16,
+	0x2c, 0x75, 0xda, 0xfa, 0x8f, 0x3a, 0xa2, 0x3e, 0xd4, 0xf5, 0x68, 0x85, 0xd3, 0xe9, 0x56, 0x7a,
+	0xcd, 0xc1, 0x87, 0xcf, 0x7c, 0x4e, 0x8f, 0xa6, 0x8c, 0x0b, 0x2f, 0x55, 0x64, 0x2f, 0x89, 0x84,
+	0xf1, 0x95, 0xf3, 0x3a, 0x2f, 0x52, 0x60, 0x13, 0xc6, 0x57, 0xe8, 0x63, 0xb8, 0xe2, 0xa9, 0x0c,
+	0x49, 0x1c, 0xd3, 0xd8, 0xb1, 0xbb, 0x46, 0xcf, 0xc2, 0x16, 0x4f, 0xe5, 0x50, 0xd9, 0xee, 0x0c,
+	0xde, 0xbe, 0x30, 0x0f, 0x64, 0x41, 0x35, 0xb8, 0x0d, 0x3c, 0xfb, 0x95, 0x3a, 0xcd, 0xbc, 0x60,
+	0x6e, 0x1b, 0xa8, 0x05, 0x16, 0xf6, 0x46, 0x9e, 0xff, 0x8b, 0x37, 0xb6, 0x4d, 0x65, 0x0d, 0x47,
+	0x23, 0x6f, 0x3a, 0xf7, 0xc6, 0x76, 0x45, 0x59, 0x63, 0x6f, 0x34, 0xf1, 0x03, 0x6f, 0x6c, 0x57,
+	0xdd, 0xbf, 0x8c, 0x4b, 0xa5, 0x0f, 0xa3, 0x28, 0xdd, 0xf2, 0x32, 0xa5, 0x5f, 0x28, 0xdb, 0x7c,
+	0x41, 0xd9, 0xe7, 0xf2, 0xad, 0x5c, 0xca, 0xf7, 0x9c, 0xf3, 0x6a, 0x29, 0xe7, 0xb5, 0x97, 0x38,
+	0xaf, 0xff, 0x2f, 0xce, 0x1b, 0x17, 0x9c, 0xbb, 0x3f, 0xc2, 0xf5, 0x39, 0x01, 0xd3, 0xed, 0x22,
+	0x61, 0xd1, 0xe8, 0x81, 0xfc, 0xc7, 0x6d, 0x77, 0x7f, 0x37, 0xe1, 0x13, 0x95, 0x44, 0x85, 0x04,
+	0xa9, 0x64, 0xf7, 0x2c, 0xd2, 0x89, 0x66, 0x54, 0x4a, 0xc6, 0x97, 0xa2, 0x24, 0xcd, 0x7b, 0x68,
+	0x44, 0x0f, 0x44, 0x3e, 0x3f, 0x5e, 0x75, 0x65, 0xfa, 0xb1, 0x72, 0x5f, 0x6f, 0xf7, 0xbc, 0x59,
+	0x38, 0x37, 0xd4, 0xc2, 0xeb, 0x43, 0xb8, 0xe5, 0x92, 0x25, 0x9a, 0xb0, 0x2a, 0x06, 0x0d, 0xdd,
+	0x29, 0x04, 0x2d, 0x01, 0xf1, 0xa3, 0xea, 0x61, 0x42, 0x1f, 0x69, 0xa2, 0xe9, 0xeb, 0x0c, 0xbe,
+	0x3b, 0xdd, 0xf6, 0xb2, 0x4e, 0xfb, 0xc7, 0xe0, 0x44, 0xc5, 0xe3, 0x37, 0xfc, 0x1c, 0x72, 0xbf,
+	0x81, 0x37, 0x17, 0x7e, 0xa8, 0x01, 0x95, 0xe1, 0x64, 0x62, 0xbf, 0x52, 0x0a, 0xfb, 0xd9, 0x0b,
+	0xe6, 0xfe, 0x6d, 0x30, 0xb3, 0x8d, 0x83, 0x3e, 0x4d, 0xd7, 0xcf, 0xa5, 0x86, 0x29, 0x89, 0x31,
+	0x8d, 0x28, 0xcb, 0xa4, 0x28, 0xca, 0x96, 0xf0, 0xe3, 0x40, 0x83, 0x72, 0xb2, 0x48, 0x68, 0xce,
+	0x8f, 0x85, 0xf7, 0xa6, 0xfb, 0x87, 0x01, 0xf6, 0xf9, 0xd4, 0xd0, 0x0f, 0x60, 0x15, 0x8f, 0x86,
+	0x70, 0x0c, 0x2d, 0x8f, 0x9b, 0x7f, 0x7d, 0xe2, 0xf0, 0x21, 0x04, 0xfd, 0x04, 0xad, 0x4c, 0x0f,
+	0x3e, 0x54, 0x53, 0x10, 0x8e, 0xa9, 0x53, 0x7c, 0x5e, 0x9e, 0xe2, 0x59, 0x26, 0xb8, 0x99, 0x1d,
+	0xce, 0x02, 0x7d, 0x0f, 0x0d, 0x92, 0xaf, 0x90, 0x9e, 0xdf, 0x3f, 0xb6, 0x51, 0xec, 0x1a, 0xde,
+	0x47, 0x2c, 0xea, 0xda, 0xf5, 0xeb, 0xbf, 0x07, 0x00, 0x3a, 0x6b, 0x9a, 0xb7, 0x34, 0x07, 0x00,
+	0x00,
 }
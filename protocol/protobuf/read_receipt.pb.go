@@ -0,0 +1,87 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: read_receipt.proto
+
+package protobuf
+
+import (
+	fmt "fmt"
+	proto "github.com/golang/protobuf/proto"
+	math "math"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.ProtoPackageIsVersion3 // please upgrade the proto package
+
+type ReadReceipt struct {
+	// Lamport timestamp of the receipt
+	Clock uint64 `protobuf:"varint,1,opt,name=clock,proto3" json:"clock,omitempty"`
+	// Ids of the messages of the one-to-one chat that have been read
+	MessageIds           []string `protobuf:"bytes,2,rep,name=message_ids,json=messageIds,proto3" json:"message_ids,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ReadReceipt) Reset()         { *m = ReadReceipt{} }
+func (m *ReadReceipt) String() string { return proto.CompactTextString(m) }
+func (*ReadReceipt) ProtoMessage()    {}
+func (*ReadReceipt) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3a5ac8fe3db696b5, []int{0}
+}
+
+func (m *ReadReceipt) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReadReceipt.Unmarshal(m, b)
+}
+func (m *ReadReceipt) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReadReceipt.Marshal(b, m, deterministic)
+}
+func (m *ReadReceipt) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReadReceipt.Merge(m, src)
+}
+func (m *ReadReceipt) XXX_Size() int {
+	return xxx_messageInfo_ReadReceipt.Size(m)
+}
+func (m *ReadReceipt) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReadReceipt.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReadReceipt proto.InternalMessageInfo
+
+func (m *ReadReceipt) GetClock() uint64 {
+	if m != nil {
+		return m.Clock
+	}
+	return 0
+}
+
+func (m *ReadReceipt) GetMessageIds() []string {
+	if m != nil {
+		return m.MessageIds
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*ReadReceipt)(nil), "protobuf.ReadReceipt")
+}
+
+func init() { proto.RegisterFile("read_receipt.proto", fileDescriptor_3a5ac8fe3db696b5) }
+
+var fileDescriptor_3a5ac8fe3db696b5 = []byte{
+	// 109 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x12, 0x2a, 0x4a, 0x4d, 0x4c,
+	0x89, 0x2f, 0x4a, 0x4d, 0x4e, 0xcd, 0x2c, 0x28, 0xd1, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0xe2,
+	0x00, 0x53, 0x49, 0xa5, 0x69, 0x4a, 0x2e, 0x5c, 0xdc, 0x41, 0xa9, 0x89, 0x29, 0x41, 0x10, 0x69,
+	0x21, 0x11, 0x2e, 0xd6, 0xe4, 0x9c, 0xfc, 0xe4, 0x6c, 0x09, 0x46, 0x05, 0x46, 0x0d, 0x96, 0x20,
+	0x08, 0x47, 0x48, 0x9e, 0x8b, 0x3b, 0x37, 0xb5, 0xb8, 0x38, 0x31, 0x3d, 0x35, 0x3e, 0x33, 0xa5,
+	0x58, 0x82, 0x49, 0x81, 0x59, 0x83, 0x33, 0x88, 0x0b, 0x2a, 0xe4, 0x99, 0x52, 0x9c, 0xc4, 0x06,
+	0x36, 0xcf, 0x18, 0x30, 0x00, 0xad, 0xd0, 0xd8, 0x3a, 0x6c, 0x00, 0x00, 0x00,
+}
@@ -4,7 +4,7 @@ import (
 	"github.com/golang/protobuf/proto"
 )
 
-//go:generate protoc --go_out=. ./chat_message.proto ./application_metadata_message.proto ./membership_update_message.proto ./command.proto ./contact.proto ./pairing.proto
+//go:generate protoc --go_out=. ./chat_message.proto ./application_metadata_message.proto ./membership_update_message.proto ./command.proto ./contact.proto ./pairing.proto ./emoji_reaction.proto ./edit_message.proto ./delete_message.proto ./message_chunk.proto ./pin_message.proto ./read_receipt.proto ./typing_status.proto ./disappearing_messages.proto ./group_chat_invitation.proto ./communities.proto ./poll_vote.proto
 
 func Unmarshal(payload []byte) (*ApplicationMetadataMessage, error) {
 	var message ApplicationMetadataMessage
@@ -0,0 +1,176 @@
+package protocol
+
+import (
+	"context"
+	"errors"
+
+	"github.com/golang/protobuf/proto"
+
+	"github.com/status-im/status-go/protocol/protobuf"
+)
+
+// ReadReceiptsSettings gives access to the setting, global to the account,
+// indicating whether read receipts are sent to contacts. The clock value of
+// its last change is kept along with it, so that the last change made on any
+// of the paired devices wins
+type ReadReceiptsSettings interface {
+	GetSendReadReceipts() (bool, error)
+	GetSendReadReceiptsClock() (uint64, error)
+	SetSendReadReceipts(enabled bool, clock uint64) error
+}
+
+// SetSendReadReceipts turns read receipts on or off, on this installation and
+// on all the paired ones
+func (m *Messenger) SetSendReadReceipts(ctx context.Context, enabled bool) error {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	if m.readReceiptsSettings == nil {
+		return errors.New("read receipts are not available")
+	}
+
+	lastClock, err := m.readReceiptsSettings.GetSendReadReceiptsClock()
+	if err != nil {
+		return err
+	}
+
+	clock := m.getTimesource().GetCurrentTime()
+	if clock <= lastClock {
+		clock = lastClock + 1
+	}
+
+	err = m.readReceiptsSettings.SetSendReadReceipts(enabled, clock)
+	if err != nil {
+		return err
+	}
+
+	return m.syncReadReceiptsSetting(ctx, enabled, clock)
+}
+
+func (m *Messenger) sendReadReceiptsEnabled() (bool, error) {
+	if m.readReceiptsSettings == nil {
+		return false, nil
+	}
+	return m.readReceiptsSettings.GetSendReadReceipts()
+}
+
+// readReceiptMessageIDs returns, out of ids, the messages of the one-to-one
+// chat sent by the contact that have not been seen yet, if read receipts are
+// enabled
+func (m *Messenger) readReceiptMessageIDs(chatID string, ids []string) ([]string, error) {
+	chat, ok := m.allChats[chatID]
+	if !ok || !chat.OneToOne() {
+		return nil, nil
+	}
+
+	enabled, err := m.sendReadReceiptsEnabled()
+	if err != nil || !enabled {
+		return nil, err
+	}
+
+	var receiptIDs []string
+	for _, id := range ids {
+		message, err := m.persistence.MessageByID(id)
+		if err == errRecordNotFound {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		if message.LocalChatID != chatID || message.From != chatID || message.Seen {
+			continue
+		}
+		receiptIDs = append(receiptIDs, id)
+	}
+	return receiptIDs, nil
+}
+
+// sendReadReceipt tells the contact of a one-to-one chat that the messages
+// have been read, all of them in a single receipt
+func (m *Messenger) sendReadReceipt(ctx context.Context, chat *Chat, ids []string) error {
+	if len(ids) == 0 {
+		return nil
+	}
+
+	clock, _ := chat.NextClockAndTimestamp(m.getTimesource())
+	receipt := &protobuf.ReadReceipt{
+		Clock:      clock,
+		MessageIds: ids,
+	}
+	encodedMessage, err := proto.Marshal(receipt)
+	if err != nil {
+		return err
+	}
+
+	_, err = m.dispatchMessage(ctx, &RawMessage{
+		LocalChatID: chat.ID,
+		Payload:     encodedMessage,
+		MessageType: protobuf.ApplicationMetadataMessage_READ_RECEIPT,
+	})
+	if err != nil {
+		return err
+	}
+
+	chat.LastClockValue = clock
+	return m.saveChat(chat)
+}
+
+// syncReadReceiptsSetting sync the read receipts setting with paired devices,
+// settingClock being the clock value of its last change
+func (m *Messenger) syncReadReceiptsSetting(ctx context.Context, enabled bool, settingClock uint64) error {
+	var err error
+	if !m.hasPairedDevices() {
+		return nil
+	}
+	chatID := contactIDFromPublicKey(&m.identity.PublicKey)
+
+	chat, ok := m.allChats[chatID]
+	if !ok {
+		chat = OneToOneFromPublicKey(&m.identity.PublicKey, m.getTimesource())
+		// We don't want to show the chat to the user
+		chat.Active = false
+	}
+
+	m.allChats[chat.ID] = chat
+	clock, _ := chat.NextClockAndTimestamp(m.getTimesource())
+
+	syncMessage := &protobuf.SyncReadReceiptsSetting{
+		Clock:   settingClock,
+		Enabled: enabled,
+	}
+	encodedMessage, err := proto.Marshal(syncMessage)
+	if err != nil {
+		return err
+	}
+
+	_, err = m.dispatchMessage(ctx, &RawMessage{
+		LocalChatID:         chatID,
+		Payload:             encodedMessage,
+		MessageType:         protobuf.ApplicationMetadataMessage_SYNC_READ_RECEIPTS_SETTING,
+		ResendAutomatically: true,
+	})
+	if err != nil {
+		return err
+	}
+
+	chat.LastClockValue = clock
+	return m.saveChat(chat)
+}
+
+// handleSyncReadReceiptsSetting applies the read receipts setting changed on
+// a paired device, unless it's older than ours
+func (m *Messenger) handleSyncReadReceiptsSetting(message protobuf.SyncReadReceiptsSetting) error {
+	if m.readReceiptsSettings == nil {
+		return nil
+	}
+
+	clock, err := m.readReceiptsSettings.GetSendReadReceiptsClock()
+	if err != nil {
+		return err
+	}
+	if clock >= message.Clock {
+		return nil
+	}
+
+	return m.readReceiptsSettings.SetSendReadReceipts(message.Enabled, message.Clock)
+}
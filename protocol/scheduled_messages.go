@@ -0,0 +1,241 @@
+package protocol
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"github.com/status-im/status-go/protocol/protobuf"
+	v1protocol "github.com/status-im/status-go/protocol/v1"
+)
+
+// scheduledMessagesInterval is how often the scheduled messages that are due
+// are looked up
+const scheduledMessagesInterval = time.Second
+
+// scheduledMessagesRetryInterval is how long to wait before sending again a
+// scheduled message that failed to be sent, doubled after each failure
+const scheduledMessagesRetryInterval = 30 * time.Second
+
+// scheduledMessagesMaxAttempts is the number of times sending a scheduled
+// message is attempted before giving up
+const scheduledMessagesMaxAttempts = 5
+
+// ScheduledMessage is a message prepared to be sent to a chat at a later
+// time. Its clock value, timestamp and id are only set once it is sent
+type ScheduledMessage struct {
+	ID     string `json:"id"`
+	ChatID string `json:"chatId"`
+	// SendAt is the time in milliseconds the message is sent at
+	SendAt  uint64   `json:"sendAt"`
+	Message *Message `json:"message"`
+	// Attempts is the number of times the message failed to be sent
+	Attempts int `json:"attempts"`
+	// Failed is set once the message failed to be sent too many times, it's
+	// no longer retried until it's edited
+	Failed bool `json:"failed"`
+	// retryAt is the time in milliseconds the message is sent again at after
+	// failing to be sent
+	retryAt uint64
+}
+
+// failedAttempt records a failure to send the message at now, in
+// milliseconds, and delays the next attempt
+func (s *ScheduledMessage) failedAttempt(now uint64) {
+	s.Attempts++
+	if s.Attempts >= scheduledMessagesMaxAttempts {
+		s.Failed = true
+		return
+	}
+	delay := scheduledMessagesRetryInterval << uint(s.Attempts-1)
+	s.retryAt = now + uint64(delay/time.Millisecond)
+}
+
+// ScheduleChatMessage prepares the message and stores it to be sent to its
+// chat at sendAt, in milliseconds. Scheduled messages are kept across
+// restarts, the ones due while the messenger was stopped are sent on start
+func (m *Messenger) ScheduleChatMessage(message *Message, sendAt uint64) (*ScheduledMessage, error) {
+	// Links are unfurled before acquiring the lock, as fetching the
+	// previews might take a while
+	if message.ContentType == protobuf.ChatMessage_TEXT_PLAIN && len(message.Links) == 0 {
+		message.Links = m.unfurlLinks(context.Background(), message.Text)
+	}
+
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	if _, ok := m.allChats[message.ChatId]; !ok {
+		return nil, errors.New("Chat not found")
+	}
+
+	if sendAt <= m.getTimesource().GetCurrentTime() {
+		return nil, errors.New("scheduled messages must be sent in the future")
+	}
+
+	if message.ContentType == protobuf.ChatMessage_IMAGE && len(message.ImagePath) != 0 {
+		image, err := loadImage(message.ImagePath)
+		if err != nil {
+			return nil, err
+		}
+		message.Payload = &protobuf.ChatMessage_Image{Image: image}
+		message.ImagePath = ""
+	}
+
+	scheduled := &ScheduledMessage{
+		ID:      uuid.New().String(),
+		ChatID:  message.ChatId,
+		SendAt:  sendAt,
+		Message: message,
+	}
+
+	err := m.persistence.SaveScheduledMessage(scheduled)
+	if err != nil {
+		return nil, err
+	}
+	return scheduled, nil
+}
+
+// ScheduledMessages returns the messages that are yet to be sent, the ones
+// to be sent first coming first
+func (m *Messenger) ScheduledMessages() ([]*ScheduledMessage, error) {
+	return m.persistence.ScheduledMessages()
+}
+
+// EditScheduledMessage changes the text and the time a scheduled message is
+// sent at. An empty text keeps the current text, only the text of text
+// messages can be changed. A message that failed to be sent is attempted
+// again
+func (m *Messenger) EditScheduledMessage(id string, newText string, sendAt uint64) (*ScheduledMessage, error) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	scheduled, err := m.persistence.ScheduledMessageByID(id)
+	if err != nil {
+		return nil, err
+	}
+
+	if sendAt <= m.getTimesource().GetCurrentTime() {
+		return nil, errors.New("scheduled messages must be sent in the future")
+	}
+	scheduled.SendAt = sendAt
+	scheduled.Attempts = 0
+	scheduled.Failed = false
+	scheduled.retryAt = 0
+
+	if len(strings.TrimSpace(newText)) != 0 && newText != scheduled.Message.Text {
+		if scheduled.Message.ContentType != protobuf.ChatMessage_TEXT_PLAIN {
+			return nil, errors.New("only text messages can be edited")
+		}
+		scheduled.Message.Text = newText
+		scheduled.Message.Links = m.unfurlLinks(context.Background(), newText)
+	}
+
+	err = m.persistence.SaveScheduledMessage(scheduled)
+	if err != nil {
+		return nil, err
+	}
+	return scheduled, nil
+}
+
+// CancelScheduledMessage removes a scheduled message, it is not sent
+func (m *Messenger) CancelScheduledMessage(id string) error {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	if _, err := m.persistence.ScheduledMessageByID(id); err != nil {
+		return err
+	}
+	return m.persistence.DeleteScheduledMessage(id)
+}
+
+func (m *Messenger) scheduledMessagesLoop() {
+	defer m.loops.Done()
+
+	ticker := time.NewTicker(scheduledMessagesInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			if err := m.sendScheduledMessages(); err != nil {
+				m.logger.Warn("failed to send scheduled messages", zap.Error(err))
+			}
+		case <-m.quit:
+			return
+		}
+	}
+}
+
+// sendScheduledMessages sends the scheduled messages that are due. Sent
+// messages are returned by the next call to RetrieveAll, messages that
+// failed to be sent are retried later, until they failed too many times.
+// Messages that can't be sent anymore, because they failed too many times
+// or because their chat is gone, are kept as failed and returned by the
+// next call to RetrieveAll
+func (m *Messenger) sendScheduledMessages() error {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	logger := m.logger.With(zap.String("site", "sendScheduledMessages"))
+
+	now := m.getTimesource().GetCurrentTime()
+	due, err := m.persistence.DueScheduledMessages(now)
+	if err != nil {
+		return err
+	}
+
+	for _, scheduled := range due {
+		chat, ok := m.allChats[scheduled.ChatID]
+		if !ok || !chat.Active {
+			logger.Warn("failed to send scheduled message, chat not found", zap.String("id", scheduled.ID), zap.String("chatID", scheduled.ChatID))
+			scheduled.Failed = true
+			if err := m.saveFailedScheduledMessage(scheduled); err != nil {
+				return err
+			}
+			continue
+		}
+
+		message := scheduled.Message
+		message.ChatId = chat.ID
+		err := extendMessageFromChat(message, chat, &m.identity.PublicKey, m.getTimesource())
+		if err == nil {
+			// The clock value is calculated now rather than when the
+			// message was scheduled, so that it's ordered after the
+			// messages sent in the meantime
+			message.Clock = v1protocol.CalcMessageClock(chat.LastClockValue, message.Timestamp)
+			_, err = m.dispatchChatMessage(context.Background(), chat, message)
+		}
+		if err != nil {
+			logger.Warn("failed to send scheduled message", zap.String("id", scheduled.ID), zap.Error(err))
+			scheduled.failedAttempt(now)
+			if err := m.saveFailedScheduledMessage(scheduled); err != nil {
+				return err
+			}
+			continue
+		}
+
+		if err := m.persistence.DeleteScheduledMessage(scheduled.ID); err != nil {
+			return err
+		}
+		m.sentScheduledMessages = append(m.sentScheduledMessages, message)
+	}
+
+	return nil
+}
+
+// saveFailedScheduledMessage stores a scheduled message that failed to be
+// sent, it's returned by the next call to RetrieveAll once it's no longer
+// retried
+func (m *Messenger) saveFailedScheduledMessage(scheduled *ScheduledMessage) error {
+	if err := m.persistence.SaveScheduledMessage(scheduled); err != nil {
+		return err
+	}
+	if scheduled.Failed {
+		m.failedScheduledMessages = append(m.failedScheduledMessages, scheduled)
+	}
+	return nil
+}
@@ -0,0 +1,260 @@
+package protocol
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"errors"
+	"sort"
+
+	"github.com/status-im/status-go/protocol/protobuf"
+)
+
+// SendStatusUpdate posts a status update on our timeline, it's received by
+// all the users who added us as a contact
+func (m *Messenger) SendStatusUpdate(ctx context.Context, text string) (*MessengerResponse, error) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	chat := m.timelineChat(&m.identity.PublicKey)
+	if _, ok := m.allChats[chat.ID]; !ok {
+		if err := m.Join(*chat); err != nil {
+			return nil, err
+		}
+		if err := m.saveChat(chat); err != nil {
+			return nil, err
+		}
+	}
+
+	message := &Message{}
+	message.ChatId = chat.ID
+	message.Text = text
+	message.ContentType = protobuf.ChatMessage_STATUS
+
+	return m.sendChatMessage(ctx, message)
+}
+
+// SendStatusUpdateComment comments on a status update of our timeline or of
+// the timeline of a contact
+func (m *Messenger) SendStatusUpdateComment(ctx context.Context, messageID string, text string) (*MessengerResponse, error) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	post, err := m.persistence.MessageByID(messageID)
+	if err == errRecordNotFound {
+		return nil, errors.New("status update not found")
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	message := &Message{}
+	message.ChatId = post.LocalChatID
+	message.Text = text
+	message.ResponseTo = messageID
+	message.ContentType = protobuf.ChatMessage_TEXT_PLAIN
+
+	return m.sendChatMessage(ctx, message)
+}
+
+// Timeline returns the status updates posted by us and the contacts we
+// follow, most recent first. The returned cursor is used to fetch the next
+// page, it's empty once all of them have been returned
+func (m *Messenger) Timeline(cursor string, limit int) ([]*Message, string, error) {
+	m.mutex.Lock()
+	var chatIDs []string
+	for _, chat := range m.allChats {
+		if chat.Timeline() && chat.Active {
+			chatIDs = append(chatIDs, chat.ID)
+		}
+	}
+	m.mutex.Unlock()
+
+	return m.persistence.TimelineMessages(chatIDs, cursor, limit)
+}
+
+// StatusUpdateComments returns the comments on a status update, in the order
+// they were posted
+func (m *Messenger) StatusUpdateComments(messageID string) ([]*Message, error) {
+	post, err := m.persistence.MessageByID(messageID)
+	if err == errRecordNotFound {
+		return nil, errors.New("status update not found")
+	}
+	if err != nil {
+		return nil, err
+	}
+	if post.ContentType != protobuf.ChatMessage_STATUS {
+		return nil, errors.New("not a status update")
+	}
+
+	replies, err := m.persistence.MessagesByResponseTo(messageID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Messages quoting the status update elsewhere are not comments
+	var comments []*Message
+	for _, reply := range replies {
+		if reply.LocalChatID == post.LocalChatID {
+			comments = append(comments, reply)
+		}
+	}
+
+	sort.Slice(comments, func(i, j int) bool {
+		return comments[i].Clock < comments[j].Clock
+	})
+	return comments, nil
+}
+
+// timelineChat returns the timeline of the user with the given public key,
+// it's created if we don't know it yet
+func (m *Messenger) timelineChat(publicKey *ecdsa.PublicKey) *Chat {
+	if chat, ok := m.allChats[timelineChatID(publicKey)]; ok {
+		return chat
+	}
+	chat := CreateTimelineChat(publicKey, m.getTimesource())
+	return &chat
+}
+
+// timelinePost returns the status update of the timeline a comment or a
+// reaction refers to
+func (m *Messenger) timelinePost(chat *Chat, messageID string) (*Message, error) {
+	post, err := m.persistence.MessageByID(messageID)
+	if err == errRecordNotFound {
+		return nil, errors.New("status update not found")
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if post.LocalChatID != chat.ID || post.ContentType != protobuf.ChatMessage_STATUS {
+		return nil, errors.New("not a status update of the timeline")
+	}
+	return post, nil
+}
+
+// checkTimelineMessage checks, before sending it, that a message posted on a
+// timeline is either a status update on our own timeline or a comment on a
+// status update of the timeline
+func (m *Messenger) checkTimelineMessage(chat *Chat, message *Message) error {
+	if err := validateTimelineChatMessage(&message.ChatMessage); err != nil {
+		return err
+	}
+
+	if message.ContentType == protobuf.ChatMessage_STATUS {
+		if !chat.IsAdmin(contactIDFromPublicKey(&m.identity.PublicKey)) {
+			return errors.New("status updates can only be posted on our own timeline")
+		}
+		return nil
+	}
+
+	_, err := m.timelinePost(chat, message.ResponseTo)
+	return err
+}
+
+// saveMessagesAwaitingPost saves comments on status updates not received
+// yet, hidden
+func (m *Messenger) saveMessagesAwaitingPost(messages []*Message) error {
+	err := m.persistence.SaveMessagesLegacy(messages)
+	if err != nil {
+		return err
+	}
+
+	ids := make([]string, 0, len(messages))
+	for _, message := range messages {
+		ids = append(ids, message.ID)
+	}
+	return m.persistence.HideMessagesAwaitingPost(ids)
+}
+
+// showCommentsAndReactionsOnPosts adds the comments and the reactions
+// received before the status updates of the response to it, showing the
+// comments. Only status updates can be reacted to, the reactions received
+// before a comment are deleted
+func (m *Messenger) showCommentsAndReactionsOnPosts(response *MessengerResponse) error {
+	for _, message := range response.Messages {
+		chat, ok := m.allChats[message.LocalChatID]
+		if !ok || !chat.Timeline() {
+			continue
+		}
+
+		if message.ContentType != protobuf.ChatMessage_STATUS {
+			if err := m.persistence.DeleteEmojiReactionsByMessageID(message.ID, chat.ID); err != nil {
+				return err
+			}
+			continue
+		}
+
+		if err := m.persistence.ShowMessagesAwaitingPost(chat.ID, message.ID); err != nil {
+			return err
+		}
+		comments, err := m.persistence.MessagesByResponseTo(message.ID)
+		if err != nil {
+			return err
+		}
+		for _, comment := range comments {
+			if comment.LocalChatID == chat.ID && !messagesContain(response.Messages, comment.ID) {
+				response.Messages = append(response.Messages, comment)
+			}
+		}
+
+		reactions, err := m.persistence.EmojiReactionsByMessageID(message.ID, chat.ID)
+		if err != nil {
+			return err
+		}
+		for _, reaction := range reactions {
+			if !emojiReactionsContain(response.EmojiReactions, reaction.ID) {
+				response.EmojiReactions = append(response.EmojiReactions, reaction)
+			}
+		}
+	}
+	return nil
+}
+
+func messagesContain(messages []*Message, messageID string) bool {
+	for _, message := range messages {
+		if message.ID == messageID {
+			return true
+		}
+	}
+	return false
+}
+
+func emojiReactionsContain(emojiReactions []*EmojiReaction, emojiReactionID string) bool {
+	for _, emojiReaction := range emojiReactions {
+		if emojiReaction.ID == emojiReactionID {
+			return true
+		}
+	}
+	return false
+}
+
+// updateTimelineSubscription follows the timeline of a contact once added by
+// us, and stops following it once removed or blocked
+func (m *Messenger) updateTimelineSubscription(contact *Contact) error {
+	if contact.ID == contactIDFromPublicKey(&m.identity.PublicKey) {
+		return nil
+	}
+
+	publicKey, err := contact.PublicKey()
+	if err != nil {
+		return err
+	}
+
+	follow := contact.IsAdded() && !contact.IsBlocked()
+	chat := m.timelineChat(publicKey)
+	_, ok := m.allChats[chat.ID]
+	if (ok && chat.Active == follow) || (!ok && !follow) {
+		return nil
+	}
+
+	chat.Active = follow
+	if follow {
+		err = m.Join(*chat)
+	} else {
+		err = m.Leave(*chat)
+	}
+	if err != nil {
+		return err
+	}
+	return m.saveChat(chat)
+}
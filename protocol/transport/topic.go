@@ -50,6 +50,12 @@ func ContactCodeTopic(publicKey *ecdsa.PublicKey) string {
 	return "0x" + PublicKeyToStr(publicKey) + "-contact-code"
 }
 
+// TimelineTopic returns the topic the status updates of the user with the
+// given public key are posted on
+func TimelineTopic(publicKey *ecdsa.PublicKey) string {
+	return "0x" + PublicKeyToStr(publicKey) + "-timeline"
+}
+
 func NegotiatedTopic(publicKey *ecdsa.PublicKey) string {
 	return "0x" + PublicKeyToStr(publicKey) + "-negotiated"
 }
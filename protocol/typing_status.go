@@ -0,0 +1,108 @@
+package protocol
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"encoding/json"
+	"errors"
+
+	"github.com/golang/protobuf/proto"
+
+	"github.com/status-im/status-go/protocol/protobuf"
+)
+
+// maxTypingStatusGroupSize is the maximum number of members of a private
+// group chat for typing statuses to be sent, as each member receives its own
+// copy of every status
+const maxTypingStatusGroupSize = 20
+
+// TypingStatus represents a contact starting or stopping typing in a chat.
+// Typing statuses are ephemeral, they are never persisted
+type TypingStatus struct {
+	protobuf.TypingStatus
+
+	// From is a public key of the author of the typing status
+	From string
+
+	// LocalChatID is the id of the chat the author is typing in
+	LocalChatID string
+
+	// SigPubKey is the ecdsa encoded public key of the typing status author
+	SigPubKey *ecdsa.PublicKey `json:"-"`
+}
+
+// GetSigPubKey returns an ecdsa encoded public key
+// this function is required to implement the ChatEntity interface
+func (t *TypingStatus) GetSigPubKey() *ecdsa.PublicKey {
+	return t.SigPubKey
+}
+
+// MarshalJSON implements the json.Marshaler interface
+func (t *TypingStatus) MarshalJSON() ([]byte, error) {
+	item := struct {
+		Clock  uint64 `json:"clock"`
+		ChatID string `json:"chatId"`
+		From   string `json:"from"`
+		Typing bool   `json:"typing"`
+	}{
+		Clock:  t.Clock,
+		ChatID: t.LocalChatID,
+		From:   t.From,
+		Typing: t.Typing,
+	}
+
+	return json.Marshal(item)
+}
+
+// SendTypingStatus tells the other members of a one-to-one or small private
+// group chat that we started or stopped typing. Typing statuses are sent with
+// a short TTL and are neither persisted nor retransmitted
+func (m *Messenger) SendTypingStatus(chatID string, typing bool) error {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	chat, ok := m.allChats[chatID]
+	if !ok {
+		return errors.New("Chat not found")
+	}
+
+	var recipients []*ecdsa.PublicKey
+	switch chat.ChatType {
+	case ChatTypeOneToOne:
+		publicKey, err := chat.PublicKey()
+		if err != nil {
+			return err
+		}
+		recipients = append(recipients, publicKey)
+	case ChatTypePrivateGroupChat:
+		if len(chat.Members) > maxTypingStatusGroupSize {
+			return errors.New("too many members to send typing statuses")
+		}
+		members, err := chat.MembersAsPublicKeys()
+		if err != nil {
+			return err
+		}
+		for _, member := range members {
+			if !isPubKeyEqual(member, &m.identity.PublicKey) {
+				recipients = append(recipients, member)
+			}
+		}
+	default:
+		return errors.New("typing statuses are only supported in one-to-one and private group chats")
+	}
+
+	clock, _ := chat.NextClockAndTimestamp(m.getTimesource())
+	status := &protobuf.TypingStatus{
+		Clock:       clock,
+		ChatId:      chat.ID,
+		Typing:      typing,
+		MessageType: chat.MessageType(),
+	}
+	encodedMessage, err := proto.Marshal(status)
+	if err != nil {
+		return err
+	}
+
+	_, err = m.processor.SendEphemeralRaw(context.Background(), recipients, encodedMessage, protobuf.ApplicationMetadataMessage_TYPING_STATUS)
+	return err
+}
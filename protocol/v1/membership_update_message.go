@@ -48,14 +48,16 @@ func MembershipUpdateEventFromProtobuf(chatID string, raw []byte) (*MembershipUp
 		return nil, err
 	}
 	return &MembershipUpdateEvent{
-		ClockValue: decodedEvent.Clock,
-		ChatID:     chatID,
-		Members:    decodedEvent.Members,
-		Name:       decodedEvent.Name,
-		Type:       decodedEvent.Type,
-		Signature:  signature,
-		RawPayload: encodedEvent,
-		From:       from,
+		ClockValue:  decodedEvent.Clock,
+		ChatID:      chatID,
+		Members:     decodedEvent.Members,
+		Name:        decodedEvent.Name,
+		Description: decodedEvent.Description,
+		Image:       decodedEvent.Image,
+		Type:        decodedEvent.Type,
+		Signature:   signature,
+		RawPayload:  encodedEvent,
+		From:        from,
 	}, nil
 }
 
@@ -98,14 +100,16 @@ func EncodeMembershipUpdateMessage(value MembershipUpdateMessage) ([]byte, error
 // MembershipUpdateEvent contains an event information.
 // Member and Members are hex-encoded values with 0x prefix.
 type MembershipUpdateEvent struct {
-	Type       protobuf.MembershipUpdateEvent_EventType `json:"type"`
-	ClockValue uint64                                   `json:"clockValue"`
-	Members    []string                                 `json:"members,omitempty"` // in "members-added" and "admins-added" events
-	Name       string                                   `json:"name,omitempty"`    // name of the group chat
-	From       string
-	Signature  []byte
-	ChatID     string
-	RawPayload []byte
+	Type        protobuf.MembershipUpdateEvent_EventType `json:"type"`
+	ClockValue  uint64                                   `json:"clockValue"`
+	Members     []string                                 `json:"members,omitempty"`     // in "members-added" and "admins-added" events
+	Name        string                                   `json:"name,omitempty"`        // name of the group chat
+	Description string                                   `json:"description,omitempty"` // description of the group chat
+	Image       []byte                                   `json:"image,omitempty"`       // encoded image of the group chat
+	From        string
+	Signature   []byte
+	ChatID      string
+	RawPayload  []byte
 }
 
 func (u *MembershipUpdateEvent) Equal(update MembershipUpdateEvent) bool {
@@ -136,10 +140,12 @@ func (u *MembershipUpdateEvent) Sign(key *ecdsa.PrivateKey) error {
 
 func (u *MembershipUpdateEvent) ToProtobuf() *protobuf.MembershipUpdateEvent {
 	return &protobuf.MembershipUpdateEvent{
-		Clock:   u.ClockValue,
-		Name:    u.Name,
-		Members: u.Members,
-		Type:    u.Type,
+		Clock:       u.ClockValue,
+		Name:        u.Name,
+		Description: u.Description,
+		Image:       u.Image,
+		Members:     u.Members,
+		Type:        u.Type,
 	}
 }
 
@@ -175,6 +181,22 @@ func NewNameChangedEvent(name string, clock uint64) MembershipUpdateEvent {
 	}
 }
 
+func NewDescriptionChangedEvent(description string, clock uint64) MembershipUpdateEvent {
+	return MembershipUpdateEvent{
+		Type:        protobuf.MembershipUpdateEvent_DESCRIPTION_CHANGED,
+		Description: description,
+		ClockValue:  clock,
+	}
+}
+
+func NewImageChangedEvent(image []byte, clock uint64) MembershipUpdateEvent {
+	return MembershipUpdateEvent{
+		Type:       protobuf.MembershipUpdateEvent_IMAGE_CHANGED,
+		Image:      image,
+		ClockValue: clock,
+	}
+}
+
 func NewMembersAddedEvent(members []string, clock uint64) MembershipUpdateEvent {
 	return MembershipUpdateEvent{
 		Type:       protobuf.MembershipUpdateEvent_MEMBERS_ADDED,
@@ -214,13 +236,24 @@ func NewAdminRemovedEvent(admin string, clock uint64) MembershipUpdateEvent {
 	}
 }
 
+func NewOwnershipTransferredEvent(owner string, clock uint64) MembershipUpdateEvent {
+	return MembershipUpdateEvent{
+		Type:       protobuf.MembershipUpdateEvent_OWNERSHIP_TRANSFERRED,
+		Members:    []string{owner},
+		ClockValue: clock,
+	}
+}
+
 type Group struct {
-	chatID  string
-	name    string
-	events  []MembershipUpdateEvent
-	admins  *stringSet
-	members *stringSet
-	joined  *stringSet
+	chatID      string
+	name        string
+	description string
+	image       []byte
+	owner       string
+	events      []MembershipUpdateEvent
+	admins      *stringSet
+	members     *stringSet
+	joined      *stringSet
 }
 
 func groupChatID(creator *ecdsa.PublicKey) string {
@@ -256,23 +289,30 @@ func newGroup(chatID string, events []MembershipUpdateEvent) (*Group, error) {
 	return &g, nil
 }
 
+// init applies the events in order. Concurrent events might conflict, for
+// example an admin adding members while being removed as admin, so the events
+// no longer valid once the ones before them are applied are dropped. As all
+// the members sort the events the same way, they all drop the same ones
 func (g *Group) init() error {
 	g.sortEvents()
 
 	var chatID string
 
+	events := make([]MembershipUpdateEvent, 0, len(g.events))
 	for _, event := range g.events {
 		if chatID == "" {
 			chatID = event.ChatID
 		} else if event.ChatID != chatID {
 			return errors.New("updates contain different chat IDs")
 		}
-		valid := g.validateEvent(event)
-		if !valid {
-			return fmt.Errorf("invalid event %#+v from %s", event, event.From)
+		if !g.validateEvent(event) {
+			continue
 		}
 		g.processEvent(event)
+		events = append(events, event)
 	}
+	g.events = events
+	g.dropReplacedImageEvents()
 
 	valid := g.validateChatID(g.chatID)
 	if !valid {
@@ -293,6 +333,20 @@ func (g Group) Name() string {
 	return g.name
 }
 
+func (g Group) Description() string {
+	return g.description
+}
+
+func (g Group) Image() []byte {
+	return g.image
+}
+
+// Owner returns the admin who can remove other admins and transfer the
+// ownership of the group
+func (g Group) Owner() string {
+	return g.owner
+}
+
 func (g Group) Events() []MembershipUpdateEvent {
 	return g.events
 }
@@ -326,9 +380,31 @@ func (g *Group) ProcessEvent(event MembershipUpdateEvent) error {
 	// Check if exists
 	g.events = append(g.events, event)
 	g.processEvent(event)
+	g.dropReplacedImageEvents()
 	return nil
 }
 
+// dropReplacedImageEvents removes the image changes followed by a later one.
+// The events are sent along with every membership update, only the current
+// image is kept so that they stay well under the size limit of a message
+func (g *Group) dropReplacedImageEvents() {
+	last := -1
+	for i, event := range g.events {
+		if event.Type == protobuf.MembershipUpdateEvent_IMAGE_CHANGED {
+			last = i
+		}
+	}
+
+	events := make([]MembershipUpdateEvent, 0, len(g.events))
+	for i, event := range g.events {
+		if event.Type == protobuf.MembershipUpdateEvent_IMAGE_CHANGED && i != last {
+			continue
+		}
+		events = append(events, event)
+	}
+	g.events = events
+}
+
 func (g Group) LastClockValue() uint64 {
 	if len(g.events) == 0 {
 		return 0
@@ -371,6 +447,8 @@ func (g Group) validateEvent(event MembershipUpdateEvent) bool {
 		return g.admins.Empty() && g.members.Empty()
 	case protobuf.MembershipUpdateEvent_NAME_CHANGED:
 		return g.admins.Has(event.From) && len(event.Name) > 0
+	case protobuf.MembershipUpdateEvent_DESCRIPTION_CHANGED, protobuf.MembershipUpdateEvent_IMAGE_CHANGED:
+		return g.admins.Has(event.From)
 	case protobuf.MembershipUpdateEvent_MEMBERS_ADDED:
 		return g.admins.Has(event.From)
 	case protobuf.MembershipUpdateEvent_MEMBER_JOINED:
@@ -381,7 +459,12 @@ func (g Group) validateEvent(event MembershipUpdateEvent) bool {
 	case protobuf.MembershipUpdateEvent_ADMINS_ADDED:
 		return g.admins.Has(event.From) && stringSliceSubset(event.Members, g.members.List())
 	case protobuf.MembershipUpdateEvent_ADMIN_REMOVED:
-		return len(event.Members) == 1 && g.admins.Has(event.From) && event.From == event.Members[0]
+		// The owner has to transfer the ownership before not being an admin
+		// anymore. Admins can remove themselves, only the owner can remove
+		// other admins
+		return len(event.Members) == 1 && g.admins.Has(event.Members[0]) && event.Members[0] != g.owner && (event.From == event.Members[0] || event.From == g.owner)
+	case protobuf.MembershipUpdateEvent_OWNERSHIP_TRANSFERRED:
+		return len(event.Members) == 1 && event.From == g.owner && event.Members[0] != g.owner && g.members.Has(event.Members[0])
 	default:
 		return false
 	}
@@ -394,26 +477,55 @@ func (g *Group) processEvent(event MembershipUpdateEvent) {
 		g.members.Add(event.From)
 		g.joined.Add(event.From)
 		g.admins.Add(event.From)
+		g.owner = event.From
 	case protobuf.MembershipUpdateEvent_NAME_CHANGED:
 		g.name = event.Name
+	case protobuf.MembershipUpdateEvent_DESCRIPTION_CHANGED:
+		g.description = event.Description
+	case protobuf.MembershipUpdateEvent_IMAGE_CHANGED:
+		g.image = event.Image
 	case protobuf.MembershipUpdateEvent_ADMINS_ADDED:
 		g.admins.Add(event.Members...)
 	case protobuf.MembershipUpdateEvent_ADMIN_REMOVED:
 		g.admins.Remove(event.Members[0])
+	case protobuf.MembershipUpdateEvent_OWNERSHIP_TRANSFERRED:
+		g.owner = event.Members[0]
+		g.admins.Add(event.Members[0])
 	case protobuf.MembershipUpdateEvent_MEMBERS_ADDED:
 		g.members.Add(event.Members...)
 	case protobuf.MembershipUpdateEvent_MEMBER_REMOVED:
 		g.admins.Remove(event.Members[0])
 		g.joined.Remove(event.Members[0])
 		g.members.Remove(event.Members[0])
+		if event.Members[0] == g.owner {
+			g.promoteSuccessor()
+		}
 	case protobuf.MembershipUpdateEvent_MEMBER_JOINED:
 		g.joined.Add(event.From)
 	}
 }
 
+// promoteSuccessor makes the admin who has been one the longest the owner of
+// the group once the owner left it, or the member who has been one the
+// longest if no admin is left
+func (g *Group) promoteSuccessor() {
+	g.owner = ""
+	if !g.admins.Empty() {
+		g.owner = g.admins.List()[0]
+	} else if !g.members.Empty() {
+		g.owner = g.members.List()[0]
+		g.admins.Add(g.owner)
+	}
+}
+
+// sortEvents sorts the events by clock value, the ones with the same clock
+// value by signature so that all the members apply them in the same order
 func (g *Group) sortEvents() {
 	sort.Slice(g.events, func(i, j int) bool {
-		return g.events[i].ClockValue < g.events[j].ClockValue
+		if g.events[i].ClockValue != g.events[j].ClockValue {
+			return g.events[i].ClockValue < g.events[j].ClockValue
+		}
+		return bytes.Compare(g.events[i].Signature, g.events[j].Signature) < 0
 	})
 }
 
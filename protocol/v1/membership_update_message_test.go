@@ -1,6 +1,7 @@
 package protocol
 
 import (
+	"crypto/ecdsa"
 	"testing"
 
 	"github.com/golang/protobuf/proto"
@@ -80,6 +81,10 @@ func TestGroupProcessEvent(t *testing.T) {
 			members: newStringSetFromSlice(members),
 		}
 	}
+	withOwner := func(g Group, owner string) Group {
+		g.owner = owner
+		return g
+	}
 
 	testCases := []struct {
 		Name   string
@@ -91,7 +96,7 @@ func TestGroupProcessEvent(t *testing.T) {
 		{
 			Name:   "chat-created event",
 			Group:  createGroup(nil, nil, nil, ""),
-			Result: createGroup([]string{"0xabc"}, []string{"0xabc"}, []string{"0xabc"}, "some-name"),
+			Result: withOwner(createGroup([]string{"0xabc"}, []string{"0xabc"}, []string{"0xabc"}, "some-name"), "0xabc"),
 			From:   "0xabc",
 			Event:  NewChatCreatedEvent("some-name", 0),
 		},
@@ -137,6 +142,27 @@ func TestGroupProcessEvent(t *testing.T) {
 			From:   "0xdef",
 			Event:  NewMemberJoinedEvent(0),
 		},
+		{
+			Name:   "ownership-transferred event",
+			Group:  withOwner(createGroup([]string{"0xabc"}, []string{"0xabc", "0xdef"}, nil, ""), "0xabc"),
+			Result: withOwner(createGroup([]string{"0xabc", "0xdef"}, []string{"0xabc", "0xdef"}, nil, ""), "0xdef"),
+			From:   "0xabc",
+			Event:  NewOwnershipTransferredEvent("0xdef", 0),
+		},
+		{
+			Name:   "member-removed event promotes the oldest admin when the owner leaves",
+			Group:  withOwner(createGroup([]string{"0xabc", "0xdef"}, []string{"0xabc", "0x123", "0xdef"}, nil, ""), "0xabc"),
+			Result: withOwner(createGroup([]string{"0xdef"}, []string{"0x123", "0xdef"}, nil, ""), "0xdef"),
+			From:   "0xabc",
+			Event:  NewMemberRemovedEvent("0xabc", 0),
+		},
+		{
+			Name:   "member-removed event promotes the oldest member when the last admin leaves",
+			Group:  withOwner(createGroup([]string{"0xabc"}, []string{"0xabc", "0x123", "0xdef"}, nil, ""), "0xabc"),
+			Result: withOwner(createGroup([]string{"0x123"}, []string{"0x123", "0xdef"}, nil, ""), "0x123"),
+			From:   "0xabc",
+			Event:  NewMemberRemovedEvent("0xabc", 0),
+		},
 	}
 
 	for _, tc := range testCases {
@@ -156,6 +182,11 @@ func TestGroupValidateEvent(t *testing.T) {
 			members: newStringSetFromSlice(members),
 		}
 	}
+	createGroupWithOwner := func(owner string, admins, members []string) Group {
+		g := createGroup(admins, members)
+		g.owner = owner
+		return g
+	}
 
 	testCases := []struct {
 		Name   string
@@ -199,6 +230,34 @@ func TestGroupValidateEvent(t *testing.T) {
 			Event:  NewNameChangedEvent("new-name", 0),
 			Result: false,
 		},
+		{
+			Name:   "description-changed allowed because from is admin",
+			From:   "0xabc",
+			Group:  createGroup([]string{"0xabc"}, nil),
+			Event:  NewDescriptionChangedEvent("new-description", 0),
+			Result: true,
+		},
+		{
+			Name:   "description-changed not allowed for non-admins",
+			From:   "0xabc",
+			Group:  createGroup(nil, []string{"0xabc"}),
+			Event:  NewDescriptionChangedEvent("new-description", 0),
+			Result: false,
+		},
+		{
+			Name:   "image-changed allowed because from is admin",
+			From:   "0xabc",
+			Group:  createGroup([]string{"0xabc"}, nil),
+			Event:  NewImageChangedEvent([]byte("new-image"), 0),
+			Result: true,
+		},
+		{
+			Name:   "image-changed not allowed for non-admins",
+			From:   "0xabc",
+			Group:  createGroup(nil, []string{"0xabc"}),
+			Event:  NewImageChangedEvent([]byte("new-image"), 0),
+			Result: false,
+		},
 		{
 			Name:   "members-added allowed because from is admin",
 			From:   "0xabc",
@@ -297,6 +356,48 @@ func TestGroupValidateEvent(t *testing.T) {
 			Event:  NewAdminRemovedEvent("0xabc", 0),
 			Result: false,
 		},
+		{
+			Name:   "admin-removed allowed because from is the owner",
+			From:   "0xabc",
+			Group:  createGroupWithOwner("0xabc", []string{"0xabc", "0xdef"}, []string{"0xabc", "0xdef"}),
+			Event:  NewAdminRemovedEvent("0xdef", 0),
+			Result: true,
+		},
+		{
+			Name:   "admin-removed not allowed for the owner",
+			From:   "0xabc",
+			Group:  createGroupWithOwner("0xabc", []string{"0xabc", "0xdef"}, []string{"0xabc", "0xdef"}),
+			Event:  NewAdminRemovedEvent("0xabc", 0),
+			Result: false,
+		},
+		{
+			Name:   "admin-removed not allowed because removed is not admin",
+			From:   "0xabc",
+			Group:  createGroupWithOwner("0xabc", []string{"0xabc"}, []string{"0xabc", "0xdef"}),
+			Event:  NewAdminRemovedEvent("0xdef", 0),
+			Result: false,
+		},
+		{
+			Name:   "ownership-transferred allowed because from is the owner",
+			From:   "0xabc",
+			Group:  createGroupWithOwner("0xabc", []string{"0xabc"}, []string{"0xabc", "0xdef"}),
+			Event:  NewOwnershipTransferredEvent("0xdef", 0),
+			Result: true,
+		},
+		{
+			Name:   "ownership-transferred not allowed for admins",
+			From:   "0xabc",
+			Group:  createGroupWithOwner("0x123", []string{"0x123", "0xabc"}, []string{"0x123", "0xabc", "0xdef"}),
+			Event:  NewOwnershipTransferredEvent("0xdef", 0),
+			Result: false,
+		},
+		{
+			Name:   "ownership-transferred not allowed because not in members",
+			From:   "0xabc",
+			Group:  createGroupWithOwner("0xabc", []string{"0xabc"}, []string{"0xabc"}),
+			Event:  NewOwnershipTransferredEvent("0xdef", 0),
+			Result: false,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -308,6 +409,82 @@ func TestGroupValidateEvent(t *testing.T) {
 	}
 }
 
+func TestGroupConflictingEvents(t *testing.T) {
+	creatorKey, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	adminKey, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	creator := publicKeyToString(&creatorKey.PublicKey)
+	admin := publicKeyToString(&adminKey.PublicKey)
+	chatID := groupChatID(&creatorKey.PublicKey)
+
+	signedEvent := func(event MembershipUpdateEvent, key *ecdsa.PrivateKey) MembershipUpdateEvent {
+		event.ChatID = chatID
+		require.NoError(t, event.Sign(key))
+		return event
+	}
+
+	events := []MembershipUpdateEvent{
+		signedEvent(NewChatCreatedEvent("test", 1), creatorKey),
+		signedEvent(NewMembersAddedEvent([]string{admin}, 2), creatorKey),
+		signedEvent(NewAdminsAddedEvent([]string{admin}, 3), creatorKey),
+		signedEvent(NewAdminRemovedEvent(admin, 4), creatorKey),
+		// Sent concurrently, before the admin received their removal
+		signedEvent(NewMembersAddedEvent([]string{"0xdef"}, 5), adminKey),
+	}
+
+	// Events are applied in the same order whatever order they are received in
+	reversed := make([]MembershipUpdateEvent, 0, len(events))
+	for i := len(events) - 1; i >= 0; i-- {
+		reversed = append(reversed, events[i])
+	}
+
+	for _, received := range [][]MembershipUpdateEvent{events, reversed} {
+		g, err := NewGroupWithEvents(chatID, append([]MembershipUpdateEvent(nil), received...))
+		require.NoError(t, err)
+		require.Equal(t, []string{creator}, g.Admins())
+		require.Equal(t, []string{creator, admin}, g.Members())
+		require.Equal(t, creator, g.Owner())
+		require.Len(t, g.Events(), 4)
+	}
+
+	// A successor is promoted once the owner leaves
+	g, err := NewGroupWithEvents(chatID, append(events[:2:2], signedEvent(NewMemberRemovedEvent(creator, 3), creatorKey)))
+	require.NoError(t, err)
+	require.Equal(t, []string{admin}, g.Admins())
+	require.Equal(t, admin, g.Owner())
+}
+
+func TestGroupReplacedImageEvents(t *testing.T) {
+	creatorKey, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	chatID := groupChatID(&creatorKey.PublicKey)
+
+	signedEvent := func(event MembershipUpdateEvent) MembershipUpdateEvent {
+		event.ChatID = chatID
+		require.NoError(t, event.Sign(creatorKey))
+		return event
+	}
+
+	// Only the latest image is kept in the events sent to the members
+	g, err := NewGroupWithEvents(chatID, []MembershipUpdateEvent{
+		signedEvent(NewChatCreatedEvent("test", 1)),
+		signedEvent(NewImageChangedEvent([]byte("image-1"), 2)),
+		signedEvent(NewNameChangedEvent("new-name", 3)),
+		signedEvent(NewImageChangedEvent([]byte("image-2"), 4)),
+	})
+	require.NoError(t, err)
+	require.Equal(t, []byte("image-2"), g.Image())
+	require.Len(t, g.Events(), 3)
+	require.Equal(t, protobuf.MembershipUpdateEvent_IMAGE_CHANGED, g.Events()[2].Type)
+
+	err = g.ProcessEvent(signedEvent(NewImageChangedEvent([]byte("image-3"), 5)))
+	require.NoError(t, err)
+	require.Equal(t, []byte("image-3"), g.Image())
+	require.Len(t, g.Events(), 3)
+	require.Equal(t, []byte("image-3"), g.Events()[2].Image)
+}
+
 func TestMembershipUpdateEventEqual(t *testing.T) {
 	u1 := MembershipUpdateEvent{
 		Type:       protobuf.MembershipUpdateEvent_CHAT_CREATED,
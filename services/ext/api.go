@@ -16,6 +16,7 @@ import (
 	"github.com/status-im/status-go/mailserver"
 	"github.com/status-im/status-go/protocol"
 	"github.com/status-im/status-go/protocol/encryption/multidevice"
+	"github.com/status-im/status-go/protocol/protobuf"
 	"github.com/status-im/status-go/protocol/transport"
 	"github.com/status-im/status-go/services/ext/mailservers"
 )
@@ -222,6 +223,101 @@ func (api *PublicAPI) AddAdminsToGroupChat(ctx Context, chatID string, members [
 	return api.service.messenger.AddAdminsToGroupChat(ctx, chatID, members)
 }
 
+// RemoveAdminFromGroupChat removes an admin of a group chat, who stays a member
+func (api *PublicAPI) RemoveAdminFromGroupChat(ctx Context, chatID string, admin string) (*protocol.MessengerResponse, error) {
+	return api.service.messenger.RemoveAdminFromGroupChat(ctx, chatID, admin)
+}
+
+// TransferGroupChatOwnership makes a member of a group chat its owner
+func (api *PublicAPI) TransferGroupChatOwnership(ctx Context, chatID string, owner string) (*protocol.MessengerResponse, error) {
+	return api.service.messenger.TransferGroupChatOwnership(ctx, chatID, owner)
+}
+
+// ChangeGroupChatDescription changes the description of a group chat, only admins can change it
+func (api *PublicAPI) ChangeGroupChatDescription(ctx Context, chatID string, description string) (*protocol.MessengerResponse, error) {
+	return api.service.messenger.ChangeGroupChatDescription(ctx, chatID, description)
+}
+
+// ChangeGroupChatImage changes the image of a group chat to the one at imagePath, an empty path removing it
+func (api *PublicAPI) ChangeGroupChatImage(ctx Context, chatID string, imagePath string) (*protocol.MessengerResponse, error) {
+	return api.service.messenger.ChangeGroupChatImage(ctx, chatID, imagePath)
+}
+
+// CreateGroupChatInvitation creates an invitation to join a group chat, valid for validity milliseconds
+func (api *PublicAPI) CreateGroupChatInvitation(chatID string, validity uint64) (*protocol.GroupChatInvitation, error) {
+	return api.service.messenger.CreateGroupChatInvitation(chatID, validity)
+}
+
+// RevokeGroupChatInvitation revokes an invitation to join a group chat
+func (api *PublicAPI) RevokeGroupChatInvitation(ctx Context, invitationID string) error {
+	return api.service.messenger.RevokeGroupChatInvitation(ctx, invitationID)
+}
+
+// RedeemGroupChatInvitation sends a request to join a group chat to its admins
+func (api *PublicAPI) RedeemGroupChatInvitation(ctx Context, code string) error {
+	return api.service.messenger.RedeemGroupChatInvitation(ctx, code)
+}
+
+// PendingGroupChatJoinRequests returns the join requests of a group chat that have not been answered yet
+func (api *PublicAPI) PendingGroupChatJoinRequests(chatID string) ([]*protocol.GroupChatJoinRequest, error) {
+	return api.service.messenger.PendingGroupChatJoinRequests(chatID)
+}
+
+// ApproveGroupChatJoinRequest adds the author of a join request to the group chat
+func (api *PublicAPI) ApproveGroupChatJoinRequest(ctx Context, chatID string, from string) (*protocol.MessengerResponse, error) {
+	return api.service.messenger.ApproveGroupChatJoinRequest(ctx, chatID, from)
+}
+
+// RejectGroupChatJoinRequest rejects a join request, the other admins of the group chat are told
+func (api *PublicAPI) RejectGroupChatJoinRequest(ctx Context, chatID string, from string) error {
+	return api.service.messenger.RejectGroupChatJoinRequest(ctx, chatID, from)
+}
+
+// CreateCommunity creates a community owned by the user
+func (api *PublicAPI) CreateCommunity(ctx Context, name string, description string, access protobuf.CommunityDescription_Access) (*protocol.MessengerResponse, error) {
+	return api.service.messenger.CreateCommunity(ctx, name, description, access)
+}
+
+// CreateCommunityChannel adds a channel to a community owned by the user
+func (api *PublicAPI) CreateCommunityChannel(ctx Context, communityID string, name string, description string) (*protocol.MessengerResponse, error) {
+	return api.service.messenger.CreateCommunityChannel(ctx, communityID, name, description)
+}
+
+// Communities returns the communities known to the user
+func (api *PublicAPI) Communities(parent context.Context) ([]*protocol.Community, error) {
+	return api.service.messenger.Communities()
+}
+
+// RequestToJoinCommunity sends a request to join a community to its owner
+func (api *PublicAPI) RequestToJoinCommunity(ctx Context, communityID string) error {
+	return api.service.messenger.RequestToJoinCommunity(ctx, communityID)
+}
+
+// PendingCommunityRequestsToJoin returns the requests to join a community that have not been answered yet
+func (api *PublicAPI) PendingCommunityRequestsToJoin(communityID string) ([]*protocol.CommunityRequestToJoin, error) {
+	return api.service.messenger.PendingCommunityRequestsToJoin(communityID)
+}
+
+// AcceptCommunityRequestToJoin adds the author of a request to join to the community
+func (api *PublicAPI) AcceptCommunityRequestToJoin(ctx Context, communityID string, from string) (*protocol.MessengerResponse, error) {
+	return api.service.messenger.AcceptCommunityRequestToJoin(ctx, communityID, from)
+}
+
+// DeclineCommunityRequestToJoin declines a request to join a community
+func (api *PublicAPI) DeclineCommunityRequestToJoin(communityID string, from string) error {
+	return api.service.messenger.DeclineCommunityRequestToJoin(communityID, from)
+}
+
+// RemoveUserFromCommunity removes a member from a community and rotates its key
+func (api *PublicAPI) RemoveUserFromCommunity(ctx Context, communityID string, member string) (*protocol.MessengerResponse, error) {
+	return api.service.messenger.RemoveUserFromCommunity(ctx, communityID, member)
+}
+
+// LeaveCommunity leaves a community
+func (api *PublicAPI) LeaveCommunity(ctx Context, communityID string) (*protocol.MessengerResponse, error) {
+	return api.service.messenger.LeaveCommunity(ctx, communityID)
+}
+
 func (api *PublicAPI) ConfirmJoiningGroup(ctx context.Context, chatID string) (*protocol.MessengerResponse, error) {
 	return api.service.messenger.ConfirmJoiningGroup(ctx, chatID)
 }
@@ -243,6 +339,18 @@ func (api *PublicAPI) DeleteChat(parent context.Context, chatID string) error {
 	return api.service.messenger.DeleteChat(chatID)
 }
 
+func (api *PublicAPI) MuteChat(ctx context.Context, chatID string, mutedUntil uint64) (*protocol.MessengerResponse, error) {
+	return api.service.messenger.MuteChat(ctx, chatID, mutedUntil)
+}
+
+func (api *PublicAPI) UnmuteChat(ctx context.Context, chatID string) (*protocol.MessengerResponse, error) {
+	return api.service.messenger.UnmuteChat(ctx, chatID)
+}
+
+func (api *PublicAPI) SetChatNotificationLevel(ctx context.Context, chatID string, level protocol.ChatNotificationLevel) (*protocol.MessengerResponse, error) {
+	return api.service.messenger.SetChatNotificationLevel(ctx, chatID, level)
+}
+
 func (api *PublicAPI) SaveContact(parent context.Context, contact *protocol.Contact) error {
 	return api.service.messenger.SaveContact(contact)
 }
@@ -256,6 +364,41 @@ func (api *PublicAPI) Contacts(parent context.Context) []*protocol.Contact {
 	return api.service.messenger.Contacts()
 }
 
+// SetContactLocalNickname sets the nickname a contact is shown with, it's only synced with our paired installations
+func (api *PublicAPI) SetContactLocalNickname(parent context.Context, contactID string, nickname string) (*protocol.Contact, error) {
+	return api.service.messenger.SetContactLocalNickname(contactID, nickname)
+}
+
+// SendContactRequest adds the contact and asks to be added back
+func (api *PublicAPI) SendContactRequest(ctx context.Context, contactID string, text string) (*protocol.MessengerResponse, error) {
+	return api.service.messenger.SendContactRequest(ctx, contactID, text)
+}
+
+// AcceptContactRequest adds the author of a pending contact request
+func (api *PublicAPI) AcceptContactRequest(ctx context.Context, contactID string) (*protocol.MessengerResponse, error) {
+	return api.service.messenger.AcceptContactRequest(ctx, contactID)
+}
+
+// DeclineContactRequest removes a pending contact request
+func (api *PublicAPI) DeclineContactRequest(ctx context.Context, contactID string) (*protocol.MessengerResponse, error) {
+	return api.service.messenger.DeclineContactRequest(ctx, contactID)
+}
+
+// RetractContactRequest withdraws a contact request we sent
+func (api *PublicAPI) RetractContactRequest(ctx context.Context, contactID string) (*protocol.MessengerResponse, error) {
+	return api.service.messenger.RetractContactRequest(ctx, contactID)
+}
+
+// PendingContactRequests returns the contact requests not answered yet
+func (api *PublicAPI) PendingContactRequests(parent context.Context) []*protocol.Contact {
+	return api.service.messenger.PendingContactRequests()
+}
+
+// SetHideNonContactMessages turns hiding one-to-one messages from non-contacts on or off
+func (api *PublicAPI) SetHideNonContactMessages(enabled bool) error {
+	return api.service.messenger.SetHideNonContactMessages(enabled)
+}
+
 func (api *PublicAPI) RemoveFilters(parent context.Context, chats []*transport.Filter) error {
 	return api.service.messenger.RemoveFilters(chats)
 }
@@ -297,6 +440,123 @@ func (api *PublicAPI) ChatMessages(chatID, cursor string, limit int) (*Applicati
 	}, nil
 }
 
+func (api *PublicAPI) EditMessage(ctx context.Context, messageID, newText string) (*protocol.MessengerResponse, error) {
+	return api.service.messenger.EditMessage(ctx, messageID, newText)
+}
+
+// DeleteMessageForEveryone deletes a message sent by us for all the
+// participants of the chat, unlike DeleteMessage which only deletes it locally
+func (api *PublicAPI) DeleteMessageForEveryone(ctx context.Context, messageID string) (*protocol.MessengerResponse, error) {
+	return api.service.messenger.DeleteMessageForEveryone(ctx, messageID)
+}
+
+// SetDisappearingMessagesTimer sets the time in milliseconds after which the
+// messages of a one-to-one or private group chat are deleted, 0 to turn
+// disappearing messages off
+func (api *PublicAPI) SetDisappearingMessagesTimer(ctx context.Context, chatID string, timer uint64) (*protocol.MessengerResponse, error) {
+	return api.service.messenger.SetDisappearingMessagesTimer(ctx, chatID, timer)
+}
+
+// ScheduleChatMessage stores a message to be sent to its chat at sendAt,
+// in milliseconds
+func (api *PublicAPI) ScheduleChatMessage(message *protocol.Message, sendAt uint64) (*protocol.ScheduledMessage, error) {
+	return api.service.messenger.ScheduleChatMessage(message, sendAt)
+}
+
+func (api *PublicAPI) ScheduledMessages() ([]*protocol.ScheduledMessage, error) {
+	return api.service.messenger.ScheduledMessages()
+}
+
+// EditScheduledMessage changes the text and the time a scheduled message is
+// sent at, an empty text keeps the current text
+func (api *PublicAPI) EditScheduledMessage(id, newText string, sendAt uint64) (*protocol.ScheduledMessage, error) {
+	return api.service.messenger.EditScheduledMessage(id, newText, sendAt)
+}
+
+func (api *PublicAPI) CancelScheduledMessage(id string) error {
+	return api.service.messenger.CancelScheduledMessage(id)
+}
+
+// ExportChat exports the history of a chat as json, markdown or html, only
+// json exports can be imported back
+func (api *PublicAPI) ExportChat(chatID string, format protocol.ExportFormat) (string, error) {
+	data, err := api.service.messenger.ExportChat(chatID, format)
+	if err != nil {
+		return "", err
+	}
+	return string(data), nil
+}
+
+// ImportChat restores the messages of a json export, skipping the ones that
+// already exist
+func (api *PublicAPI) ImportChat(data string) (*protocol.MessengerResponse, error) {
+	return api.service.messenger.ImportChat([]byte(data))
+}
+
+// ForwardMessage sends a copy of a message to each of the chats, attributed
+// to the author of the original message
+func (api *PublicAPI) ForwardMessage(ctx context.Context, messageID string, chatIDs []string) (*protocol.MessengerResponse, error) {
+	return api.service.messenger.ForwardMessage(ctx, messageID, chatIDs)
+}
+
+func (api *PublicAPI) MessageEditHistory(messageID string) ([]*protocol.EditMessage, error) {
+	return api.service.messenger.MessageEditHistory(messageID)
+}
+
+type ApplicationSearchResultsResponse struct {
+	Results []*protocol.SearchResult `json:"results"`
+	Cursor  string                   `json:"cursor"`
+}
+
+func (api *PublicAPI) SearchMessages(query string, chatIDs []string, from, to uint64, cursor string, limit int) (*ApplicationSearchResultsResponse, error) {
+	results, cursor, err := api.service.messenger.SearchMessages(query, chatIDs, from, to, cursor, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ApplicationSearchResultsResponse{
+		Results: results,
+		Cursor:  cursor,
+	}, nil
+}
+
+type ApplicationPinnedMessagesResponse struct {
+	PinnedMessages []*protocol.PinnedMessage `json:"pinnedMessages"`
+	Cursor         string                    `json:"cursor"`
+}
+
+func (api *PublicAPI) ChatPinnedMessages(chatID, cursor string, limit int) (*ApplicationPinnedMessagesResponse, error) {
+	pinnedMessages, cursor, err := api.service.messenger.PinnedMessagesByChatID(chatID, cursor, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ApplicationPinnedMessagesResponse{
+		PinnedMessages: pinnedMessages,
+		Cursor:         cursor,
+	}, nil
+}
+
+func (api *PublicAPI) PinMessage(ctx context.Context, messageID string) (*protocol.MessengerResponse, error) {
+	return api.service.messenger.PinMessage(ctx, messageID)
+}
+
+func (api *PublicAPI) UnpinMessage(ctx context.Context, messageID string) (*protocol.MessengerResponse, error) {
+	return api.service.messenger.UnpinMessage(ctx, messageID)
+}
+
+func (api *PublicAPI) EmojiReactionsByChatID(chatID string, cursor string, limit int) ([]*protocol.EmojiReaction, error) {
+	return api.service.messenger.EmojiReactionsByChatID(chatID, cursor, limit)
+}
+
+func (api *PublicAPI) SendEmojiReaction(ctx context.Context, chatID, messageID string, emojiID protobuf.EmojiReaction_Type) (*protocol.MessengerResponse, error) {
+	return api.service.messenger.SendEmojiReaction(ctx, chatID, messageID, emojiID)
+}
+
+func (api *PublicAPI) SendEmojiReactionRetraction(ctx context.Context, emojiReactionID string) (*protocol.MessengerResponse, error) {
+	return api.service.messenger.SendEmojiReactionRetraction(ctx, emojiReactionID)
+}
+
 func (api *PublicAPI) StartMessenger() error {
 	return api.service.StartMessenger()
 }
@@ -313,6 +573,16 @@ func (api *PublicAPI) MarkMessagesSeen(chatID string, ids []string) error {
 	return api.service.messenger.MarkMessagesSeen(chatID, ids)
 }
 
+// SetSendReadReceipts turns read receipts on or off on all paired installations
+func (api *PublicAPI) SetSendReadReceipts(ctx context.Context, enabled bool) error {
+	return api.service.messenger.SetSendReadReceipts(ctx, enabled)
+}
+
+// SendTypingStatus tells the members of the chat that we started or stopped typing
+func (api *PublicAPI) SendTypingStatus(chatID string, typing bool) error {
+	return api.service.messenger.SendTypingStatus(chatID, typing)
+}
+
 func (api *PublicAPI) MarkAllRead(chatID string) error {
 	return api.service.messenger.MarkAllRead(chatID)
 }
@@ -325,6 +595,54 @@ func (api *PublicAPI) SendChatMessage(ctx context.Context, message *protocol.Mes
 	return api.service.messenger.SendChatMessage(ctx, message)
 }
 
+// SendAudioMessage sends a voice message to the chat
+func (api *PublicAPI) SendAudioMessage(ctx context.Context, chatID string, payload []byte, audioType protobuf.AudioMessage_AudioType, durationMs uint64) (*protocol.MessengerResponse, error) {
+	return api.service.messenger.SendAudioMessage(ctx, chatID, payload, audioType, durationMs)
+}
+
+// CreatePoll sends a poll to a chat, closesAt is the time in milliseconds after which votes are ignored
+func (api *PublicAPI) CreatePoll(ctx context.Context, chatID string, question string, options []string, multipleChoice bool, closesAt uint64) (*protocol.MessengerResponse, error) {
+	return api.service.messenger.CreatePoll(ctx, chatID, question, options, multipleChoice, closesAt)
+}
+
+// Vote votes on a poll, options are the indexes of the options chosen
+func (api *PublicAPI) Vote(ctx context.Context, messageID string, options []uint32) (*protocol.MessengerResponse, error) {
+	return api.service.messenger.Vote(ctx, messageID, options)
+}
+
+// PollResults returns the tally of the votes on a poll
+func (api *PublicAPI) PollResults(messageID string) (*protocol.PollResults, error) {
+	return api.service.messenger.PollResults(messageID)
+}
+
+// SendStatusUpdate posts a status update on our timeline
+func (api *PublicAPI) SendStatusUpdate(ctx context.Context, text string) (*protocol.MessengerResponse, error) {
+	return api.service.messenger.SendStatusUpdate(ctx, text)
+}
+
+// SendStatusUpdateComment comments on a status update
+func (api *PublicAPI) SendStatusUpdateComment(ctx context.Context, messageID, text string) (*protocol.MessengerResponse, error) {
+	return api.service.messenger.SendStatusUpdateComment(ctx, messageID, text)
+}
+
+// Timeline returns the status updates posted by us and our contacts, most recent first
+func (api *PublicAPI) Timeline(cursor string, limit int) (*ApplicationMessagesResponse, error) {
+	messages, cursor, err := api.service.messenger.Timeline(cursor, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ApplicationMessagesResponse{
+		Messages: messages,
+		Cursor:   cursor,
+	}, nil
+}
+
+// StatusUpdateComments returns the comments on a status update
+func (api *PublicAPI) StatusUpdateComments(messageID string) ([]*protocol.Message, error) {
+	return api.service.messenger.StatusUpdateComments(messageID)
+}
+
 func (api *PublicAPI) ReSendChatMessage(ctx context.Context, messageID string) error {
 	return api.service.messenger.ReSendChatMessage(ctx, messageID)
 }
@@ -361,6 +679,16 @@ func (api *PublicAPI) SendContactUpdates(ctx context.Context, name, picture stri
 	return api.service.messenger.SendContactUpdates(ctx, name, picture)
 }
 
+// Profile returns our profile
+func (api *PublicAPI) Profile() protocol.Profile {
+	return api.service.messenger.Profile()
+}
+
+// SetProfile updates our profile and sends it to our contacts and paired devices
+func (api *PublicAPI) SetProfile(ctx context.Context, profile protocol.Profile) error {
+	return api.service.messenger.SetProfile(ctx, profile)
+}
+
 func (api *PublicAPI) SendContactUpdate(ctx context.Context, contactID, name, picture string) (*protocol.MessengerResponse, error) {
 	return api.service.messenger.SendContactUpdate(ctx, contactID, name, picture)
 }
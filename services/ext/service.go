@@ -181,6 +181,9 @@ func (s *Service) retrieveMessagesLoop(tick time.Duration, cancel <-chan struct{
 				log.Error("failed to retrieve raw messages", "err", err)
 				continue
 			}
+			if len(response.TypingStatuses) != 0 {
+				PublisherSignalHandler{}.TypingStatuses(response.TypingStatuses)
+			}
 			if !response.IsEmpty() {
 				PublisherSignalHandler{}.NewMessages(response)
 			}
@@ -448,12 +451,19 @@ func buildMessengerOptions(
 		protocol.WithDatabase(db),
 		protocol.WithEnvelopesMonitorConfig(envelopesMonitorConfig),
 		protocol.WithOnNegotiatedFilters(onNegotiatedFilters),
+		protocol.WithLinkPreviews(accounts.NewDB(db).GetLinkPreviewAllowedDomains),
+		protocol.WithReadReceiptsSettings(accounts.NewDB(db)),
+		protocol.WithContactRequestsSettings(accounts.NewDB(db)),
 	}
 
 	if config.DataSyncEnabled {
 		options = append(options, protocol.WithDatasync())
 	}
 
+	if config.MaxMessageSize > 0 {
+		options = append(options, protocol.WithMaxMessageChunkSize(protocol.MaxMessageChunkSize(config.MaxMessageSize)))
+	}
+
 	if config.VerifyTransactionURL != "" {
 		client := &verifyTransactionClient{
 			url:     config.VerifyTransactionURL,
@@ -48,3 +48,7 @@ func (h PublisherSignalHandler) FilterAdded(filters []*signal.Filter) {
 func (h PublisherSignalHandler) NewMessages(response *protocol.MessengerResponse) {
 	signal.SendNewMessages(response)
 }
+
+func (h PublisherSignalHandler) TypingStatuses(statuses []*protocol.TypingStatus) {
+	signal.SendTypingStatuses(statuses)
+}
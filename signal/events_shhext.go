@@ -38,6 +38,9 @@ const (
 
 	// EventNewMessages is triggered when we receive new messages
 	EventNewMessages = "messages.new"
+
+	// EventTypingStatuses is triggered when contacts start or stop typing
+	EventTypingStatuses = "messages.typing"
 )
 
 // EnvelopeSignal includes hash of the envelope.
@@ -60,6 +63,11 @@ type DecryptMessageFailedSignal struct {
 	Sender string `json:"sender"`
 }
 
+// TypingStatusesSignal holds the typing statuses received from contacts
+type TypingStatusesSignal struct {
+	TypingStatuses []*statusproto.TypingStatus `json:"typingStatuses"`
+}
+
 // BundleAddedSignal holds the identity and installation id of the user
 type BundleAddedSignal struct {
 	Identity       string `json:"identity"`
@@ -161,3 +169,7 @@ func SendWhisperFilterAdded(filters []*Filter) {
 func SendNewMessages(response *statusproto.MessengerResponse) {
 	send(EventNewMessages, response)
 }
+
+func SendTypingStatuses(statuses []*statusproto.TypingStatus) {
+	send(EventTypingStatuses, TypingStatusesSignal{TypingStatuses: statuses})
+}